@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+
+	clobClient "github.com/juninhoitabh/clob-go/pkg/clob-client"
+)
+
+func (c *cli) account(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: missing account command", errUsage)
+	}
+
+	switch args[0] {
+	case "create":
+		return c.accountCreate(ctx, args[1:])
+	case "credit":
+		return c.accountCredit(ctx, args[1:])
+	case "balances":
+		return c.accountBalances(ctx, args[1:])
+	default:
+		return fmt.Errorf("%w: unknown account command %q", errUsage, args[0])
+	}
+}
+
+func (c *cli) accountCreate(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: account create <name>", errUsage)
+	}
+
+	created, err := c.client.CreateAccount(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	return c.print(created, func(w io.Writer) {
+		fmt.Fprintln(w, "ACCOUNT_ID\tAPI_KEY\tAPI_SECRET")
+		fmt.Fprintf(w, "%s\t%s\t%s\n", created.AccountID, created.APIKey, created.APISecret)
+	})
+}
+
+func (c *cli) accountCredit(ctx context.Context, args []string) error {
+	if len(args) != 3 {
+		return fmt.Errorf("%w: account credit <account_id> <asset> <amount>", errUsage)
+	}
+
+	amount, err := strconv.ParseInt(args[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: amount %q is not an integer", errUsage, args[2])
+	}
+
+	acct, err := c.client.Credit(ctx, args[0], args[1], amount)
+	if err != nil {
+		return err
+	}
+
+	return c.print(acct, func(w io.Writer) {
+		writeBalances(w, acct.AccountID, acct.Balances)
+	})
+}
+
+func (c *cli) accountBalances(ctx context.Context, args []string) error {
+	flags := c.flagSet("account balances")
+	aggregated := flags.Bool("aggregated", false, "add up the balances of the account's sub-accounts")
+
+	err := c.parse(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%w: account balances [-aggregated] <account_id>", errUsage)
+	}
+
+	if *aggregated {
+		acct, err := c.client.GetAggregatedBalances(ctx, flags.Arg(0))
+		if err != nil {
+			return err
+		}
+
+		return c.print(acct, func(w io.Writer) {
+			writeBalances(w, acct.AccountID, acct.Balances)
+		})
+	}
+
+	acct, err := c.client.GetAccount(ctx, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	return c.print(acct, func(w io.Writer) {
+		writeBalances(w, acct.AccountID, acct.Balances)
+	})
+}
+
+func writeBalances(w io.Writer, accountID string, balances map[string]clobClient.Balance) {
+	assets := make([]string, 0, len(balances))
+	for asset := range balances {
+		assets = append(assets, asset)
+	}
+
+	slices.Sort(assets)
+
+	fmt.Fprintln(w, "ACCOUNT_ID\tASSET\tAVAILABLE\tRESERVED")
+
+	for _, asset := range assets {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\n", accountID, asset, balances[asset].Available, balances[asset].Reserved)
+	}
+}
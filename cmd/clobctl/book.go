@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+
+	clobClient "github.com/juninhoitabh/clob-go/pkg/clob-client"
+)
+
+func (c *cli) book(ctx context.Context, args []string) error {
+	flags := c.flagSet("book")
+	depth := flags.Int("depth", 10, "price levels shown per side; 0 shows all")
+
+	err := c.parse(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 || *depth < 0 {
+		return fmt.Errorf("%w: book [-depth <n>] <instrument>", errUsage)
+	}
+
+	book, err := c.client.GetBook(ctx, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if *depth > 0 {
+		book.Bids = book.Bids[:min(*depth, len(book.Bids))]
+		book.Asks = book.Asks[:min(*depth, len(book.Asks))]
+	}
+
+	return c.print(book, func(w io.Writer) {
+		writeLadder(w, book)
+	})
+}
+
+// writeLadder prints the book as a depth ladder: asks above bids, both from
+// the highest price down, so the best ask and best bid meet at the spread.
+// TOTAL is the quantity available from the best price up to that level.
+func writeLadder(w io.Writer, book *clobClient.Book) {
+	status := ""
+	if book.Halted {
+		status = " (halted)"
+	}
+
+	fmt.Fprintf(w, "%s%s\tlast %d\t\t\n", book.Instrument, status, book.LastPrice)
+	fmt.Fprintln(w, "SIDE\tPRICE\tQTY\tTOTAL")
+
+	asks := make([]string, len(book.Asks))
+	total := int64(0)
+
+	for i, level := range book.Asks {
+		total += level.Qty
+		asks[i] = fmt.Sprintf("ask\t%d\t%d\t%d", level.Price, level.Qty, total)
+	}
+
+	slices.Reverse(asks)
+
+	for _, line := range asks {
+		fmt.Fprintln(w, line)
+	}
+
+	if len(book.Bids) > 0 && len(book.Asks) > 0 {
+		fmt.Fprintf(w, "---\tspread %d\t\t\n", book.Asks[0].Price-book.Bids[0].Price)
+	} else {
+		fmt.Fprintln(w, "---\t\t\t")
+	}
+
+	total = 0
+
+	for _, level := range book.Bids {
+		total += level.Qty
+		fmt.Fprintf(w, "bid\t%d\t%d\t%d\n", level.Price, level.Qty, total)
+	}
+}
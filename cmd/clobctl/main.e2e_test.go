@@ -0,0 +1,241 @@
+//go:build all || e2e
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
+	"github.com/juninhoitabh/clob-go/internal/infra/http-server/router"
+	"github.com/juninhoitabh/clob-go/internal/infra/http-server/router/routes"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesAPIKey "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey"
+	repositoriesBook "github.com/juninhoitabh/clob-go/internal/infra/repositories/book"
+	repositoriesOrder "github.com/juninhoitabh/clob-go/internal/infra/repositories/order"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
+	clobClient "github.com/juninhoitabh/clob-go/pkg/clob-client"
+)
+
+const (
+	adminAPIKeyTest    = "clobctl-admin-key"
+	adminAPISecretTest = "clobctl-admin-secret"
+)
+
+type ClobctlE2ETestSuite struct {
+	suite.Suite
+	server *httptest.Server
+}
+
+func (suite *ClobctlE2ETestSuite) SetupSuite() {
+	t := suite.T()
+
+	t.Setenv("AUTH_ENABLED", "true")
+	t.Setenv("RATE_LIMIT_ENABLED", "false")
+	t.Setenv("ADMIN_API_KEY", adminAPIKeyTest)
+	t.Setenv("ADMIN_API_SECRET", adminAPISecretTest)
+
+	log.SetOutput(io.Discard)
+
+	repositoriesAccount.ResetInMemoryAccountRepository()
+	repositoriesAPIKey.ResetInMemoryAPIKeyRepository()
+	repositoriesBook.ResetInMemoryBookRepository()
+	repositoriesOrder.ResetInMemoryOrderRepository()
+	repositoriesTrade.ResetInMemoryTradeRepository()
+
+	config.Init()
+
+	suite.server = httptest.NewServer(router.Generate(config.EnvConfigInstance.ApiPort, routes.NewPlaceOrderUseCase()))
+}
+
+func (suite *ClobctlE2ETestSuite) TearDownSuite() {
+	suite.server.Close()
+	log.SetOutput(os.Stderr)
+}
+
+// clobctl runs the CLI against the test server and returns its exit code,
+// stdout and stderr.
+func (suite *ClobctlE2ETestSuite) clobctl(ctx context.Context, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+
+	code := run(ctx, append([]string{"-url", suite.server.URL}, args...), &stdout, &stderr)
+
+	return code, stdout.String(), stderr.String()
+}
+
+func (suite *ClobctlE2ETestSuite) admin(args ...string) []string {
+	return append([]string{"-api-key", adminAPIKeyTest, "-api-secret", adminAPISecretTest}, args...)
+}
+
+// trader creates and credits an account, returning the flags that sign as it
+// and its id.
+func (suite *ClobctlE2ETestSuite) trader(name, asset, amount string) ([]string, string) {
+	t := suite.T()
+
+	code, stdout, stderr := suite.clobctl(t.Context(), "-o", "json", "account", "create", name)
+	require.Equal(t, 0, code, stderr)
+
+	var created clobClient.CreatedAccount
+	require.NoError(t, json.Unmarshal([]byte(stdout), &created))
+
+	code, _, stderr = suite.clobctl(t.Context(), suite.admin("account", "credit", created.AccountID, asset, amount)...)
+	require.Equal(t, 0, code, stderr)
+
+	return []string{"-api-key", created.APIKey, "-api-secret", created.APISecret}, created.AccountID
+}
+
+func (suite *ClobctlE2ETestSuite) TestAccount_CreateCreditBalances() {
+	t := suite.T()
+
+	code, stdout, stderr := suite.clobctl(t.Context(), "account", "create", "clobctl-table")
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `^ACCOUNT_ID +API_KEY +API_SECRET\n`, stdout)
+
+	auth, accountID := suite.trader("clobctl-balances", "USDT", "250")
+
+	code, stdout, stderr = suite.clobctl(t.Context(), append(auth, "account", "balances", accountID)...)
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "ASSET")
+	assert.Regexp(t, accountID+` +USDT +250 +0`, stdout)
+
+	code, stdout, stderr = suite.clobctl(t.Context(), append(auth, "-o", "json", "account", "balances", "-aggregated", accountID)...)
+	require.Equal(t, 0, code, stderr)
+
+	var aggregated clobClient.AggregatedAccount
+	require.NoError(t, json.Unmarshal([]byte(stdout), &aggregated))
+	assert.Equal(t, int64(250), aggregated.Balances["USDT"].Available)
+}
+
+func (suite *ClobctlE2ETestSuite) TestOrders_PlaceBookTradesCancel() {
+	t := suite.T()
+
+	buyer, buyerID := suite.trader("clobctl-buyer", "USDT", "100000")
+	seller, sellerID := suite.trader("clobctl-seller", "CTL", "100")
+
+	for _, price := range []string{"101", "103"} {
+		code, _, stderr := suite.clobctl(t.Context(), append(seller, "order", "place", "-account", sellerID, "-instrument", "CTL/USDT", "-side", "sell", "-price", price, "-qty", "4")...)
+		require.Equal(t, 0, code, stderr)
+	}
+
+	code, stdout, stderr := suite.clobctl(t.Context(), append(buyer, "order", "place", "-account", buyerID, "-instrument", "CTL/USDT", "-side", "buy", "-price", "101", "-qty", "1", "-client-order-id", "ctl-take")...)
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "ctl-take")
+	assert.Contains(t, stdout, "MAKER_ORDER_ID")
+
+	code, stdout, stderr = suite.clobctl(t.Context(), append(buyer, "-o", "json", "order", "place", "-account", buyerID, "-instrument", "CTL/USDT", "-side", "buy", "-price", "99", "-qty", "2")...)
+	require.Equal(t, 0, code, stderr)
+
+	var resting clobClient.PlaceOrderOutput
+	require.NoError(t, json.Unmarshal([]byte(stdout), &resting))
+
+	code, stdout, stderr = suite.clobctl(t.Context(), "book", "CTL/USDT")
+	require.Equal(t, 0, code, stderr)
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	require.Len(t, lines, 6, stdout)
+	assert.Regexp(t, `^CTL/USDT +last 101`, lines[0])
+	assert.Regexp(t, `^ask +103 +4 +7$`, lines[2])
+	assert.Regexp(t, `^ask +101 +3 +3$`, lines[3])
+	assert.Regexp(t, `^--- +spread 2`, lines[4])
+	assert.Regexp(t, `^bid +99 +2 +2$`, lines[5])
+
+	code, stdout, stderr = suite.clobctl(t.Context(), "-o", "json", "book", "-depth", "1", "CTL/USDT")
+	require.Equal(t, 0, code, stderr)
+
+	var book clobClient.Book
+	require.NoError(t, json.Unmarshal([]byte(stdout), &book))
+	assert.Equal(t, []clobClient.Level{{Price: 101, Qty: 3}}, book.Asks)
+
+	code, stdout, stderr = suite.clobctl(t.Context(), append(buyer, "trades", "-account", buyerID)...)
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `CTL/USDT +101 +1 +`+buyerID+` +`+sellerID, stdout)
+
+	code, stdout, stderr = suite.clobctl(t.Context(), append(buyer, "order", "cancel", resting.Order.ID)...)
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, resting.Order.ID+`.*CTL/USDT +buy +99 +2 +0`, stdout)
+
+	code, _, stderr = suite.clobctl(t.Context(), append(buyer, "order", "cancel", resting.Order.ID)...)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "ORDER_NOT_OPEN")
+
+	code, stdout, stderr = suite.clobctl(t.Context(), append(seller, "-o", "json", "order", "cancel-all", "-side", "sell", sellerID)...)
+	require.Equal(t, 0, code, stderr)
+
+	var canceled []clobClient.Order
+	require.NoError(t, json.Unmarshal([]byte(stdout), &canceled))
+	assert.Len(t, canceled, 2)
+}
+
+func (suite *ClobctlE2ETestSuite) TestTrades_Follow() {
+	t := suite.T()
+
+	buyer, buyerID := suite.trader("clobctl-follow-buyer", "USDT", "100000")
+	seller, sellerID := suite.trader("clobctl-follow-seller", "FLW", "100")
+
+	place := func(auth []string, accountID, side string) {
+		code, _, stderr := suite.clobctl(t.Context(), append(auth, "order", "place", "-account", accountID, "-instrument", "FLW/USDT", "-side", side, "-price", "10", "-qty", "1")...)
+		require.Equal(t, 0, code, stderr)
+	}
+
+	place(seller, sellerID, "sell")
+	place(buyer, buyerID, "buy")
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan string)
+
+	go func() {
+		_, stdout, _ := suite.clobctl(ctx, "-o", "json", "trades", "-instrument", "FLW/USDT", "-follow", "-interval", "10ms")
+		done <- stdout
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	place(seller, sellerID, "sell")
+	place(buyer, buyerID, "buy")
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	stdout := <-done
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	require.Len(t, lines, 2, stdout)
+
+	for _, line := range lines {
+		var trade clobClient.Trade
+		require.NoError(t, json.Unmarshal([]byte(line), &trade))
+		assert.Equal(t, "FLW/USDT", trade.Instrument)
+	}
+}
+
+func (suite *ClobctlE2ETestSuite) TestUsage() {
+	t := suite.T()
+
+	code, _, stderr := suite.clobctl(t.Context())
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "usage: clobctl")
+
+	code, _, stderr = suite.clobctl(t.Context(), "order", "place", "-account", "a1")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "order place needs")
+
+	code, _, _ = suite.clobctl(t.Context(), "-o", "yaml", "book", "BTC/USDT")
+	assert.Equal(t, 2, code)
+
+	code, _, stderr = suite.clobctl(t.Context(), "book", "NONE/USDT")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "404")
+}
+
+func TestClobctl(t *testing.T) {
+	suite.Run(t, new(ClobctlE2ETestSuite))
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+
+	clobClient "github.com/juninhoitabh/clob-go/pkg/clob-client"
+)
+
+const usage = `usage: clobctl [flags] <command> [args]
+
+commands:
+  account create <name>
+  account credit <account_id> <asset> <amount>
+  account balances [-aggregated] <account_id>
+  order place -account <id> -instrument <inst> -side <buy|sell> -price <p> -qty <q> [-client-order-id <cid>]
+  order get <order_id>
+  order cancel <order_id>
+  order cancel -account <id> -client-order-id <cid>
+  order cancel-all [-instrument <inst>] [-side <buy|sell>] <account_id>
+  book [-depth <n>] <instrument>
+  trades [-account <id>] [-instrument <inst>] [-limit <n>] [-follow] [-interval <d>]
+
+flags:
+`
+
+var errUsage = errors.New("usage")
+
+type cli struct {
+	client *clobClient.Client
+	stdout io.Writer
+	stderr io.Writer
+	json   bool
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	os.Exit(run(ctx, os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("clobctl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+
+	baseURL := flags.String("url", getEnv("CLOB_URL", "http://localhost:3000"), "API base url (CLOB_URL)")
+	apiKey := flags.String("api-key", os.Getenv("CLOB_API_KEY"), "API key used to sign requests (CLOB_API_KEY)")
+	apiSecret := flags.String("api-secret", os.Getenv("CLOB_API_SECRET"), "API secret used to sign requests (CLOB_API_SECRET)")
+	output := flags.String("o", "table", "output format: table or json")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return 2
+	}
+
+	if *output != "table" && *output != "json" {
+		fmt.Fprintf(stderr, "clobctl: unknown output format %q\n", *output)
+
+		return 2
+	}
+
+	var opts []clobClient.Option
+	if *apiKey != "" {
+		opts = append(opts, clobClient.WithCredentials(*apiKey, *apiSecret))
+	}
+
+	client, err := clobClient.NewClient(*baseURL, opts...)
+	if err != nil {
+		fmt.Fprintln(stderr, "clobctl:", err)
+
+		return 2
+	}
+
+	c := &cli{client: client, stdout: stdout, stderr: stderr, json: *output == "json"}
+
+	err = c.dispatch(ctx, flags.Args())
+	if errors.Is(err, errUsage) {
+		fmt.Fprintln(stderr, err)
+		flags.Usage()
+
+		return 2
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, "clobctl:", err)
+
+		return 1
+	}
+
+	return 0
+}
+
+func (c *cli) dispatch(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: missing command", errUsage)
+	}
+
+	switch args[0] {
+	case "account":
+		return c.account(ctx, args[1:])
+	case "order":
+		return c.order(ctx, args[1:])
+	case "book":
+		return c.book(ctx, args[1:])
+	case "trades":
+		return c.trades(ctx, args[1:])
+	default:
+		return fmt.Errorf("%w: unknown command %q", errUsage, args[0])
+	}
+}
+
+// flagSet returns a subcommand's flag set; parse errors are reported as
+// usage errors.
+func (c *cli) flagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+
+	return flags
+}
+
+func (c *cli) parse(flags *flag.FlagSet, args []string) error {
+	err := flags.Parse(args)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", errUsage, flags.Name(), err)
+	}
+
+	return nil
+}
+
+// print writes v as indented JSON, or as the table written by table.
+func (c *cli) print(v any, table func(w io.Writer)) error {
+	if c.json {
+		encoder := json.NewEncoder(c.stdout)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(v)
+	}
+
+	writer := tabwriter.NewWriter(c.stdout, 0, 0, 2, ' ', 0)
+	table(writer)
+
+	return writer.Flush()
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return defaultValue
+}
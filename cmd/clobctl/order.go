@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	clobClient "github.com/juninhoitabh/clob-go/pkg/clob-client"
+)
+
+func (c *cli) order(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: missing order command", errUsage)
+	}
+
+	switch args[0] {
+	case "place":
+		return c.orderPlace(ctx, args[1:])
+	case "get":
+		return c.orderGet(ctx, args[1:])
+	case "cancel":
+		return c.orderCancel(ctx, args[1:])
+	case "cancel-all":
+		return c.orderCancelAll(ctx, args[1:])
+	default:
+		return fmt.Errorf("%w: unknown order command %q", errUsage, args[0])
+	}
+}
+
+func (c *cli) orderPlace(ctx context.Context, args []string) error {
+	var input clobClient.PlaceOrderInput
+
+	flags := c.flagSet("order place")
+	flags.StringVar(&input.AccountID, "account", "", "account_id")
+	flags.StringVar(&input.Instrument, "instrument", "", "instrument, such as BTC/USDT")
+	flags.StringVar(&input.Side, "side", "", "buy or sell")
+	flags.Int64Var(&input.Price, "price", 0, "limit price")
+	flags.Int64Var(&input.Qty, "qty", 0, "quantity")
+	flags.StringVar(&input.ClientOrderID, "client-order-id", "", "client order id; a random one is used when empty")
+
+	err := c.parse(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if input.AccountID == "" || input.Instrument == "" || input.Side == "" || input.Price <= 0 || input.Qty <= 0 {
+		return fmt.Errorf("%w: order place needs -account, -instrument, -side, -price and -qty", errUsage)
+	}
+
+	output, err := c.client.PlaceOrder(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	return c.print(output, func(w io.Writer) {
+		writeOrders(w, output.Order)
+
+		if len(output.Trades) > 0 {
+			fmt.Fprintln(w)
+			writeTradesHeader(w)
+
+			for _, trade := range output.Trades {
+				trade.Instrument = output.Order.Instrument
+				writeTrade(w, trade)
+			}
+		}
+	})
+}
+
+func (c *cli) orderGet(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: order get <order_id>", errUsage)
+	}
+
+	order, err := c.client.GetOrder(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	return c.print(order, func(w io.Writer) {
+		writeOrders(w, *order)
+	})
+}
+
+func (c *cli) orderCancel(ctx context.Context, args []string) error {
+	flags := c.flagSet("order cancel")
+	accountID := flags.String("account", "", "account_id, to cancel by client order id")
+	clientOrderID := flags.String("client-order-id", "", "client order id, to cancel by client order id")
+
+	err := c.parse(flags, args)
+	if err != nil {
+		return err
+	}
+
+	var order *clobClient.Order
+
+	switch {
+	case flags.NArg() == 1 && *accountID == "" && *clientOrderID == "":
+		order, err = c.client.CancelOrder(ctx, flags.Arg(0))
+	case flags.NArg() == 0 && *accountID != "" && *clientOrderID != "":
+		order, err = c.client.CancelOrderByClientOrderID(ctx, *accountID, *clientOrderID)
+	default:
+		return fmt.Errorf("%w: order cancel <order_id> | order cancel -account <id> -client-order-id <cid>", errUsage)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return c.print(order, func(w io.Writer) {
+		writeOrders(w, *order)
+	})
+}
+
+func (c *cli) orderCancelAll(ctx context.Context, args []string) error {
+	flags := c.flagSet("order cancel-all")
+	instrument := flags.String("instrument", "", "only cancel orders of this instrument")
+	side := flags.String("side", "", "only cancel orders of this side")
+
+	err := c.parse(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%w: order cancel-all [-instrument <inst>] [-side <buy|sell>] <account_id>", errUsage)
+	}
+
+	orders, err := c.client.CancelAll(ctx, flags.Arg(0), *instrument, *side)
+	if err != nil {
+		return err
+	}
+
+	return c.print(orders, func(w io.Writer) {
+		writeOrders(w, orders...)
+	})
+}
+
+func writeOrders(w io.Writer, orders ...clobClient.Order) {
+	fmt.Fprintln(w, "ORDER_ID\tCLIENT_ORDER_ID\tACCOUNT_ID\tINSTRUMENT\tSIDE\tPRICE\tQTY\tREMAINING\tCREATED_AT")
+
+	for _, order := range orders {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%d\t%s\n",
+			order.ID, order.ClientOrderID, order.AccountID, order.Instrument, order.Side,
+			order.Price, order.Qty, order.Remaining, order.CreatedAt.Format(time.RFC3339),
+		)
+	}
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	clobClient "github.com/juninhoitabh/clob-go/pkg/clob-client"
+)
+
+// followLimit bounds each poll of trades -follow; trades beyond it between
+// two polls are not printed.
+const followLimit = 1000
+
+func (c *cli) trades(ctx context.Context, args []string) error {
+	var input clobClient.ListTradesInput
+
+	flags := c.flagSet("trades")
+	flags.StringVar(&input.AccountID, "account", "", "only trades of this account; needs its key")
+	flags.StringVar(&input.Instrument, "instrument", "", "only trades of this instrument")
+	flags.IntVar(&input.Limit, "limit", 20, "most recent trades printed first")
+	follow := flags.Bool("follow", false, "keep printing new trades until interrupted")
+	interval := flags.Duration("interval", time.Second, "poll interval of -follow")
+
+	err := c.parse(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 0 || *interval <= 0 {
+		return fmt.Errorf("%w: trades [-account <id>] [-instrument <inst>] [-limit <n>] [-follow] [-interval <d>]", errUsage)
+	}
+
+	limit := input.Limit
+	if *follow {
+		input.Limit = followLimit
+	}
+
+	trades, err := c.client.ListTrades(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	// The API lists the newest trade first; a tail prints oldest first.
+	slices.Reverse(trades)
+
+	if !*follow {
+		return c.print(trades, func(w io.Writer) {
+			writeTradesHeader(w)
+
+			for _, trade := range trades {
+				writeTrade(w, trade)
+			}
+		})
+	}
+
+	tail := &tradeTail{out: c.stdout, json: c.json}
+
+	err = tail.write(trades, max(len(trades)-limit, 0))
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		trades, err = c.client.ListTrades(ctx, input)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		slices.Reverse(trades)
+
+		err = tail.write(trades, 0)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// tradeTail prints each trade once, as a table row or a line of JSON. A
+// trade missing from a poll of the newest followLimit trades cannot come
+// back, so only the last poll's ids are remembered.
+type tradeTail struct {
+	seen    map[string]bool
+	out     io.Writer
+	json    bool
+	started bool
+}
+
+// write prints the trades not seen before, skipping the first skip ones.
+func (t *tradeTail) write(trades []clobClient.Trade, skip int) error {
+	writer := tabwriter.NewWriter(t.out, 0, 0, 2, ' ', 0)
+	encoder := json.NewEncoder(t.out)
+
+	if !t.json && !t.started {
+		writeTradesHeader(writer)
+		t.started = true
+	}
+
+	seen := make(map[string]bool, len(trades))
+
+	for i, trade := range trades {
+		seen[trade.TradeID] = true
+
+		if i < skip || t.seen[trade.TradeID] {
+			continue
+		}
+
+		if t.json {
+			err := encoder.Encode(trade)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		writeTrade(writer, trade)
+	}
+
+	t.seen = seen
+
+	return writer.Flush()
+}
+
+func writeTradesHeader(w io.Writer) {
+	fmt.Fprintln(w, "EXECUTED_AT\tTRADE_ID\tINSTRUMENT\tPRICE\tQTY\tBUYER_ID\tSELLER_ID\tTAKER_ORDER_ID\tMAKER_ORDER_ID")
+}
+
+func writeTrade(w io.Writer, trade clobClient.Trade) {
+	executedAt := ""
+	if !trade.ExecutedAt.IsZero() {
+		executedAt = trade.ExecutedAt.Format(time.RFC3339Nano)
+	}
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\t%s\t%s\t%s\n",
+		executedAt, trade.TradeID, trade.Instrument, trade.Price, trade.Qty,
+		trade.BuyerID, trade.SellerID, trade.TakerOrderID, trade.MakerOrderID,
+	)
+}
@@ -0,0 +1,174 @@
+//go:build all || e2e
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const recordingJSONLTest = `{"ts":"2024-03-01T12:00:00Z","type":"new","order_id":"s1","account_id":"alice","instrument":"btc/usdt","side":"sell","price":100,"qty":5}
+{"ts":"2024-03-01T12:00:01Z","type":"new","order_id":"s2","account_id":"alice","instrument":"BTC/USDT","side":"sell","price":101,"qty":5}
+{"ts":"2024-03-01T12:00:02Z","type":"new","order_id":"b1","account_id":"bob","instrument":"BTC/USDT","side":"buy","price":101,"qty":7}
+
+{"ts":"2024-03-01T12:00:03Z","type":"cancel","order_id":"s1"}
+{"ts":"2024-03-01T12:00:04Z","type":"new","order_id":"b2","account_id":"bob","instrument":"BTC/USDT","side":"buy","price":500,"qty":1}
+{"ts":"2024-03-01T12:00:05Z","type":"new","order_id":"b3","account_id":"bob","instrument":"BTC/USDT","side":"buy","price":99,"qty":2}
+`
+
+const recordingCSVTest = `ts,type,order_id,account_id,instrument,side,price,qty
+2024-03-01T12:00:00Z,new,s1,alice,BTC/USDT,sell,100,5
+2024-03-01T12:00:01Z,new,b1,bob,BTC/USDT,buy,100,2
+2024-03-01T12:00:02Z,cancel,s1,,,,,
+2024-03-01T12:00:03Z,cancel,s1,,,,,
+`
+
+type ReplayE2ETestSuite struct {
+	suite.Suite
+}
+
+// replay runs the tool on recording, written to a file named name, and
+// returns its exit code, stdout and stderr.
+func (suite *ReplayE2ETestSuite) replay(ctx context.Context, name, recording string, args ...string) (int, string, string) {
+	t := suite.T()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(recording), 0o600))
+
+	var stdout, stderr bytes.Buffer
+
+	code := run(ctx, append(args, path), strings.NewReader(""), &stdout, &stderr)
+
+	return code, stdout.String(), stderr.String()
+}
+
+func (suite *ReplayE2ETestSuite) TestJSONL() {
+	t := suite.T()
+
+	code, stdout, stderr := suite.replay(t.Context(), "flow.jsonl", recordingJSONLTest, "-o", "json", "-band-bps", "1000", "-move-bps", "0")
+	require.Equal(t, 0, code, stderr)
+
+	var res result
+	require.NoError(t, json.Unmarshal([]byte(stdout), &res))
+
+	assert.Equal(t, 6, res.Commands)
+
+	require.Len(t, res.Trades, 2)
+	assert.Equal(t, "b1", res.Trades[0].TakerOrderID)
+	assert.Equal(t, "s1", res.Trades[0].MakerOrderID)
+	assert.Equal(t, int64(100), res.Trades[0].Price)
+	assert.Equal(t, int64(5), res.Trades[0].Qty)
+	assert.Equal(t, "2024-03-01T12:00:02Z", res.Trades[0].ExecutedAt.Format("2006-01-02T15:04:05Z07:00"))
+	assert.Equal(t, int64(101), res.Trades[1].Price)
+	assert.Equal(t, int64(2), res.Trades[1].Qty)
+	assert.Equal(t, 3, res.Trades[1].Line)
+	assert.Equal(t, uint64(1), res.Trades[0].Seq)
+	assert.Equal(t, uint64(2), res.Trades[1].Seq)
+
+	require.Len(t, res.Rejects, 2)
+	assert.Equal(t, "ORDER_NOT_OPEN", res.Rejects[0].Code)
+	assert.Equal(t, 5, res.Rejects[0].Line)
+	assert.Equal(t, "PRICE_OUT_OF_BAND", res.Rejects[1].Code)
+
+	require.Len(t, res.Books, 1)
+	b := res.Books[0]
+	assert.Equal(t, "BTC/USDT", b.Instrument)
+	assert.Equal(t, int64(101), b.LastPrice)
+	assert.Equal(t, []level{{Price: 101, Qty: 3, Orders: []restingOrder{{OrderID: "s2", AccountID: "alice", Remaining: 3}}}}, b.Asks)
+	assert.Equal(t, []level{{Price: 99, Qty: 2, Orders: []restingOrder{{OrderID: "b3", AccountID: "bob", Remaining: 2}}}}, b.Bids)
+}
+
+func (suite *ReplayE2ETestSuite) TestCSVTable() {
+	t := suite.T()
+
+	code, stdout, stderr := suite.replay(t.Context(), "flow.csv", recordingCSVTest)
+	require.Equal(t, 0, code, stderr)
+
+	assert.Contains(t, stdout, "commands 4, trades 1, rejects 1")
+	assert.Regexp(t, `\n3 +2024-03-01T12:00:01Z +BTC/USDT +100 +2 +buy +b1 +s1 +bob +alice\n`, stdout)
+	assert.Regexp(t, `\n5 +2024-03-01T12:00:03Z +cancel +s1 +ORDER_NOT_OPEN`, stdout)
+	assert.Regexp(t, `BTC/USDT +last 100`, stdout)
+}
+
+func (suite *ReplayE2ETestSuite) TestCircuitBreakerHalts() {
+	t := suite.T()
+
+	recording := `{"ts":"2024-03-01T12:00:00Z","type":"new","order_id":"s1","account_id":"alice","instrument":"BTC/USDT","side":"sell","price":100,"qty":1}
+{"ts":"2024-03-01T12:00:00Z","type":"new","order_id":"s2","account_id":"alice","instrument":"BTC/USDT","side":"sell","price":120,"qty":1}
+{"ts":"2024-03-01T12:00:01Z","type":"new","order_id":"b1","account_id":"bob","instrument":"BTC/USDT","side":"buy","price":120,"qty":2}
+{"ts":"2024-03-01T12:00:02Z","type":"new","order_id":"b2","account_id":"bob","instrument":"BTC/USDT","side":"buy","price":100,"qty":1}
+{"ts":"2024-03-01T12:10:00Z","type":"new","order_id":"b3","account_id":"bob","instrument":"BTC/USDT","side":"buy","price":105,"qty":1}
+`
+
+	code, stdout, stderr := suite.replay(t.Context(), "halt.jsonl", recording, "-o", "json", "-band-bps", "0", "-move-bps", "1000", "-window", "1m", "-halt", "5m")
+	require.Equal(t, 0, code, stderr)
+
+	var res result
+	require.NoError(t, json.Unmarshal([]byte(stdout), &res))
+
+	require.Len(t, res.Trades, 1)
+	assert.Equal(t, int64(100), res.Trades[0].Price)
+
+	require.Len(t, res.Rejects, 1)
+	assert.Equal(t, "b2", res.Rejects[0].OrderID)
+	assert.Equal(t, "INSTRUMENT_HALTED", res.Rejects[0].Code)
+
+	// The halt was over by the time of b3, which rests below the ask.
+	require.Len(t, res.Books, 1)
+	assert.False(t, res.Books[0].Halted)
+	require.Len(t, res.Books[0].Bids, 1)
+	assert.Equal(t, int64(105), res.Books[0].Bids[0].Price)
+}
+
+func (suite *ReplayE2ETestSuite) TestErrors() {
+	t := suite.T()
+
+	code, _, stderr := suite.replay(t.Context(), "back.jsonl", `{"ts":"2024-03-01T12:00:01Z","type":"cancel","order_id":"x"}
+{"ts":"2024-03-01T12:00:00Z","type":"cancel","order_id":"x"}
+`)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "line 2: ts")
+
+	code, _, stderr = suite.replay(t.Context(), "kind.jsonl", `{"ts":"2024-03-01T12:00:00Z","type":"amend","order_id":"x"}`)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, `unknown type "amend"`)
+
+	code, _, stderr = suite.replay(t.Context(), "header.csv", "when,type\n")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, `unknown column "when"`)
+
+	code, _, _ = suite.replay(t.Context(), "flow.jsonl", recordingJSONLTest, "-format", "xml")
+	assert.Equal(t, 2, code)
+
+	var stderrBuf bytes.Buffer
+
+	code = run(t.Context(), nil, strings.NewReader(""), &bytes.Buffer{}, &stderrBuf)
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderrBuf.String(), "usage: replay")
+}
+
+func (suite *ReplayE2ETestSuite) TestStdin() {
+	t := suite.T()
+
+	var stdout, stderr bytes.Buffer
+
+	code := run(t.Context(), []string{"-format", "csv", "-o", "json", "-"}, strings.NewReader(recordingCSVTest), &stdout, &stderr)
+	require.Equal(t, 0, code, stderr.String())
+
+	var res result
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &res))
+	assert.Len(t, res.Trades, 1)
+}
+
+func TestReplay(t *testing.T) {
+	suite.Run(t, new(ReplayE2ETestSuite))
+}
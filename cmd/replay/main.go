@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
+)
+
+const usage = `usage: replay [flags] <recording | ->
+
+Replays recorded new and cancel commands through the matching engine
+against fresh books and prints the trades, the rejects and the final books.
+
+flags:
+`
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	os.Exit(run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	log.SetOutput(io.Discard)
+	config.Init()
+
+	flags := flag.NewFlagSet("replay", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+
+	format := flags.String("format", "", "recording format: csv or jsonl; empty picks csv for .csv files and jsonl otherwise")
+	output := flags.String("o", "table", "output format: table or json")
+	speed := flags.Float64("speed", 0, "replay at this multiple of the recorded pace; 0 replays as fast as possible")
+	bandBps := flags.Int64("band-bps", config.EnvConfigInstance.PriceBandBps, "price band around the last price, in bps; 0 disables it (PRICE_BAND_BPS)")
+	moveBps := flags.Int64("move-bps", config.EnvConfigInstance.CircuitBreakerMoveBps, "move within -window that halts the book, in bps; 0 disables it (CIRCUIT_BREAKER_MOVE_BPS)")
+	window := flags.Duration("window", config.EnvConfigInstance.CircuitBreakerWindow, "circuit breaker window (CIRCUIT_BREAKER_WINDOW)")
+	halt := flags.Duration("halt", config.EnvConfigInstance.CircuitBreakerHalt, "how long a tripped circuit breaker halts the book (CIRCUIT_BREAKER_HALT)")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return 2
+	}
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+
+		return 2
+	}
+
+	path := flags.Arg(0)
+
+	if *format == "" {
+		*format = formatJSONL
+		if strings.EqualFold(filepath.Ext(path), ".csv") {
+			*format = formatCSV
+		}
+	}
+
+	if *format != formatCSV && *format != formatJSONL {
+		fmt.Fprintf(stderr, "replay: unknown recording format %q\n", *format)
+
+		return 2
+	}
+
+	if *output != "table" && *output != "json" {
+		fmt.Fprintf(stderr, "replay: unknown output format %q\n", *output)
+
+		return 2
+	}
+
+	if *speed < 0 {
+		fmt.Fprintln(stderr, "replay: -speed must not be negative")
+
+		return 2
+	}
+
+	input := stdin
+
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			fmt.Fprintln(stderr, "replay:", err)
+
+			return 1
+		}
+		defer file.Close()
+
+		input = file
+	}
+
+	rec, err := newRecording(input, *format)
+	if err != nil {
+		fmt.Fprintln(stderr, "replay:", err)
+
+		return 1
+	}
+
+	r := newReplayer(domainBook.CircuitBreakerProps{
+		BandBps:      *bandBps,
+		MoveBps:      *moveBps,
+		Window:       *window,
+		HaltDuration: *halt,
+	})
+
+	res, err := r.replay(ctx, rec, *speed)
+	if err != nil {
+		fmt.Fprintln(stderr, "replay:", err)
+
+		return 1
+	}
+
+	if *output == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(res)
+	} else {
+		err = res.write(stdout)
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, "replay:", err)
+
+		return 1
+	}
+
+	return 0
+}
+
+func (r *result) write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "commands %d, trades %d, rejects %d\n", r.Commands, len(r.Trades), len(r.Rejects))
+
+	if len(r.Trades) > 0 {
+		fmt.Fprintln(tw, "\nLINE\tEXECUTED_AT\tINSTRUMENT\tPRICE\tQTY\tTAKER_SIDE\tTAKER_ORDER_ID\tMAKER_ORDER_ID\tBUYER_ID\tSELLER_ID")
+
+		for _, t := range r.Trades {
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\n",
+				t.Line, t.ExecutedAt.Format(time.RFC3339Nano), t.Instrument, t.Price, t.Qty,
+				t.TakerSide, t.TakerOrderID, t.MakerOrderID, t.BuyerID, t.SellerID)
+		}
+	}
+
+	if len(r.Rejects) > 0 {
+		fmt.Fprintln(tw, "\nLINE\tTS\tTYPE\tORDER_ID\tCODE\tMESSAGE")
+
+		for _, rj := range r.Rejects {
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n",
+				rj.Line, rj.At.Format(time.RFC3339Nano), rj.Type, rj.OrderID, rj.Code, rj.Message)
+		}
+	}
+
+	for _, b := range r.Books {
+		status := ""
+		if b.Halted {
+			status = " (halted)"
+		}
+
+		fmt.Fprintf(tw, "\n%s%s\tlast %d\t\t\n", b.Instrument, status, b.LastPrice)
+		fmt.Fprintln(tw, "SIDE\tPRICE\tQTY\tORDERS")
+
+		for i := len(b.Asks) - 1; i >= 0; i-- {
+			fmt.Fprintf(tw, "ask\t%d\t%d\t%d\n", b.Asks[i].Price, b.Asks[i].Qty, len(b.Asks[i].Orders))
+		}
+
+		fmt.Fprintln(tw, "---\t\t\t")
+
+		for _, l := range b.Bids {
+			fmt.Fprintf(tw, "bid\t%d\t%d\t%d\n", l.Price, l.Qty, len(l.Orders))
+		}
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	formatCSV   = "csv"
+	formatJSONL = "jsonl"
+
+	commandNew    = "new"
+	commandCancel = "cancel"
+)
+
+// csvColumns are the columns a CSV recording may have, named in its header
+// row; ts and type are required.
+var csvColumns = []string{"ts", "type", "order_id", "account_id", "instrument", "side", "price", "qty"}
+
+type (
+	// command is one recorded order entry: a new order, or the cancel of
+	// one by its order ID.
+	command struct {
+		At         time.Time `json:"ts"`
+		Type       string    `json:"type"`
+		OrderID    string    `json:"order_id"`
+		AccountID  string    `json:"account_id"`
+		Instrument string    `json:"instrument"`
+		Side       string    `json:"side"`
+		Price      int64     `json:"price"`
+		Qty        int64     `json:"qty"`
+		Line       int       `json:"-"`
+	}
+	// recording reads commands one at a time and returns io.EOF after the
+	// last one.
+	recording interface {
+		next() (*command, error)
+	}
+	jsonlRecording struct {
+		scanner *bufio.Scanner
+		line    int
+	}
+	csvRecording struct {
+		reader  *csv.Reader
+		columns map[string]int
+		line    int
+	}
+)
+
+func newRecording(r io.Reader, format string) (recording, error) {
+	if format == formatCSV {
+		return newCSVRecording(r)
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	return &jsonlRecording{scanner: scanner}, nil
+}
+
+func (r *jsonlRecording) next() (*command, error) {
+	for r.scanner.Scan() {
+		r.line++
+
+		raw := bytes.TrimSpace(r.scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		var cmd command
+
+		err := json.Unmarshal(raw, &cmd)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.line, err)
+		}
+
+		cmd.Line = r.line
+
+		return &cmd, cmd.validate()
+	}
+
+	err := r.scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
+
+func newCSVRecording(r io.Reader) (*csvRecording, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("line 1: missing header")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(csvColumns, name) {
+			return nil, fmt.Errorf("line 1: unknown column %q", name)
+		}
+
+		columns[name] = i
+	}
+
+	for _, name := range []string{"ts", "type"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("line 1: missing column %q", name)
+		}
+	}
+
+	return &csvRecording{reader: reader, columns: columns, line: 1}, nil
+}
+
+func (r *csvRecording) next() (*command, error) {
+	record, err := r.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	r.line, _ = r.reader.FieldPos(0)
+
+	field := func(name string) string {
+		i, ok := r.columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[i])
+	}
+
+	cmd := &command{
+		Type:       field("type"),
+		OrderID:    field("order_id"),
+		AccountID:  field("account_id"),
+		Instrument: field("instrument"),
+		Side:       field("side"),
+		Line:       r.line,
+	}
+
+	cmd.At, err = time.Parse(time.RFC3339Nano, field("ts"))
+	if err != nil {
+		return nil, fmt.Errorf("line %d: ts: %w", r.line, err)
+	}
+
+	cmd.Price, err = parseAmount(field("price"))
+	if err != nil {
+		return nil, fmt.Errorf("line %d: price: %w", r.line, err)
+	}
+
+	cmd.Qty, err = parseAmount(field("qty"))
+	if err != nil {
+		return nil, fmt.Errorf("line %d: qty: %w", r.line, err)
+	}
+
+	return cmd, cmd.validate()
+}
+
+// validate checks what a command needs to be replayed at all; whether the
+// engine accepts it is up to the replay.
+func (c *command) validate() error {
+	c.Type = strings.ToLower(c.Type)
+	c.Instrument = strings.ToUpper(c.Instrument)
+
+	if c.At.IsZero() {
+		return fmt.Errorf("line %d: missing ts", c.Line)
+	}
+
+	switch c.Type {
+	case commandNew:
+		if c.Instrument == "" {
+			return fmt.Errorf("line %d: new needs an instrument", c.Line)
+		}
+	case commandCancel:
+		if c.OrderID == "" {
+			return fmt.Errorf("line %d: cancel needs an order_id", c.Line)
+		}
+	default:
+		return fmt.Errorf("line %d: unknown type %q, expected new or cancel", c.Line, c.Type)
+	}
+
+	return nil
+}
+
+// parseAmount reads an integer price or quantity; cancels leave them empty.
+func parseAmount(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(value, 10, 64)
+}
@@ -0,0 +1,362 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"time"
+
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+const (
+	rejectInvalidOrder     = "INVALID_ORDER"
+	rejectDuplicateOrderID = "DUPLICATE_ORDER_ID"
+	rejectUnknownOrder     = "UNKNOWN_ORDER"
+)
+
+type (
+	// tradeLine is a trade as replayed. It carries the trade's number in its
+	// book, which with the instrument is all its ID is written from.
+	tradeLine struct {
+		ExecutedAt   time.Time `json:"executed_at"`
+		Instrument   string    `json:"instrument"`
+		TakerOrderID string    `json:"taker_order_id"`
+		MakerOrderID string    `json:"maker_order_id"`
+		BuyerID      string    `json:"buyer_id"`
+		SellerID     string    `json:"seller_id"`
+		TakerSide    string    `json:"taker_side"`
+		Line         int       `json:"line"`
+		Seq          uint64    `json:"seq"`
+		Price        int64     `json:"price"`
+		Qty          int64     `json:"qty"`
+	}
+	// rejectLine is a command the engine turned down, with the reject code
+	// the API would have answered.
+	rejectLine struct {
+		At      time.Time `json:"ts"`
+		Type    string    `json:"type"`
+		OrderID string    `json:"order_id"`
+		Code    string    `json:"code"`
+		Message string    `json:"message"`
+		Line    int       `json:"line"`
+	}
+	restingOrder struct {
+		OrderID   string `json:"order_id"`
+		AccountID string `json:"account_id"`
+		Remaining int64  `json:"remaining"`
+	}
+	level struct {
+		Orders []restingOrder `json:"orders"`
+		Price  int64          `json:"price"`
+		Qty    int64          `json:"qty"`
+	}
+	bookSnapshot struct {
+		Instrument string  `json:"instrument"`
+		Bids       []level `json:"bids"`
+		Asks       []level `json:"asks"`
+		LastPrice  int64   `json:"last_price"`
+		Halted     bool    `json:"halted"`
+	}
+	result struct {
+		Trades   []tradeLine    `json:"trades"`
+		Rejects  []rejectLine   `json:"rejects"`
+		Books    []bookSnapshot `json:"books"`
+		Commands int            `json:"commands"`
+	}
+	// replayer holds one fresh book per instrument and every order it has
+	// seen, by the order ID recorded with it. Every match is reported into
+	// the same report, whose trades are copied out before the next one.
+	replayer struct {
+		books          map[string]*domainBook.Book
+		orders         map[string]*domainOrder.Order
+		report         *services.TradeReport
+		circuitBreaker domainBook.CircuitBreakerProps
+		result         result
+		last           time.Time
+	}
+)
+
+func newReplayer(circuitBreaker domainBook.CircuitBreakerProps) *replayer {
+	return &replayer{
+		books:          map[string]*domainBook.Book{},
+		orders:         map[string]*domainOrder.Order{},
+		report:         &services.TradeReport{},
+		circuitBreaker: circuitBreaker,
+		result: result{
+			Trades:  []tradeLine{},
+			Rejects: []rejectLine{},
+		},
+	}
+}
+
+// replay applies every command of rec in order. With a positive speed it
+// waits between commands for the recorded gap divided by speed; otherwise
+// it runs them back to back. Timestamps must not go backwards.
+func (r *replayer) replay(ctx context.Context, rec recording, speed float64) (*result, error) {
+	var first time.Time
+
+	start := time.Now()
+
+	for {
+		cmd, err := rec.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if cmd.At.Before(r.last) {
+			return nil, fmt.Errorf("line %d: ts %s is before the previous command's", cmd.Line, cmd.At.Format(time.RFC3339Nano))
+		}
+
+		if first.IsZero() {
+			first = cmd.At
+		}
+
+		if speed > 0 {
+			wait := time.Until(start.Add(time.Duration(float64(cmd.At.Sub(first)) / speed)))
+
+			err = sleep(ctx, wait)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = ctx.Err()
+		if err != nil {
+			return nil, err
+		}
+
+		r.apply(cmd)
+	}
+
+	r.result.Books = r.snapshot()
+
+	return &r.result, nil
+}
+
+func (r *replayer) apply(cmd *command) {
+	r.last = cmd.At
+	r.result.Commands++
+
+	var err error
+
+	if cmd.Type == commandCancel {
+		err = r.cancel(cmd)
+	} else {
+		err = r.place(cmd)
+	}
+
+	if err != nil {
+		r.result.Rejects = append(r.result.Rejects, rejectLine{
+			At:      cmd.At,
+			Type:    cmd.Type,
+			OrderID: cmd.OrderID,
+			Code:    rejectCode(err),
+			Message: err.Error(),
+			Line:    cmd.Line,
+		})
+	}
+}
+
+// place runs a new order the way PlaceOrderUseCase does, minus accounts:
+// the price band check, then matching; an order cut short by a halt does
+// not rest.
+func (r *replayer) place(cmd *command) error {
+	if cmd.OrderID == "" {
+		cmd.OrderID = fmt.Sprintf("line-%d", cmd.Line)
+	}
+
+	if _, ok := r.orders[cmd.OrderID]; ok {
+		return shared.NewRejectError(rejectDuplicateOrderID, "order id already used")
+	}
+
+	side, err := domainOrder.ParseSide(cmd.Side)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = domainBook.SplitInstrument(cmd.Instrument)
+	if err != nil {
+		return err
+	}
+
+	// The recorded order ID is set before Prepare, which keeps it instead
+	// of generating one.
+	o := &domainOrder.Order{
+		AccountID:  cmd.AccountID,
+		Instrument: cmd.Instrument,
+		Side:       side,
+		Price:      cmd.Price,
+		Qty:        cmd.Qty,
+		Remaining:  cmd.Qty,
+	}
+	o.ID = idObjValue.NewID(cmd.OrderID, idObjValue.Str)
+
+	err = o.Prepare(idObjValue.Str)
+	if err != nil {
+		return err
+	}
+
+	b, err := r.book(cmd.Instrument)
+	if err != nil {
+		return err
+	}
+
+	err = b.CircuitBreaker.CheckPrice(cmd.Price, cmd.At)
+	if err != nil {
+		return err
+	}
+
+	o.CreatedAt = cmd.At
+	r.orders[cmd.OrderID] = o
+
+	report := r.report
+	report.Reset()
+	services.MatchOrderInto(report, b, o, cmd.At)
+
+	for _, trade := range report.Trades {
+		r.result.Trades = append(r.result.Trades, tradeLine{
+			ExecutedAt:   trade.ExecutedAt,
+			Instrument:   trade.Instrument,
+			TakerOrderID: trade.TakerOrderID,
+			MakerOrderID: trade.MakerOrderID,
+			BuyerID:      trade.BuyerID,
+			SellerID:     trade.SellerID,
+			TakerSide:    sideName(trade.TakerSide),
+			Line:         cmd.Line,
+			Seq:          trade.Seq,
+			Price:        trade.Price,
+			Qty:          trade.Qty,
+		})
+	}
+
+	if report.Halted {
+		o.Cancel()
+	}
+
+	return nil
+}
+
+func (r *replayer) cancel(cmd *command) error {
+	o, ok := r.orders[cmd.OrderID]
+	if !ok {
+		return shared.NewRejectError(rejectUnknownOrder, "order not found")
+	}
+
+	if o.Remaining == 0 {
+		return domainOrder.ErrOrderNotOpen
+	}
+
+	r.books[o.Instrument].RemoveOrder(o)
+	o.Cancel()
+
+	return nil
+}
+
+func (r *replayer) book(instrument string) (*domainBook.Book, error) {
+	b, ok := r.books[instrument]
+	if ok {
+		return b, nil
+	}
+
+	b, err := domainBook.NewBook(domainBook.BookProps{
+		Instrument:     instrument,
+		CircuitBreaker: r.circuitBreaker,
+	}, idObjValue.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	r.books[instrument] = b
+
+	return b, nil
+}
+
+// snapshot lists every book by instrument, best prices first, with the
+// orders resting at each level in time priority. Halted is as of the last
+// command.
+func (r *replayer) snapshot() []bookSnapshot {
+	snapshots := []bookSnapshot{}
+
+	for _, instrument := range slices.Sorted(maps.Keys(r.books)) {
+		b := r.books[instrument]
+
+		snapshots = append(snapshots, bookSnapshot{
+			Instrument: instrument,
+			Bids:       levels(b.BidPrices(), b.Bids()),
+			Asks:       levels(b.AskPrices(), b.Asks()),
+			LastPrice:  b.CircuitBreaker.LastPrice,
+			Halted:     b.CircuitBreaker.IsHalted(r.last),
+		})
+	}
+
+	return snapshots
+}
+
+func levels(prices []int64, byPrice map[int64]*domainBook.PriceLevel) []level {
+	out := []level{}
+
+	for _, price := range prices {
+		pl := byPrice[price]
+		l := level{Price: price, Orders: []restingOrder{}}
+
+		for _, o := range pl.Orders() {
+			l.Qty += o.Remaining
+			l.Orders = append(l.Orders, restingOrder{
+				OrderID:   o.GetID(),
+				AccountID: o.AccountID,
+				Remaining: o.Remaining,
+			})
+		}
+
+		out = append(out, l)
+	}
+
+	return out
+}
+
+// rejectCode names a reject the way the API does: the reject code, or a
+// code of this tool for the plain validation errors.
+func rejectCode(err error) string {
+	var rejectErr *shared.RejectError
+	if errors.As(err, &rejectErr) {
+		return rejectErr.Code
+	}
+
+	return rejectInvalidOrder
+}
+
+func sideName(side domainOrder.Side) string {
+	if side == domainOrder.Sell {
+		return "sell"
+	}
+
+	return "buy"
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
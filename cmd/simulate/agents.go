@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+const (
+	agentRandom      = "random"
+	agentMarketMaker = "maker"
+	agentMomentum    = "momentum"
+)
+
+type (
+	// agent is one trading strategy; step makes one decision and sends the
+	// orders and cancels it leads to.
+	agent interface {
+		step(ctx context.Context)
+		// withdraw cancels what the agent left resting, without recording
+		// the calls.
+		withdraw(ctx context.Context)
+	}
+	// trading is what every strategy shares: its account, the orders it
+	// left resting and how it reports each call.
+	trading struct {
+		trader     trader
+		venue      venue
+		stats      *stats
+		rng        *rand.Rand
+		instrument string
+		open       []string
+		reference  int64
+		maxQty     int64
+	}
+	// randomAgent places orders of random side and size around the last
+	// price, and now and then cancels one of its resting orders.
+	randomAgent struct {
+		trading
+	}
+	// marketMakerAgent keeps one bid and one ask around the last price,
+	// replacing both on every step.
+	marketMakerAgent struct {
+		trading
+	}
+	// momentumAgent buys at the best ask after the last price went up and
+	// sells at the best bid after it went down, cancelling what did not fill.
+	momentumAgent struct {
+		trading
+		previous int64
+	}
+)
+
+func newAgent(kind string, base trading) agent {
+	switch kind {
+	case agentMarketMaker:
+		return &marketMakerAgent{trading: base}
+	case agentMomentum:
+		return &momentumAgent{trading: base}
+	default:
+		return &randomAgent{trading: base}
+	}
+}
+
+func (a *randomAgent) step(ctx context.Context) {
+	if len(a.open) > 0 && a.rng.Float64() < 0.3 {
+		i := a.rng.Intn(len(a.open))
+		orderID := a.open[i]
+
+		a.open[i] = a.open[len(a.open)-1]
+		a.open = a.open[:len(a.open)-1]
+
+		a.cancel(ctx, orderID)
+
+		return
+	}
+
+	mid := a.quote(ctx).mid(a.reference)
+	spread := max(mid/100, 1)
+	price := max(mid+a.rng.Int63n(2*spread+1)-spread, 1)
+
+	side := "buy"
+	if a.rng.Intn(2) == 0 {
+		side = "sell"
+	}
+
+	a.place(ctx, side, price, 1+a.rng.Int63n(a.maxQty))
+}
+
+func (a *marketMakerAgent) step(ctx context.Context) {
+	a.cancelAll(ctx)
+
+	mid := a.quote(ctx).mid(a.reference)
+	half := max(mid/500, 1)
+
+	a.place(ctx, "buy", max(mid-half, 1), a.maxQty)
+	a.place(ctx, "sell", mid+half, a.maxQty)
+}
+
+func (a *momentumAgent) step(ctx context.Context) {
+	q := a.quote(ctx)
+	previous := a.previous
+	a.previous = q.Last
+
+	switch {
+	case previous == 0 || q.Last == previous:
+		return
+	case q.Last > previous && q.BestAsk > 0:
+		a.place(ctx, "buy", q.BestAsk, 1+a.rng.Int63n(a.maxQty))
+	case q.Last < previous && q.BestBid > 0:
+		a.place(ctx, "sell", q.BestBid, 1+a.rng.Int63n(a.maxQty))
+	}
+
+	a.cancelAll(ctx)
+}
+
+// mid is the last price, or reference before the first trade.
+func (q quote) mid(reference int64) int64 {
+	if q.Last > 0 {
+		return q.Last
+	}
+
+	return reference
+}
+
+func (t *trading) quote(ctx context.Context) quote {
+	start := time.Now()
+	q, err := t.venue.quote(ctx, t.instrument)
+	t.observe(ctx, opBook, start, err)
+
+	return q
+}
+
+func (t *trading) place(ctx context.Context, side string, price, qty int64) {
+	start := time.Now()
+	result, err := t.trader.place(ctx, t.instrument, side, price, qty)
+	t.observe(ctx, opPlace, start, err)
+
+	if err != nil {
+		return
+	}
+
+	t.stats.fills(result.Fills)
+
+	if result.Remaining > 0 {
+		t.open = append(t.open, result.OrderID)
+	}
+}
+
+func (t *trading) cancel(ctx context.Context, orderID string) {
+	start := time.Now()
+	err := t.trader.cancel(ctx, orderID)
+	t.observe(ctx, opCancel, start, err)
+}
+
+// observe records a call unless the run ended while it was in flight: its
+// latency and outcome would be the deadline's, not the venue's.
+func (t *trading) observe(ctx context.Context, op string, start time.Time, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	t.stats.observe(op, time.Since(start), err)
+}
+
+func (t *trading) cancelAll(ctx context.Context) {
+	for _, orderID := range t.open {
+		t.cancel(ctx, orderID)
+	}
+
+	t.open = t.open[:0]
+}
+
+func (t *trading) withdraw(ctx context.Context) {
+	for _, orderID := range t.open {
+		_ = t.trader.cancel(ctx, orderID)
+	}
+
+	t.open = t.open[:0]
+}
@@ -0,0 +1,96 @@
+//go:build all || e2e
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SimulateE2ETestSuite struct {
+	suite.Suite
+}
+
+// simulate runs the tool with a few steps per agent and decodes its JSON
+// report.
+func (suite *SimulateE2ETestSuite) simulate(ctx context.Context, args ...string) (int, report, string) {
+	t := suite.T()
+
+	var stdout, stderr bytes.Buffer
+
+	args = append([]string{"-o", "json", "-steps", "50", "-random", "4", "-makers", "1", "-momentum", "1"}, args...)
+	code := run(ctx, args, &stdout, &stderr)
+
+	var r report
+	if code != 2 {
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &r), stderr.String())
+	}
+
+	return code, r, stderr.String()
+}
+
+func (suite *SimulateE2ETestSuite) assertReport(r report) {
+	t := suite.T()
+
+	assert.Equal(t, 6, r.Agents)
+	assert.True(t, r.Conserved)
+	assert.Positive(t, r.Operations)
+	assert.Positive(t, r.Trades)
+	assert.Positive(t, r.Outcomes[opPlace+" ok"])
+
+	for _, a := range r.Assets {
+		assert.Zero(t, a.Diff, a.Asset)
+		assert.Equal(t, int64(6_000_000), a.Credited, a.Asset)
+	}
+
+	for _, l := range r.Latencies {
+		assert.LessOrEqual(t, l.P50, l.P99, l.Op)
+		assert.LessOrEqual(t, l.P99, l.Max, l.Op)
+	}
+}
+
+func (suite *SimulateE2ETestSuite) TestInproc() {
+	t := suite.T()
+
+	code, r, stderr := suite.simulate(t.Context(), "-instrument", "sin/usdt", "-base-funds", "1000000", "-quote-funds", "1000000")
+	require.Equal(t, 0, code, stderr)
+
+	assert.Equal(t, modeInproc, r.Mode)
+	assert.Equal(t, "SIN/USDT", r.Instrument)
+	suite.assertReport(r)
+}
+
+func (suite *SimulateE2ETestSuite) TestHTTP() {
+	t := suite.T()
+
+	code, r, stderr := suite.simulate(t.Context(), "-mode", "http", "-instrument", "SIH/USDT", "-base-funds", "1000000", "-quote-funds", "1000000")
+	require.Equal(t, 0, code, stderr)
+
+	assert.Equal(t, modeHTTP, r.Mode)
+	suite.assertReport(r)
+}
+
+func (suite *SimulateE2ETestSuite) TestUsage() {
+	t := suite.T()
+
+	code, _, stderr := suite.simulate(t.Context(), "-mode", "grpc")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, `unknown mode "grpc"`)
+
+	code, _, stderr = suite.simulate(t.Context(), "-random", "0", "-makers", "0", "-momentum", "0")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "at least one agent")
+
+	code, _, _ = suite.simulate(t.Context(), "-instrument", "SIM")
+	assert.Equal(t, 2, code)
+}
+
+func TestSimulate(t *testing.T) {
+	suite.Run(t, new(SimulateE2ETestSuite))
+}
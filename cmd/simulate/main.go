@@ -0,0 +1,258 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
+)
+
+const (
+	modeInproc = "inproc"
+	modeHTTP   = "http"
+)
+
+type options struct {
+	mode        string
+	url         string
+	adminKey    string
+	adminSecret string
+	instrument  string
+	output      string
+	duration    time.Duration
+	seed        int64
+	reference   int64
+	baseFunds   int64
+	quoteFunds  int64
+	maxQty      int64
+	steps       int
+	random      int
+	makers      int
+	momentum    int
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	os.Exit(run(ctx, os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	var opts options
+
+	flags := flag.NewFlagSet("simulate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&opts.mode, "mode", modeInproc, "inproc drives the use cases in this process; http drives a server through the REST API")
+	flags.StringVar(&opts.url, "url", "", "server of -mode http; empty serves the API from this process")
+	flags.StringVar(&opts.adminKey, "admin-key", os.Getenv("CLOB_ADMIN_KEY"), "admin API key that funds the accounts over HTTP (CLOB_ADMIN_KEY)")
+	flags.StringVar(&opts.adminSecret, "admin-secret", os.Getenv("CLOB_ADMIN_SECRET"), "admin API secret (CLOB_ADMIN_SECRET)")
+	flags.StringVar(&opts.instrument, "instrument", "SIM/USDT", "instrument traded")
+	flags.StringVar(&opts.output, "o", "table", "report format: table or json")
+	flags.DurationVar(&opts.duration, "duration", 10*time.Second, "how long the agents trade")
+	flags.Int64Var(&opts.seed, "seed", 1, "seed of the agents' random choices")
+	flags.Int64Var(&opts.reference, "price", 10_000, "price the agents trade around before the first trade")
+	flags.Int64Var(&opts.baseFunds, "base-funds", 1_000_000, "base asset credited to each agent")
+	flags.Int64Var(&opts.quoteFunds, "quote-funds", 10_000_000_000, "quote asset credited to each agent")
+	flags.Int64Var(&opts.maxQty, "max-qty", 10, "largest order quantity")
+	flags.IntVar(&opts.steps, "steps", 0, "stop each agent after this many steps; 0 runs for -duration")
+	flags.IntVar(&opts.random, "random", 8, "random agents")
+	flags.IntVar(&opts.makers, "makers", 2, "market maker agents")
+	flags.IntVar(&opts.momentum, "momentum", 2, "momentum agents")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return 2
+	}
+
+	err = opts.validate()
+	if err != nil {
+		fmt.Fprintln(stderr, "simulate:", err)
+
+		return 2
+	}
+
+	log.SetOutput(io.Discard)
+	config.Init()
+
+	r, err := simulate(ctx, opts)
+	if err != nil {
+		fmt.Fprintln(stderr, "simulate:", err)
+
+		return 1
+	}
+
+	if opts.output == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(r)
+	} else {
+		err = r.write(stdout)
+	}
+
+	if err != nil {
+		fmt.Fprintln(stderr, "simulate:", err)
+
+		return 1
+	}
+
+	if !r.Conserved {
+		return 1
+	}
+
+	return 0
+}
+
+func (o *options) validate() error {
+	if o.mode != modeInproc && o.mode != modeHTTP {
+		return fmt.Errorf("unknown mode %q", o.mode)
+	}
+
+	if o.output != "table" && o.output != "json" {
+		return fmt.Errorf("unknown output format %q", o.output)
+	}
+
+	if o.random < 0 || o.makers < 0 || o.momentum < 0 || o.random+o.makers+o.momentum == 0 {
+		return fmt.Errorf("at least one agent is needed")
+	}
+
+	if o.reference <= 0 || o.maxQty <= 0 || o.baseFunds < 0 || o.quoteFunds < 0 || o.steps < 0 {
+		return fmt.Errorf("-price and -max-qty must be positive, funds and -steps not negative")
+	}
+
+	o.instrument = strings.ToUpper(o.instrument)
+
+	_, _, err := domainBook.SplitInstrument(o.instrument)
+
+	return err
+}
+
+// simulate funds one account per agent, runs the agents concurrently and
+// reports on them, checking that the assets credited are all still held
+// by the agents or the fee account.
+func simulate(ctx context.Context, opts options) (*report, error) {
+	v, err := openVenue(opts)
+	if err != nil {
+		return nil, err
+	}
+	defer v.close()
+
+	base, quote, _ := domainBook.SplitInstrument(opts.instrument)
+	funds := map[string]int64{base: opts.baseFunds, quote: opts.quoteFunds}
+
+	kinds := make([]string, 0, opts.random+opts.makers+opts.momentum)
+	kinds = append(kinds, repeat(agentMarketMaker, opts.makers)...)
+	kinds = append(kinds, repeat(agentRandom, opts.random)...)
+	kinds = append(kinds, repeat(agentMomentum, opts.momentum)...)
+
+	st := newStats()
+	runID := time.Now().UnixNano()
+	traders := make([]trader, len(kinds))
+	agents := make([]agent, len(kinds))
+	credited := map[string]int64{}
+
+	for i, kind := range kinds {
+		traders[i], err = v.openAccount(ctx, fmt.Sprintf("sim-%d-%s-%d", runID, kind, i), funds)
+		if err != nil {
+			return nil, fmt.Errorf("open account of agent %d: %w", i, err)
+		}
+
+		for asset, amount := range funds {
+			credited[asset] += amount
+		}
+
+		agents[i] = newAgent(kind, trading{
+			trader:     traders[i],
+			venue:      v,
+			stats:      st,
+			rng:        rand.New(rand.NewSource(opts.seed + int64(i))),
+			instrument: opts.instrument,
+			reference:  opts.reference,
+			maxQty:     opts.maxQty,
+		})
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, opts.duration)
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	start := time.Now()
+
+	for _, a := range agents {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for steps := 0; opts.steps == 0 || steps < opts.steps; steps++ {
+				if runCtx.Err() != nil {
+					return
+				}
+
+				a.step(runCtx)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	elapsed := time.Since(start)
+
+	// Orders left resting would trade with the next run's agents on the
+	// same book and move assets out of its accounts.
+	for _, a := range agents {
+		a.withdraw(ctx)
+	}
+
+	held := map[string]int64{}
+
+	// The balances are read after the run, with the caller's context: the
+	// run's own may have expired.
+	for i, t := range traders {
+		balances, err := t.balances(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("balances of agent %d: %w", i, err)
+		}
+
+		for asset, amount := range balances {
+			held[asset] += amount
+		}
+	}
+
+	r := st.report(elapsed, credited, held)
+	r.Mode = opts.mode
+	r.Instrument = opts.instrument
+	r.Agents = len(agents)
+
+	return r, nil
+}
+
+func openVenue(opts options) (venue, error) {
+	if opts.mode == modeHTTP {
+		return newHTTPVenue(opts.url, opts.adminKey, opts.adminSecret)
+	}
+
+	return newInprocVenue(), nil
+}
+
+func repeat(kind string, n int) []string {
+	kinds := make([]string, n)
+	for i := range kinds {
+		kinds[i] = kind
+	}
+
+	return kinds
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/juninhoitabh/clob-go/internal/shared"
+	clobClient "github.com/juninhoitabh/clob-go/pkg/clob-client"
+)
+
+const (
+	opBook   = "book"
+	opPlace  = "place"
+	opCancel = "cancel"
+)
+
+// stats collects every call's latency and outcome, and the fees of every
+// trade, from all agents.
+type stats struct {
+	latencies map[string][]time.Duration
+	outcomes  map[string]int
+	fees      map[string]int64
+	mu        sync.Mutex
+	trades    int
+}
+
+func newStats() *stats {
+	return &stats{
+		latencies: map[string][]time.Duration{},
+		outcomes:  map[string]int{},
+		fees:      map[string]int64{},
+	}
+}
+
+func (s *stats) observe(op string, took time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.latencies[op] = append(s.latencies[op], took)
+	s.outcomes[op+" "+outcome(err)]++
+}
+
+func (s *stats) fills(fills []fill) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.trades += len(fills)
+
+	for _, f := range fills {
+		if f.MakerFee != 0 {
+			s.fees[f.MakerFeeAsset] += f.MakerFee
+		}
+
+		if f.TakerFee != 0 {
+			s.fees[f.TakerFeeAsset] += f.TakerFee
+		}
+	}
+}
+
+// outcome names the result of a call: ok, the reject code, or the error
+// message for errors without one.
+func outcome(err error) string {
+	if err == nil {
+		return "ok"
+	}
+
+	var rejectErr *shared.RejectError
+	if errors.As(err, &rejectErr) && rejectErr.Code != "" {
+		return rejectErr.Code
+	}
+
+	var apiErr *clobClient.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Message
+	}
+
+	return err.Error()
+}
+
+type (
+	latencyReport struct {
+		Op     string        `json:"op"`
+		Count  int           `json:"count"`
+		PerSec float64       `json:"per_sec"`
+		P50    time.Duration `json:"p50_ns"`
+		P90    time.Duration `json:"p90_ns"`
+		P99    time.Duration `json:"p99_ns"`
+		Max    time.Duration `json:"max_ns"`
+	}
+	// assetReport compares what was credited with what the accounts hold
+	// plus the fees the fee account took; Diff is zero when nothing was
+	// created or lost.
+	assetReport struct {
+		Asset    string `json:"asset"`
+		Credited int64  `json:"credited"`
+		Held     int64  `json:"held"`
+		Fees     int64  `json:"fees"`
+		Diff     int64  `json:"diff"`
+	}
+	report struct {
+		Outcomes   map[string]int  `json:"outcomes"`
+		Mode       string          `json:"mode"`
+		Instrument string          `json:"instrument"`
+		Latencies  []latencyReport `json:"latencies"`
+		Assets     []assetReport   `json:"assets"`
+		Elapsed    time.Duration   `json:"elapsed_ns"`
+		Agents     int             `json:"agents"`
+		Operations int             `json:"operations"`
+		Trades     int             `json:"trades"`
+		PerSec     float64         `json:"per_sec"`
+		Conserved  bool            `json:"conserved"`
+	}
+)
+
+func (s *stats) report(elapsed time.Duration, credited, held map[string]int64) *report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := &report{
+		Outcomes:  maps.Clone(s.outcomes),
+		Elapsed:   elapsed,
+		Trades:    s.trades,
+		Conserved: true,
+	}
+
+	for _, op := range slices.Sorted(maps.Keys(s.latencies)) {
+		latencies := slices.Clone(s.latencies[op])
+		slices.Sort(latencies)
+
+		r.Operations += len(latencies)
+		r.Latencies = append(r.Latencies, latencyReport{
+			Op:     op,
+			Count:  len(latencies),
+			PerSec: perSecond(len(latencies), elapsed),
+			P50:    percentile(latencies, 0.50),
+			P90:    percentile(latencies, 0.90),
+			P99:    percentile(latencies, 0.99),
+			Max:    latencies[len(latencies)-1],
+		})
+	}
+
+	r.PerSec = perSecond(r.Operations, elapsed)
+
+	assets := maps.Clone(credited)
+	for asset := range held {
+		assets[asset] += 0
+	}
+
+	for _, asset := range slices.Sorted(maps.Keys(assets)) {
+		line := assetReport{
+			Asset:    asset,
+			Credited: credited[asset],
+			Held:     held[asset],
+			Fees:     s.fees[asset],
+		}
+		line.Diff = line.Held + line.Fees - line.Credited
+
+		if line.Diff != 0 {
+			r.Conserved = false
+		}
+
+		r.Assets = append(r.Assets, line)
+	}
+
+	return r
+}
+
+// percentile returns the nearest-rank percentile of sorted latencies.
+func percentile(sorted []time.Duration, q float64) time.Duration {
+	rank := int(math.Ceil(q * float64(len(sorted))))
+
+	return sorted[max(rank-1, 0)]
+}
+
+func perSecond(count int, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return float64(count) / elapsed.Seconds()
+}
+
+func (r *report) write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "mode %s, instrument %s, %d agents, %s\n", r.Mode, r.Instrument, r.Agents, r.Elapsed.Round(time.Millisecond))
+	fmt.Fprintf(tw, "operations %d (%.1f/s), trades %d\n\n", r.Operations, r.PerSec, r.Trades)
+
+	fmt.Fprintln(tw, "OP\tCOUNT\tPER_SEC\tP50\tP90\tP99\tMAX")
+
+	for _, l := range r.Latencies {
+		fmt.Fprintf(tw, "%s\t%d\t%.1f\t%s\t%s\t%s\t%s\n", l.Op, l.Count, l.PerSec, l.P50, l.P90, l.P99, l.Max)
+	}
+
+	fmt.Fprintln(tw, "\nOUTCOME\tCOUNT")
+
+	for _, name := range slices.Sorted(maps.Keys(r.Outcomes)) {
+		fmt.Fprintf(tw, "%s\t%d\n", name, r.Outcomes[name])
+	}
+
+	fmt.Fprintln(tw, "\nASSET\tCREDITED\tHELD\tFEES\tDIFF")
+
+	for _, a := range r.Assets {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\n", a.Asset, a.Credited, a.Held, a.Fees, a.Diff)
+	}
+
+	if r.Conserved {
+		fmt.Fprintln(tw, "\nconservation: ok")
+	} else {
+		fmt.Fprintln(tw, "\nconservation: FAILED")
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	accountUsecases "github.com/juninhoitabh/clob-go/internal/application/account/usecases"
+	bookUsecases "github.com/juninhoitabh/clob-go/internal/application/book/usecases"
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	domainRisk "github.com/juninhoitabh/clob-go/internal/domain/risk"
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
+	daosAccount "github.com/juninhoitabh/clob-go/internal/infra/daos/account"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesBook "github.com/juninhoitabh/clob-go/internal/infra/repositories/book"
+	repositoriesFee "github.com/juninhoitabh/clob-go/internal/infra/repositories/fee"
+	repositoriesOrder "github.com/juninhoitabh/clob-go/internal/infra/repositories/order"
+	repositoriesRisk "github.com/juninhoitabh/clob-go/internal/infra/repositories/risk"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	// fill is the part of a trade the conservation check needs: the fees
+	// moved to the fee account.
+	fill struct {
+		MakerFeeAsset string
+		TakerFeeAsset string
+		MakerFee      int64
+		TakerFee      int64
+	}
+	placed struct {
+		OrderID   string
+		Fills     []fill
+		Remaining int64
+	}
+	quote struct {
+		BestBid int64
+		BestAsk int64
+		Last    int64
+	}
+	// venue is where the agents trade: the use cases in this process, or a
+	// server over HTTP.
+	venue interface {
+		openAccount(ctx context.Context, name string, funds map[string]int64) (trader, error)
+		quote(ctx context.Context, instrument string) (quote, error)
+		close()
+	}
+	trader interface {
+		place(ctx context.Context, instrument, side string, price, qty int64) (*placed, error)
+		cancel(ctx context.Context, orderID string) error
+		// balances returns available plus reserved per asset.
+		balances(ctx context.Context) (map[string]int64, error)
+	}
+)
+
+type inprocVenue struct {
+	createAccount accountUsecases.ICreateAccountUseCase
+	creditAccount accountUsecases.ICreditAccountUseCase
+	placeOrder    orderUsecases.IPlaceOrderUseCase
+	cancelOrder   orderUsecases.ICancelOrderUseCase
+	snapshotBook  bookUsecases.ISnapshotBookUseCase
+	accountDAO    *daosAccount.InMemoryAccountDAO
+}
+
+// newInprocVenue wires the use cases the way the HTTP server does, on the
+// in-memory repositories and the configured circuit breaker and fees.
+func newInprocVenue() *inprocVenue {
+	feeVolumeTiers, err := domainFee.NewVolumeTiers(config.EnvConfigInstance.FeeVolumeTiers)
+	if err != nil {
+		log.Fatalf("invalid FEE_VOLUME_TIERS: %v", err)
+	}
+
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+	bookRepo := repositoriesBook.NewInMemoryBookRepository()
+	orderRepo := repositoriesOrder.NewInMemoryOrderRepository()
+
+	return &inprocVenue{
+		createAccount: accountUsecases.NewCreateAccountUseCase(accountRepo),
+		creditAccount: accountUsecases.NewCreditAccountUseCase(accountRepo),
+		placeOrder: orderUsecases.NewPlaceOrderUseCase(
+			bookRepo,
+			orderRepo,
+			accountRepo,
+			repositoriesFee.NewInMemoryScheduleRepository(),
+			repositoriesTrade.NewInMemoryTradeRepository(),
+			domainBook.CircuitBreakerProps{
+				BandBps:      config.EnvConfigInstance.PriceBandBps,
+				MoveBps:      config.EnvConfigInstance.CircuitBreakerMoveBps,
+				Window:       config.EnvConfigInstance.CircuitBreakerWindow,
+				HaltDuration: config.EnvConfigInstance.CircuitBreakerHalt,
+			},
+			domainFee.FeeProps{
+				AccountID:   config.EnvConfigInstance.FeeAccountID,
+				VolumeTiers: feeVolumeTiers,
+				Rates: domainFee.Rates{
+					MakerBps: config.EnvConfigInstance.MakerFeeBps,
+					TakerBps: config.EnvConfigInstance.TakerFeeBps,
+				},
+			},
+			domainRisk.NewDefaultChain(repositoriesRisk.NewInMemoryLimitsRepository()),
+		),
+		cancelOrder:  orderUsecases.NewCancelOrderUseCase(bookRepo, orderRepo, accountRepo),
+		snapshotBook: bookUsecases.NewSnapshotBookUseCase(bookRepo),
+		accountDAO:   daosAccount.NewInMemoryAccountDAO(accountRepo.Mutex(), accountRepo.AccountsMap()),
+	}
+}
+
+func (v *inprocVenue) openAccount(_ context.Context, name string, funds map[string]int64) (trader, error) {
+	created, err := v.createAccount.Execute(accountUsecases.CreateAccountInput{AccountName: name})
+	if err != nil {
+		return nil, err
+	}
+
+	for asset, amount := range funds {
+		err = v.creditAccount.Execute(accountUsecases.CreditAccountInput{AccountID: created.ID, Asset: asset, Amount: amount})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &inprocTrader{venue: v, accountID: created.ID}, nil
+}
+
+func (v *inprocVenue) quote(_ context.Context, instrument string) (quote, error) {
+	snapshot, err := v.snapshotBook.Execute(bookUsecases.SnapshotBookInput{Instrument: instrument})
+	if errors.Is(err, shared.ErrNotFound) {
+		return quote{}, nil
+	}
+
+	if err != nil {
+		return quote{}, err
+	}
+
+	q := quote{Last: snapshot.LastPrice}
+
+	if len(snapshot.Bids) > 0 {
+		q.BestBid = snapshot.Bids[0].Price
+	}
+
+	if len(snapshot.Asks) > 0 {
+		q.BestAsk = snapshot.Asks[0].Price
+	}
+
+	return q, nil
+}
+
+func (v *inprocVenue) close() {}
+
+type inprocTrader struct {
+	venue     *inprocVenue
+	accountID string
+}
+
+func (t *inprocTrader) place(_ context.Context, instrument, side string, price, qty int64) (*placed, error) {
+	output, err := t.venue.placeOrder.Execute(orderUsecases.PlaceOrderInput{
+		AccountID:  t.accountID,
+		Instrument: instrument,
+		Side:       side,
+		Price:      price,
+		Qty:        qty,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The order keeps being matched by other agents once the book is
+	// unlocked, so what rests is worked out from this placement's trades
+	// instead of read from it.
+	result := &placed{OrderID: output.Order.GetID(), Remaining: qty}
+
+	if output.TradeReport != nil {
+		for _, trade := range output.TradeReport.Trades {
+			result.Remaining -= trade.Qty
+			result.Fills = append(result.Fills, fill{
+				MakerFeeAsset: trade.MakerFeeAsset,
+				TakerFeeAsset: trade.TakerFeeAsset,
+				MakerFee:      trade.MakerFee,
+				TakerFee:      trade.TakerFee,
+			})
+		}
+
+		if output.TradeReport.Halted {
+			result.Remaining = 0
+		}
+	}
+
+	return result, nil
+}
+
+func (t *inprocTrader) cancel(_ context.Context, orderID string) error {
+	_, err := t.venue.cancelOrder.Execute(orderUsecases.CancelOrderInput{OrderID: orderID})
+
+	return err
+}
+
+func (t *inprocTrader) balances(_ context.Context) (map[string]int64, error) {
+	snapshot, err := t.venue.accountDAO.Snapshot(t.accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return totalBalances(snapshot.Balances), nil
+}
+
+func totalBalances(balances map[string]domainAccount.Balance) map[string]int64 {
+	totals := make(map[string]int64, len(balances))
+
+	for asset, balance := range balances {
+		totals[asset] = balance.Available + balance.Reserved
+	}
+
+	return totals
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
+	"github.com/juninhoitabh/clob-go/internal/infra/http-server/router"
+	"github.com/juninhoitabh/clob-go/internal/infra/http-server/router/routes"
+	clobClient "github.com/juninhoitabh/clob-go/pkg/clob-client"
+)
+
+// httpVenue trades through the REST API. Accounts are created and funded
+// through it too, so crediting needs an admin key when the server has
+// authentication enabled.
+type httpVenue struct {
+	public *clobClient.Client
+	admin  *clobClient.Client
+	server *httptest.Server
+	url    string
+}
+
+// newHTTPVenue targets the server at url, or, when url is empty, serves
+// router.Generate from this process with authentication and rate limits
+// off.
+func newHTTPVenue(url, adminKey, adminSecret string) (*httpVenue, error) {
+	v := &httpVenue{url: url}
+
+	if url == "" {
+		config.EnvConfigInstance.AuthEnabled = false
+		config.EnvConfigInstance.RateLimitEnabled = false
+
+		v.server = httptest.NewServer(router.Generate(config.EnvConfigInstance.ApiPort, routes.NewPlaceOrderUseCase()))
+		v.url = v.server.URL
+	}
+
+	var err error
+
+	v.public, err = clobClient.NewClient(v.url)
+	if err != nil {
+		v.close()
+
+		return nil, err
+	}
+
+	var adminOpts []clobClient.Option
+	if adminKey != "" {
+		adminOpts = append(adminOpts, clobClient.WithCredentials(adminKey, adminSecret))
+	}
+
+	v.admin, err = clobClient.NewClient(v.url, adminOpts...)
+	if err != nil {
+		v.close()
+
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func (v *httpVenue) openAccount(ctx context.Context, name string, funds map[string]int64) (trader, error) {
+	created, err := v.public.CreateAccount(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for asset, amount := range funds {
+		_, err = v.admin.Credit(ctx, created.AccountID, asset, amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Retries would hide failures and skew the latencies being measured.
+	client, err := clobClient.NewClient(v.url,
+		clobClient.WithCredentials(created.APIKey, created.APISecret),
+		clobClient.WithRetry(1, 0),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpTrader{client: client, accountID: created.AccountID}, nil
+}
+
+func (v *httpVenue) quote(ctx context.Context, instrument string) (quote, error) {
+	book, err := v.public.GetBook(ctx, instrument)
+	if errors.Is(err, clobClient.ErrNotFound) {
+		return quote{}, nil
+	}
+
+	if err != nil {
+		return quote{}, err
+	}
+
+	q := quote{Last: book.LastPrice}
+
+	if len(book.Bids) > 0 {
+		q.BestBid = book.Bids[0].Price
+	}
+
+	if len(book.Asks) > 0 {
+		q.BestAsk = book.Asks[0].Price
+	}
+
+	return q, nil
+}
+
+func (v *httpVenue) close() {
+	if v.server != nil {
+		v.server.Close()
+	}
+}
+
+type httpTrader struct {
+	client    *clobClient.Client
+	accountID string
+}
+
+func (t *httpTrader) place(ctx context.Context, instrument, side string, price, qty int64) (*placed, error) {
+	output, err := t.client.PlaceOrder(ctx, clobClient.PlaceOrderInput{
+		AccountID:  t.accountID,
+		Instrument: instrument,
+		Side:       side,
+		Price:      price,
+		Qty:        qty,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &placed{OrderID: output.Order.ID, Remaining: output.Order.Remaining}
+
+	for _, trade := range output.Trades {
+		result.Fills = append(result.Fills, fill{
+			MakerFeeAsset: trade.MakerFeeAsset,
+			TakerFeeAsset: trade.TakerFeeAsset,
+			MakerFee:      trade.MakerFee,
+			TakerFee:      trade.TakerFee,
+		})
+	}
+
+	return result, nil
+}
+
+func (t *httpTrader) cancel(ctx context.Context, orderID string) error {
+	_, err := t.client.CancelOrder(ctx, orderID)
+
+	return err
+}
+
+func (t *httpTrader) balances(ctx context.Context) (map[string]int64, error) {
+	acct, err := t.client.GetAccount(ctx, t.accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[string]int64, len(acct.Balances))
+
+	for asset, balance := range acct.Balances {
+		totals[asset] = balance.Available + balance.Reserved
+	}
+
+	return totals, nil
+}
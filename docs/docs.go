@@ -50,7 +50,7 @@ const docTemplate = `{
                         }
                     },
                     "201": {
-                        "description": "Created",
+                        "description": "Created, with the first API key and its secret",
                         "schema": {
                             "$ref": "#/definitions/account.createOutputDto"
                         }
@@ -61,12 +61,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/shared.Errors"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/shared.Errors"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -127,9 +121,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/accounts/{id}/credit": {
-            "post": {
-                "description": "Credit",
+        "/accounts/{id}/aggregated-balances": {
+            "get": {
+                "description": "Get the balances of an account summed with every sub-account below it, along with each sub-account's own balances",
                 "consumes": [
                     "application/json"
                 ],
@@ -139,7 +133,52 @@ const docTemplate = `{
                 "tags": [
                     "Accounts"
                 ],
-                "summary": "Credit",
+                "summary": "Get Aggregated Balances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/account.aggregatedOutputDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{id}/api-keys": {
+            "post": {
+                "description": "Issue a new API key and secret for the account. The secret is only returned once; sign requests with HMAC-SHA256 over \"timestamp\\nMETHOD\\npath\\nhex(sha256(body))\" and send X-API-Key, X-API-Timestamp and X-API-Signature. A key can only issue keys with a role up to its own; role defaults to trader",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Issue API Key",
                 "parameters": [
                     {
                         "type": "string",
@@ -150,20 +189,19 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "creditInputDto request",
+                        "description": "issueInputDto request",
                         "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/account.creditInputDto"
+                            "$ref": "#/definitions/apikey.issueInputDto"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/account.creditOutputDto"
+                            "$ref": "#/definitions/apikey.issueOutputDto"
                         }
                     },
                     "400": {
@@ -172,6 +210,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/shared.Errors"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -187,9 +237,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/books": {
+        "/accounts/{id}/client-orders/{client_order_id}": {
             "get": {
-                "description": "Get by Instrument",
+                "description": "Look up an account's order by the client_order_id it was submitted with",
                 "consumes": [
                     "application/json"
                 ],
@@ -197,15 +247,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Books"
+                    "Orders"
                 ],
-                "summary": "Get by Instrument",
+                "summary": "Orders Get By Client Order ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "instrument",
-                        "name": "instrument",
-                        "in": "query",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "client_order_id",
+                        "name": "client_order_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -213,11 +271,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/book.getByInstrumentOutputDto"
+                            "$ref": "#/definitions/order.getOutputDto"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Account not owned by the caller",
                         "schema": {
                             "$ref": "#/definitions/shared.Errors"
                         }
@@ -237,9 +301,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders": {
+        "/accounts/{id}/client-orders/{client_order_id}/cancel": {
             "post": {
-                "description": "Orders",
+                "description": "Cancel an account's order by the client_order_id it was submitted with",
                 "consumes": [
                     "application/json"
                 ],
@@ -249,23 +313,101 @@ const docTemplate = `{
                 "tags": [
                     "Orders"
                 ],
-                "summary": "Orders",
+                "summary": "Orders Cancel By Client Order ID",
                 "parameters": [
                     {
-                        "description": "placeInputDto request",
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "client_order_id",
+                        "name": "client_order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.cancelOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Account not owned by the caller",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{id}/dead-man-switch": {
+            "put": {
+                "description": "Arm the account's dead man's switch. Unless it is refreshed with a heartbeat within timeout_seconds, all of the account's open orders are cancelled and their reservations released. Setting it again replaces the timeout",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DeadManSwitch"
+                ],
+                "summary": "Set Dead Man's Switch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "setSwitchInputDto request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/order.placeInputDto"
+                            "$ref": "#/definitions/deadman.setSwitchInputDto"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/order.placeOutputDto"
+                            "$ref": "#/definitions/deadman.switchOutputDto"
                         }
                     },
                     "400": {
@@ -274,6 +416,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/shared.Errors"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Account not owned by the caller",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -287,11 +441,57 @@ const docTemplate = `{
                         }
                     }
                 }
+            },
+            "delete": {
+                "description": "Disarm the account's dead man's switch without cancelling any orders",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DeadManSwitch"
+                ],
+                "summary": "Disarm Dead Man's Switch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Account not owned by the caller",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
             }
         },
-        "/orders/{id}/cancel": {
+        "/accounts/{id}/dead-man-switch/heartbeat": {
             "post": {
-                "description": "Orders Cancel",
+                "description": "Refresh the account's dead man's switch for another full timeout. Returns 404 once the switch has fired or if it was never set",
                 "consumes": [
                     "application/json"
                 ],
@@ -299,14 +499,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Orders"
+                    "DeadManSwitch"
                 ],
-                "summary": "Orders Cancel",
+                "summary": "Dead Man's Switch Heartbeat",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "order_id",
+                        "description": "account_id",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -316,11 +516,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/order.cancelOutputDto"
+                            "$ref": "#/definitions/deadman.switchOutputDto"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Account not owned by the caller",
                         "schema": {
                             "$ref": "#/definitions/shared.Errors"
                         }
@@ -339,272 +545,3041 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "account.createInputDto": {
-            "type": "object",
-            "required": [
-                "account_name"
-            ],
-            "properties": {
-                "account_name": {
-                    "type": "string",
-                    "example": "test"
-                }
-            }
-        },
-        "account.createOutputDto": {
-            "type": "object",
-            "properties": {
-                "account_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
-                },
-                "status": {
-                    "type": "string",
-                    "example": "created"
-                }
-            }
         },
-        "account.creditBalanceOutputDto": {
-            "type": "object",
-            "properties": {
-                "available": {
-                    "type": "integer",
-                    "example": 1000
-                },
-                "reserved": {
+        "/accounts/{id}/fee-tier": {
+            "get": {
+                "description": "Get the current fee tier of an account and its traded notional over the rolling 30-day window",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fees"
+                ],
+                "summary": "Get Account Fee Tier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/fee.tierOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{id}/ledger": {
+            "get": {
+                "description": "Immutable double-entry postings behind the account balances, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Accounts"
+                ],
+                "summary": "Account Ledger",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "asset",
+                        "name": "asset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ledger.listOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{id}/orders/cancel-all": {
+            "post": {
+                "description": "Cancel every resting order of an account, optionally only those of one instrument and/or side, and release their reservations. Either all matching orders are cancelled or none are",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Orders Mass Cancel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "instrument",
+                        "name": "instrument",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "buy",
+                            "sell"
+                        ],
+                        "type": "string",
+                        "description": "side",
+                        "name": "side",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.massCancelOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Account not owned by the caller",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{id}/risk-limits": {
+            "get": {
+                "description": "Get the pre-trade risk limits of an account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Risk"
+                ],
+                "summary": "Get Risk Limits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/risk.limitsOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{id}/sessions": {
+            "post": {
+                "description": "Open a server-sent events session that emits a \"heartbeat\" event periodically. With cancel_on_disconnect=true, all of the account's open orders are cancelled when the session ends for any reason",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "DeadManSwitch"
+                ],
+                "summary": "Streaming Session",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "cancel the account's open orders when the session ends",
+                        "name": "cancel_on_disconnect",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/deadman.sessionEventDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Account not owned by the caller",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{id}/sub-accounts": {
+            "post": {
+                "description": "Create a sub-account under the account in the path. The parent's API keys can act on the sub-account.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Accounts"
+                ],
+                "summary": "Create Sub-Account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "parent account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "createInputDto request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/account.createInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/account.createSubAccountOutputDto"
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/account.createSubAccountOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Parent account not found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{id}/withdraw": {
+            "post": {
+                "description": "Request a withdrawal, debiting Available (never Reserved) and holding the funds while it is pending",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Withdrawals"
+                ],
+                "summary": "Withdraw",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "withdrawInputDto request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/withdrawal.withdrawInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/withdrawal.withdrawalOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Account not owned by the caller",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "422": {
+                        "description": "Insufficient available balance",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/accounts/{id}/api-keys": {
+            "post": {
+                "description": "Issue a new API key and secret for the account. The secret is only returned once; sign requests with HMAC-SHA256 over \"timestamp\\nMETHOD\\npath\\nhex(sha256(body))\" and send X-API-Key, X-API-Timestamp and X-API-Signature. A key can only issue keys with a role up to its own; role defaults to trader",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Issue API Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "issueInputDto request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/apikey.issueInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/apikey.issueOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/accounts/{id}/credit": {
+            "post": {
+                "description": "Credit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Accounts"
+                ],
+                "summary": "Credit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "creditInputDto request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/account.creditInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/account.creditOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/accounts/{id}/fee-tier": {
+            "put": {
+                "description": "Pin the fee tier of an account, overriding the volume-based tier; an empty tier unpins it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fees"
+                ],
+                "summary": "Set Account Fee Tier",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "setTierInputDto request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/fee.setTierInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/fee.tierOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/accounts/{id}/risk-limits": {
+            "put": {
+                "description": "Set the pre-trade risk limits of an account, zero disables a limit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Risk"
+                ],
+                "summary": "Set Risk Limits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "setLimitsInputDto request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/risk.setLimitsInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/risk.limitsOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/audit-log": {
+            "get": {
+                "description": "Admin actions with their actor and the state of the target before and after, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Audit Log",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key that performed the action",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "action",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "target",
+                        "name": "target",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/audit.listOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/books/circuit-breaker": {
+            "put": {
+                "description": "Configure the price band and circuit breaker of an instrument",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Books"
+                ],
+                "summary": "Configure Circuit Breaker",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "instrument",
+                        "name": "instrument",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "configureCircuitBreakerInputDto request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/book.configureCircuitBreakerInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/book.circuitBreakerOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/books/halt": {
+            "post": {
+                "description": "Halt trading on an instrument until it is resumed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Books"
+                ],
+                "summary": "Halt",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "instrument",
+                        "name": "instrument",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/book.circuitBreakerOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/books/resume": {
+            "post": {
+                "description": "Resume trading on a halted instrument",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Books"
+                ],
+                "summary": "Resume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "instrument",
+                        "name": "instrument",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/book.circuitBreakerOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/fees/schedules": {
+            "put": {
+                "description": "Set the maker/taker fee schedule of an instrument, a negative maker_bps is a rebate",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fees"
+                ],
+                "summary": "Set Fee Schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "instrument",
+                        "name": "instrument",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "setScheduleInputDto request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/fee.setScheduleInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/fee.scheduleOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/reconciliation": {
+            "get": {
+                "description": "Check that every asset's holdings equal deposits minus withdrawals, that each account's Reserved matches its open orders and that balances agree with the ledger",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reconciliation"
+                ],
+                "summary": "Reconcile",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/reconciliation.reconcileOutputDto"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/withdrawals/{id}/complete": {
+            "post": {
+                "description": "Mark a pending withdrawal as completed, the held funds leave the exchange",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Withdrawals"
+                ],
+                "summary": "Complete Withdrawal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "withdrawal_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/withdrawal.withdrawalOutputDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "422": {
+                        "description": "Withdrawal is not pending",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/withdrawals/{id}/fail": {
+            "post": {
+                "description": "Mark a pending withdrawal as failed, the held funds return to Available",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Withdrawals"
+                ],
+                "summary": "Fail Withdrawal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "withdrawal_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "failInputDto request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/withdrawal.failInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/withdrawal.withdrawalOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "422": {
+                        "description": "Withdrawal is not pending",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/books": {
+            "get": {
+                "description": "Get by Instrument",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Books"
+                ],
+                "summary": "Get by Instrument",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "instrument",
+                        "name": "instrument",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/book.getByInstrumentOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/fees/schedules": {
+            "get": {
+                "description": "Get the maker/taker fee schedule of an instrument",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Fees"
+                ],
+                "summary": "Get Fee Schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "instrument",
+                        "name": "instrument",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/fee.scheduleOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders": {
+            "post": {
+                "description": "Place an order. An optional client_order_id, unique per account, makes submission idempotent: resubmitting it returns the original order and its entry trades with 200",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Orders",
+                "parameters": [
+                    {
+                        "description": "placeInputDto request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order.placeInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Replayed submission",
+                        "schema": {
+                            "$ref": "#/definitions/order.placeOutputDto"
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/order.placeOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Account not owned by the caller",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "409": {
+                        "description": "Client order ID reused with different parameters",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/batch": {
+            "post": {
+                "description": "Place up to BATCH_MAX_ORDERS orders in one request. The orders run back to back on their books without other clients' orders in between; each one succeeds or fails on its own and is reported at its index with the status a single placement would return",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Orders Batch",
+                "parameters": [
+                    {
+                        "description": "batchPlaceInputDto request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order.batchPlaceInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.batchPlaceOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/cancel-batch": {
+            "post": {
+                "description": "Cancel up to BATCH_MAX_ORDERS orders in one request, each identified by order_id or by account_id and client_order_id. The cancels run back to back on their books; each one is reported at its index with the status a single cancel would return",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Orders Cancel Batch",
+                "parameters": [
+                    {
+                        "description": "batchCancelInputDto request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order.batchCancelInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.batchCancelOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}": {
+            "get": {
+                "description": "Look up an order by its exchange ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Orders Get",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "order_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.getOutputDto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Account not owned by the caller",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/cancel": {
+            "post": {
+                "description": "Orders Cancel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orders"
+                ],
+                "summary": "Orders Cancel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "order_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/order.cancelOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Account not owned by the caller",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/trades": {
+            "get": {
+                "description": "Trade history with fees, most recent first. Without account_id, the public tape: every trade, but only its ID, instrument, price, qty and time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trades"
+                ],
+                "summary": "List Trades",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "account_id",
+                        "name": "account_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "instrument",
+                        "name": "instrument",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/trade.listOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Account not owned by the caller",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/transfers": {
+            "post": {
+                "description": "Move an asset between two accounts atomically, debiting the source's Available balance. Sub-accounts can only transfer to or from their parent. Retrying with the same Idempotency-Key returns the original transfer",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transfers"
+                ],
+                "summary": "Transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Unique key per source account",
+                        "name": "Idempotency-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "transferInputDto request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transfer.transferInputDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Replayed transfer",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.transferOutputDto"
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.transferOutputDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "403": {
+                        "description": "Account not owned by the caller",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "409": {
+                        "description": "Idempotency key reused with different parameters",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "422": {
+                        "description": "Insufficient available balance or sub-account transfer outside its parent",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        },
+        "/withdrawals/{id}": {
+            "get": {
+                "description": "Get a withdrawal and its current state",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Withdrawals"
+                ],
+                "summary": "Get Withdrawal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "withdrawal_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/withdrawal.withdrawalOutputDto"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/shared.Errors"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "account.aggregatedOutputDto": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "balances": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/account.getAllByIdBalanceOutputDto"
+                    }
+                },
+                "sub_accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/account.getAllByIdOutputDto"
+                    }
+                }
+            }
+        },
+        "account.createInputDto": {
+            "type": "object",
+            "required": [
+                "account_name"
+            ],
+            "properties": {
+                "account_name": {
+                    "type": "string",
+                    "example": "test"
+                }
+            }
+        },
+        "account.createOutputDto": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "api_key": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174002"
+                },
+                "api_secret": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "created"
+                }
+            }
+        },
+        "account.createSubAccountOutputDto": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "parent_account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174001"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "created"
+                }
+            }
+        },
+        "account.creditBalanceOutputDto": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "reserved": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "account.creditInputDto": {
+            "type": "object",
+            "required": [
+                "amount",
+                "asset"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "asset": {
+                    "type": "string",
+                    "example": "USD"
+                }
+            }
+        },
+        "account.creditOutputDto": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "balances": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/account.creditBalanceOutputDto"
+                    }
+                }
+            }
+        },
+        "account.getAllByIdBalanceOutputDto": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "reserved": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "account.getAllByIdOutputDto": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "balances": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/account.getAllByIdBalanceOutputDto"
+                    }
+                },
+                "parent_account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174001"
+                },
+                "sub_account_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "apikey.issueInputDto": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "read-only",
+                        "trader",
+                        "operator",
+                        "admin"
+                    ],
+                    "example": "trader"
+                }
+            }
+        },
+        "apikey.issueOutputDto": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "api_key": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174002"
+                },
+                "api_secret": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "trader"
+                }
+            }
+        },
+        "audit.entryOutputDto": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "action": {
+                    "type": "string",
+                    "example": "account.credit"
+                },
+                "actor": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174002"
+                },
+                "after": {
+                    "type": "object"
+                },
+                "before": {
+                    "type": "object"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "entry_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "target": {
+                    "type": "string",
+                    "example": "account:123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "audit.listOutputDto": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/audit.entryOutputDto"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 250
+                }
+            }
+        },
+        "book.circuitBreakerOutputDto": {
+            "type": "object",
+            "properties": {
+                "band_bps": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "halt_seconds": {
+                    "type": "integer",
+                    "example": 300
+                },
+                "halted": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "halted_until": {
+                    "type": "string",
+                    "example": "2025-01-01T00:05:00Z"
+                },
+                "instrument": {
+                    "type": "string",
+                    "example": "BTC/USDT"
+                },
+                "last_price": {
+                    "type": "integer",
+                    "example": 50000
+                },
+                "move_bps": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "reference_price": {
+                    "type": "integer",
+                    "example": 50000
+                },
+                "window_seconds": {
+                    "type": "integer",
+                    "example": 60
+                }
+            }
+        },
+        "book.configureCircuitBreakerInputDto": {
+            "type": "object",
+            "properties": {
+                "band_bps": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1000
+                },
+                "halt_seconds": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 300
+                },
+                "move_bps": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1000
+                },
+                "reference_price": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 50000
+                },
+                "window_seconds": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 60
+                }
+            }
+        },
+        "book.getByInstrumentLevelOutputDto": {
+            "type": "object",
+            "properties": {
+                "price": {
+                    "type": "integer",
+                    "example": 50000
+                },
+                "qty": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "book.getByInstrumentOutputDto": {
+            "type": "object",
+            "properties": {
+                "asks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/book.getByInstrumentLevelOutputDto"
+                    }
+                },
+                "bids": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/book.getByInstrumentLevelOutputDto"
+                    }
+                },
+                "halted": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "instrument": {
+                    "type": "string",
+                    "example": "BTC/USDT"
+                },
+                "last_price": {
+                    "type": "integer",
+                    "example": 50000
+                }
+            }
+        },
+        "deadman.sessionEventDto": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "cancel_on_disconnect": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "time": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                }
+            }
+        },
+        "deadman.setSwitchInputDto": {
+            "type": "object",
+            "required": [
+                "timeout_seconds"
+            ],
+            "properties": {
+                "timeout_seconds": {
+                    "type": "integer",
+                    "maximum": 3600,
+                    "minimum": 1,
+                    "example": 30
+                }
+            }
+        },
+        "deadman.switchOutputDto": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:30Z"
+                },
+                "timeout_seconds": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                }
+            }
+        },
+        "fee.ratesDto": {
+            "type": "object",
+            "properties": {
+                "maker_bps": {
+                    "type": "integer",
+                    "example": -2
+                },
+                "taker_bps": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "fee.scheduleOutputDto": {
+            "type": "object",
+            "properties": {
+                "instrument": {
+                    "type": "string",
+                    "example": "BTC/USDT"
+                },
+                "maker_bps": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "taker_bps": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "tiers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/fee.ratesDto"
+                    }
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                }
+            }
+        },
+        "fee.setScheduleInputDto": {
+            "type": "object",
+            "properties": {
+                "maker_bps": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "taker_bps": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 10
+                },
+                "tiers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/fee.ratesDto"
+                    }
+                }
+            }
+        },
+        "fee.setTierInputDto": {
+            "type": "object",
+            "properties": {
+                "tier": {
+                    "type": "string",
+                    "example": "vip"
+                }
+            }
+        },
+        "fee.tierOutputDto": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "pinned": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "tier": {
+                    "type": "string",
+                    "example": "vip"
+                },
+                "volume_30d": {
+                    "type": "integer",
+                    "example": 1500000
+                }
+            }
+        },
+        "ledger.ledgerAccountDto": {
+            "type": "object",
+            "properties": {
+                "bucket": {
+                    "type": "string",
+                    "example": "available"
+                },
+                "owner": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "ledger.listOutputDto": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "offset": {
                     "type": "integer",
                     "example": 0
+                },
+                "postings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ledger.postingOutputDto"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 250
                 }
             }
         },
-        "account.creditInputDto": {
+        "ledger.postingOutputDto": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "asset": {
+                    "type": "string",
+                    "example": "USDT"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "credit": {
+                    "$ref": "#/definitions/ledger.ledgerAccountDto"
+                },
+                "debit": {
+                    "$ref": "#/definitions/ledger.ledgerAccountDto"
+                },
+                "operation": {
+                    "type": "string",
+                    "example": "reserve"
+                },
+                "posting_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "ref_id": {
+                    "type": "string",
+                    "example": "7"
+                },
+                "ref_type": {
+                    "type": "string",
+                    "example": "order"
+                },
+                "seq": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "order.batchCancelInputDto": {
             "type": "object",
             "required": [
-                "amount",
-                "asset"
+                "orders"
             ],
             "properties": {
-                "amount": {
+                "orders": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/order.batchCancelItemInputDto"
+                    }
+                }
+            }
+        },
+        "order.batchCancelItemInputDto": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "client_order_id": {
+                    "type": "string",
+                    "example": "my-order-1"
+                },
+                "order_id": {
+                    "type": "string",
+                    "example": "7"
+                }
+            }
+        },
+        "order.batchCancelItemOutputDto": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/shared.ErrorResponse"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "result": {
+                    "$ref": "#/definitions/order.cancelOutputDto"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 200
+                }
+            }
+        },
+        "order.batchCancelOutputDto": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.batchCancelItemOutputDto"
+                    }
+                }
+            }
+        },
+        "order.batchPlaceInputDto": {
+            "type": "object",
+            "required": [
+                "orders"
+            ],
+            "properties": {
+                "orders": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/order.placeInputDto"
+                    }
+                }
+            }
+        },
+        "order.batchPlaceItemOutputDto": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/shared.ErrorResponse"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "result": {
+                    "$ref": "#/definitions/order.placeOutputDto"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 201
+                }
+            }
+        },
+        "order.batchPlaceOutputDto": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.batchPlaceItemOutputDto"
+                    }
+                }
+            }
+        },
+        "order.cancelOutputDto": {
+            "type": "object",
+            "properties": {
+                "order": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "status": {
+                    "type": "string",
+                    "example": "canceled"
+                }
+            }
+        },
+        "order.getOutputDto": {
+            "type": "object",
+            "properties": {
+                "order": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
+        "order.massCancelOutputDto": {
+            "type": "object",
+            "properties": {
+                "canceled": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "additionalProperties": {}
+                    }
+                }
+            }
+        },
+        "order.placeInputDto": {
+            "type": "object",
+            "required": [
+                "account_id",
+                "instrument",
+                "price",
+                "qty",
+                "side"
+            ],
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "client_order_id": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "my-order-1"
+                },
+                "instrument": {
+                    "type": "string",
+                    "example": "BTC-USD"
+                },
+                "price": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 50000
+                },
+                "qty": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "side": {
+                    "type": "string",
+                    "enum": [
+                        "buy",
+                        "sell"
+                    ],
+                    "example": "buy"
+                }
+            }
+        },
+        "order.placeOutputDto": {
+            "type": "object",
+            "properties": {
+                "canceled_qty": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "halted": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "order": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "report": {
+                    "$ref": "#/definitions/order.placeTradeReportOutputDto"
+                }
+            }
+        },
+        "order.placeTradeOutputDto": {
+            "type": "object",
+            "required": [
+                "price",
+                "qty"
+            ],
+            "properties": {
+                "buyer_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "maker_fee": {
+                    "type": "integer",
+                    "example": -5
+                },
+                "maker_fee_asset": {
+                    "type": "string",
+                    "example": "USDT"
+                },
+                "maker_order_id": {
+                    "type": "string",
+                    "example": "7"
+                },
+                "price": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 50000
+                },
+                "qty": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "seller_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "taker_fee": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "taker_fee_asset": {
+                    "type": "string",
+                    "example": "BTC"
+                },
+                "taker_order_id": {
+                    "type": "string",
+                    "example": "7"
+                },
+                "trade_id": {
+                    "type": "string",
+                    "example": "BTC/USDT-42"
+                }
+            }
+        },
+        "order.placeTradeReportOutputDto": {
+            "type": "object",
+            "properties": {
+                "trades": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.placeTradeOutputDto"
+                    }
+                }
+            }
+        },
+        "reconciliation.assetOutputDto": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "USDT"
+                },
+                "deposits": {
+                    "type": "integer",
+                    "example": 1500
+                },
+                "expected": {
                     "type": "integer",
                     "example": 1000
                 },
-                "asset": {
-                    "type": "string",
-                    "example": "USD"
+                "fees": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "holdings": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "withdrawals": {
+                    "type": "integer",
+                    "example": 500
                 }
             }
         },
-        "account.creditOutputDto": {
+        "reconciliation.discrepancyOutputDto": {
             "type": "object",
             "properties": {
                 "account_id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "balances": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "$ref": "#/definitions/account.creditBalanceOutputDto"
-                    }
+                "actual": {
+                    "type": "integer",
+                    "example": 320
+                },
+                "asset": {
+                    "type": "string",
+                    "example": "USDT"
+                },
+                "expected": {
+                    "type": "integer",
+                    "example": 300
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "reserved_obligations"
                 }
             }
         },
-        "account.getAllByIdBalanceOutputDto": {
+        "reconciliation.reconcileOutputDto": {
             "type": "object",
             "properties": {
-                "available": {
-                    "type": "integer",
-                    "example": 1000
+                "assets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/reconciliation.assetOutputDto"
+                    }
                 },
-                "reserved": {
-                    "type": "integer",
-                    "example": 0
+                "balanced": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "checked_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "discrepancies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/reconciliation.discrepancyOutputDto"
+                    }
                 }
             }
         },
-        "account.getAllByIdOutputDto": {
+        "risk.limitsOutputDto": {
             "type": "object",
             "properties": {
                 "account_id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "balances": {
+                "max_open_orders": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "max_order_notional": {
+                    "type": "integer",
+                    "example": 1000000
+                },
+                "max_orders_per_window": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "max_positions": {
                     "type": "object",
                     "additionalProperties": {
-                        "$ref": "#/definitions/account.getAllByIdBalanceOutputDto"
+                        "type": "integer",
+                        "format": "int64"
                     }
+                },
+                "rate_window_seconds": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
                 }
             }
         },
-        "book.getByInstrumentLevelOutputDto": {
+        "risk.setLimitsInputDto": {
             "type": "object",
             "properties": {
-                "price": {
+                "max_open_orders": {
                     "type": "integer",
-                    "example": 50000
+                    "minimum": 0,
+                    "example": 50
                 },
-                "qty": {
+                "max_order_notional": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1000000
+                },
+                "max_orders_per_window": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 10
+                },
+                "max_positions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                },
+                "rate_window_seconds": {
                     "type": "integer",
+                    "minimum": 0,
                     "example": 1
                 }
             }
         },
-        "book.getByInstrumentOutputDto": {
+        "shared.ErrorResponse": {
             "type": "object",
             "properties": {
-                "asks": {
+                "code": {
+                    "type": "string"
+                },
+                "details": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/book.getByInstrumentLevelOutputDto"
+                        "type": "string"
                     }
                 },
-                "bids": {
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                }
+            }
+        },
+        "shared.Errors": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "PRICE_OUT_OF_BAND"
+                },
+                "details": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/book.getByInstrumentLevelOutputDto"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "The 'name' field is required"
+                    ]
                 },
-                "instrument": {
+                "message": {
                     "type": "string",
-                    "example": "BTC/USDT"
+                    "example": "Invalid parameter"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
                 }
             }
         },
-        "order.cancelOutputDto": {
+        "trade.listOutputDto": {
             "type": "object",
             "properties": {
-                "order": {
-                    "type": "object",
-                    "additionalProperties": {}
-                },
-                "status": {
-                    "type": "string",
-                    "example": "canceled"
+                "trades": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/trade.tradeOutputDto"
+                    }
                 }
             }
         },
-        "order.placeInputDto": {
+        "trade.tradeOutputDto": {
             "type": "object",
-            "required": [
-                "account_id",
-                "instrument",
-                "price",
-                "qty",
-                "side"
-            ],
             "properties": {
-                "account_id": {
+                "buyer_id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
+                "executed_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
                 "instrument": {
                     "type": "string",
-                    "example": "BTC-USD"
+                    "example": "BTC/USDT"
+                },
+                "maker_fee": {
+                    "type": "integer",
+                    "example": -5
+                },
+                "maker_fee_asset": {
+                    "type": "string",
+                    "example": "USDT"
+                },
+                "maker_order_id": {
+                    "type": "string",
+                    "example": "7"
                 },
                 "price": {
                     "type": "integer",
-                    "minimum": 1,
                     "example": 50000
                 },
                 "qty": {
                     "type": "integer",
-                    "minimum": 1,
                     "example": 1
                 },
-                "side": {
+                "seller_id": {
                     "type": "string",
-                    "enum": [
-                        "buy",
-                        "sell"
-                    ],
-                    "example": "buy"
-                }
-            }
-        },
-        "order.placeOutputDto": {
-            "type": "object",
-            "properties": {
-                "order": {
-                    "type": "object",
-                    "additionalProperties": {}
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "report": {
-                    "$ref": "#/definitions/order.placeTradeReportOutputDto"
+                "taker_fee": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "taker_fee_asset": {
+                    "type": "string",
+                    "example": "BTC"
+                },
+                "taker_order_id": {
+                    "type": "string",
+                    "example": "7"
+                },
+                "trade_id": {
+                    "type": "string",
+                    "example": "BTC/USDT-42"
                 }
             }
         },
-        "order.placeTradeOutputDto": {
+        "transfer.transferInputDto": {
             "type": "object",
             "required": [
-                "price",
-                "qty"
+                "amount",
+                "asset",
+                "from_account_id",
+                "to_account_id"
             ],
             "properties": {
-                "buyer_id": {
+                "amount": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "asset": {
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                    "example": "USDT"
                 },
-                "maker_order_id": {
+                "from_account_id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "price": {
+                "to_account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174001"
+                }
+            }
+        },
+        "transfer.transferOutputDto": {
+            "type": "object",
+            "properties": {
+                "amount": {
                     "type": "integer",
-                    "minimum": 1,
-                    "example": 50000
+                    "example": 1000
                 },
-                "qty": {
-                    "type": "integer",
-                    "minimum": 1,
-                    "example": 1
+                "asset": {
+                    "type": "string",
+                    "example": "USDT"
                 },
-                "seller_id": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "from_account_id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "taker_order_id": {
+                "idempotency_key": {
+                    "type": "string",
+                    "example": "8e0f5a52-5f2c-4f1e-9d4b-1c1f0c2d3e4f"
+                },
+                "to_account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174001"
+                },
+                "transfer_id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 }
             }
         },
-        "order.placeTradeReportOutputDto": {
+        "withdrawal.failInputDto": {
             "type": "object",
             "properties": {
-                "trades": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.placeTradeOutputDto"
-                    }
+                "reason": {
+                    "type": "string",
+                    "example": "rejected by custodian"
                 }
             }
         },
-        "shared.Errors": {
+        "withdrawal.withdrawInputDto": {
             "type": "object",
+            "required": [
+                "amount",
+                "asset"
+            ],
             "properties": {
-                "details": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "The 'name' field is required"
-                    ]
+                "amount": {
+                    "type": "integer",
+                    "example": 1000
                 },
-                "message": {
+                "asset": {
                     "type": "string",
-                    "example": "Invalid parameter"
+                    "example": "USDT"
+                }
+            }
+        },
+        "withdrawal.withdrawalOutputDto": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "status": {
+                "amount": {
                     "type": "integer",
-                    "example": 400
+                    "example": 1000
+                },
+                "asset": {
+                    "type": "string",
+                    "example": "USDT"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "rejected by custodian"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "withdrawal_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        }
     }
 }`
 
@@ -615,7 +3590,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "",
 	Schemes:          []string{},
 	Title:            "Clob API",
-	Description:      "Clob API without Authentication",
+	Description:      "Clob API. Requests are signed with an account API key: send X-API-Key, X-API-Timestamp (unix seconds) and X-API-Signature, the hex HMAC-SHA256 of \"timestamp\\nMETHOD\\npath\\nhex(sha256(body))\" keyed with the API secret",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
@@ -12,8 +12,16 @@ type (
 )
 
 func (c *CreateAccountUseCase) Execute(input CreateAccountInput) (*CreateAccountOutput, error) {
+	if input.ParentAccountID != "" {
+		_, err := c.accountRepo.Get(input.ParentAccountID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	account, err := domainAccount.NewAccount(domainAccount.AccountProps{
-		Name: input.AccountName,
+		Name:     input.AccountName,
+		ParentID: input.ParentAccountID,
 	}, idObjValue.Uuid)
 	if err != nil {
 		return nil, err
@@ -25,8 +33,9 @@ func (c *CreateAccountUseCase) Execute(input CreateAccountInput) (*CreateAccount
 	}
 
 	return &CreateAccountOutput{
-		ID:   account.GetID(),
-		Name: account.Name,
+		ID:       account.GetID(),
+		Name:     account.Name,
+		ParentID: account.ParentID,
 	}, nil
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/juninhoitabh/clob-go/internal/application/account/usecases/fakers"
 	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
 	"github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
 )
 
 type CreateAccountUseCaseUnitTestSuite struct {
@@ -47,6 +48,33 @@ func (suite *CreateAccountUseCaseUnitTestSuite) TestExecute_Success() {
 	assert.NotEmpty(suite.T(), output.ID)
 }
 
+func (suite *CreateAccountUseCaseUnitTestSuite) TestExecute_SubAccount() {
+	input := suite.inputFaker
+	input.ParentAccountID = "parent-id"
+
+	suite.accountRepo.EXPECT().Get("parent-id").Return(&domainAccount.Account{}, nil)
+	suite.accountRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(acct *domainAccount.Account) error {
+		assert.Equal(suite.T(), "parent-id", acct.ParentID)
+
+		return nil
+	})
+
+	output, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "parent-id", output.ParentID)
+}
+
+func (suite *CreateAccountUseCaseUnitTestSuite) TestExecute_ParentNotFound() {
+	input := suite.inputFaker
+	input.ParentAccountID = "missing"
+
+	suite.accountRepo.EXPECT().Get("missing").Return(nil, shared.ErrNotFound)
+
+	output, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), output)
+}
+
 func (suite *CreateAccountUseCaseUnitTestSuite) TestExecute_DomainError() {
 	input := suite.inputFaker
 	input.AccountName = ""
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
 )
 
 type (
@@ -16,6 +17,9 @@ func (c *CreditAccountUseCase) Execute(input CreditAccountInput) error {
 		return err
 	}
 
+	unlock := accountServices.LockAccounts(acct)
+	defer unlock()
+
 	err = acct.Credit(input.Asset, input.Amount)
 	if err != nil {
 		return err
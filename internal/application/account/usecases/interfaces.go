@@ -7,11 +7,13 @@ type (
 		Amount    int64
 	}
 	CreateAccountInput struct {
-		AccountName string
+		AccountName     string
+		ParentAccountID string
 	}
 	CreateAccountOutput struct {
-		ID   string
-		Name string
+		ID       string
+		Name     string
+		ParentID string
 	}
 	ICreditAccountUseCase interface {
 		Execute(input CreditAccountInput) error
@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"fmt"
+	"time"
+
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	AuthenticateUseCase struct {
+		APIKeyRepo domainAPIKey.IAPIKeyRepository
+		Now        func() time.Time
+		MaxSkew    time.Duration
+	}
+)
+
+func (a *AuthenticateUseCase) Execute(input AuthenticateInput) (*AuthenticateOutput, error) {
+	if input.APIKey == "" || input.Signature == "" || input.Timestamp == 0 {
+		return nil, fmt.Errorf("%w: missing api key, timestamp or signature", shared.ErrUnauthorized)
+	}
+
+	skew := a.Now().Sub(time.Unix(input.Timestamp, 0))
+	if skew > a.MaxSkew || skew < -a.MaxSkew {
+		return nil, fmt.Errorf("%w: timestamp outside the allowed window", shared.ErrUnauthorized)
+	}
+
+	key, err := a.APIKeyRepo.GetAPIKey(input.APIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if key == nil || !key.Verify(input.Signature, input.Timestamp, input.Method, input.Path, input.Body) {
+		return nil, fmt.Errorf("%w: invalid api key or signature", shared.ErrUnauthorized)
+	}
+
+	return &AuthenticateOutput{
+		APIKeyID:  key.GetID(),
+		AccountID: key.AccountID,
+		Role:      string(key.Role),
+	}, nil
+}
+
+func NewAuthenticateUseCase(
+	apiKeyRepo domainAPIKey.IAPIKeyRepository,
+	maxSkew time.Duration,
+) *AuthenticateUseCase {
+	return &AuthenticateUseCase{
+		APIKeyRepo: apiKeyRepo,
+		Now:        time.Now,
+		MaxSkew:    maxSkew,
+	}
+}
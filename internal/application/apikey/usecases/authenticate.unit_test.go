@@ -0,0 +1,112 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/apikey/usecases/fakers"
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	apikeyMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type AuthenticateUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker apikeyUsecases.AuthenticateInput
+	apiKeyRepo *apikeyMocks.MockIAPIKeyRepository
+	ctrl       *gomock.Controller
+	usecase    *apikeyUsecases.AuthenticateUseCase
+	key        *domainAPIKey.APIKey
+	now        time.Time
+}
+
+func (suite *AuthenticateUseCaseUnitTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.apiKeyRepo = apikeyMocks.NewMockIAPIKeyRepository(suite.ctrl)
+	suite.now = time.Unix(1700000000, 0)
+	suite.usecase = apikeyUsecases.NewAuthenticateUseCase(suite.apiKeyRepo, 30*time.Second)
+	suite.usecase.Now = func() time.Time { return suite.now }
+
+	suite.key, _ = domainAPIKey.NewAPIKey(domainAPIKey.APIKeyProps{AccountID: "acc-1"}, "Uuid")
+
+	suite.inputFaker = fakers.AuthenticateInputFaker()
+	suite.inputFaker.APIKey = suite.key.GetID()
+	suite.inputFaker.Timestamp = suite.now.Unix()
+	suite.inputFaker.Signature = domainAPIKey.Sign(
+		suite.key.Secret,
+		suite.inputFaker.Timestamp,
+		suite.inputFaker.Method,
+		suite.inputFaker.Path,
+		suite.inputFaker.Body,
+	)
+}
+
+func (suite *AuthenticateUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *AuthenticateUseCaseUnitTestSuite) TestExecute_Success() {
+	suite.apiKeyRepo.EXPECT().GetAPIKey(suite.key.GetID()).Return(suite.key, nil)
+
+	out, err := suite.usecase.Execute(suite.inputFaker)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "acc-1", out.AccountID)
+	assert.Equal(suite.T(), suite.key.GetID(), out.APIKeyID)
+	assert.Equal(suite.T(), "trader", out.Role)
+}
+
+func (suite *AuthenticateUseCaseUnitTestSuite) TestExecute_MissingHeaders() {
+	input := suite.inputFaker
+	input.Signature = ""
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrUnauthorized)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *AuthenticateUseCaseUnitTestSuite) TestExecute_StaleTimestamp() {
+	suite.now = suite.now.Add(31 * time.Second)
+
+	out, err := suite.usecase.Execute(suite.inputFaker)
+	assert.ErrorIs(suite.T(), err, shared.ErrUnauthorized)
+	assert.Nil(suite.T(), out)
+
+	suite.now = suite.now.Add(-62 * time.Second)
+
+	_, err = suite.usecase.Execute(suite.inputFaker)
+	assert.ErrorIs(suite.T(), err, shared.ErrUnauthorized)
+}
+
+func (suite *AuthenticateUseCaseUnitTestSuite) TestExecute_UnknownKey() {
+	suite.apiKeyRepo.EXPECT().GetAPIKey(suite.key.GetID()).Return(nil, nil)
+
+	out, err := suite.usecase.Execute(suite.inputFaker)
+	assert.ErrorIs(suite.T(), err, shared.ErrUnauthorized)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *AuthenticateUseCaseUnitTestSuite) TestExecute_TamperedBody() {
+	input := suite.inputFaker
+	input.Body = []byte(`{"qty":999999}`)
+
+	suite.apiKeyRepo.EXPECT().GetAPIKey(suite.key.GetID()).Return(suite.key, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrUnauthorized)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *AuthenticateUseCaseUnitTestSuite) TestExecute_RepoError() {
+	suite.apiKeyRepo.EXPECT().GetAPIKey(suite.key.GetID()).Return(nil, errors.New("repo error"))
+
+	out, err := suite.usecase.Execute(suite.inputFaker)
+	assert.EqualError(suite.T(), err, "repo error")
+	assert.Nil(suite.T(), out)
+}
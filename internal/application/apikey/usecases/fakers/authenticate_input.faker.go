@@ -0,0 +1,18 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+)
+
+func AuthenticateInputFaker() apikeyUsecases.AuthenticateInput {
+	faker := faker.New(0)
+
+	return apikeyUsecases.AuthenticateInput{
+		APIKey: faker.UUID(),
+		Method: "POST",
+		Path:   "/api/v1/orders",
+		Body:   []byte(`{"qty":` + faker.Numerify("###") + `}`),
+	}
+}
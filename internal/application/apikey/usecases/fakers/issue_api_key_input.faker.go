@@ -0,0 +1,15 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+)
+
+func IssueAPIKeyInputFaker() apikeyUsecases.IssueAPIKeyInput {
+	faker := faker.New(0)
+
+	return apikeyUsecases.IssueAPIKeyInput{
+		AccountID: faker.UUID(),
+	}
+}
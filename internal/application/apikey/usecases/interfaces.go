@@ -0,0 +1,37 @@
+package usecases
+
+import "time"
+
+type (
+	IssueAPIKeyInput struct {
+		AccountID  string
+		Role       string
+		IssuerRole string
+	}
+	IssueAPIKeyOutput struct {
+		CreatedAt time.Time
+		ID        string
+		AccountID string
+		Secret    string
+		Role      string
+	}
+	AuthenticateInput struct {
+		APIKey    string
+		Signature string
+		Method    string
+		Path      string
+		Body      []byte
+		Timestamp int64
+	}
+	AuthenticateOutput struct {
+		APIKeyID  string
+		AccountID string
+		Role      string
+	}
+	IIssueAPIKeyUseCase interface {
+		Execute(input IssueAPIKeyInput) (*IssueAPIKeyOutput, error)
+	}
+	IAuthenticateUseCase interface {
+		Execute(input AuthenticateInput) (*AuthenticateOutput, error)
+	}
+)
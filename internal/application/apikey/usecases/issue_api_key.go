@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type (
+	IssueAPIKeyUseCase struct {
+		AccountRepo domainAccount.IAccountRepository
+		APIKeyRepo  domainAPIKey.IAPIKeyRepository
+	}
+)
+
+func (i *IssueAPIKeyUseCase) Execute(input IssueAPIKeyInput) (*IssueAPIKeyOutput, error) {
+	role, err := domainAPIKey.ParseRole(input.Role)
+	if err != nil {
+		return nil, shared.ErrInvalidParam
+	}
+
+	if input.IssuerRole != "" && !domainAPIKey.Role(input.IssuerRole).Allows(role) {
+		return nil, shared.ErrForbidden
+	}
+
+	_, err = i.AccountRepo.Get(input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := domainAPIKey.NewAPIKey(domainAPIKey.APIKeyProps{
+		AccountID: input.AccountID,
+		Role:      role,
+	}, idObjValue.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.APIKeyRepo.SaveAPIKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IssueAPIKeyOutput{
+		CreatedAt: key.CreatedAt,
+		ID:        key.GetID(),
+		AccountID: key.AccountID,
+		Secret:    key.Secret,
+		Role:      string(key.Role),
+	}, nil
+}
+
+func NewIssueAPIKeyUseCase(
+	accountRepo domainAccount.IAccountRepository,
+	apiKeyRepo domainAPIKey.IAPIKeyRepository,
+) *IssueAPIKeyUseCase {
+	return &IssueAPIKeyUseCase{
+		AccountRepo: accountRepo,
+		APIKeyRepo:  apiKeyRepo,
+	}
+}
@@ -0,0 +1,120 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/apikey/usecases/fakers"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	apikeyMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type IssueAPIKeyUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker  apikeyUsecases.IssueAPIKeyInput
+	accountRepo *accountMocks.MockIAccountRepository
+	apiKeyRepo  *apikeyMocks.MockIAPIKeyRepository
+	ctrl        *gomock.Controller
+	usecase     *apikeyUsecases.IssueAPIKeyUseCase
+}
+
+func (suite *IssueAPIKeyUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.IssueAPIKeyInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.apiKeyRepo = apikeyMocks.NewMockIAPIKeyRepository(suite.ctrl)
+	suite.usecase = apikeyUsecases.NewIssueAPIKeyUseCase(suite.accountRepo, suite.apiKeyRepo)
+}
+
+func (suite *IssueAPIKeyUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *IssueAPIKeyUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+
+	var saved *domainAPIKey.APIKey
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.apiKeyRepo.EXPECT().SaveAPIKey(gomock.Any()).DoAndReturn(func(key *domainAPIKey.APIKey) error {
+		saved = key
+
+		return nil
+	})
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), saved.GetID(), out.ID)
+	assert.Equal(suite.T(), input.AccountID, out.AccountID)
+	assert.Equal(suite.T(), saved.Secret, out.Secret)
+	assert.NotEmpty(suite.T(), out.Secret)
+	assert.Equal(suite.T(), "trader", out.Role)
+}
+
+func (suite *IssueAPIKeyUseCaseUnitTestSuite) TestExecute_AdminIssuesOperatorKey() {
+	input := suite.inputFaker
+	input.Role = "operator"
+	input.IssuerRole = "admin"
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.apiKeyRepo.EXPECT().SaveAPIKey(gomock.Any()).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "operator", out.Role)
+}
+
+func (suite *IssueAPIKeyUseCaseUnitTestSuite) TestExecute_CannotEscalateRole() {
+	input := suite.inputFaker
+	input.Role = "admin"
+	input.IssuerRole = "trader"
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrForbidden)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *IssueAPIKeyUseCaseUnitTestSuite) TestExecute_InvalidRole() {
+	input := suite.inputFaker
+	input.Role = "root"
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *IssueAPIKeyUseCaseUnitTestSuite) TestExecute_AccountNotFound() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(nil, shared.ErrNotFound)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *IssueAPIKeyUseCaseUnitTestSuite) TestExecute_SaveError() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.apiKeyRepo.EXPECT().SaveAPIKey(gomock.Any()).Return(errors.New("save error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "save error")
+	assert.Nil(suite.T(), out)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(IssueAPIKeyUseCaseUnitTestSuite))
+	suite.Run(t, new(AuthenticateUseCaseUnitTestSuite))
+}
@@ -0,0 +1,18 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	auditUsecases "github.com/juninhoitabh/clob-go/internal/application/audit/usecases"
+)
+
+func ListEntriesInputFaker() auditUsecases.ListEntriesInput {
+	faker := faker.New(0)
+
+	return auditUsecases.ListEntriesInput{
+		Actor:  faker.UUID(),
+		Action: "account.credit",
+		Limit:  faker.Number(1, 100),
+		Offset: faker.Number(0, 10),
+	}
+}
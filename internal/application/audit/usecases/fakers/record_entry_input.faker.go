@@ -0,0 +1,21 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	auditUsecases "github.com/juninhoitabh/clob-go/internal/application/audit/usecases"
+)
+
+func RecordEntryInputFaker() auditUsecases.RecordEntryInput {
+	faker := faker.New(0)
+
+	return auditUsecases.RecordEntryInput{
+		Before:    map[string]int64{"available": 0},
+		After:     map[string]int64{"available": 1000},
+		Actor:     faker.UUID(),
+		AccountID: faker.UUID(),
+		Role:      "admin",
+		Action:    "account.credit",
+		Target:    "account:" + faker.UUID(),
+	}
+}
@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type (
+	RecordEntryInput struct {
+		Before    any
+		After     any
+		Actor     string
+		AccountID string
+		Role      string
+		Action    string
+		Target    string
+	}
+	EntryOutput struct {
+		CreatedAt time.Time
+		ID        string
+		Actor     string
+		AccountID string
+		Role      string
+		Action    string
+		Target    string
+		Before    json.RawMessage
+		After     json.RawMessage
+	}
+	ListEntriesInput struct {
+		Actor  string
+		Action string
+		Target string
+		Limit  int
+		Offset int
+	}
+	ListEntriesOutput struct {
+		Entries []EntryOutput
+		Total   int
+		Limit   int
+		Offset  int
+	}
+	IRecordEntryUseCase interface {
+		Execute(input RecordEntryInput) (*EntryOutput, error)
+	}
+	IListEntriesUseCase interface {
+		Execute(input ListEntriesInput) (*ListEntriesOutput, error)
+	}
+)
@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	domainAudit "github.com/juninhoitabh/clob-go/internal/domain/audit"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+const (
+	defaultListEntriesLimit = 100
+	maxListEntriesLimit     = 1000
+)
+
+type (
+	ListEntriesUseCase struct {
+		AuditRepo domainAudit.IAuditRepository
+	}
+)
+
+func (l *ListEntriesUseCase) Execute(input ListEntriesInput) (*ListEntriesOutput, error) {
+	if input.Limit < 0 || input.Limit > maxListEntriesLimit || input.Offset < 0 {
+		return nil, shared.ErrInvalidParam
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultListEntriesLimit
+	}
+
+	entries, total, err := l.AuditRepo.ListEntries(domainAudit.Filter{
+		Actor:  input.Actor,
+		Action: input.Action,
+		Target: input.Target,
+		Offset: input.Offset,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListEntriesOutput{
+		Entries: make([]EntryOutput, 0, len(entries)),
+		Total:   total,
+		Limit:   limit,
+		Offset:  input.Offset,
+	}
+
+	for _, entry := range entries {
+		output.Entries = append(output.Entries, newEntryOutput(entry))
+	}
+
+	return output, nil
+}
+
+func NewListEntriesUseCase(
+	auditRepo domainAudit.IAuditRepository,
+) *ListEntriesUseCase {
+	return &ListEntriesUseCase{
+		AuditRepo: auditRepo,
+	}
+}
@@ -0,0 +1,101 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	auditUsecases "github.com/juninhoitabh/clob-go/internal/application/audit/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/audit/usecases/fakers"
+	domainAudit "github.com/juninhoitabh/clob-go/internal/domain/audit"
+	auditMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/audit/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type ListEntriesUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker auditUsecases.ListEntriesInput
+	auditRepo  *auditMocks.MockIAuditRepository
+	ctrl       *gomock.Controller
+	usecase    *auditUsecases.ListEntriesUseCase
+}
+
+func (suite *ListEntriesUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.ListEntriesInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.auditRepo = auditMocks.NewMockIAuditRepository(suite.ctrl)
+	suite.usecase = auditUsecases.NewListEntriesUseCase(suite.auditRepo)
+}
+
+func (suite *ListEntriesUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *ListEntriesUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+	entry, _ := domainAudit.NewEntry(domainAudit.EntryProps{
+		Actor:  input.Actor,
+		Action: input.Action,
+		Target: "account:acc1",
+	}, idObjValue.Uuid)
+
+	suite.auditRepo.EXPECT().ListEntries(domainAudit.Filter{
+		Actor:  input.Actor,
+		Action: input.Action,
+		Offset: input.Offset,
+		Limit:  input.Limit,
+	}).Return([]*domainAudit.Entry{entry}, 12, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 12, out.Total)
+	assert.Equal(suite.T(), input.Limit, out.Limit)
+	assert.Equal(suite.T(), input.Offset, out.Offset)
+	assert.Len(suite.T(), out.Entries, 1)
+	assert.Equal(suite.T(), entry.GetID(), out.Entries[0].ID)
+	assert.Equal(suite.T(), "account:acc1", out.Entries[0].Target)
+}
+
+func (suite *ListEntriesUseCaseUnitTestSuite) TestExecute_DefaultLimit() {
+	input := suite.inputFaker
+	input.Limit = 0
+
+	suite.auditRepo.EXPECT().ListEntries(gomock.Any()).DoAndReturn(func(filter domainAudit.Filter) ([]*domainAudit.Entry, int, error) {
+		assert.Equal(suite.T(), 100, filter.Limit)
+
+		return []*domainAudit.Entry{}, 0, nil
+	})
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 100, out.Limit)
+	assert.Empty(suite.T(), out.Entries)
+}
+
+func (suite *ListEntriesUseCaseUnitTestSuite) TestExecute_InvalidPagination() {
+	input := suite.inputFaker
+	input.Limit = 5000
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+
+	input = suite.inputFaker
+	input.Offset = -1
+
+	_, err = suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+}
+
+func (suite *ListEntriesUseCaseUnitTestSuite) TestExecute_RepoError() {
+	suite.auditRepo.EXPECT().ListEntries(gomock.Any()).Return(nil, 0, errors.New("list error"))
+
+	out, err := suite.usecase.Execute(suite.inputFaker)
+	assert.EqualError(suite.T(), err, "list error")
+	assert.Nil(suite.T(), out)
+}
@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"encoding/json"
+
+	domainAudit "github.com/juninhoitabh/clob-go/internal/domain/audit"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type (
+	RecordEntryUseCase struct {
+		AuditRepo domainAudit.IAuditRepository
+	}
+)
+
+func (r *RecordEntryUseCase) Execute(input RecordEntryInput) (*EntryOutput, error) {
+	before, err := marshalState(input.Before)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := marshalState(input.After)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := domainAudit.NewEntry(domainAudit.EntryProps{
+		Actor:     input.Actor,
+		AccountID: input.AccountID,
+		Role:      input.Role,
+		Action:    input.Action,
+		Target:    input.Target,
+		Before:    before,
+		After:     after,
+	}, idObjValue.Uuid)
+	if err != nil {
+		return nil, shared.ErrInvalidParam
+	}
+
+	err = r.AuditRepo.SaveEntry(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	output := newEntryOutput(entry)
+
+	return &output, nil
+}
+
+func marshalState(state any) (json.RawMessage, error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(state)
+}
+
+func newEntryOutput(entry *domainAudit.Entry) EntryOutput {
+	return EntryOutput{
+		CreatedAt: entry.CreatedAt,
+		ID:        entry.GetID(),
+		Actor:     entry.Actor,
+		AccountID: entry.AccountID,
+		Role:      entry.Role,
+		Action:    entry.Action,
+		Target:    entry.Target,
+		Before:    entry.Before,
+		After:     entry.After,
+	}
+}
+
+func NewRecordEntryUseCase(
+	auditRepo domainAudit.IAuditRepository,
+) *RecordEntryUseCase {
+	return &RecordEntryUseCase{
+		AuditRepo: auditRepo,
+	}
+}
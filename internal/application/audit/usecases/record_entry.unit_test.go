@@ -0,0 +1,101 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	auditUsecases "github.com/juninhoitabh/clob-go/internal/application/audit/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/audit/usecases/fakers"
+	domainAudit "github.com/juninhoitabh/clob-go/internal/domain/audit"
+	auditMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/audit/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type RecordEntryUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker auditUsecases.RecordEntryInput
+	auditRepo  *auditMocks.MockIAuditRepository
+	ctrl       *gomock.Controller
+	usecase    *auditUsecases.RecordEntryUseCase
+}
+
+func (suite *RecordEntryUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.RecordEntryInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.auditRepo = auditMocks.NewMockIAuditRepository(suite.ctrl)
+	suite.usecase = auditUsecases.NewRecordEntryUseCase(suite.auditRepo)
+}
+
+func (suite *RecordEntryUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *RecordEntryUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+
+	var saved *domainAudit.Entry
+
+	suite.auditRepo.EXPECT().SaveEntry(gomock.Any()).DoAndReturn(func(entry *domainAudit.Entry) error {
+		saved = entry
+
+		return nil
+	})
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), saved.GetID(), out.ID)
+	assert.Equal(suite.T(), input.Actor, out.Actor)
+	assert.Equal(suite.T(), input.Action, out.Action)
+	assert.Equal(suite.T(), input.Target, out.Target)
+	assert.JSONEq(suite.T(), `{"available":0}`, string(out.Before))
+	assert.JSONEq(suite.T(), `{"available":1000}`, string(out.After))
+}
+
+func (suite *RecordEntryUseCaseUnitTestSuite) TestExecute_NilStates() {
+	input := suite.inputFaker
+	input.Before = nil
+
+	suite.auditRepo.EXPECT().SaveEntry(gomock.Any()).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), out.Before)
+	assert.NotNil(suite.T(), out.After)
+}
+
+func (suite *RecordEntryUseCaseUnitTestSuite) TestExecute_Invalid() {
+	input := suite.inputFaker
+	input.Action = ""
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *RecordEntryUseCaseUnitTestSuite) TestExecute_UnmarshalableState() {
+	input := suite.inputFaker
+	input.After = make(chan int)
+
+	out, err := suite.usecase.Execute(input)
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *RecordEntryUseCaseUnitTestSuite) TestExecute_SaveError() {
+	suite.auditRepo.EXPECT().SaveEntry(gomock.Any()).Return(errors.New("save error"))
+
+	out, err := suite.usecase.Execute(suite.inputFaker)
+	assert.EqualError(suite.T(), err, "save error")
+	assert.Nil(suite.T(), out)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(RecordEntryUseCaseUnitTestSuite))
+	suite.Run(t, new(ListEntriesUseCaseUnitTestSuite))
+}
@@ -0,0 +1,84 @@
+package usecases
+
+import (
+	"time"
+
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type (
+	ConfigureCircuitBreakerUseCase struct {
+		BookRepo domainBook.IBookRepository
+	}
+)
+
+func (c *ConfigureCircuitBreakerUseCase) Execute(input ConfigureCircuitBreakerInput) (*CircuitBreakerOutput, error) {
+	if input.ReferencePrice < 0 || input.BandBps < 0 || input.MoveBps < 0 || input.Window < 0 || input.HaltDuration < 0 {
+		return nil, shared.ErrInvalidParam
+	}
+
+	if _, _, err := domainBook.SplitInstrument(input.Instrument); err != nil {
+		return nil, shared.ErrInvalidParam
+	}
+
+	props := domainBook.CircuitBreakerProps{
+		ReferencePrice: input.ReferencePrice,
+		BandBps:        input.BandBps,
+		MoveBps:        input.MoveBps,
+		Window:         input.Window,
+		HaltDuration:   input.HaltDuration,
+	}
+
+	created, err := domainBook.NewBook(domainBook.BookProps{
+		Instrument:     input.Instrument,
+		CircuitBreaker: props,
+	}, idObjValue.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := c.BookRepo.GetOrCreateBook(created)
+	if err != nil {
+		return nil, err
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	if b != created {
+		b.CircuitBreaker.Configure(props)
+	}
+
+	err = c.BookRepo.SaveBook(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCircuitBreakerOutput(b, time.Now()), nil
+}
+
+func newCircuitBreakerOutput(b *domainBook.Book, now time.Time) *CircuitBreakerOutput {
+	cb := b.CircuitBreaker
+
+	return &CircuitBreakerOutput{
+		Instrument:     b.Instrument,
+		ReferencePrice: cb.ReferencePrice,
+		LastPrice:      cb.LastPrice,
+		BandBps:        cb.BandBps,
+		MoveBps:        cb.MoveBps,
+		Window:         cb.Window,
+		HaltDuration:   cb.HaltDuration,
+		HaltedUntil:    cb.HaltedUntil,
+		Halted:         cb.IsHalted(now),
+	}
+}
+
+func NewConfigureCircuitBreakerUseCase(
+	bookRepo domainBook.IBookRepository,
+) *ConfigureCircuitBreakerUseCase {
+	return &ConfigureCircuitBreakerUseCase{
+		BookRepo: bookRepo,
+	}
+}
@@ -0,0 +1,116 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	bookUsecases "github.com/juninhoitabh/clob-go/internal/application/book/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/book/usecases/fakers"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/infra/repositories/book/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type ConfigureCircuitBreakerUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker bookUsecases.ConfigureCircuitBreakerInput
+	bookRepo   *mocks.MockIBookRepository
+	ctrl       *gomock.Controller
+	usecase    *bookUsecases.ConfigureCircuitBreakerUseCase
+}
+
+func (suite *ConfigureCircuitBreakerUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.ConfigureCircuitBreakerInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.bookRepo = mocks.NewMockIBookRepository(suite.ctrl)
+	suite.usecase = bookUsecases.NewConfigureCircuitBreakerUseCase(suite.bookRepo)
+}
+
+func (suite *ConfigureCircuitBreakerUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *ConfigureCircuitBreakerUseCaseUnitTestSuite) TestExecute_ExistingBook() {
+	input := suite.inputFaker
+
+	b, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, idObjValue.Uuid)
+
+	suite.bookRepo.EXPECT().GetOrCreateBook(gomock.Any()).Return(b, nil)
+	suite.bookRepo.EXPECT().SaveBook(b).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), input.Instrument, out.Instrument)
+	assert.Equal(suite.T(), input.ReferencePrice, out.ReferencePrice)
+	assert.Equal(suite.T(), input.BandBps, out.BandBps)
+	assert.Equal(suite.T(), input.MoveBps, out.MoveBps)
+	assert.Equal(suite.T(), input.Window, out.Window)
+	assert.Equal(suite.T(), input.HaltDuration, out.HaltDuration)
+	assert.False(suite.T(), out.Halted)
+	assert.Equal(suite.T(), input.BandBps, b.CircuitBreaker.BandBps)
+}
+
+func (suite *ConfigureCircuitBreakerUseCaseUnitTestSuite) TestExecute_CreatesBook() {
+	input := suite.inputFaker
+
+	suite.bookRepo.EXPECT().GetOrCreateBook(gomock.Any()).DoAndReturn(func(b *domainBook.Book) (*domainBook.Book, error) {
+		return b, nil
+	})
+	suite.bookRepo.EXPECT().SaveBook(gomock.Any()).DoAndReturn(func(b *domainBook.Book) error {
+		assert.Equal(suite.T(), input.Instrument, b.Instrument)
+		assert.Equal(suite.T(), input.ReferencePrice, b.CircuitBreaker.ReferencePrice)
+
+		return nil
+	})
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), input.BandBps, out.BandBps)
+}
+
+func (suite *ConfigureCircuitBreakerUseCaseUnitTestSuite) TestExecute_InvalidParams() {
+	input := suite.inputFaker
+	input.BandBps = -1
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *ConfigureCircuitBreakerUseCaseUnitTestSuite) TestExecute_InvalidInstrument() {
+	input := suite.inputFaker
+	input.Instrument = "BTCUSDT"
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *ConfigureCircuitBreakerUseCaseUnitTestSuite) TestExecute_GetOrCreateBookError() {
+	input := suite.inputFaker
+
+	suite.bookRepo.EXPECT().GetOrCreateBook(gomock.Any()).Return(nil, errors.New("repo error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *ConfigureCircuitBreakerUseCaseUnitTestSuite) TestExecute_SaveBookError() {
+	input := suite.inputFaker
+
+	suite.bookRepo.EXPECT().GetOrCreateBook(gomock.Any()).DoAndReturn(func(b *domainBook.Book) (*domainBook.Book, error) {
+		return b, nil
+	})
+	suite.bookRepo.EXPECT().SaveBook(gomock.Any()).Return(errors.New("save error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), out)
+}
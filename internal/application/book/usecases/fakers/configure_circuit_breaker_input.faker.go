@@ -0,0 +1,22 @@
+package fakers
+
+import (
+	"time"
+
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	bookUsecases "github.com/juninhoitabh/clob-go/internal/application/book/usecases"
+)
+
+func ConfigureCircuitBreakerInputFaker() bookUsecases.ConfigureCircuitBreakerInput {
+	faker := faker.New(0)
+
+	return bookUsecases.ConfigureCircuitBreakerInput{
+		Instrument:     "BTC/USDT",
+		ReferencePrice: int64(faker.Number(1000, 100000)),
+		BandBps:        int64(faker.Number(1, 5000)),
+		MoveBps:        int64(faker.Number(1, 5000)),
+		Window:         time.Duration(faker.Number(1, 600)) * time.Second,
+		HaltDuration:   time.Duration(faker.Number(1, 600)) * time.Second,
+	}
+}
@@ -0,0 +1,16 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	bookUsecases "github.com/juninhoitabh/clob-go/internal/application/book/usecases"
+)
+
+func HaltBookInputFaker() bookUsecases.HaltBookInput {
+	faker := faker.New(0)
+
+	return bookUsecases.HaltBookInput{
+		Instrument: faker.Word(),
+		Halted:     true,
+	}
+}
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"time"
+
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	HaltBookUseCase struct {
+		BookRepo domainBook.IBookRepository
+	}
+)
+
+func (h *HaltBookUseCase) Execute(input HaltBookInput) (*CircuitBreakerOutput, error) {
+	b, err := h.BookRepo.GetBook(input.Instrument)
+	if err != nil {
+		return nil, err
+	}
+
+	if b == nil {
+		return nil, shared.ErrNotFound
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	now := time.Now()
+
+	if input.Halted {
+		b.CircuitBreaker.Halt()
+	} else {
+		b.CircuitBreaker.Resume()
+	}
+
+	err = h.BookRepo.SaveBook(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCircuitBreakerOutput(b, now), nil
+}
+
+func NewHaltBookUseCase(
+	bookRepo domainBook.IBookRepository,
+) *HaltBookUseCase {
+	return &HaltBookUseCase{
+		BookRepo: bookRepo,
+	}
+}
@@ -0,0 +1,90 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	bookUsecases "github.com/juninhoitabh/clob-go/internal/application/book/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/book/usecases/fakers"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/infra/repositories/book/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type HaltBookUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker bookUsecases.HaltBookInput
+	bookRepo   *mocks.MockIBookRepository
+	ctrl       *gomock.Controller
+	usecase    *bookUsecases.HaltBookUseCase
+}
+
+func (suite *HaltBookUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.HaltBookInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.bookRepo = mocks.NewMockIBookRepository(suite.ctrl)
+	suite.usecase = bookUsecases.NewHaltBookUseCase(suite.bookRepo)
+}
+
+func (suite *HaltBookUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *HaltBookUseCaseUnitTestSuite) TestExecute_HaltAndResume() {
+	input := suite.inputFaker
+
+	b, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, idObjValue.Uuid)
+
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(b, nil).Times(2)
+	suite.bookRepo.EXPECT().SaveBook(b).Return(nil).Times(2)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), out.Halted)
+	assert.False(suite.T(), out.HaltedUntil.IsZero())
+
+	input.Halted = false
+
+	out, err = suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), out.Halted)
+}
+
+func (suite *HaltBookUseCaseUnitTestSuite) TestExecute_BookNotFound() {
+	input := suite.inputFaker
+
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(nil, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *HaltBookUseCaseUnitTestSuite) TestExecute_GetBookError() {
+	input := suite.inputFaker
+
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(nil, errors.New("repo error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *HaltBookUseCaseUnitTestSuite) TestExecute_SaveBookError() {
+	input := suite.inputFaker
+
+	b, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, idObjValue.Uuid)
+
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(b, nil)
+	suite.bookRepo.EXPECT().SaveBook(b).Return(errors.New("save error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), out)
+}
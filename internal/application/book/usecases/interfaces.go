@@ -1,5 +1,7 @@
 package usecases
 
+import "time"
+
 type (
 	SnapshotBookInput struct {
 		Instrument string
@@ -8,8 +10,39 @@ type (
 		Instrument string
 		Bids       []Level
 		Asks       []Level
+		LastPrice  int64
+		Halted     bool
+	}
+	ConfigureCircuitBreakerInput struct {
+		Instrument     string
+		ReferencePrice int64
+		BandBps        int64
+		MoveBps        int64
+		Window         time.Duration
+		HaltDuration   time.Duration
+	}
+	HaltBookInput struct {
+		Instrument string
+		Halted     bool
+	}
+	CircuitBreakerOutput struct {
+		HaltedUntil    time.Time
+		Instrument     string
+		ReferencePrice int64
+		LastPrice      int64
+		BandBps        int64
+		MoveBps        int64
+		Window         time.Duration
+		HaltDuration   time.Duration
+		Halted         bool
 	}
 	ISnapshotBookUseCase interface {
 		Execute(input SnapshotBookInput) (*SnapshotBookOutput, error)
 	}
+	IConfigureCircuitBreakerUseCase interface {
+		Execute(input ConfigureCircuitBreakerInput) (*CircuitBreakerOutput, error)
+	}
+	IHaltBookUseCase interface {
+		Execute(input HaltBookInput) (*CircuitBreakerOutput, error)
+	}
 )
@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"time"
+
 	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
 	"github.com/juninhoitabh/clob-go/internal/shared"
 )
@@ -25,10 +27,15 @@ func (s *SnapshotBookUseCase) Execute(input SnapshotBookInput) (*SnapshotBookOut
 		return nil, shared.ErrNotFound
 	}
 
+	b.Lock()
+	defer b.Unlock()
+
 	out := &SnapshotBookOutput{
 		Instrument: input.Instrument,
 		Bids:       []Level{},
 		Asks:       []Level{},
+		LastPrice:  b.CircuitBreaker.LastPrice,
+		Halted:     b.CircuitBreaker.IsHalted(time.Now()),
 	}
 
 	for _, p := range b.BidPrices() {
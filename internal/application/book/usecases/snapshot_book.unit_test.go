@@ -81,4 +81,6 @@ func (suite *SnapshotBookUseCaseUnitTestSuite) TestExecute_RepoError() {
 
 func TestSuite(t *testing.T) {
 	suite.Run(t, new(SnapshotBookUseCaseUnitTestSuite))
+	suite.Run(t, new(ConfigureCircuitBreakerUseCaseUnitTestSuite))
+	suite.Run(t, new(HaltBookUseCaseUnitTestSuite))
 }
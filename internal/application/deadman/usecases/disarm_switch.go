@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	domainDeadman "github.com/juninhoitabh/clob-go/internal/domain/deadman"
+)
+
+type (
+	DisarmSwitchUseCase struct {
+		AccountRepo domainAccount.IAccountRepository
+		SwitchRepo  domainDeadman.ISwitchRepository
+	}
+)
+
+func (d *DisarmSwitchUseCase) Execute(input DisarmSwitchInput) error {
+	err := accountServices.Authorize(d.AccountRepo, input.CallerAccountID, input.AccountID)
+	if err != nil {
+		return err
+	}
+
+	return d.SwitchRepo.DeleteSwitch(input.AccountID)
+}
+
+func NewDisarmSwitchUseCase(
+	accountRepo domainAccount.IAccountRepository,
+	switchRepo domainDeadman.ISwitchRepository,
+) *DisarmSwitchUseCase {
+	return &DisarmSwitchUseCase{
+		AccountRepo: accountRepo,
+		SwitchRepo:  switchRepo,
+	}
+}
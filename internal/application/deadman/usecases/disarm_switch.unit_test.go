@@ -0,0 +1,48 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	deadmanUsecases "github.com/juninhoitabh/clob-go/internal/application/deadman/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	deadmanMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/deadman/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type DisarmSwitchUseCaseUnitTestSuite struct {
+	suite.Suite
+	accountRepo *accountMocks.MockIAccountRepository
+	switchRepo  *deadmanMocks.MockISwitchRepository
+	ctrl        *gomock.Controller
+	usecase     *deadmanUsecases.DisarmSwitchUseCase
+}
+
+func (suite *DisarmSwitchUseCaseUnitTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.switchRepo = deadmanMocks.NewMockISwitchRepository(suite.ctrl)
+	suite.usecase = deadmanUsecases.NewDisarmSwitchUseCase(suite.accountRepo, suite.switchRepo)
+}
+
+func (suite *DisarmSwitchUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *DisarmSwitchUseCaseUnitTestSuite) TestExecute_Success() {
+	suite.switchRepo.EXPECT().DeleteSwitch("acc1").Return(nil)
+
+	err := suite.usecase.Execute(deadmanUsecases.DisarmSwitchInput{AccountID: "acc1"})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *DisarmSwitchUseCaseUnitTestSuite) TestExecute_ForbiddenForOtherAccount() {
+	suite.accountRepo.EXPECT().Get("acc1").Return(&domainAccount.Account{}, nil)
+
+	err := suite.usecase.Execute(deadmanUsecases.DisarmSwitchInput{AccountID: "acc1", CallerAccountID: "acc999"})
+	assert.ErrorIs(suite.T(), err, shared.ErrForbidden)
+}
@@ -0,0 +1,18 @@
+package fakers
+
+import (
+	"time"
+
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	deadmanUsecases "github.com/juninhoitabh/clob-go/internal/application/deadman/usecases"
+)
+
+func SetSwitchInputFaker() deadmanUsecases.SetSwitchInput {
+	faker := faker.New(0)
+
+	return deadmanUsecases.SetSwitchInput{
+		AccountID: faker.UUID(),
+		Timeout:   time.Duration(faker.Number(1, 60)) * time.Second,
+	}
+}
@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainDeadman "github.com/juninhoitabh/clob-go/internal/domain/deadman"
+)
+
+type (
+	FireExpiredSwitchesUseCase struct {
+		SwitchRepo domainDeadman.ISwitchRepository
+		MassCancel orderUsecases.IMassCancelOrdersUseCase
+	}
+)
+
+// Execute mass-cancels the open orders of every account whose switch expired
+// by input.Now. A failure on one account is reported and does not stop the
+// others. A switch is only removed once its cancel succeeded, so a failed
+// one fires again on the next sweep.
+func (f *FireExpiredSwitchesUseCase) Execute(input FireExpiredSwitchesInput) (*FireExpiredSwitchesOutput, error) {
+	expired, err := f.SwitchRepo.ListExpired(input.Now)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &FireExpiredSwitchesOutput{Fired: make([]FiredSwitch, 0, len(expired))}
+
+	for _, sw := range expired {
+		fired := FiredSwitch{AccountID: sw.AccountID}
+
+		massCancelOutput, err := f.MassCancel.Execute(orderUsecases.MassCancelOrdersInput{AccountID: sw.AccountID})
+		if err == nil {
+			fired.Canceled = len(massCancelOutput.Orders)
+			err = f.SwitchRepo.DeleteFired(sw)
+		}
+
+		fired.Err = err
+
+		output.Fired = append(output.Fired, fired)
+	}
+
+	return output, nil
+}
+
+func NewFireExpiredSwitchesUseCase(
+	switchRepo domainDeadman.ISwitchRepository,
+	massCancel orderUsecases.IMassCancelOrdersUseCase,
+) *FireExpiredSwitchesUseCase {
+	return &FireExpiredSwitchesUseCase{
+		SwitchRepo: switchRepo,
+		MassCancel: massCancel,
+	}
+}
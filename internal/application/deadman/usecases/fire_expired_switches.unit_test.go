@@ -0,0 +1,102 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	deadmanUsecases "github.com/juninhoitabh/clob-go/internal/application/deadman/usecases"
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainDeadman "github.com/juninhoitabh/clob-go/internal/domain/deadman"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	deadmanMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/deadman/mocks"
+)
+
+type stubMassCancelUseCase struct {
+	outputs map[string]*orderUsecases.MassCancelOrdersOutput
+	inputs  []orderUsecases.MassCancelOrdersInput
+}
+
+func (s *stubMassCancelUseCase) Execute(input orderUsecases.MassCancelOrdersInput) (*orderUsecases.MassCancelOrdersOutput, error) {
+	s.inputs = append(s.inputs, input)
+
+	output, ok := s.outputs[input.AccountID]
+	if !ok {
+		return nil, errors.New("mass cancel failed")
+	}
+
+	return output, nil
+}
+
+type FireExpiredSwitchesUseCaseUnitTestSuite struct {
+	suite.Suite
+	switchRepo *deadmanMocks.MockISwitchRepository
+	massCancel *stubMassCancelUseCase
+	ctrl       *gomock.Controller
+	usecase    *deadmanUsecases.FireExpiredSwitchesUseCase
+}
+
+func (suite *FireExpiredSwitchesUseCaseUnitTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.switchRepo = deadmanMocks.NewMockISwitchRepository(suite.ctrl)
+	suite.massCancel = &stubMassCancelUseCase{outputs: map[string]*orderUsecases.MassCancelOrdersOutput{
+		"acc1": {Orders: []*domainOrder.Order{{}, {}}},
+	}}
+	suite.usecase = deadmanUsecases.NewFireExpiredSwitchesUseCase(suite.switchRepo, suite.massCancel)
+}
+
+func (suite *FireExpiredSwitchesUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *FireExpiredSwitchesUseCaseUnitTestSuite) TestExecute_CancelsEachExpiredAccount() {
+	now := time.Now()
+
+	canceled := &domainDeadman.Switch{AccountID: "acc1"}
+
+	suite.switchRepo.EXPECT().ListExpired(now).Return([]*domainDeadman.Switch{
+		canceled,
+		{AccountID: "acc2"},
+	}, nil)
+	suite.switchRepo.EXPECT().DeleteFired(canceled).Return(nil)
+
+	out, err := suite.usecase.Execute(deadmanUsecases.FireExpiredSwitchesInput{Now: now})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), out.Fired, 2)
+
+	assert.Equal(suite.T(), "acc1", out.Fired[0].AccountID)
+	assert.Equal(suite.T(), 2, out.Fired[0].Canceled)
+	assert.NoError(suite.T(), out.Fired[0].Err)
+
+	assert.Equal(suite.T(), "acc2", out.Fired[1].AccountID)
+	assert.Error(suite.T(), out.Fired[1].Err)
+
+	assert.Equal(suite.T(), []orderUsecases.MassCancelOrdersInput{{AccountID: "acc1"}, {AccountID: "acc2"}}, suite.massCancel.inputs)
+}
+
+func (suite *FireExpiredSwitchesUseCaseUnitTestSuite) TestExecute_DeleteFiredError() {
+	now := time.Now()
+	sw := &domainDeadman.Switch{AccountID: "acc1"}
+
+	suite.switchRepo.EXPECT().ListExpired(now).Return([]*domainDeadman.Switch{sw}, nil)
+	suite.switchRepo.EXPECT().DeleteFired(sw).Return(errors.New("delete failed"))
+
+	out, err := suite.usecase.Execute(deadmanUsecases.FireExpiredSwitchesInput{Now: now})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), out.Fired, 1)
+	assert.Equal(suite.T(), 2, out.Fired[0].Canceled)
+	assert.EqualError(suite.T(), out.Fired[0].Err, "delete failed")
+}
+
+func (suite *FireExpiredSwitchesUseCaseUnitTestSuite) TestExecute_RepositoryError() {
+	suite.switchRepo.EXPECT().ListExpired(gomock.Any()).Return(nil, errors.New("boom"))
+
+	out, err := suite.usecase.Execute(deadmanUsecases.FireExpiredSwitchesInput{Now: time.Now()})
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), out)
+}
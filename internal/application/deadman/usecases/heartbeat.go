@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"time"
+
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	domainDeadman "github.com/juninhoitabh/clob-go/internal/domain/deadman"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	HeartbeatUseCase struct {
+		AccountRepo domainAccount.IAccountRepository
+		SwitchRepo  domainDeadman.ISwitchRepository
+	}
+)
+
+// Execute re-arms the account's switch for another full timeout. It fails
+// with ErrNotFound once the switch has fired or was never set.
+func (h *HeartbeatUseCase) Execute(input HeartbeatInput) (*SwitchOutput, error) {
+	err := accountServices.Authorize(h.AccountRepo, input.CallerAccountID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := h.SwitchRepo.GetSwitch(input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	// An expired switch is still stored until its cancel succeeds, but it
+	// has fired and a heartbeat cannot take it back.
+	if current == nil || current.Expired(now) {
+		return nil, shared.ErrNotFound
+	}
+
+	sw, err := domainDeadman.NewSwitch(domainDeadman.SwitchProps{
+		AccountID: current.AccountID,
+		Timeout:   current.Timeout,
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.SwitchRepo.SaveSwitch(sw)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSwitchOutput(sw), nil
+}
+
+func NewHeartbeatUseCase(
+	accountRepo domainAccount.IAccountRepository,
+	switchRepo domainDeadman.ISwitchRepository,
+) *HeartbeatUseCase {
+	return &HeartbeatUseCase{
+		AccountRepo: accountRepo,
+		SwitchRepo:  switchRepo,
+	}
+}
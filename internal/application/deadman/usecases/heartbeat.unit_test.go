@@ -0,0 +1,75 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	deadmanUsecases "github.com/juninhoitabh/clob-go/internal/application/deadman/usecases"
+	domainDeadman "github.com/juninhoitabh/clob-go/internal/domain/deadman"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	deadmanMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/deadman/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type HeartbeatUseCaseUnitTestSuite struct {
+	suite.Suite
+	accountRepo *accountMocks.MockIAccountRepository
+	switchRepo  *deadmanMocks.MockISwitchRepository
+	ctrl        *gomock.Controller
+	usecase     *deadmanUsecases.HeartbeatUseCase
+}
+
+func (suite *HeartbeatUseCaseUnitTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.switchRepo = deadmanMocks.NewMockISwitchRepository(suite.ctrl)
+	suite.usecase = deadmanUsecases.NewHeartbeatUseCase(suite.accountRepo, suite.switchRepo)
+}
+
+func (suite *HeartbeatUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *HeartbeatUseCaseUnitTestSuite) TestExecute_RearmsWithSameTimeout() {
+	armedAt := time.Now().Add(-20 * time.Second)
+	current, _ := domainDeadman.NewSwitch(domainDeadman.SwitchProps{AccountID: "acc1", Timeout: 30 * time.Second}, armedAt)
+
+	var saved *domainDeadman.Switch
+
+	suite.switchRepo.EXPECT().GetSwitch("acc1").Return(current, nil)
+	suite.switchRepo.EXPECT().SaveSwitch(gomock.Any()).DoAndReturn(func(sw *domainDeadman.Switch) error {
+		saved = sw
+
+		return nil
+	})
+
+	out, err := suite.usecase.Execute(deadmanUsecases.HeartbeatInput{AccountID: "acc1"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 30*time.Second, out.Timeout)
+	assert.True(suite.T(), out.ExpiresAt.After(current.ExpiresAt))
+	assert.Equal(suite.T(), saved.ExpiresAt, out.ExpiresAt)
+	assert.Equal(suite.T(), armedAt.Add(30*time.Second), current.ExpiresAt)
+}
+
+func (suite *HeartbeatUseCaseUnitTestSuite) TestExecute_ExpiredAwaitingCancel() {
+	current, _ := domainDeadman.NewSwitch(domainDeadman.SwitchProps{AccountID: "acc1", Timeout: time.Second}, time.Now().Add(-time.Minute))
+
+	suite.switchRepo.EXPECT().GetSwitch("acc1").Return(current, nil)
+
+	out, err := suite.usecase.Execute(deadmanUsecases.HeartbeatInput{AccountID: "acc1"})
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *HeartbeatUseCaseUnitTestSuite) TestExecute_NotArmed() {
+	suite.switchRepo.EXPECT().GetSwitch("acc1").Return(nil, nil)
+
+	out, err := suite.usecase.Execute(deadmanUsecases.HeartbeatInput{AccountID: "acc1"})
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
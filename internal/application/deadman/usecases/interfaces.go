@@ -0,0 +1,55 @@
+package usecases
+
+import "time"
+
+type (
+	SetSwitchInput struct {
+		AccountID       string
+		CallerAccountID string
+		Timeout         time.Duration
+	}
+	HeartbeatInput struct {
+		AccountID       string
+		CallerAccountID string
+	}
+	DisarmSwitchInput struct {
+		AccountID       string
+		CallerAccountID string
+	}
+	SwitchOutput struct {
+		ExpiresAt time.Time
+		UpdatedAt time.Time
+		AccountID string
+		Timeout   time.Duration
+	}
+	OpenSessionInput struct {
+		AccountID       string
+		CallerAccountID string
+	}
+	FireExpiredSwitchesInput struct {
+		Now time.Time
+	}
+	FiredSwitch struct {
+		Err       error
+		AccountID string
+		Canceled  int
+	}
+	FireExpiredSwitchesOutput struct {
+		Fired []FiredSwitch
+	}
+	ISetSwitchUseCase interface {
+		Execute(input SetSwitchInput) (*SwitchOutput, error)
+	}
+	IHeartbeatUseCase interface {
+		Execute(input HeartbeatInput) (*SwitchOutput, error)
+	}
+	IDisarmSwitchUseCase interface {
+		Execute(input DisarmSwitchInput) error
+	}
+	IOpenSessionUseCase interface {
+		Execute(input OpenSessionInput) error
+	}
+	IFireExpiredSwitchesUseCase interface {
+		Execute(input FireExpiredSwitchesInput) (*FireExpiredSwitchesOutput, error)
+	}
+)
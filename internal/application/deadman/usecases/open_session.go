@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	OpenSessionUseCase struct {
+		AccountRepo domainAccount.IAccountRepository
+	}
+)
+
+// Execute checks that the caller may open a streaming session for the
+// account, which may cancel the account's orders when it disconnects.
+func (o *OpenSessionUseCase) Execute(input OpenSessionInput) error {
+	err := accountServices.Authorize(o.AccountRepo, input.CallerAccountID, input.AccountID)
+	if err != nil {
+		return err
+	}
+
+	_, err = o.AccountRepo.Get(input.AccountID)
+	if err != nil {
+		return shared.ErrNotFound
+	}
+
+	return nil
+}
+
+func NewOpenSessionUseCase(accountRepo domainAccount.IAccountRepository) *OpenSessionUseCase {
+	return &OpenSessionUseCase{
+		AccountRepo: accountRepo,
+	}
+}
@@ -0,0 +1,47 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	deadmanUsecases "github.com/juninhoitabh/clob-go/internal/application/deadman/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type OpenSessionUseCaseUnitTestSuite struct {
+	suite.Suite
+	accountRepo *accountMocks.MockIAccountRepository
+	ctrl        *gomock.Controller
+	usecase     *deadmanUsecases.OpenSessionUseCase
+}
+
+func (suite *OpenSessionUseCaseUnitTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.usecase = deadmanUsecases.NewOpenSessionUseCase(suite.accountRepo)
+}
+
+func (suite *OpenSessionUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *OpenSessionUseCaseUnitTestSuite) TestExecute_Success() {
+	suite.accountRepo.EXPECT().Get("acc1").Return(&domainAccount.Account{}, nil)
+
+	err := suite.usecase.Execute(deadmanUsecases.OpenSessionInput{AccountID: "acc1"})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OpenSessionUseCaseUnitTestSuite) TestExecute_AccountNotFound() {
+	suite.accountRepo.EXPECT().Get("acc1").Return(nil, errors.New("not found"))
+
+	err := suite.usecase.Execute(deadmanUsecases.OpenSessionInput{AccountID: "acc1"})
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+}
@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"time"
+
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	domainDeadman "github.com/juninhoitabh/clob-go/internal/domain/deadman"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	SetSwitchUseCase struct {
+		AccountRepo domainAccount.IAccountRepository
+		SwitchRepo  domainDeadman.ISwitchRepository
+	}
+)
+
+func (s *SetSwitchUseCase) Execute(input SetSwitchInput) (*SwitchOutput, error) {
+	err := accountServices.Authorize(s.AccountRepo, input.CallerAccountID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.AccountRepo.Get(input.AccountID)
+	if err != nil {
+		return nil, shared.ErrNotFound
+	}
+
+	sw, err := domainDeadman.NewSwitch(domainDeadman.SwitchProps{
+		AccountID: input.AccountID,
+		Timeout:   input.Timeout,
+	}, time.Now())
+	if err != nil {
+		return nil, shared.ErrInvalidParam
+	}
+
+	err = s.SwitchRepo.SaveSwitch(sw)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSwitchOutput(sw), nil
+}
+
+func newSwitchOutput(sw *domainDeadman.Switch) *SwitchOutput {
+	return &SwitchOutput{
+		ExpiresAt: sw.ExpiresAt,
+		UpdatedAt: sw.UpdatedAt,
+		AccountID: sw.AccountID,
+		Timeout:   sw.Timeout,
+	}
+}
+
+func NewSetSwitchUseCase(
+	accountRepo domainAccount.IAccountRepository,
+	switchRepo domainDeadman.ISwitchRepository,
+) *SetSwitchUseCase {
+	return &SetSwitchUseCase{
+		AccountRepo: accountRepo,
+		SwitchRepo:  switchRepo,
+	}
+}
@@ -0,0 +1,95 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	deadmanUsecases "github.com/juninhoitabh/clob-go/internal/application/deadman/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/deadman/usecases/fakers"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainDeadman "github.com/juninhoitabh/clob-go/internal/domain/deadman"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	deadmanMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/deadman/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type SetSwitchUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker  deadmanUsecases.SetSwitchInput
+	accountRepo *accountMocks.MockIAccountRepository
+	switchRepo  *deadmanMocks.MockISwitchRepository
+	ctrl        *gomock.Controller
+	usecase     *deadmanUsecases.SetSwitchUseCase
+}
+
+func (suite *SetSwitchUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.SetSwitchInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.switchRepo = deadmanMocks.NewMockISwitchRepository(suite.ctrl)
+	suite.usecase = deadmanUsecases.NewSetSwitchUseCase(suite.accountRepo, suite.switchRepo)
+}
+
+func (suite *SetSwitchUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *SetSwitchUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.switchRepo.EXPECT().SaveSwitch(gomock.Any()).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), input.AccountID, out.AccountID)
+	assert.Equal(suite.T(), input.Timeout, out.Timeout)
+	assert.Equal(suite.T(), out.UpdatedAt.Add(input.Timeout), out.ExpiresAt)
+}
+
+func (suite *SetSwitchUseCaseUnitTestSuite) TestExecute_InvalidTimeout() {
+	input := suite.inputFaker
+	input.Timeout = domainDeadman.MaxTimeout + time.Second
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *SetSwitchUseCaseUnitTestSuite) TestExecute_AccountNotFound() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(nil, errors.New("not found"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *SetSwitchUseCaseUnitTestSuite) TestExecute_ForbiddenForOtherAccount() {
+	input := suite.inputFaker
+	input.CallerAccountID = "acc999"
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrForbidden)
+	assert.Nil(suite.T(), out)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(SetSwitchUseCaseUnitTestSuite))
+	suite.Run(t, new(HeartbeatUseCaseUnitTestSuite))
+	suite.Run(t, new(DisarmSwitchUseCaseUnitTestSuite))
+	suite.Run(t, new(FireExpiredSwitchesUseCaseUnitTestSuite))
+	suite.Run(t, new(OpenSessionUseCaseUnitTestSuite))
+}
@@ -0,0 +1,15 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	feeUsecases "github.com/juninhoitabh/clob-go/internal/application/fee/usecases"
+)
+
+func GetAccountFeeTierInputFaker() feeUsecases.GetAccountFeeTierInput {
+	faker := faker.New(0)
+
+	return feeUsecases.GetAccountFeeTierInput{
+		AccountID: faker.UUID(),
+	}
+}
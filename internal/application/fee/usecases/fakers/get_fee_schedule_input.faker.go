@@ -0,0 +1,15 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	feeUsecases "github.com/juninhoitabh/clob-go/internal/application/fee/usecases"
+)
+
+func GetFeeScheduleInputFaker() feeUsecases.GetFeeScheduleInput {
+	faker := faker.New(0)
+
+	return feeUsecases.GetFeeScheduleInput{
+		Instrument: faker.CurrencyShort() + "/" + faker.CurrencyShort(),
+	}
+}
@@ -0,0 +1,16 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	feeUsecases "github.com/juninhoitabh/clob-go/internal/application/fee/usecases"
+)
+
+func SetAccountFeeTierInputFaker() feeUsecases.SetAccountFeeTierInput {
+	faker := faker.New(0)
+
+	return feeUsecases.SetAccountFeeTierInput{
+		AccountID: faker.UUID(),
+		Tier:      faker.Word(),
+	}
+}
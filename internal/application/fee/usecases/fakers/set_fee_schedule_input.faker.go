@@ -0,0 +1,19 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	feeUsecases "github.com/juninhoitabh/clob-go/internal/application/fee/usecases"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+)
+
+func SetFeeScheduleInputFaker() feeUsecases.SetFeeScheduleInput {
+	faker := faker.New(0)
+
+	return feeUsecases.SetFeeScheduleInput{
+		Instrument: faker.CurrencyShort() + "/" + faker.CurrencyShort(),
+		MakerBps:   int64(faker.Number(0, 10)),
+		TakerBps:   int64(faker.Number(10, 50)),
+		Tiers:      map[string]domainFee.Rates{"vip": {MakerBps: -5, TakerBps: 10}},
+	}
+}
@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"time"
+
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	domainTrade "github.com/juninhoitabh/clob-go/internal/domain/trade"
+)
+
+type (
+	GetAccountFeeTierUseCase struct {
+		AccountRepo domainAccount.IAccountRepository
+		TradeRepo   domainTrade.ITradeRepository
+		VolumeTiers domainFee.VolumeTiers
+	}
+)
+
+func (g *GetAccountFeeTierUseCase) Execute(input GetAccountFeeTierInput) (*AccountFeeTierOutput, error) {
+	acct, err := g.AccountRepo.Get(input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	volume, err := g.TradeRepo.RollingVolume(input.AccountID, time.Now().Add(-domainFee.VolumeWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := accountServices.LockAccounts(acct)
+	defer unlock()
+
+	// The stored tier is otherwise only refreshed when the account trades,
+	// so an account that stopped trading would keep its old discount.
+	if !acct.FeeTierPinned && len(g.VolumeTiers) > 0 {
+		tier := g.VolumeTiers.TierFor(volume)
+
+		if tier != acct.FeeTier {
+			acct.SetFeeTier(tier)
+
+			err = g.AccountRepo.Save(acct)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &AccountFeeTierOutput{
+		AccountID: input.AccountID,
+		Tier:      acct.FeeTier,
+		Volume:    volume,
+		Pinned:    acct.FeeTierPinned,
+	}, nil
+}
+
+func NewGetAccountFeeTierUseCase(
+	accountRepo domainAccount.IAccountRepository,
+	tradeRepo domainTrade.ITradeRepository,
+	volumeTiers domainFee.VolumeTiers,
+) *GetAccountFeeTierUseCase {
+	return &GetAccountFeeTierUseCase{
+		AccountRepo: accountRepo,
+		TradeRepo:   tradeRepo,
+		VolumeTiers: volumeTiers,
+	}
+}
@@ -0,0 +1,108 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	feeUsecases "github.com/juninhoitabh/clob-go/internal/application/fee/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/fee/usecases/fakers"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	tradeMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type GetAccountFeeTierUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker  feeUsecases.GetAccountFeeTierInput
+	accountRepo *accountMocks.MockIAccountRepository
+	tradeRepo   *tradeMocks.MockITradeRepository
+	ctrl        *gomock.Controller
+	usecase     *feeUsecases.GetAccountFeeTierUseCase
+}
+
+func (suite *GetAccountFeeTierUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.GetAccountFeeTierInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.tradeRepo = tradeMocks.NewMockITradeRepository(suite.ctrl)
+
+	tiers, _ := domainFee.NewVolumeTiers(map[string]int64{"silver": 1000, "gold": 10000})
+	suite.usecase = feeUsecases.NewGetAccountFeeTierUseCase(suite.accountRepo, suite.tradeRepo, tiers)
+}
+
+func (suite *GetAccountFeeTierUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *GetAccountFeeTierUseCaseUnitTestSuite) TestExecute_VolumeTier() {
+	input := suite.inputFaker
+	acct := &domainAccount.Account{}
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(acct, nil)
+	suite.tradeRepo.EXPECT().RollingVolume(input.AccountID, gomock.Any()).Return(int64(1100), nil)
+	suite.accountRepo.EXPECT().Save(acct).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1100), out.Volume)
+	assert.Equal(suite.T(), "silver", out.Tier)
+	assert.Equal(suite.T(), "silver", acct.FeeTier)
+	assert.False(suite.T(), out.Pinned)
+}
+
+func (suite *GetAccountFeeTierUseCaseUnitTestSuite) TestExecute_ExpiredTierRefreshedOnRead() {
+	input := suite.inputFaker
+	acct := &domainAccount.Account{FeeTier: "gold"}
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(acct, nil)
+	suite.tradeRepo.EXPECT().RollingVolume(input.AccountID, gomock.Any()).Return(int64(0), nil)
+	suite.accountRepo.EXPECT().Save(acct).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "", out.Tier)
+	assert.Equal(suite.T(), "", acct.FeeTier)
+}
+
+func (suite *GetAccountFeeTierUseCaseUnitTestSuite) TestExecute_PinnedTier() {
+	input := suite.inputFaker
+	acct := &domainAccount.Account{}
+	acct.PinFeeTier("market-maker")
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(acct, nil)
+	suite.tradeRepo.EXPECT().RollingVolume(input.AccountID, gomock.Any()).Return(int64(0), nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(0), out.Volume)
+	assert.Equal(suite.T(), "market-maker", out.Tier)
+	assert.True(suite.T(), out.Pinned)
+}
+
+func (suite *GetAccountFeeTierUseCaseUnitTestSuite) TestExecute_AccountNotFound() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(nil, shared.ErrNotFound)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *GetAccountFeeTierUseCaseUnitTestSuite) TestExecute_RollingVolumeError() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.tradeRepo.EXPECT().RollingVolume(input.AccountID, gomock.Any()).Return(int64(0), errors.New("repo error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "repo error")
+	assert.Nil(suite.T(), out)
+}
@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+)
+
+type (
+	GetFeeScheduleUseCase struct {
+		ScheduleRepo domainFee.IScheduleRepository
+		DefaultRates domainFee.Rates
+	}
+)
+
+func (g *GetFeeScheduleUseCase) Execute(input GetFeeScheduleInput) (*FeeScheduleOutput, error) {
+	schedule, err := g.ScheduleRepo.GetSchedule(input.Instrument)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule == nil {
+		return &FeeScheduleOutput{
+			Tiers:      map[string]domainFee.Rates{},
+			Instrument: input.Instrument,
+			MakerBps:   g.DefaultRates.MakerBps,
+			TakerBps:   g.DefaultRates.TakerBps,
+		}, nil
+	}
+
+	return newFeeScheduleOutput(schedule), nil
+}
+
+func NewGetFeeScheduleUseCase(
+	scheduleRepo domainFee.IScheduleRepository,
+	defaultRates domainFee.Rates,
+) *GetFeeScheduleUseCase {
+	return &GetFeeScheduleUseCase{
+		ScheduleRepo: scheduleRepo,
+		DefaultRates: defaultRates,
+	}
+}
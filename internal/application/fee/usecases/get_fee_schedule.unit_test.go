@@ -0,0 +1,73 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	feeUsecases "github.com/juninhoitabh/clob-go/internal/application/fee/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/fee/usecases/fakers"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	feeMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/fee/mocks"
+)
+
+type GetFeeScheduleUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker   feeUsecases.GetFeeScheduleInput
+	scheduleRepo *feeMocks.MockIScheduleRepository
+	ctrl         *gomock.Controller
+	usecase      *feeUsecases.GetFeeScheduleUseCase
+}
+
+func (suite *GetFeeScheduleUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.GetFeeScheduleInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.scheduleRepo = feeMocks.NewMockIScheduleRepository(suite.ctrl)
+	suite.usecase = feeUsecases.NewGetFeeScheduleUseCase(suite.scheduleRepo, domainFee.Rates{MakerBps: 1, TakerBps: 2})
+}
+
+func (suite *GetFeeScheduleUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *GetFeeScheduleUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+
+	schedule, _ := domainFee.NewSchedule(domainFee.ScheduleProps{
+		Instrument: input.Instrument,
+		Rates:      domainFee.Rates{MakerBps: 5, TakerBps: 15},
+	})
+	suite.scheduleRepo.EXPECT().GetSchedule(input.Instrument).Return(schedule, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(5), out.MakerBps)
+	assert.Equal(suite.T(), int64(15), out.TakerBps)
+}
+
+func (suite *GetFeeScheduleUseCaseUnitTestSuite) TestExecute_DefaultRates() {
+	input := suite.inputFaker
+
+	suite.scheduleRepo.EXPECT().GetSchedule(input.Instrument).Return(nil, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), input.Instrument, out.Instrument)
+	assert.Equal(suite.T(), int64(1), out.MakerBps)
+	assert.Equal(suite.T(), int64(2), out.TakerBps)
+	assert.True(suite.T(), out.UpdatedAt.IsZero())
+}
+
+func (suite *GetFeeScheduleUseCaseUnitTestSuite) TestExecute_GetScheduleError() {
+	input := suite.inputFaker
+
+	suite.scheduleRepo.EXPECT().GetSchedule(input.Instrument).Return(nil, errors.New("repo error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "repo error")
+	assert.Nil(suite.T(), out)
+}
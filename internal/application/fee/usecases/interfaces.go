@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"time"
+
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+)
+
+type (
+	SetFeeScheduleInput struct {
+		Tiers      map[string]domainFee.Rates
+		Instrument string
+		MakerBps   int64
+		TakerBps   int64
+	}
+	GetFeeScheduleInput struct {
+		Instrument string
+	}
+	FeeScheduleOutput struct {
+		UpdatedAt  time.Time
+		Tiers      map[string]domainFee.Rates
+		Instrument string
+		MakerBps   int64
+		TakerBps   int64
+	}
+	SetAccountFeeTierInput struct {
+		AccountID string
+		Tier      string
+	}
+	GetAccountFeeTierInput struct {
+		AccountID string
+	}
+	AccountFeeTierOutput struct {
+		AccountID string
+		Tier      string
+		Volume    int64
+		Pinned    bool
+	}
+	ISetFeeScheduleUseCase interface {
+		Execute(input SetFeeScheduleInput) (*FeeScheduleOutput, error)
+	}
+	IGetFeeScheduleUseCase interface {
+		Execute(input GetFeeScheduleInput) (*FeeScheduleOutput, error)
+	}
+	ISetAccountFeeTierUseCase interface {
+		Execute(input SetAccountFeeTierInput) (*AccountFeeTierOutput, error)
+	}
+	IGetAccountFeeTierUseCase interface {
+		Execute(input GetAccountFeeTierInput) (*AccountFeeTierOutput, error)
+	}
+)
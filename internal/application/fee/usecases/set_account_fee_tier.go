@@ -0,0 +1,43 @@
+package usecases
+
+import (
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+)
+
+type (
+	SetAccountFeeTierUseCase struct {
+		AccountRepo domainAccount.IAccountRepository
+	}
+)
+
+func (s *SetAccountFeeTierUseCase) Execute(input SetAccountFeeTierInput) (*AccountFeeTierOutput, error) {
+	acct, err := s.AccountRepo.Get(input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := accountServices.LockAccounts(acct)
+	defer unlock()
+
+	acct.PinFeeTier(input.Tier)
+
+	err = s.AccountRepo.Save(acct)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountFeeTierOutput{
+		AccountID: input.AccountID,
+		Tier:      acct.FeeTier,
+		Pinned:    acct.FeeTierPinned,
+	}, nil
+}
+
+func NewSetAccountFeeTierUseCase(
+	accountRepo domainAccount.IAccountRepository,
+) *SetAccountFeeTierUseCase {
+	return &SetAccountFeeTierUseCase{
+		AccountRepo: accountRepo,
+	}
+}
@@ -0,0 +1,71 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	feeUsecases "github.com/juninhoitabh/clob-go/internal/application/fee/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/fee/usecases/fakers"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type SetAccountFeeTierUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker  feeUsecases.SetAccountFeeTierInput
+	accountRepo *accountMocks.MockIAccountRepository
+	ctrl        *gomock.Controller
+	usecase     *feeUsecases.SetAccountFeeTierUseCase
+}
+
+func (suite *SetAccountFeeTierUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.SetAccountFeeTierInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.usecase = feeUsecases.NewSetAccountFeeTierUseCase(suite.accountRepo)
+}
+
+func (suite *SetAccountFeeTierUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *SetAccountFeeTierUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+	acct := &domainAccount.Account{}
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(acct, nil)
+	suite.accountRepo.EXPECT().Save(acct).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), strings.ToLower(input.Tier), out.Tier)
+	assert.Equal(suite.T(), strings.ToLower(input.Tier), acct.FeeTier)
+}
+
+func (suite *SetAccountFeeTierUseCaseUnitTestSuite) TestExecute_AccountNotFound() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(nil, shared.ErrNotFound)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *SetAccountFeeTierUseCaseUnitTestSuite) TestExecute_SaveError() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.accountRepo.EXPECT().Save(gomock.Any()).Return(errors.New("save error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "save error")
+	assert.Nil(suite.T(), out)
+}
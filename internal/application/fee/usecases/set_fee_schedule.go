@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	SetFeeScheduleUseCase struct {
+		ScheduleRepo domainFee.IScheduleRepository
+	}
+)
+
+func (s *SetFeeScheduleUseCase) Execute(input SetFeeScheduleInput) (*FeeScheduleOutput, error) {
+	schedule, err := domainFee.NewSchedule(domainFee.ScheduleProps{
+		Instrument: input.Instrument,
+		Rates: domainFee.Rates{
+			MakerBps: input.MakerBps,
+			TakerBps: input.TakerBps,
+		},
+		Tiers: input.Tiers,
+	})
+	if err != nil {
+		return nil, shared.ErrInvalidParam
+	}
+
+	err = s.ScheduleRepo.SaveSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFeeScheduleOutput(schedule), nil
+}
+
+func newFeeScheduleOutput(schedule *domainFee.Schedule) *FeeScheduleOutput {
+	return &FeeScheduleOutput{
+		UpdatedAt:  schedule.UpdatedAt,
+		Tiers:      schedule.Tiers,
+		Instrument: schedule.Instrument,
+		MakerBps:   schedule.MakerBps,
+		TakerBps:   schedule.TakerBps,
+	}
+}
+
+func NewSetFeeScheduleUseCase(
+	scheduleRepo domainFee.IScheduleRepository,
+) *SetFeeScheduleUseCase {
+	return &SetFeeScheduleUseCase{
+		ScheduleRepo: scheduleRepo,
+	}
+}
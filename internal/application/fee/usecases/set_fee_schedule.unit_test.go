@@ -0,0 +1,77 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	feeUsecases "github.com/juninhoitabh/clob-go/internal/application/fee/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/fee/usecases/fakers"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	feeMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/fee/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type SetFeeScheduleUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker   feeUsecases.SetFeeScheduleInput
+	scheduleRepo *feeMocks.MockIScheduleRepository
+	ctrl         *gomock.Controller
+	usecase      *feeUsecases.SetFeeScheduleUseCase
+}
+
+func (suite *SetFeeScheduleUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.SetFeeScheduleInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.scheduleRepo = feeMocks.NewMockIScheduleRepository(suite.ctrl)
+	suite.usecase = feeUsecases.NewSetFeeScheduleUseCase(suite.scheduleRepo)
+}
+
+func (suite *SetFeeScheduleUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *SetFeeScheduleUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+
+	suite.scheduleRepo.EXPECT().SaveSchedule(gomock.Any()).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), strings.ToUpper(input.Instrument), out.Instrument)
+	assert.Equal(suite.T(), input.MakerBps, out.MakerBps)
+	assert.Equal(suite.T(), input.TakerBps, out.TakerBps)
+	assert.Equal(suite.T(), domainFee.Rates{MakerBps: -5, TakerBps: 10}, out.Tiers["vip"])
+}
+
+func (suite *SetFeeScheduleUseCaseUnitTestSuite) TestExecute_InvalidSchedule() {
+	input := suite.inputFaker
+	input.TakerBps = -1
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *SetFeeScheduleUseCaseUnitTestSuite) TestExecute_SaveScheduleError() {
+	input := suite.inputFaker
+
+	suite.scheduleRepo.EXPECT().SaveSchedule(gomock.Any()).Return(errors.New("save error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "save error")
+	assert.Nil(suite.T(), out)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(SetFeeScheduleUseCaseUnitTestSuite))
+	suite.Run(t, new(GetFeeScheduleUseCaseUnitTestSuite))
+	suite.Run(t, new(SetAccountFeeTierUseCaseUnitTestSuite))
+	suite.Run(t, new(GetAccountFeeTierUseCaseUnitTestSuite))
+}
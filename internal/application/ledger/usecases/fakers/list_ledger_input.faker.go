@@ -0,0 +1,18 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	ledgerUsecases "github.com/juninhoitabh/clob-go/internal/application/ledger/usecases"
+)
+
+func ListLedgerInputFaker() ledgerUsecases.ListLedgerInput {
+	faker := faker.New(0)
+
+	return ledgerUsecases.ListLedgerInput{
+		AccountID: faker.UUID(),
+		Asset:     "BTC",
+		Limit:     faker.Number(1, 100),
+		Offset:    faker.Number(0, 10),
+	}
+}
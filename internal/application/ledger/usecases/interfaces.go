@@ -0,0 +1,23 @@
+package usecases
+
+import (
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
+)
+
+type (
+	ListLedgerInput struct {
+		AccountID string
+		Asset     string
+		Limit     int
+		Offset    int
+	}
+	ListLedgerOutput struct {
+		Postings []ledger.Posting
+		Total    int
+		Limit    int
+		Offset   int
+	}
+	IListLedgerUseCase interface {
+		Execute(input ListLedgerInput) (*ListLedgerOutput, error)
+	}
+)
@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainLedger "github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+const (
+	defaultListLedgerLimit = 100
+	maxListLedgerLimit     = 1000
+)
+
+type (
+	ListLedgerUseCase struct {
+		AccountRepo domainAccount.IAccountRepository
+		LedgerRepo  domainLedger.ILedgerRepository
+	}
+)
+
+func (l *ListLedgerUseCase) Execute(input ListLedgerInput) (*ListLedgerOutput, error) {
+	if input.Limit < 0 || input.Limit > maxListLedgerLimit || input.Offset < 0 {
+		return nil, shared.ErrInvalidParam
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultListLedgerLimit
+	}
+
+	_, err := l.AccountRepo.Get(input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	postings, total, err := l.LedgerRepo.ListPostings(domainLedger.Filter{
+		AccountID: input.AccountID,
+		Asset:     input.Asset,
+		Offset:    input.Offset,
+		Limit:     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListLedgerOutput{
+		Postings: postings,
+		Total:    total,
+		Limit:    limit,
+		Offset:   input.Offset,
+	}, nil
+}
+
+func NewListLedgerUseCase(
+	accountRepo domainAccount.IAccountRepository,
+	ledgerRepo domainLedger.ILedgerRepository,
+) *ListLedgerUseCase {
+	return &ListLedgerUseCase{
+		AccountRepo: accountRepo,
+		LedgerRepo:  ledgerRepo,
+	}
+}
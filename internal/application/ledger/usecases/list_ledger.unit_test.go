@@ -0,0 +1,114 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	ledgerUsecases "github.com/juninhoitabh/clob-go/internal/application/ledger/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/ledger/usecases/fakers"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainLedger "github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	ledgerMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/ledger/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type ListLedgerUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker  ledgerUsecases.ListLedgerInput
+	accountRepo *accountMocks.MockIAccountRepository
+	ledgerRepo  *ledgerMocks.MockILedgerRepository
+	ctrl        *gomock.Controller
+	usecase     *ledgerUsecases.ListLedgerUseCase
+}
+
+func (suite *ListLedgerUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.ListLedgerInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.ledgerRepo = ledgerMocks.NewMockILedgerRepository(suite.ctrl)
+	suite.usecase = ledgerUsecases.NewListLedgerUseCase(suite.accountRepo, suite.ledgerRepo)
+}
+
+func (suite *ListLedgerUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *ListLedgerUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+	postings := []domainLedger.Posting{{ID: "p1", Asset: "BTC", Amount: 10}}
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.ledgerRepo.EXPECT().ListPostings(domainLedger.Filter{
+		AccountID: input.AccountID,
+		Asset:     input.Asset,
+		Offset:    input.Offset,
+		Limit:     input.Limit,
+	}).Return(postings, 25, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), postings, out.Postings)
+	assert.Equal(suite.T(), 25, out.Total)
+	assert.Equal(suite.T(), input.Limit, out.Limit)
+	assert.Equal(suite.T(), input.Offset, out.Offset)
+}
+
+func (suite *ListLedgerUseCaseUnitTestSuite) TestExecute_DefaultLimit() {
+	input := suite.inputFaker
+	input.Limit = 0
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.ledgerRepo.EXPECT().ListPostings(gomock.Any()).Return(nil, 0, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 100, out.Limit)
+}
+
+func (suite *ListLedgerUseCaseUnitTestSuite) TestExecute_InvalidPagination() {
+	input := suite.inputFaker
+	input.Limit = 1001
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+
+	input = suite.inputFaker
+	input.Offset = -1
+
+	out, err = suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *ListLedgerUseCaseUnitTestSuite) TestExecute_AccountNotFound() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(nil, shared.ErrNotFound)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *ListLedgerUseCaseUnitTestSuite) TestExecute_RepoError() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.ledgerRepo.EXPECT().ListPostings(gomock.Any()).Return(nil, 0, errors.New("repo error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "repo error")
+	assert.Nil(suite.T(), out)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(ListLedgerUseCaseUnitTestSuite))
+}
@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type BatchCancelOrdersUseCase struct {
+	CancelOrder *CancelOrderUseCase
+	MaxOrders   int
+}
+
+// Execute cancels every order while holding all the books involved. Orders
+// that cannot be found or cancelled are reported per item.
+func (b *BatchCancelOrdersUseCase) Execute(input BatchCancelOrdersInput) (*BatchCancelOrdersOutput, error) {
+	if len(input.Orders) == 0 || len(input.Orders) > b.MaxOrders {
+		return nil, shared.ErrInvalidParam
+	}
+
+	books := make([]*domainBook.Book, 0, len(input.Orders))
+
+	for _, cancel := range input.Orders {
+		order, err := findOrder(
+			b.CancelOrder.OrderRepo,
+			b.CancelOrder.AccountRepo,
+			cancel.OrderID,
+			cancel.AccountID,
+			cancel.ClientOrderID,
+			cancel.CallerAccountID,
+		)
+		if err != nil {
+			continue
+		}
+
+		book, err := b.CancelOrder.BookRepo.GetBook(order.Instrument)
+		if err != nil || book == nil {
+			continue
+		}
+
+		books = append(books, book)
+	}
+
+	held, unlock := holdBooks(books)
+	defer unlock()
+
+	output := &BatchCancelOrdersOutput{Results: make([]BatchCancelOrderResult, len(input.Orders))}
+
+	for i, cancel := range input.Orders {
+		result, err := b.CancelOrder.cancel(cancel, held)
+		output.Results[i] = BatchCancelOrderResult{Output: result, Err: err}
+	}
+
+	return output, nil
+}
+
+func NewBatchCancelOrdersUseCase(cancelOrder *CancelOrderUseCase, maxOrders int) *BatchCancelOrdersUseCase {
+	return &BatchCancelOrdersUseCase{
+		CancelOrder: cancelOrder,
+		MaxOrders:   maxOrders,
+	}
+}
@@ -0,0 +1,92 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	bookMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/book/mocks"
+	orderMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/order/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type BatchCancelOrdersUseCaseUnitTestSuite struct {
+	suite.Suite
+	bookRepo    *bookMocks.MockIBookRepository
+	orderRepo   *orderMocks.MockIOrderRepository
+	accountRepo *accountMocks.MockIAccountRepository
+	ctrl        *gomock.Controller
+	usecase     *orderUsecases.BatchCancelOrdersUseCase
+}
+
+func (suite *BatchCancelOrdersUseCaseUnitTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.bookRepo = bookMocks.NewMockIBookRepository(suite.ctrl)
+	suite.orderRepo = orderMocks.NewMockIOrderRepository(suite.ctrl)
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.usecase = orderUsecases.NewBatchCancelOrdersUseCase(
+		orderUsecases.NewCancelOrderUseCase(suite.bookRepo, suite.orderRepo, suite.accountRepo),
+		3,
+	)
+}
+
+func (suite *BatchCancelOrdersUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *BatchCancelOrdersUseCaseUnitTestSuite) TestExecute_PerItemResults() {
+	order := &domainOrder.Order{
+		AccountID:  "acc123",
+		Instrument: "BTC/USDT",
+		Side:       domainOrder.Sell,
+		Price:      100,
+		Remaining:  5,
+	}
+	order.ID.ID = "order-1"
+	book := &domainBook.Book{Instrument: "BTC/USDT"}
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"BTC": {Available: 0, Reserved: 5},
+		},
+	}
+
+	suite.orderRepo.EXPECT().GetOrder("order-1").Return(order, nil).Times(4)
+	suite.orderRepo.EXPECT().GetOrder("missing").Return(nil, nil).Times(2)
+	suite.bookRepo.EXPECT().GetBook(order.Instrument).Return(book, nil).Times(4)
+	suite.bookRepo.EXPECT().SaveBook(book).Return(nil)
+	suite.accountRepo.EXPECT().Get(order.AccountID).Return(account, nil)
+	suite.accountRepo.EXPECT().Save(account).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(order).Return(nil)
+
+	out, err := suite.usecase.Execute(orderUsecases.BatchCancelOrdersInput{
+		Orders: []orderUsecases.CancelOrderInput{
+			{OrderID: "order-1"},
+			{OrderID: "missing"},
+			{OrderID: "order-1"},
+		},
+	})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), out.Results, 3)
+
+	assert.NoError(suite.T(), out.Results[0].Err)
+	assert.Equal(suite.T(), order, out.Results[0].Output.Order)
+	assert.ErrorIs(suite.T(), out.Results[1].Err, shared.ErrNotFound)
+	assert.ErrorIs(suite.T(), out.Results[2].Err, domainOrder.ErrOrderNotOpen)
+	assert.Equal(suite.T(), int64(5), account.Balances["BTC"].Available)
+}
+
+func (suite *BatchCancelOrdersUseCaseUnitTestSuite) TestExecute_TooManyOrders() {
+	out, err := suite.usecase.Execute(orderUsecases.BatchCancelOrdersInput{
+		Orders: make([]orderUsecases.CancelOrderInput, 4),
+	})
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	bookServices "github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+// heldBooks are the books a batch has already locked, so the per-order paths
+// must not lock them again.
+type heldBooks map[*domainBook.Book]bool
+
+func holdBooks(books []*domainBook.Book) (heldBooks, func()) {
+	held := make(heldBooks, len(books))
+
+	for _, b := range books {
+		held[b] = true
+	}
+
+	return held, bookServices.LockBooks(books...)
+}
+
+type BatchPlaceOrdersUseCase struct {
+	PlaceOrder *PlaceOrderUseCase
+	MaxOrders  int
+}
+
+// Execute places every order while holding all the books involved, so the
+// batch runs as one uninterrupted sequence. Each order succeeds or fails on
+// its own; a failure does not roll back earlier orders.
+func (b *BatchPlaceOrdersUseCase) Execute(input BatchPlaceOrdersInput) (*BatchPlaceOrdersOutput, error) {
+	if len(input.Orders) == 0 || len(input.Orders) > b.MaxOrders {
+		return nil, shared.ErrInvalidParam
+	}
+
+	books := make([]*domainBook.Book, 0, len(input.Orders))
+
+	for _, order := range input.Orders {
+		if _, _, err := domainBook.SplitInstrument(order.Instrument); err != nil {
+			continue
+		}
+
+		book, err := b.PlaceOrder.loadBook(order.Instrument)
+		if err != nil {
+			continue
+		}
+
+		books = append(books, book)
+	}
+
+	held, unlock := holdBooks(books)
+	defer unlock()
+
+	output := &BatchPlaceOrdersOutput{Results: make([]BatchPlaceOrderResult, len(input.Orders))}
+
+	for i, order := range input.Orders {
+		result, err := b.PlaceOrder.place(order, held)
+		output.Results[i] = BatchPlaceOrderResult{Output: result, Err: err}
+	}
+
+	return output, nil
+}
+
+func NewBatchPlaceOrdersUseCase(placeOrder *PlaceOrderUseCase, maxOrders int) *BatchPlaceOrdersUseCase {
+	return &BatchPlaceOrdersUseCase{
+		PlaceOrder: placeOrder,
+		MaxOrders:  maxOrders,
+	}
+}
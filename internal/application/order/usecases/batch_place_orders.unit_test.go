@@ -0,0 +1,121 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/order/usecases/fakers"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	bookMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/book/mocks"
+	feeMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/fee/mocks"
+	orderMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/order/mocks"
+	tradeMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type BatchPlaceOrdersUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker  orderUsecases.PlaceOrderInput
+	bookRepo    *bookMocks.MockIBookRepository
+	orderRepo   *orderMocks.MockIOrderRepository
+	accountRepo *accountMocks.MockIAccountRepository
+	ctrl        *gomock.Controller
+	usecase     *orderUsecases.BatchPlaceOrdersUseCase
+}
+
+func (suite *BatchPlaceOrdersUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.PlaceOrderInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.bookRepo = bookMocks.NewMockIBookRepository(suite.ctrl)
+	suite.orderRepo = orderMocks.NewMockIOrderRepository(suite.ctrl)
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.usecase = orderUsecases.NewBatchPlaceOrdersUseCase(
+		orderUsecases.NewPlaceOrderUseCase(
+			suite.bookRepo,
+			suite.orderRepo,
+			suite.accountRepo,
+			feeMocks.NewMockIScheduleRepository(suite.ctrl),
+			tradeMocks.NewMockITradeRepository(suite.ctrl),
+			domainBook.CircuitBreakerProps{},
+			domainFee.FeeProps{AccountID: "fees"},
+			nil,
+		),
+		2,
+	)
+}
+
+func (suite *BatchPlaceOrdersUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *BatchPlaceOrdersUseCaseUnitTestSuite) TestExecute_PerItemResults() {
+	valid := suite.inputFaker
+	valid.Side = "buy"
+	valid.Price = 100
+	valid.Qty = 2
+
+	invalid := valid
+	invalid.Price = 0
+
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 1000, Reserved: 0},
+		},
+	}
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: valid.Instrument}, "Uuid")
+
+	suite.bookRepo.EXPECT().GetBook(valid.Instrument).Return(book, nil).AnyTimes()
+	suite.bookRepo.EXPECT().SaveBook(book).Return(nil)
+	suite.accountRepo.EXPECT().Get(valid.AccountID).Return(account, nil)
+	suite.accountRepo.EXPECT().Save(account).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil)
+
+	out, err := suite.usecase.Execute(orderUsecases.BatchPlaceOrdersInput{
+		Orders: []orderUsecases.PlaceOrderInput{valid, invalid},
+	})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), out.Results, 2)
+
+	assert.NoError(suite.T(), out.Results[0].Err)
+	assert.Equal(suite.T(), int64(2), out.Results[0].Output.Order.Qty)
+	assert.ErrorIs(suite.T(), out.Results[1].Err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out.Results[1].Output)
+	assert.Equal(suite.T(), int64(200), account.Balances["USDT"].Reserved)
+}
+
+func (suite *BatchPlaceOrdersUseCaseUnitTestSuite) TestExecute_InvalidInstrumentReportedPerItem() {
+	input := suite.inputFaker
+	input.Instrument = "INVALID"
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+
+	out, err := suite.usecase.Execute(orderUsecases.BatchPlaceOrdersInput{
+		Orders: []orderUsecases.PlaceOrderInput{input},
+	})
+	require.NoError(suite.T(), err)
+	assert.Error(suite.T(), out.Results[0].Err)
+}
+
+func (suite *BatchPlaceOrdersUseCaseUnitTestSuite) TestExecute_Empty() {
+	out, err := suite.usecase.Execute(orderUsecases.BatchPlaceOrdersInput{})
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *BatchPlaceOrdersUseCaseUnitTestSuite) TestExecute_TooManyOrders() {
+	input := suite.inputFaker
+
+	out, err := suite.usecase.Execute(orderUsecases.BatchPlaceOrdersInput{
+		Orders: []orderUsecases.PlaceOrderInput{input, input, input},
+	})
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
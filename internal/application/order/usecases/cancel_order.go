@@ -2,7 +2,9 @@ package usecases
 
 import (
 	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
 	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
 	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
 	"github.com/juninhoitabh/clob-go/internal/shared"
 )
@@ -14,15 +16,22 @@ type CancelOrderUseCase struct {
 }
 
 func (c *CancelOrderUseCase) Execute(input CancelOrderInput) (*CancelOrderOutput, error) {
-	order, err := c.OrderRepo.GetOrder(input.OrderID)
+	return c.cancel(input, nil)
+}
+
+func (c *CancelOrderUseCase) cancel(input CancelOrderInput, held heldBooks) (*CancelOrderOutput, error) {
+	order, err := findOrder(
+		c.OrderRepo,
+		c.AccountRepo,
+		input.OrderID,
+		input.AccountID,
+		input.ClientOrderID,
+		input.CallerAccountID,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	if order == nil {
-		return nil, shared.ErrNotFound
-	}
-
 	b, err := c.BookRepo.GetBook(order.Instrument)
 	if err != nil {
 		return nil, err
@@ -32,6 +41,15 @@ func (c *CancelOrderUseCase) Execute(input CancelOrderInput) (*CancelOrderOutput
 		return nil, shared.ErrNotFound
 	}
 
+	if !held[b] {
+		b.Lock()
+		defer b.Unlock()
+	}
+
+	if order.Remaining == 0 {
+		return nil, domainOrder.ErrOrderNotOpen
+	}
+
 	b.RemoveOrder(order)
 
 	err = c.BookRepo.SaveBook(b)
@@ -49,15 +67,18 @@ func (c *CancelOrderUseCase) Execute(input CancelOrderInput) (*CancelOrderOutput
 		return nil, shared.ErrNotFound
 	}
 
+	unlock := accountServices.LockAccounts(acct)
+	defer unlock()
+
 	if order.Side == domainOrder.Buy {
 		amount := shared.Mul(order.Price, order.Remaining)
 
-		err = acct.ReleaseReserved(quote, amount)
+		err = acct.ReleaseReserved(quote, amount, ledger.OrderRef(order.GetID()))
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		err = acct.ReleaseReserved(base, order.Remaining)
+		err = acct.ReleaseReserved(base, order.Remaining, ledger.OrderRef(order.GetID()))
 		if err != nil {
 			return nil, err
 		}
@@ -68,7 +89,7 @@ func (c *CancelOrderUseCase) Execute(input CancelOrderInput) (*CancelOrderOutput
 		return nil, err
 	}
 
-	order.Remaining = 0
+	order.Cancel()
 
 	err = c.OrderRepo.SaveOrder(order)
 	if err != nil {
@@ -85,6 +85,21 @@ func (suite *CancelOrderUseCaseUnitTestSuite) TestExecute_OrderNotFound() {
 	assert.Nil(suite.T(), out)
 }
 
+func (suite *CancelOrderUseCaseUnitTestSuite) TestExecute_ForbiddenForOtherAccount() {
+	input := suite.inputFaker
+	input.CallerAccountID = "acc999"
+
+	order := &domainOrder.Order{AccountID: "acc123", Instrument: "BTC/USDT", Remaining: 5}
+
+	suite.orderRepo.EXPECT().GetOrder(input.OrderID).Return(order, nil)
+	suite.accountRepo.EXPECT().Get("acc123").Return(&domainAccount.Account{}, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrForbidden)
+	assert.Nil(suite.T(), out)
+	assert.Equal(suite.T(), int64(5), order.Remaining)
+}
+
 func (suite *CancelOrderUseCaseUnitTestSuite) TestExecute_BookNotFound() {
 	input := suite.inputFaker
 	order := &domainOrder.Order{Instrument: "BTC/USDT"}
@@ -298,7 +313,68 @@ func (suite *CancelOrderUseCaseUnitTestSuite) TestExecute_SaveBookError() {
 	assert.Nil(suite.T(), out)
 }
 
+func (suite *CancelOrderUseCaseUnitTestSuite) TestExecute_ByClientOrderID() {
+	input := orderUsecases.CancelOrderInput{AccountID: "acc123", ClientOrderID: "client-1"}
+
+	order := &domainOrder.Order{
+		AccountID:     "acc123",
+		ClientOrderID: "client-1",
+		Instrument:    "BTC/USDT",
+		Side:          domainOrder.Sell,
+		Price:         100,
+		Remaining:     5,
+	}
+	book := &domainBook.Book{Instrument: "BTC/USDT"}
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"BTC": {Available: 0, Reserved: 5},
+		},
+	}
+
+	suite.orderRepo.EXPECT().GetOrderByClientOrderID("acc123", "client-1").Return(order, nil)
+	suite.bookRepo.EXPECT().GetBook(order.Instrument).Return(book, nil)
+	suite.bookRepo.EXPECT().SaveBook(book).Return(nil)
+	suite.accountRepo.EXPECT().Get(order.AccountID).Return(account, nil)
+	suite.accountRepo.EXPECT().Save(account).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(order).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), order, out.Order)
+	assert.Equal(suite.T(), int64(0), order.Remaining)
+	assert.Equal(suite.T(), int64(5), account.Balances["BTC"].Available)
+}
+
+func (suite *CancelOrderUseCaseUnitTestSuite) TestExecute_MissingIdentifiers() {
+	out, err := suite.usecase.Execute(orderUsecases.CancelOrderInput{AccountID: "acc123"})
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *CancelOrderUseCaseUnitTestSuite) TestExecute_OrderNotOpen() {
+	input := suite.inputFaker
+	order := &domainOrder.Order{
+		AccountID:  "acc123",
+		Instrument: "BTC/USDT",
+		Side:       domainOrder.Buy,
+		Price:      100,
+		Remaining:  0,
+	}
+	order.ID.ID = input.OrderID
+
+	suite.orderRepo.EXPECT().GetOrder(input.OrderID).Return(order, nil)
+	suite.bookRepo.EXPECT().GetBook(order.Instrument).Return(&domainBook.Book{Instrument: "BTC/USDT"}, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, domainOrder.ErrOrderNotOpen)
+	assert.Nil(suite.T(), out)
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, new(CancelOrderUseCaseUnitTestSuite))
 	suite.Run(t, new(PlaceOrderUseCaseUnitTestSuite))
+	suite.Run(t, new(GetOrderUseCaseUnitTestSuite))
+	suite.Run(t, new(BatchPlaceOrdersUseCaseUnitTestSuite))
+	suite.Run(t, new(BatchCancelOrdersUseCaseUnitTestSuite))
+	suite.Run(t, new(MassCancelOrdersUseCaseUnitTestSuite))
 }
@@ -0,0 +1,81 @@
+package usecases
+
+import (
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type GetOrderUseCase struct {
+	OrderRepo   domainOrder.IOrderRepository
+	AccountRepo account.IAccountRepository
+}
+
+func (g *GetOrderUseCase) Execute(input GetOrderInput) (*GetOrderOutput, error) {
+	order, err := findOrder(
+		g.OrderRepo,
+		g.AccountRepo,
+		input.OrderID,
+		input.AccountID,
+		input.ClientOrderID,
+		input.CallerAccountID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetOrderOutput{Order: order}, nil
+}
+
+// findOrder resolves an order by exchange ID or, when that is empty, by the
+// account's client order ID, and checks the caller may act on it.
+func findOrder(
+	orderRepo domainOrder.IOrderRepository,
+	accountRepo account.IAccountRepository,
+	orderID, accountID, clientOrderID, callerAccountID string,
+) (*domainOrder.Order, error) {
+	var (
+		order *domainOrder.Order
+		err   error
+	)
+
+	switch {
+	case orderID != "":
+		order, err = orderRepo.GetOrder(orderID)
+	case accountID != "" && clientOrderID != "":
+		err = accountServices.Authorize(accountRepo, callerAccountID, accountID)
+		if err != nil {
+			return nil, err
+		}
+
+		order, err = orderRepo.GetOrderByClientOrderID(accountID, clientOrderID)
+	default:
+		return nil, shared.ErrInvalidParam
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if order == nil {
+		return nil, shared.ErrNotFound
+	}
+
+	err = accountServices.Authorize(accountRepo, callerAccountID, order.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func NewGetOrderUseCase(
+	orderRepo domainOrder.IOrderRepository,
+	accountRepo account.IAccountRepository,
+) *GetOrderUseCase {
+	return &GetOrderUseCase{
+		OrderRepo:   orderRepo,
+		AccountRepo: accountRepo,
+	}
+}
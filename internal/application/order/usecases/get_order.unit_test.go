@@ -0,0 +1,81 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	orderMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/order/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type GetOrderUseCaseUnitTestSuite struct {
+	suite.Suite
+	orderRepo   *orderMocks.MockIOrderRepository
+	accountRepo *accountMocks.MockIAccountRepository
+	ctrl        *gomock.Controller
+	usecase     *orderUsecases.GetOrderUseCase
+}
+
+func (suite *GetOrderUseCaseUnitTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.orderRepo = orderMocks.NewMockIOrderRepository(suite.ctrl)
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.usecase = orderUsecases.NewGetOrderUseCase(suite.orderRepo, suite.accountRepo)
+}
+
+func (suite *GetOrderUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *GetOrderUseCaseUnitTestSuite) TestExecute_ByOrderID() {
+	order := &domainOrder.Order{AccountID: "acc123"}
+
+	suite.orderRepo.EXPECT().GetOrder("order-1").Return(order, nil)
+
+	out, err := suite.usecase.Execute(orderUsecases.GetOrderInput{OrderID: "order-1"})
+	assert.NoError(suite.T(), err)
+	assert.Same(suite.T(), order, out.Order)
+}
+
+func (suite *GetOrderUseCaseUnitTestSuite) TestExecute_ByClientOrderID() {
+	order := &domainOrder.Order{AccountID: "acc123", ClientOrderID: "client-1"}
+
+	suite.orderRepo.EXPECT().GetOrderByClientOrderID("acc123", "client-1").Return(order, nil)
+
+	out, err := suite.usecase.Execute(orderUsecases.GetOrderInput{AccountID: "acc123", ClientOrderID: "client-1"})
+	assert.NoError(suite.T(), err)
+	assert.Same(suite.T(), order, out.Order)
+}
+
+func (suite *GetOrderUseCaseUnitTestSuite) TestExecute_NotFound() {
+	suite.orderRepo.EXPECT().GetOrderByClientOrderID("acc123", "unknown").Return(nil, nil)
+
+	out, err := suite.usecase.Execute(orderUsecases.GetOrderInput{AccountID: "acc123", ClientOrderID: "unknown"})
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *GetOrderUseCaseUnitTestSuite) TestExecute_MissingIdentifiers() {
+	out, err := suite.usecase.Execute(orderUsecases.GetOrderInput{ClientOrderID: "client-1"})
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *GetOrderUseCaseUnitTestSuite) TestExecute_ForbiddenBeforeLookup() {
+	suite.accountRepo.EXPECT().Get("acc123").Return(&domainAccount.Account{}, nil)
+
+	out, err := suite.usecase.Execute(orderUsecases.GetOrderInput{
+		AccountID:       "acc123",
+		ClientOrderID:   "client-1",
+		CallerAccountID: "acc999",
+	})
+	assert.ErrorIs(suite.T(), err, shared.ErrForbidden)
+	assert.Nil(suite.T(), out)
+}
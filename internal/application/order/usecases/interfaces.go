@@ -7,21 +7,70 @@ import (
 
 type (
 	CancelOrderInput struct {
-		OrderID string
+		OrderID         string
+		AccountID       string
+		ClientOrderID   string
+		CallerAccountID string
 	}
 	CancelOrderOutput struct {
 		Order *domainOrder.Order
 	}
 	PlaceOrderInput struct {
-		AccountID  string
-		Instrument string
-		Side       string
-		Price      int64
-		Qty        int64
+		AccountID       string
+		ClientOrderID   string
+		CallerAccountID string
+		Instrument      string
+		Side            string
+		Price           int64
+		Qty             int64
 	}
+	// PlaceOrderOutput is Halted when the instrument halted during the
+	// match, which cancels the CanceledQty the order had left instead of
+	// resting it.
 	PlaceOrderOutput struct {
 		Order       *domainOrder.Order
 		TradeReport *services.TradeReport
+		CanceledQty int64
+		Replayed    bool
+		Halted      bool
+	}
+	GetOrderInput struct {
+		OrderID         string
+		AccountID       string
+		ClientOrderID   string
+		CallerAccountID string
+	}
+	GetOrderOutput struct {
+		Order *domainOrder.Order
+	}
+	BatchPlaceOrdersInput struct {
+		Orders []PlaceOrderInput
+	}
+	BatchPlaceOrderResult struct {
+		Err    error
+		Output *PlaceOrderOutput
+	}
+	BatchPlaceOrdersOutput struct {
+		Results []BatchPlaceOrderResult
+	}
+	BatchCancelOrdersInput struct {
+		Orders []CancelOrderInput
+	}
+	BatchCancelOrderResult struct {
+		Err    error
+		Output *CancelOrderOutput
+	}
+	BatchCancelOrdersOutput struct {
+		Results []BatchCancelOrderResult
+	}
+	MassCancelOrdersInput struct {
+		AccountID       string
+		Instrument      string
+		Side            string
+		CallerAccountID string
+	}
+	MassCancelOrdersOutput struct {
+		Orders []*domainOrder.Order
 	}
 	ICancelOrderUseCase interface {
 		Execute(input CancelOrderInput) (*CancelOrderOutput, error)
@@ -29,4 +78,16 @@ type (
 	IPlaceOrderUseCase interface {
 		Execute(input PlaceOrderInput) (*PlaceOrderOutput, error)
 	}
+	IGetOrderUseCase interface {
+		Execute(input GetOrderInput) (*GetOrderOutput, error)
+	}
+	IBatchPlaceOrdersUseCase interface {
+		Execute(input BatchPlaceOrdersInput) (*BatchPlaceOrdersOutput, error)
+	}
+	IBatchCancelOrdersUseCase interface {
+		Execute(input BatchCancelOrdersInput) (*BatchCancelOrdersOutput, error)
+	}
+	IMassCancelOrdersUseCase interface {
+		Execute(input MassCancelOrdersInput) (*MassCancelOrdersOutput, error)
+	}
 )
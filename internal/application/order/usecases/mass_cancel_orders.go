@@ -0,0 +1,241 @@
+package usecases
+
+import (
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type MassCancelOrdersUseCase struct {
+	BookRepo    domainBook.IBookRepository
+	OrderRepo   domainOrder.IOrderRepository
+	AccountRepo account.IAccountRepository
+}
+
+type reservation struct {
+	asset  string
+	amount int64
+}
+
+// Execute cancels every resting order of the account that matches the
+// optional instrument and side filters. The books and the account stay locked
+// throughout, and the reserves are checked before anything changes, so either
+// all matching orders are cancelled or none are.
+func (m *MassCancelOrdersUseCase) Execute(input MassCancelOrdersInput) (*MassCancelOrdersOutput, error) {
+	if input.AccountID == "" {
+		return nil, shared.ErrInvalidParam
+	}
+
+	var side domainOrder.Side
+
+	if input.Side != "" {
+		parsed, err := domainOrder.ParseSide(input.Side)
+		if err != nil {
+			return nil, shared.ErrInvalidParam
+		}
+
+		side = parsed
+	}
+
+	err := accountServices.Authorize(m.AccountRepo, input.CallerAccountID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	acct, err := m.AccountRepo.Get(input.AccountID)
+	if err != nil {
+		return nil, shared.ErrNotFound
+	}
+
+	books, open, unlockBooks, err := m.lockBooks(input.AccountID, input.Instrument, side)
+	if err != nil {
+		return nil, err
+	}
+	defer unlockBooks()
+
+	unlockAccount := accountServices.LockAccounts(acct)
+	defer unlockAccount()
+
+	orders := make([]*domainOrder.Order, 0, len(open))
+	reservations := make([]reservation, 0, len(open))
+	totals := make(map[string]int64)
+
+	for _, listed := range open {
+		b := books[listed.Instrument]
+		if b == nil || !matchesMassCancel(listed, input.Instrument, side) {
+			continue
+		}
+
+		// The book, now locked, has the final word on what still rests.
+		order := b.Order(listed.Seq)
+		if order == nil || order.AccountID != input.AccountID || order.Remaining == 0 {
+			continue
+		}
+
+		r, err := reservationFor(order)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, order)
+		reservations = append(reservations, r)
+		totals[r.asset] += r.amount
+	}
+
+	if len(orders) == 0 {
+		return &MassCancelOrdersOutput{Orders: orders}, nil
+	}
+
+	for asset, total := range totals {
+		bal, ok := acct.Balances[asset]
+		if !ok || bal.Reserved < total {
+			return nil, account.ErrInsufficient
+		}
+	}
+
+	for i, order := range orders {
+		books[order.Instrument].RemoveOrder(order)
+
+		err = acct.ReleaseReserved(reservations[i].asset, reservations[i].amount, ledger.OrderRef(order.GetID()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, b := range books {
+		err = m.BookRepo.SaveBook(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = m.AccountRepo.Save(acct)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, order := range orders {
+		order.Cancel()
+
+		err = m.OrderRepo.SaveOrder(order)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &MassCancelOrdersOutput{Orders: orders}, nil
+}
+
+// lockBooks locks the books holding the account's matching open orders, then
+// lists those orders again under the locks, since more may have been placed
+// meanwhile. One placed in a book not yet locked sends it round again.
+func (m *MassCancelOrdersUseCase) lockBooks(
+	accountID, instrument string,
+	side domainOrder.Side,
+) (map[string]*domainBook.Book, []*domainOrder.Order, func(), error) {
+	open, err := m.OrderRepo.ListOpenOrdersByAccount(accountID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	for {
+		books, looked, err := m.booksFor(open, instrument, side)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		bookList := make([]*domainBook.Book, 0, len(books))
+		for _, b := range books {
+			bookList = append(bookList, b)
+		}
+
+		_, unlock := holdBooks(bookList)
+
+		open, err = m.OrderRepo.ListOpenOrdersByAccount(accountID)
+		if err != nil {
+			unlock()
+
+			return nil, nil, nil, err
+		}
+
+		if coversOpenOrders(looked, open, instrument, side) {
+			return books, open, unlock, nil
+		}
+
+		unlock()
+	}
+}
+
+// booksFor fetches the book of every order matching the filters, along with
+// the instruments it looked up, whose book may turn out to be gone.
+func (m *MassCancelOrdersUseCase) booksFor(
+	open []*domainOrder.Order,
+	instrument string,
+	side domainOrder.Side,
+) (map[string]*domainBook.Book, map[string]bool, error) {
+	books := make(map[string]*domainBook.Book)
+	looked := make(map[string]bool)
+
+	for _, order := range open {
+		if !matchesMassCancel(order, instrument, side) || looked[order.Instrument] {
+			continue
+		}
+
+		looked[order.Instrument] = true
+
+		b, err := m.BookRepo.GetBook(order.Instrument)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if b != nil {
+			books[order.Instrument] = b
+		}
+	}
+
+	return books, looked, nil
+}
+
+// coversOpenOrders reports whether the book of every matching order of open
+// was looked up.
+func coversOpenOrders(looked map[string]bool, open []*domainOrder.Order, instrument string, side domainOrder.Side) bool {
+	for _, order := range open {
+		if matchesMassCancel(order, instrument, side) && !looked[order.Instrument] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchesMassCancel(order *domainOrder.Order, instrument string, side domainOrder.Side) bool {
+	return (instrument == "" || order.Instrument == instrument) && (side == 0 || order.Side == side)
+}
+
+func reservationFor(order *domainOrder.Order) (reservation, error) {
+	base, quote, err := domainBook.SplitInstrument(order.Instrument)
+	if err != nil {
+		return reservation{}, err
+	}
+
+	if order.Side == domainOrder.Buy {
+		return reservation{asset: quote, amount: shared.Mul(order.Price, order.Remaining)}, nil
+	}
+
+	return reservation{asset: base, amount: order.Remaining}, nil
+}
+
+func NewMassCancelOrdersUseCase(
+	bookRepo domainBook.IBookRepository,
+	orderRepo domainOrder.IOrderRepository,
+	accountRepo account.IAccountRepository,
+) *MassCancelOrdersUseCase {
+	return &MassCancelOrdersUseCase{
+		BookRepo:    bookRepo,
+		OrderRepo:   orderRepo,
+		AccountRepo: accountRepo,
+	}
+}
@@ -0,0 +1,221 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	bookMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/book/mocks"
+	orderMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/order/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type MassCancelOrdersUseCaseUnitTestSuite struct {
+	suite.Suite
+	bookRepo    *bookMocks.MockIBookRepository
+	orderRepo   *orderMocks.MockIOrderRepository
+	accountRepo *accountMocks.MockIAccountRepository
+	ctrl        *gomock.Controller
+	usecase     *orderUsecases.MassCancelOrdersUseCase
+	account     *domainAccount.Account
+	btcBook     *domainBook.Book
+	ethBook     *domainBook.Book
+	btcBuy      *domainOrder.Order
+	btcSell     *domainOrder.Order
+	ethBuy      *domainOrder.Order
+}
+
+func (suite *MassCancelOrdersUseCaseUnitTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.bookRepo = bookMocks.NewMockIBookRepository(suite.ctrl)
+	suite.orderRepo = orderMocks.NewMockIOrderRepository(suite.ctrl)
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.usecase = orderUsecases.NewMassCancelOrdersUseCase(suite.bookRepo, suite.orderRepo, suite.accountRepo)
+
+	suite.account = &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 0, Reserved: 210},
+			"BTC":  {Available: 0, Reserved: 3},
+		},
+	}
+
+	suite.btcBook, _ = domainBook.NewBook(domainBook.BookProps{Instrument: "BTC/USDT"}, "Uuid")
+	suite.ethBook, _ = domainBook.NewBook(domainBook.BookProps{Instrument: "ETH/USDT"}, "Uuid")
+
+	suite.btcBuy = &domainOrder.Order{AccountID: "acc123", Instrument: "BTC/USDT", Side: domainOrder.Buy, Price: 100, Remaining: 2}
+	suite.btcSell = &domainOrder.Order{AccountID: "acc123", Instrument: "BTC/USDT", Side: domainOrder.Sell, Price: 120, Remaining: 3}
+	suite.ethBuy = &domainOrder.Order{AccountID: "acc123", Instrument: "ETH/USDT", Side: domainOrder.Buy, Price: 10, Remaining: 1}
+
+	suite.btcBuy.ID = idObjValue.NewID("btc-buy", idObjValue.Str)
+	suite.btcSell.ID = idObjValue.NewID("btc-sell", idObjValue.Str)
+	suite.ethBuy.ID = idObjValue.NewID("eth-buy", idObjValue.Str)
+
+	suite.btcBook.AddOrder(suite.btcBuy)
+	suite.btcBook.AddOrder(suite.btcSell)
+	suite.ethBook.AddOrder(suite.ethBuy)
+}
+
+func (suite *MassCancelOrdersUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *MassCancelOrdersUseCaseUnitTestSuite) expectOpenOrders() {
+	suite.accountRepo.EXPECT().Get("acc123").Return(suite.account, nil)
+	suite.orderRepo.EXPECT().ListOpenOrdersByAccount("acc123").
+		Return([]*domainOrder.Order{suite.btcBuy, suite.btcSell, suite.ethBuy}, nil).Times(2)
+}
+
+func (suite *MassCancelOrdersUseCaseUnitTestSuite) TestExecute_AllOrders() {
+	suite.expectOpenOrders()
+	suite.bookRepo.EXPECT().GetBook("BTC/USDT").Return(suite.btcBook, nil)
+	suite.bookRepo.EXPECT().GetBook("ETH/USDT").Return(suite.ethBook, nil)
+	suite.bookRepo.EXPECT().SaveBook(gomock.Any()).Return(nil).Times(2)
+	suite.accountRepo.EXPECT().Save(suite.account).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil).Times(3)
+
+	out, err := suite.usecase.Execute(orderUsecases.MassCancelOrdersInput{AccountID: "acc123"})
+	require.NoError(suite.T(), err)
+	assert.Len(suite.T(), out.Orders, 3)
+
+	assert.Equal(suite.T(), int64(210), suite.account.Balances["USDT"].Available)
+	assert.Equal(suite.T(), int64(0), suite.account.Balances["USDT"].Reserved)
+	assert.Equal(suite.T(), int64(3), suite.account.Balances["BTC"].Available)
+	assert.Nil(suite.T(), suite.btcBook.BestBid())
+	assert.Nil(suite.T(), suite.btcBook.BestAsk())
+	assert.Nil(suite.T(), suite.ethBook.BestBid())
+	assert.Equal(suite.T(), int64(0), suite.btcSell.Remaining)
+}
+
+func (suite *MassCancelOrdersUseCaseUnitTestSuite) TestExecute_FilteredByInstrumentAndSide() {
+	suite.expectOpenOrders()
+	suite.bookRepo.EXPECT().GetBook("BTC/USDT").Return(suite.btcBook, nil)
+	suite.bookRepo.EXPECT().SaveBook(suite.btcBook).Return(nil)
+	suite.accountRepo.EXPECT().Save(suite.account).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(suite.btcBuy).Return(nil)
+
+	out, err := suite.usecase.Execute(orderUsecases.MassCancelOrdersInput{
+		AccountID:  "acc123",
+		Instrument: "BTC/USDT",
+		Side:       "buy",
+	})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domainOrder.Order{suite.btcBuy}, out.Orders)
+
+	assert.Equal(suite.T(), int64(200), suite.account.Balances["USDT"].Available)
+	assert.Equal(suite.T(), int64(10), suite.account.Balances["USDT"].Reserved)
+	assert.Equal(suite.T(), int64(3), suite.account.Balances["BTC"].Reserved)
+	assert.Nil(suite.T(), suite.btcBook.BestBid())
+	assert.NotNil(suite.T(), suite.btcBook.BestAsk())
+	assert.Equal(suite.T(), int64(1), suite.ethBuy.Remaining)
+}
+
+func (suite *MassCancelOrdersUseCaseUnitTestSuite) TestExecute_ReserveShortfallChangesNothing() {
+	suite.account.Balances["USDT"].Reserved = 205
+
+	suite.expectOpenOrders()
+	suite.bookRepo.EXPECT().GetBook("BTC/USDT").Return(suite.btcBook, nil)
+	suite.bookRepo.EXPECT().GetBook("ETH/USDT").Return(suite.ethBook, nil)
+
+	out, err := suite.usecase.Execute(orderUsecases.MassCancelOrdersInput{AccountID: "acc123"})
+	assert.ErrorIs(suite.T(), err, domainAccount.ErrInsufficient)
+	assert.Nil(suite.T(), out)
+
+	assert.Equal(suite.T(), int64(205), suite.account.Balances["USDT"].Reserved)
+	assert.Equal(suite.T(), int64(3), suite.account.Balances["BTC"].Reserved)
+	assert.NotNil(suite.T(), suite.btcBook.BestBid())
+	assert.NotNil(suite.T(), suite.btcBook.BestAsk())
+	assert.Equal(suite.T(), int64(2), suite.btcBuy.Remaining)
+}
+
+func (suite *MassCancelOrdersUseCaseUnitTestSuite) TestExecute_CancelsOrderPlacedInHeldBookWhileLocking() {
+	suite.accountRepo.EXPECT().Get("acc123").Return(suite.account, nil)
+	gomock.InOrder(
+		suite.orderRepo.EXPECT().ListOpenOrdersByAccount("acc123").
+			Return([]*domainOrder.Order{suite.btcBuy}, nil),
+		suite.orderRepo.EXPECT().ListOpenOrdersByAccount("acc123").
+			Return([]*domainOrder.Order{suite.btcBuy, suite.btcSell}, nil),
+	)
+	suite.bookRepo.EXPECT().GetBook("BTC/USDT").Return(suite.btcBook, nil)
+	suite.bookRepo.EXPECT().SaveBook(suite.btcBook).Return(nil)
+	suite.accountRepo.EXPECT().Save(suite.account).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil).Times(2)
+
+	out, err := suite.usecase.Execute(orderUsecases.MassCancelOrdersInput{AccountID: "acc123"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domainOrder.Order{suite.btcBuy, suite.btcSell}, out.Orders)
+	assert.Nil(suite.T(), suite.btcBook.BestAsk())
+}
+
+func (suite *MassCancelOrdersUseCaseUnitTestSuite) TestExecute_RelocksWhenOrderPlacedInAnotherBook() {
+	suite.accountRepo.EXPECT().Get("acc123").Return(suite.account, nil)
+	gomock.InOrder(
+		suite.orderRepo.EXPECT().ListOpenOrdersByAccount("acc123").
+			Return([]*domainOrder.Order{suite.btcBuy}, nil),
+		suite.orderRepo.EXPECT().ListOpenOrdersByAccount("acc123").
+			Return([]*domainOrder.Order{suite.btcBuy, suite.ethBuy}, nil).Times(2),
+	)
+	suite.bookRepo.EXPECT().GetBook("BTC/USDT").Return(suite.btcBook, nil).Times(2)
+	suite.bookRepo.EXPECT().GetBook("ETH/USDT").Return(suite.ethBook, nil)
+	suite.bookRepo.EXPECT().SaveBook(gomock.Any()).Return(nil).Times(2)
+	suite.accountRepo.EXPECT().Save(suite.account).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil).Times(2)
+
+	out, err := suite.usecase.Execute(orderUsecases.MassCancelOrdersInput{AccountID: "acc123"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domainOrder.Order{suite.btcBuy, suite.ethBuy}, out.Orders)
+	assert.Nil(suite.T(), suite.ethBook.BestBid())
+	assert.NotNil(suite.T(), suite.btcBook.BestAsk())
+}
+
+func (suite *MassCancelOrdersUseCaseUnitTestSuite) TestExecute_SkipsOrderNoLongerResting() {
+	suite.btcBook.RemoveOrder(suite.btcSell)
+
+	suite.expectOpenOrders()
+	suite.bookRepo.EXPECT().GetBook("BTC/USDT").Return(suite.btcBook, nil)
+	suite.bookRepo.EXPECT().SaveBook(suite.btcBook).Return(nil)
+	suite.accountRepo.EXPECT().Save(suite.account).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(suite.btcBuy).Return(nil)
+
+	out, err := suite.usecase.Execute(orderUsecases.MassCancelOrdersInput{AccountID: "acc123", Instrument: "BTC/USDT"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domainOrder.Order{suite.btcBuy}, out.Orders)
+	assert.Equal(suite.T(), int64(3), suite.account.Balances["BTC"].Reserved)
+}
+
+func (suite *MassCancelOrdersUseCaseUnitTestSuite) TestExecute_NoOpenOrders() {
+	suite.accountRepo.EXPECT().Get("acc123").Return(suite.account, nil)
+	suite.orderRepo.EXPECT().ListOpenOrdersByAccount("acc123").Return(nil, nil).Times(2)
+
+	out, err := suite.usecase.Execute(orderUsecases.MassCancelOrdersInput{AccountID: "acc123"})
+	require.NoError(suite.T(), err)
+	assert.Empty(suite.T(), out.Orders)
+}
+
+func (suite *MassCancelOrdersUseCaseUnitTestSuite) TestExecute_InvalidSide() {
+	out, err := suite.usecase.Execute(orderUsecases.MassCancelOrdersInput{AccountID: "acc123", Side: "hold"})
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *MassCancelOrdersUseCaseUnitTestSuite) TestExecute_MissingAccount() {
+	out, err := suite.usecase.Execute(orderUsecases.MassCancelOrdersInput{})
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *MassCancelOrdersUseCaseUnitTestSuite) TestExecute_ForbiddenForOtherAccount() {
+	suite.accountRepo.EXPECT().Get("acc123").Return(suite.account, nil)
+
+	out, err := suite.usecase.Execute(orderUsecases.MassCancelOrdersInput{AccountID: "acc123", CallerAccountID: "acc999"})
+	assert.ErrorIs(suite.T(), err, shared.ErrForbidden)
+	assert.Nil(suite.T(), out)
+}
@@ -1,25 +1,46 @@
 package usecases
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
 	"github.com/juninhoitabh/clob-go/internal/domain/account"
 	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
 	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
 	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
 	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	domainRisk "github.com/juninhoitabh/clob-go/internal/domain/risk"
+	domainTrade "github.com/juninhoitabh/clob-go/internal/domain/trade"
 	"github.com/juninhoitabh/clob-go/internal/shared"
 	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
 )
 
 type (
 	PlaceOrderUseCase struct {
-		BookRepo    domainBook.IBookRepository
-		OrderRepo   domainOrder.IOrderRepository
-		AccountRepo account.IAccountRepository
+		BookRepo            domainBook.IBookRepository
+		OrderRepo           domainOrder.IOrderRepository
+		AccountRepo         account.IAccountRepository
+		FeeScheduleRepo     domainFee.IScheduleRepository
+		TradeRepo           domainTrade.ITradeRepository
+		RiskChecker         domainRisk.IRiskChecker
+		FeeProps            domainFee.FeeProps
+		CircuitBreakerProps domainBook.CircuitBreakerProps
 	}
 )
 
 func (p *PlaceOrderUseCase) Execute(input PlaceOrderInput) (*PlaceOrderOutput, error) {
-	if input.Price <= 0 || input.Qty <= 0 {
+	return p.place(input, nil)
+}
+
+// place runs one order under its book's lock, unless the caller already
+// holds that book as part of a batch. Balances change only under the
+// accounts' locks, taken after the book's.
+func (p *PlaceOrderUseCase) place(input PlaceOrderInput, held heldBooks) (*PlaceOrderOutput, error) {
+	if input.Price <= 0 || input.Qty <= 0 || len(input.ClientOrderID) > domainOrder.MaxClientOrderIDLength {
 		return nil, shared.ErrInvalidParam
 	}
 
@@ -28,6 +49,22 @@ func (p *PlaceOrderUseCase) Execute(input PlaceOrderInput) (*PlaceOrderOutput, e
 		return nil, err
 	}
 
+	err = accountServices.Authorize(p.AccountRepo, input.CallerAccountID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ClientOrderID != "" {
+		existing, err := p.OrderRepo.GetOrderByClientOrderID(input.AccountID, input.ClientOrderID)
+		if err != nil {
+			return nil, err
+		}
+
+		if existing != nil {
+			return p.replay(existing, input, side)
+		}
+	}
+
 	acct, err := p.AccountRepo.Get(input.AccountID)
 	if err != nil {
 		return nil, shared.ErrNotFound
@@ -38,70 +75,239 @@ func (p *PlaceOrderUseCase) Execute(input PlaceOrderInput) (*PlaceOrderOutput, e
 		return nil, err
 	}
 
-	if side == domainOrder.Buy {
-		cost := shared.Mul(input.Price, input.Qty)
+	b, err := p.loadBook(input.Instrument)
+	if err != nil {
+		return nil, err
+	}
+
+	if !held[b] {
+		b.Lock()
+		defer b.Unlock()
+	}
+
+	now := time.Now()
+
+	err = b.CircuitBreaker.CheckPrice(input.Price, now)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := p.reserveOrder(input, acct, side, base, quote, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.OrderRepo.SaveOrder(order)
+	if errors.Is(err, domainOrder.ErrDuplicateClientOrderID) {
+		return p.replayConcurrent(acct, order, input, side, base, quote)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The match goes into a pooled report, whose trades are copied out for
+	// the repository and the caller before it goes back.
+	report := services.AcquireTradeReport()
+	defer services.ReleaseTradeReport(report)
+
+	services.MatchOrderInto(report, b, order, time.Now())
+
+	err = p.BookRepo.SaveBook(b)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.saveFills(report, order)
+	if err != nil {
+		return nil, err
+	}
+
+	unlockAccounts, err := p.lockTradeAccounts(acct, report)
+	if err != nil {
+		return nil, err
+	}
+	defer unlockAccounts()
+
+	err = p.settleTrades(report, order, input.Instrument, base, quote)
+	if err != nil {
+		return nil, err
+	}
 
-		err = acct.Reserve(quote, cost)
+	output := &PlaceOrderOutput{
+		Order:       order,
+		TradeReport: report.Clone(),
+		Halted:      report.Halted,
+	}
+
+	if report.Halted && order.Remaining > 0 {
+		output.CanceledQty, err = p.releaseRemaining(acct, order, base, quote)
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		err = acct.Reserve(base, input.Qty)
+	}
+
+	return output, nil
+}
+
+// saveFills saves every order the match filled, makers and taker alike,
+// while the book is still locked, so the open orders listed for their
+// accounts keep up with the book.
+func (p *PlaceOrderUseCase) saveFills(report *services.TradeReport, taker *domainOrder.Order) error {
+	if len(report.Trades) == 0 {
+		return nil
+	}
+
+	for i := range report.Trades {
+		maker, err := p.OrderRepo.GetOrder(report.Trades[i].MakerOrderID)
 		if err != nil {
-			return nil, err
+			return err
+		}
+
+		if maker == nil {
+			continue
+		}
+
+		err = p.OrderRepo.SaveOrder(maker)
+		if err != nil {
+			return err
 		}
 	}
 
-	err = p.AccountRepo.Save(acct)
+	return p.OrderRepo.SaveOrder(taker)
+}
+
+// reserveOrder runs the risk checks and reserves the order's funds under
+// the account's lock, which the caller takes after its book's.
+func (p *PlaceOrderUseCase) reserveOrder(
+	input PlaceOrderInput,
+	acct *account.Account,
+	side domainOrder.Side,
+	base, quote string,
+	now time.Time,
+) (*domainOrder.Order, error) {
+	unlock := accountServices.LockAccounts(acct)
+	defer unlock()
+
+	err := p.checkRisk(input, acct, side, base, quote, now)
 	if err != nil {
 		return nil, err
 	}
 
 	order, err := domainOrder.NewOrder(domainOrder.OrderProps{
-		AccountID:  input.AccountID,
-		Instrument: input.Instrument,
-		Side:       side,
-		Price:      input.Price,
-		Qty:        input.Qty,
-		Remaining:  input.Qty,
-	}, idObjValue.Uuid)
+		AccountID:     input.AccountID,
+		ClientOrderID: input.ClientOrderID,
+		Instrument:    input.Instrument,
+		Side:          side,
+		Price:         input.Price,
+		Qty:           input.Qty,
+		Remaining:     input.Qty,
+	}, idObjValue.Seq)
 	if err != nil {
 		return nil, err
 	}
 
-	err = p.OrderRepo.SaveOrder(order)
+	if side == domainOrder.Buy {
+		err = acct.Reserve(quote, shared.Mul(input.Price, input.Qty), ledger.OrderRef(order.GetID()))
+	} else {
+		err = acct.Reserve(base, input.Qty, ledger.OrderRef(order.GetID()))
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
-	b, err := p.BookRepo.GetBook(input.Instrument)
+	err = p.AccountRepo.Save(acct)
 	if err != nil {
 		return nil, err
 	}
 
-	if b == nil {
-		b, err = domainBook.NewBook(domainBook.BookProps{
-			Instrument: input.Instrument,
-		}, idObjValue.Uuid)
-		if err != nil {
-			return nil, err
+	return order, nil
+}
+
+// lockTradeAccounts locks the taker's account and every account the
+// report's trades settle into, the fee account included, in ID order. The
+// caller already holds the book, so the lock order stays book first, then
+// accounts.
+func (p *PlaceOrderUseCase) lockTradeAccounts(taker *account.Account, report *services.TradeReport) (func(), error) {
+	accts := []*account.Account{taker}
+
+	if len(report.Trades) == 0 {
+		return accountServices.LockAccounts(accts...), nil
+	}
+
+	seen := map[string]bool{taker.GetID(): true}
+
+	for _, trade := range report.Trades {
+		for _, accountID := range []string{trade.BuyerID, trade.SellerID} {
+			if seen[accountID] {
+				continue
+			}
+
+			seen[accountID] = true
+
+			acct, err := p.AccountRepo.Get(accountID)
+			if err != nil {
+				return nil, shared.ErrNotFound
+			}
+
+			accts = append(accts, acct)
 		}
+	}
 
-		err = p.BookRepo.SaveBook(b)
-		if err != nil {
-			return nil, err
+	if p.FeeProps.AccountID != "" {
+		if feeAcct, err := p.AccountRepo.Get(p.FeeProps.AccountID); err == nil {
+			accts = append(accts, feeAcct)
 		}
 	}
 
-	report := services.MatchOrder(b, order)
+	return accountServices.LockAccounts(accts...), nil
+}
 
-	err = p.BookRepo.SaveBook(b)
+func (p *PlaceOrderUseCase) loadBook(instrument string) (*domainBook.Book, error) {
+	b, err := p.BookRepo.GetBook(instrument)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, trade := range report.Trades {
-		err := accountServices.SettleTrade(
+	if b != nil {
+		return b, nil
+	}
+
+	b, err = domainBook.NewBook(domainBook.BookProps{
+		Instrument:     instrument,
+		CircuitBreaker: p.CircuitBreakerProps,
+	}, idObjValue.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.BookRepo.GetOrCreateBook(b)
+}
+
+func (p *PlaceOrderUseCase) settleTrades(
+	report *services.TradeReport,
+	taker *domainOrder.Order,
+	instrument, base, quote string,
+) error {
+	if len(report.Trades) == 0 {
+		return nil
+	}
+
+	schedule, err := p.feeSchedule(instrument)
+	if err != nil {
+		return err
+	}
+
+	err = p.refreshFeeTiers(report)
+	if err != nil {
+		return err
+	}
+
+	for i := range report.Trades {
+		trade := &report.Trades[i]
+
+		fees, err := accountServices.SettleTrade(
 			p.AccountRepo,
 			trade.BuyerID,
 			trade.SellerID,
@@ -109,26 +315,266 @@ func (p *PlaceOrderUseCase) Execute(input PlaceOrderInput) (*PlaceOrderOutput, e
 			quote,
 			trade.Price,
 			trade.Qty,
+			trade.TakerSide,
+			schedule,
+			p.FeeProps.AccountID,
+			ledger.TradeRef(trade.ID()),
 		)
 		if err != nil {
-			return nil, err
+			return err
+		}
+
+		trade.MakerFee = fees.MakerFee
+		trade.MakerFeeAsset = fees.MakerFeeAsset
+		trade.TakerFee = fees.TakerFee
+		trade.TakerFeeAsset = fees.TakerFeeAsset
+
+		err = p.releasePriceImprovement(taker, trade, quote)
+		if err != nil {
+			return err
+		}
+
+		saved := *trade
+
+		err = p.TradeRepo.SaveTrade(&saved)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// releasePriceImprovement frees the part of a buy taker's reserve that was
+// held at its limit price but not spent because the fill came in lower.
+func (p *PlaceOrderUseCase) releasePriceImprovement(taker *domainOrder.Order, trade *services.Trade, quote string) error {
+	if taker.Side != domainOrder.Buy || trade.Price >= taker.Price {
+		return nil
+	}
+
+	acct, err := p.AccountRepo.Get(taker.AccountID)
+	if err != nil {
+		return shared.ErrNotFound
+	}
+
+	err = acct.ReleaseReserved(quote, shared.Mul(taker.Price-trade.Price, trade.Qty), ledger.OrderRef(taker.GetID()))
+	if err != nil {
+		return err
+	}
+
+	return p.AccountRepo.Save(acct)
+}
+
+func (p *PlaceOrderUseCase) refreshFeeTiers(report *services.TradeReport) error {
+	if len(p.FeeProps.VolumeTiers) == 0 {
+		return nil
+	}
+
+	since := time.Now().Add(-domainFee.VolumeWindow)
+	refreshed := make(map[string]bool)
+
+	for _, trade := range report.Trades {
+		for _, accountID := range []string{trade.BuyerID, trade.SellerID} {
+			if refreshed[accountID] {
+				continue
+			}
+
+			refreshed[accountID] = true
+
+			acct, err := p.AccountRepo.Get(accountID)
+			if err != nil {
+				return shared.ErrNotFound
+			}
+
+			if acct.FeeTierPinned {
+				continue
+			}
+
+			volume, err := p.TradeRepo.RollingVolume(accountID, since)
+			if err != nil {
+				return err
+			}
+
+			acct.SetFeeTier(p.FeeProps.VolumeTiers.TierFor(volume))
+
+			err = p.AccountRepo.Save(acct)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *PlaceOrderUseCase) feeSchedule(instrument string) (*domainFee.Schedule, error) {
+	schedule, err := p.FeeScheduleRepo.GetSchedule(instrument)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule != nil {
+		return schedule, nil
+	}
+
+	return domainFee.NewSchedule(domainFee.ScheduleProps{
+		Instrument: instrument,
+		Rates:      p.FeeProps.Rates,
+	})
+}
+
+func (p *PlaceOrderUseCase) checkRisk(
+	input PlaceOrderInput,
+	acct *account.Account,
+	side domainOrder.Side,
+	base, quote string,
+	now time.Time,
+) error {
+	if p.RiskChecker == nil {
+		return nil
+	}
+
+	return p.RiskChecker.Check(domainRisk.OrderContext{
+		Now:      now,
+		Balances: acct.Balances,
+		ListOpenOrders: func() ([]*domainOrder.Order, error) {
+			return p.OrderRepo.ListOpenOrdersByAccount(input.AccountID)
+		},
+		AccountID:  input.AccountID,
+		Instrument: input.Instrument,
+		Base:       base,
+		Quote:      quote,
+		Side:       side,
+		Price:      input.Price,
+		Qty:        input.Qty,
+	})
+}
+
+// replay answers a resubmitted client order ID with the original order and
+// the trades it took on entry, rejecting reuse with different parameters.
+func (p *PlaceOrderUseCase) replay(
+	existing *domainOrder.Order,
+	input PlaceOrderInput,
+	side domainOrder.Side,
+) (*PlaceOrderOutput, error) {
+	if !existing.Matches(domainOrder.OrderProps{
+		AccountID:     input.AccountID,
+		ClientOrderID: input.ClientOrderID,
+		Instrument:    input.Instrument,
+		Side:          side,
+		Price:         input.Price,
+		Qty:           input.Qty,
+	}) {
+		return nil, fmt.Errorf("%w: %w", shared.ErrAlreadyExists, domainOrder.ErrClientOrderIDMismatch)
+	}
+
+	trades, err := p.TradeRepo.ListTrades(domainTrade.Filter{
+		AccountID:  existing.AccountID,
+		Instrument: existing.Instrument,
+		Since:      existing.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &services.TradeReport{}
+
+	for _, trade := range slices.Backward(trades) {
+		if trade.TakerOrderID == existing.GetID() {
+			report.Trades = append(report.Trades, *trade)
 		}
 	}
 
 	return &PlaceOrderOutput{
-		Order:       order,
+		Order:       existing,
 		TradeReport: report,
+		Replayed:    true,
 	}, nil
 }
 
+// replayConcurrent handles losing a race with another submission of the same
+// client order ID: the reserve taken for order is given back before replaying
+// the winner.
+func (p *PlaceOrderUseCase) replayConcurrent(
+	acct *account.Account,
+	order *domainOrder.Order,
+	input PlaceOrderInput,
+	side domainOrder.Side,
+	base, quote string,
+) (*PlaceOrderOutput, error) {
+	unlock := accountServices.LockAccounts(acct)
+	err := p.releaseReserve(acct, order, base, quote)
+	unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := p.OrderRepo.GetOrderByClientOrderID(input.AccountID, input.ClientOrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		return nil, fmt.Errorf("%w: %w", shared.ErrAlreadyExists, domainOrder.ErrDuplicateClientOrderID)
+	}
+
+	return p.replay(existing, input, side)
+}
+
+// releaseRemaining cancels what order has left and returns the quantity.
+func (p *PlaceOrderUseCase) releaseRemaining(acct *account.Account, order *domainOrder.Order, base, quote string) (int64, error) {
+	err := p.releaseReserve(acct, order, base, quote)
+	if err != nil {
+		return 0, err
+	}
+
+	canceled := order.Cancel()
+
+	err = p.OrderRepo.SaveOrder(order)
+	if err != nil {
+		return 0, err
+	}
+
+	return canceled, nil
+}
+
+// releaseReserve gives back what order still holds; the caller holds
+// acct's lock.
+func (p *PlaceOrderUseCase) releaseReserve(acct *account.Account, order *domainOrder.Order, base, quote string) error {
+	var err error
+
+	if order.Side == domainOrder.Buy {
+		err = acct.ReleaseReserved(quote, shared.Mul(order.Price, order.Remaining), ledger.OrderRef(order.GetID()))
+	} else {
+		err = acct.ReleaseReserved(base, order.Remaining, ledger.OrderRef(order.GetID()))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return p.AccountRepo.Save(acct)
+}
+
 func NewPlaceOrderUseCase(
 	bookRepo domainBook.IBookRepository,
 	orderRepo domainOrder.IOrderRepository,
 	accountRepo account.IAccountRepository,
+	feeScheduleRepo domainFee.IScheduleRepository,
+	tradeRepo domainTrade.ITradeRepository,
+	circuitBreakerProps domainBook.CircuitBreakerProps,
+	feeProps domainFee.FeeProps,
+	riskChecker domainRisk.IRiskChecker,
 ) *PlaceOrderUseCase {
 	return &PlaceOrderUseCase{
-		BookRepo:    bookRepo,
-		OrderRepo:   orderRepo,
-		AccountRepo: accountRepo,
+		BookRepo:            bookRepo,
+		OrderRepo:           orderRepo,
+		AccountRepo:         accountRepo,
+		FeeScheduleRepo:     feeScheduleRepo,
+		TradeRepo:           tradeRepo,
+		RiskChecker:         riskChecker,
+		FeeProps:            feeProps,
+		CircuitBreakerProps: circuitBreakerProps,
 	}
 }
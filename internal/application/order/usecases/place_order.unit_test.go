@@ -4,6 +4,10 @@ package usecases_test
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -13,10 +17,16 @@ import (
 	"github.com/juninhoitabh/clob-go/internal/application/order/usecases/fakers"
 	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
 	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
 	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	domainRisk "github.com/juninhoitabh/clob-go/internal/domain/risk"
 	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
 	bookMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/book/mocks"
+	feeMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/fee/mocks"
 	orderMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/order/mocks"
+	riskMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/risk/mocks"
+	tradeMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade/mocks"
 	"github.com/juninhoitabh/clob-go/internal/shared"
 )
 
@@ -26,6 +36,8 @@ type PlaceOrderUseCaseUnitTestSuite struct {
 	bookRepo    *bookMocks.MockIBookRepository
 	orderRepo   *orderMocks.MockIOrderRepository
 	accountRepo *accountMocks.MockIAccountRepository
+	feeRepo     *feeMocks.MockIScheduleRepository
+	tradeRepo   *tradeMocks.MockITradeRepository
 	ctrl        *gomock.Controller
 	usecase     *orderUsecases.PlaceOrderUseCase
 }
@@ -36,7 +48,18 @@ func (suite *PlaceOrderUseCaseUnitTestSuite) SetupTest() {
 	suite.bookRepo = bookMocks.NewMockIBookRepository(suite.ctrl)
 	suite.orderRepo = orderMocks.NewMockIOrderRepository(suite.ctrl)
 	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
-	suite.usecase = orderUsecases.NewPlaceOrderUseCase(suite.bookRepo, suite.orderRepo, suite.accountRepo)
+	suite.feeRepo = feeMocks.NewMockIScheduleRepository(suite.ctrl)
+	suite.tradeRepo = tradeMocks.NewMockITradeRepository(suite.ctrl)
+	suite.usecase = orderUsecases.NewPlaceOrderUseCase(
+		suite.bookRepo,
+		suite.orderRepo,
+		suite.accountRepo,
+		suite.feeRepo,
+		suite.tradeRepo,
+		domainBook.CircuitBreakerProps{},
+		domainFee.FeeProps{AccountID: "fees"},
+		nil,
+	)
 }
 
 func (suite *PlaceOrderUseCaseUnitTestSuite) TearDownTest() {
@@ -70,6 +93,7 @@ func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_Success() {
 	assert.Equal(suite.T(), input.Instrument, out.Order.Instrument)
 	assert.Equal(suite.T(), input.Price, out.Order.Price)
 	assert.Equal(suite.T(), input.Qty, out.Order.Qty)
+	assert.Equal(suite.T(), strconv.FormatUint(out.Order.Seq, 10), out.Order.GetID())
 }
 
 func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_InvalidParam() {
@@ -100,6 +124,17 @@ func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_AccountNotFound() {
 	assert.Nil(suite.T(), out)
 }
 
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_ForbiddenForOtherAccount() {
+	input := suite.inputFaker
+	input.CallerAccountID = "acc999"
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrForbidden)
+	assert.Nil(suite.T(), out)
+}
+
 func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_SplitInstrumentError() {
 	input := suite.inputFaker
 	input.Instrument = "INVALID"
@@ -124,6 +159,8 @@ func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_ReserveError_Buy() {
 		},
 	}
 	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
 
 	out, err := suite.usecase.Execute(input)
 	assert.Error(suite.T(), err)
@@ -142,6 +179,8 @@ func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_ReserveError_Sell() {
 		},
 	}
 	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
 
 	out, err := suite.usecase.Execute(input)
 	assert.Error(suite.T(), err)
@@ -181,8 +220,6 @@ func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_GetBookError() {
 		},
 	}
 	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
-	suite.accountRepo.EXPECT().Save(account).Return(nil)
-	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil)
 	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(nil, errors.New("get book error"))
 
 	out, err := suite.usecase.Execute(input)
@@ -190,7 +227,7 @@ func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_GetBookError() {
 	assert.Nil(suite.T(), out)
 }
 
-func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_SaveBookError_NewBook() {
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_GetOrCreateBookError() {
 	input := suite.inputFaker
 	input.Side = "buy"
 	input.Price = 100
@@ -202,10 +239,8 @@ func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_SaveBookError_NewBook()
 		},
 	}
 	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
-	suite.accountRepo.EXPECT().Save(account).Return(nil)
-	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil)
 	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(nil, nil)
-	suite.bookRepo.EXPECT().SaveBook(gomock.Any()).Return(errors.New("save book error"))
+	suite.bookRepo.EXPECT().GetOrCreateBook(gomock.Any()).Return(nil, errors.New("save book error"))
 
 	out, err := suite.usecase.Execute(input)
 	assert.Error(suite.T(), err)
@@ -247,6 +282,8 @@ func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_SaveAccountError() {
 		},
 	}
 	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
 	suite.accountRepo.EXPECT().Save(account).Return(errors.New("save account error"))
 
 	out, err := suite.usecase.Execute(input)
@@ -263,6 +300,8 @@ func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_SaveAccountError2() {
 		},
 	}
 	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
 	suite.accountRepo.EXPECT().Save(gomock.Any()).Return(errors.New("save error")).AnyTimes()
 
 	out, err := suite.usecase.Execute(input)
@@ -284,6 +323,8 @@ func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_SaveOrderError() {
 	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
 	suite.accountRepo.EXPECT().Save(account).Return(nil)
 	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(errors.New("save order error"))
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
 
 	out, err := suite.usecase.Execute(input)
 	assert.Error(suite.T(), err)
@@ -303,7 +344,8 @@ func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_SettleTradeError() {
 	}
 	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
 	suite.accountRepo.EXPECT().Save(account).Return(nil)
-	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil).Times(2)
+	suite.orderRepo.EXPECT().GetOrder("").Return(nil, nil)
 
 	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
 	sellerOrder := &domainOrder.Order{
@@ -324,8 +366,649 @@ func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_SettleTradeError() {
 	}
 
 	suite.accountRepo.EXPECT().Get(gomock.Any()).Return(sellerAccount, nil).AnyTimes()
+	suite.feeRepo.EXPECT().GetSchedule(input.Instrument).Return(nil, nil)
 
 	out, err := suite.usecase.Execute(input)
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), out)
 }
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_PriceOutOfBand() {
+	input := suite.inputFaker
+	input.Side = "buy"
+	input.Price = 1000
+	input.Qty = 1
+
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 1000, Reserved: 0},
+		},
+	}
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+
+	book, _ := domainBook.NewBook(domainBook.BookProps{
+		Instrument:     input.Instrument,
+		CircuitBreaker: domainBook.CircuitBreakerProps{ReferencePrice: 100, BandBps: 1000},
+	}, "Uuid")
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, domainBook.ErrPriceOutOfBand)
+	assert.Nil(suite.T(), out)
+	assert.Equal(suite.T(), int64(0), account.Balances["USDT"].Reserved)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_InstrumentHalted() {
+	input := suite.inputFaker
+
+	account := &domainAccount.Account{}
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	book.CircuitBreaker.Halt()
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, domainBook.ErrInstrumentHalted)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_NewBookUsesCircuitBreakerProps() {
+	input := suite.inputFaker
+	input.Side = "buy"
+	input.Price = 100
+	input.Qty = 10
+
+	props := domainBook.CircuitBreakerProps{BandBps: 500, MoveBps: 700}
+	usecase := orderUsecases.NewPlaceOrderUseCase(
+		suite.bookRepo,
+		suite.orderRepo,
+		suite.accountRepo,
+		suite.feeRepo,
+		suite.tradeRepo,
+		props,
+		domainFee.FeeProps{},
+		nil,
+	)
+
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 1000, Reserved: 0},
+		},
+	}
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+	suite.accountRepo.EXPECT().Save(account).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil)
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(nil, nil)
+
+	var saved *domainBook.Book
+
+	suite.bookRepo.EXPECT().GetOrCreateBook(gomock.Any()).DoAndReturn(func(b *domainBook.Book) (*domainBook.Book, error) {
+		saved = b
+
+		return b, nil
+	})
+	suite.bookRepo.EXPECT().SaveBook(gomock.Any()).Return(nil)
+
+	out, err := usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), out)
+	assert.Equal(suite.T(), int64(500), saved.CircuitBreaker.BandBps)
+	assert.Equal(suite.T(), int64(700), saved.CircuitBreaker.MoveBps)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_NewBookCreatedConcurrentlyIsUsed() {
+	input := suite.inputFaker
+	input.Side = "buy"
+	input.Price = 100
+	input.Qty = 10
+
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 1000, Reserved: 0},
+		},
+	}
+	existing, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+	suite.accountRepo.EXPECT().Save(account).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil)
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(nil, nil)
+	suite.bookRepo.EXPECT().GetOrCreateBook(gomock.Any()).Return(existing, nil)
+	suite.bookRepo.EXPECT().SaveBook(existing).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), out)
+	assert.Len(suite.T(), existing.Bids(), 1)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_HaltReleasesRemaining() {
+	input := suite.inputFaker
+	input.Side = "buy"
+	input.Price = 200
+	input.Qty = 2
+
+	buyer := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 400, Reserved: 0},
+		},
+	}
+	seller := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"BTC": {Available: 0, Reserved: 2},
+		},
+	}
+
+	book, _ := domainBook.NewBook(domainBook.BookProps{
+		Instrument:     input.Instrument,
+		CircuitBreaker: domainBook.CircuitBreakerProps{MoveBps: 1000, Window: time.Minute},
+	}, "Uuid")
+	book.AddOrder(&domainOrder.Order{AccountID: "seller", Side: domainOrder.Sell, Price: 100, Qty: 1, Remaining: 1})
+	book.AddOrder(&domainOrder.Order{AccountID: "seller", Side: domainOrder.Sell, Price: 200, Qty: 1, Remaining: 1})
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(buyer, nil).AnyTimes()
+	suite.accountRepo.EXPECT().Get("seller").Return(seller, nil).AnyTimes()
+	suite.accountRepo.EXPECT().Get("fees").Return(nil, shared.ErrNotFound).AnyTimes()
+	suite.accountRepo.EXPECT().Save(gomock.Any()).Return(nil).AnyTimes()
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil).AnyTimes()
+	suite.orderRepo.EXPECT().GetOrder("").Return(nil, nil)
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
+	suite.bookRepo.EXPECT().SaveBook(book).Return(nil)
+	suite.feeRepo.EXPECT().GetSchedule(input.Instrument).Return(nil, nil)
+	suite.tradeRepo.EXPECT().SaveTrade(gomock.Any()).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), out.TradeReport.Halted)
+	assert.True(suite.T(), out.Halted)
+	assert.Equal(suite.T(), int64(1), out.CanceledQty)
+	assert.Len(suite.T(), out.TradeReport.Trades, 1)
+	assert.Equal(suite.T(), int64(0), out.Order.Remaining)
+	assert.True(suite.T(), out.Order.Canceled())
+	assert.Equal(suite.T(), int64(300), buyer.Balances["USDT"].Available)
+	assert.Equal(suite.T(), int64(0), buyer.Balances["USDT"].Reserved)
+	assert.Equal(suite.T(), int64(1), buyer.Balances["BTC"].Available)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_ReleasesBuyPriceImprovement() {
+	input := suite.inputFaker
+	input.Side = "buy"
+	input.Price = 120
+	input.Qty = 3
+
+	buyer := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 1000, Reserved: 0},
+		},
+	}
+	seller := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"BTC": {Available: 0, Reserved: 2},
+		},
+	}
+
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	book.AddOrder(&domainOrder.Order{AccountID: "seller", Side: domainOrder.Sell, Price: 100, Qty: 2, Remaining: 2})
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(buyer, nil).AnyTimes()
+	suite.accountRepo.EXPECT().Get("seller").Return(seller, nil).AnyTimes()
+	suite.accountRepo.EXPECT().Get("fees").Return(nil, shared.ErrNotFound).AnyTimes()
+	suite.accountRepo.EXPECT().Save(gomock.Any()).Return(nil).AnyTimes()
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil).Times(2)
+	suite.orderRepo.EXPECT().GetOrder("").Return(nil, nil)
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
+	suite.bookRepo.EXPECT().SaveBook(book).Return(nil)
+	suite.feeRepo.EXPECT().GetSchedule(input.Instrument).Return(nil, nil)
+	suite.tradeRepo.EXPECT().SaveTrade(gomock.Any()).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), out.Order.Remaining)
+	assert.Equal(suite.T(), int64(120), buyer.Balances["USDT"].Reserved)
+	assert.Equal(suite.T(), int64(680), buyer.Balances["USDT"].Available)
+	assert.Equal(suite.T(), int64(2), buyer.Balances["BTC"].Available)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_RiskRejectedBeforeReserve() {
+	input := suite.inputFaker
+	input.Side = "buy"
+	input.Price = 100
+	input.Qty = 10
+
+	limitsRepo := riskMocks.NewMockILimitsRepository(suite.ctrl)
+	usecase := orderUsecases.NewPlaceOrderUseCase(
+		suite.bookRepo,
+		suite.orderRepo,
+		suite.accountRepo,
+		suite.feeRepo,
+		suite.tradeRepo,
+		domainBook.CircuitBreakerProps{},
+		domainFee.FeeProps{},
+		domainRisk.NewDefaultChain(limitsRepo),
+	)
+
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 1000, Reserved: 0},
+		},
+	}
+	limits, _ := domainRisk.NewLimits(domainRisk.LimitsProps{AccountID: input.AccountID, MaxOrderNotional: 500})
+
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
+	limitsRepo.EXPECT().GetLimits(input.AccountID).Return(limits, nil)
+
+	out, err := usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, domainRisk.ErrMaxOrderNotional)
+	assert.Nil(suite.T(), out)
+	assert.Equal(suite.T(), int64(1000), account.Balances["USDT"].Available)
+	assert.Equal(suite.T(), int64(0), account.Balances["USDT"].Reserved)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_RiskListOpenOrdersError() {
+	input := suite.inputFaker
+	input.Side = "buy"
+	input.Price = 100
+	input.Qty = 10
+
+	limitsRepo := riskMocks.NewMockILimitsRepository(suite.ctrl)
+	usecase := orderUsecases.NewPlaceOrderUseCase(
+		suite.bookRepo,
+		suite.orderRepo,
+		suite.accountRepo,
+		suite.feeRepo,
+		suite.tradeRepo,
+		domainBook.CircuitBreakerProps{},
+		domainFee.FeeProps{},
+		domainRisk.NewDefaultChain(limitsRepo),
+	)
+
+	account := &domainAccount.Account{Balances: map[string]*domainAccount.Balance{}}
+	limits, _ := domainRisk.NewLimits(domainRisk.LimitsProps{AccountID: input.AccountID, MaxOpenOrders: 5})
+
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
+	limitsRepo.EXPECT().GetLimits(input.AccountID).Return(limits, nil)
+	suite.orderRepo.EXPECT().ListOpenOrdersByAccount(input.AccountID).Return(nil, errors.New("list error"))
+
+	out, err := usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "list error")
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_RiskPassesWithoutLimits() {
+	input := suite.inputFaker
+	input.Side = "buy"
+	input.Price = 100
+	input.Qty = 10
+
+	limitsRepo := riskMocks.NewMockILimitsRepository(suite.ctrl)
+	usecase := orderUsecases.NewPlaceOrderUseCase(
+		suite.bookRepo,
+		suite.orderRepo,
+		suite.accountRepo,
+		suite.feeRepo,
+		suite.tradeRepo,
+		domainBook.CircuitBreakerProps{},
+		domainFee.FeeProps{},
+		domainRisk.NewDefaultChain(limitsRepo),
+	)
+
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 1000, Reserved: 0},
+		},
+	}
+
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+	suite.accountRepo.EXPECT().Save(account).Return(nil)
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
+	suite.bookRepo.EXPECT().SaveBook(book).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil)
+	limitsRepo.EXPECT().GetLimits(input.AccountID).Return(nil, nil)
+
+	out, err := usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), out)
+	assert.Equal(suite.T(), int64(1000), account.Balances["USDT"].Reserved)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_AppliesFeeSchedule() {
+	input := suite.inputFaker
+	input.Side = "buy"
+	input.Price = 10
+	input.Qty = 1000
+
+	buyer := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 10000, Reserved: 0},
+		},
+	}
+	seller := &domainAccount.Account{
+		FeeTier: "vip",
+		Balances: map[string]*domainAccount.Balance{
+			"BTC": {Available: 0, Reserved: 1000},
+		},
+	}
+	feeAccount := &domainAccount.Account{Balances: map[string]*domainAccount.Balance{}, System: true}
+
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	maker := &domainOrder.Order{AccountID: "seller", Side: domainOrder.Sell, Price: 10, Qty: 1000, Remaining: 1000}
+	maker.ID.ID = "maker-1"
+	book.AddOrder(maker)
+
+	schedule, _ := domainFee.NewSchedule(domainFee.ScheduleProps{
+		Instrument: input.Instrument,
+		Rates:      domainFee.Rates{MakerBps: 10, TakerBps: 20},
+		Tiers:      map[string]domainFee.Rates{"vip": {MakerBps: -10, TakerBps: 10}},
+	})
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(buyer, nil).AnyTimes()
+	suite.accountRepo.EXPECT().Get("seller").Return(seller, nil).Times(2)
+	suite.accountRepo.EXPECT().Get("fees").Return(feeAccount, nil).Times(2)
+	suite.accountRepo.EXPECT().Save(gomock.Any()).Return(nil).AnyTimes()
+	suite.orderRepo.EXPECT().GetOrder("maker-1").Return(maker, nil)
+	suite.orderRepo.EXPECT().SaveOrder(maker).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil).Times(2)
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
+	suite.bookRepo.EXPECT().SaveBook(book).Return(nil)
+	suite.feeRepo.EXPECT().GetSchedule(input.Instrument).Return(schedule, nil)
+
+	var saved *services.Trade
+	suite.tradeRepo.EXPECT().SaveTrade(gomock.Any()).DoAndReturn(func(t *services.Trade) error {
+		saved = t
+		return nil
+	})
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), out.TradeReport.Trades, 1)
+
+	trade := out.TradeReport.Trades[0]
+	assert.Equal(suite.T(), trade, *saved)
+	assert.NotSame(suite.T(), &out.TradeReport.Trades[0], saved)
+	assert.Equal(suite.T(), input.Instrument+"-1", saved.ID())
+	assert.Equal(suite.T(), int64(-1), trade.MakerFee)
+	assert.Equal(suite.T(), "BTC", trade.MakerFeeAsset)
+	assert.Equal(suite.T(), int64(2), trade.TakerFee)
+	assert.Equal(suite.T(), "BTC", trade.TakerFeeAsset)
+	assert.Equal(suite.T(), int64(998), buyer.Balances["BTC"].Available)
+	assert.Equal(suite.T(), int64(10000), seller.Balances["USDT"].Available)
+	assert.Equal(suite.T(), int64(1), seller.Balances["BTC"].Available)
+	assert.Equal(suite.T(), int64(1), feeAccount.Balances["BTC"].Available)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_GetScheduleError() {
+	input := suite.inputFaker
+	input.Side = "buy"
+	input.Price = 100
+	input.Qty = 1
+
+	buyer := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 100, Reserved: 0},
+		},
+	}
+
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	book.AddOrder(&domainOrder.Order{AccountID: "seller", Side: domainOrder.Sell, Price: 100, Qty: 1, Remaining: 1})
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(buyer, nil).Times(2)
+	suite.accountRepo.EXPECT().Get("seller").Return(&domainAccount.Account{}, nil)
+	suite.accountRepo.EXPECT().Get("fees").Return(nil, shared.ErrNotFound)
+	suite.accountRepo.EXPECT().Save(buyer).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil).Times(2)
+	suite.orderRepo.EXPECT().GetOrder("").Return(nil, nil)
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
+	suite.bookRepo.EXPECT().SaveBook(book).Return(nil)
+	suite.feeRepo.EXPECT().GetSchedule(input.Instrument).Return(nil, errors.New("schedule error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "schedule error")
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_SaveTradeError() {
+	input := suite.inputFaker
+	input.Side = "buy"
+	input.Price = 100
+	input.Qty = 1
+
+	buyer := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 100, Reserved: 0},
+		},
+	}
+	seller := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"BTC": {Available: 0, Reserved: 1},
+		},
+	}
+
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	book.AddOrder(&domainOrder.Order{AccountID: "seller", Side: domainOrder.Sell, Price: 100, Qty: 1, Remaining: 1})
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(buyer, nil).AnyTimes()
+	suite.accountRepo.EXPECT().Get("seller").Return(seller, nil).Times(2)
+	suite.accountRepo.EXPECT().Get("fees").Return(nil, shared.ErrNotFound)
+	suite.accountRepo.EXPECT().Save(gomock.Any()).Return(nil).AnyTimes()
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil).Times(2)
+	suite.orderRepo.EXPECT().GetOrder("").Return(nil, nil)
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
+	suite.bookRepo.EXPECT().SaveBook(book).Return(nil)
+	suite.feeRepo.EXPECT().GetSchedule(input.Instrument).Return(nil, nil)
+	suite.tradeRepo.EXPECT().SaveTrade(gomock.Any()).Return(errors.New("save trade error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "save trade error")
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_RefreshesVolumeFeeTiers() {
+	input := suite.inputFaker
+	input.Side = "buy"
+	input.Price = 10
+	input.Qty = 1000
+
+	tiers, _ := domainFee.NewVolumeTiers(map[string]int64{"silver": 5000})
+	usecase := orderUsecases.NewPlaceOrderUseCase(
+		suite.bookRepo,
+		suite.orderRepo,
+		suite.accountRepo,
+		suite.feeRepo,
+		suite.tradeRepo,
+		domainBook.CircuitBreakerProps{},
+		domainFee.FeeProps{AccountID: "fees", VolumeTiers: tiers},
+		nil,
+	)
+
+	buyer := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 10000, Reserved: 0},
+		},
+	}
+	seller := &domainAccount.Account{
+		FeeTier:       "vip",
+		FeeTierPinned: true,
+		Balances: map[string]*domainAccount.Balance{
+			"BTC": {Available: 0, Reserved: 1000},
+		},
+	}
+	feeAccount := &domainAccount.Account{Balances: map[string]*domainAccount.Balance{}, System: true}
+
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	book.AddOrder(&domainOrder.Order{AccountID: "seller", Side: domainOrder.Sell, Price: 10, Qty: 1000, Remaining: 1000})
+
+	schedule, _ := domainFee.NewSchedule(domainFee.ScheduleProps{
+		Instrument: input.Instrument,
+		Rates:      domainFee.Rates{MakerBps: 10, TakerBps: 20},
+		Tiers:      map[string]domainFee.Rates{"silver": {MakerBps: 0, TakerBps: 10}},
+	})
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(buyer, nil).AnyTimes()
+	suite.accountRepo.EXPECT().Get("seller").Return(seller, nil).AnyTimes()
+	suite.accountRepo.EXPECT().Get("fees").Return(feeAccount, nil).Times(2)
+	suite.accountRepo.EXPECT().Save(gomock.Any()).Return(nil).AnyTimes()
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil).Times(2)
+	suite.orderRepo.EXPECT().GetOrder("").Return(nil, nil)
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
+	suite.bookRepo.EXPECT().SaveBook(book).Return(nil)
+	suite.feeRepo.EXPECT().GetSchedule(input.Instrument).Return(schedule, nil)
+	suite.tradeRepo.EXPECT().RollingVolume(input.AccountID, gomock.Any()).Return(int64(5000), nil)
+	suite.tradeRepo.EXPECT().SaveTrade(gomock.Any()).Return(nil)
+
+	out, err := usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), out.TradeReport.Trades, 1)
+	assert.Equal(suite.T(), "silver", buyer.FeeTier)
+	assert.Equal(suite.T(), "vip", seller.FeeTier)
+	assert.Equal(suite.T(), int64(1), out.TradeReport.Trades[0].TakerFee)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_RefreshFeeTiersRollingVolumeError() {
+	input := suite.inputFaker
+	input.Side = "buy"
+	input.Price = 100
+	input.Qty = 1
+
+	tiers, _ := domainFee.NewVolumeTiers(map[string]int64{"silver": 5000})
+	usecase := orderUsecases.NewPlaceOrderUseCase(
+		suite.bookRepo,
+		suite.orderRepo,
+		suite.accountRepo,
+		suite.feeRepo,
+		suite.tradeRepo,
+		domainBook.CircuitBreakerProps{},
+		domainFee.FeeProps{AccountID: "fees", VolumeTiers: tiers},
+		nil,
+	)
+
+	buyer := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 100, Reserved: 0},
+		},
+	}
+
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+	book.AddOrder(&domainOrder.Order{AccountID: "seller", Side: domainOrder.Sell, Price: 100, Qty: 1, Remaining: 1})
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(buyer, nil).AnyTimes()
+	suite.accountRepo.EXPECT().Get("seller").Return(&domainAccount.Account{}, nil)
+	suite.accountRepo.EXPECT().Get("fees").Return(nil, shared.ErrNotFound)
+	suite.accountRepo.EXPECT().Save(buyer).Return(nil)
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(nil).Times(2)
+	suite.orderRepo.EXPECT().GetOrder("").Return(nil, nil)
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
+	suite.bookRepo.EXPECT().SaveBook(book).Return(nil)
+	suite.feeRepo.EXPECT().GetSchedule(input.Instrument).Return(nil, nil)
+	suite.tradeRepo.EXPECT().RollingVolume(input.AccountID, gomock.Any()).Return(int64(0), errors.New("rolling volume error"))
+
+	out, err := usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "rolling volume error")
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_ClientOrderIDTooLong() {
+	input := suite.inputFaker
+	input.ClientOrderID = strings.Repeat("x", domainOrder.MaxClientOrderIDLength+1)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_ClientOrderIDReplaysOriginal() {
+	input := suite.inputFaker
+	input.ClientOrderID = "client-1"
+	input.Side = "buy"
+
+	existing := &domainOrder.Order{
+		AccountID:     input.AccountID,
+		ClientOrderID: input.ClientOrderID,
+		Instrument:    input.Instrument,
+		Side:          domainOrder.Buy,
+		Price:         input.Price,
+		Qty:           input.Qty,
+		CreatedAt:     time.Now().Add(-time.Minute),
+	}
+	existing.ID.ID = "order-1"
+
+	first := &services.Trade{Seq: 1, TakerOrderID: "order-1"}
+	asMaker := &services.Trade{Seq: 2, MakerOrderID: "order-1"}
+	second := &services.Trade{Seq: 3, TakerOrderID: "order-1"}
+
+	suite.orderRepo.EXPECT().GetOrderByClientOrderID(input.AccountID, "client-1").Return(existing, nil)
+	suite.tradeRepo.EXPECT().ListTrades(gomock.Any()).Return([]*services.Trade{second, asMaker, first}, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), out.Replayed)
+	assert.Same(suite.T(), existing, out.Order)
+	assert.Equal(suite.T(), []services.Trade{*first, *second}, out.TradeReport.Trades)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_ClientOrderIDMismatch() {
+	input := suite.inputFaker
+	input.ClientOrderID = "client-1"
+	input.Side = "buy"
+
+	existing := &domainOrder.Order{
+		AccountID:     input.AccountID,
+		ClientOrderID: input.ClientOrderID,
+		Instrument:    input.Instrument,
+		Side:          domainOrder.Buy,
+		Price:         input.Price + 1,
+		Qty:           input.Qty,
+	}
+
+	suite.orderRepo.EXPECT().GetOrderByClientOrderID(input.AccountID, "client-1").Return(existing, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrAlreadyExists)
+	assert.ErrorIs(suite.T(), err, domainOrder.ErrClientOrderIDMismatch)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *PlaceOrderUseCaseUnitTestSuite) TestExecute_ClientOrderIDRaceReleasesReserve() {
+	input := suite.inputFaker
+	input.ClientOrderID = "client-1"
+	input.Side = "buy"
+	input.Price = 100
+	input.Qty = 10
+
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 1000, Reserved: 0},
+		},
+	}
+	winner := &domainOrder.Order{
+		AccountID:     input.AccountID,
+		ClientOrderID: input.ClientOrderID,
+		Instrument:    input.Instrument,
+		Side:          domainOrder.Buy,
+		Price:         input.Price,
+		Qty:           input.Qty,
+	}
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: input.Instrument}, "Uuid")
+
+	gomock.InOrder(
+		suite.orderRepo.EXPECT().GetOrderByClientOrderID(input.AccountID, "client-1").Return(nil, nil),
+		suite.orderRepo.EXPECT().GetOrderByClientOrderID(input.AccountID, "client-1").Return(winner, nil),
+	)
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+	suite.accountRepo.EXPECT().Save(account).Return(nil).Times(2)
+	suite.bookRepo.EXPECT().GetBook(input.Instrument).Return(book, nil)
+	suite.orderRepo.EXPECT().SaveOrder(gomock.Any()).Return(fmt.Errorf("%w: %w", shared.ErrAlreadyExists, domainOrder.ErrDuplicateClientOrderID))
+	suite.tradeRepo.EXPECT().ListTrades(gomock.Any()).Return(nil, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), out.Replayed)
+	assert.Same(suite.T(), winner, out.Order)
+	assert.Equal(suite.T(), int64(1000), account.Balances["USDT"].Available)
+	assert.Equal(suite.T(), int64(0), account.Balances["USDT"].Reserved)
+}
@@ -0,0 +1,19 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/reconciliation"
+)
+
+type (
+	ReconcileOutput struct {
+		CheckedAt     time.Time
+		Assets        []reconciliation.AssetSummary
+		Discrepancies []reconciliation.Discrepancy
+		Balanced      bool
+	}
+	IReconcileUseCase interface {
+		Execute() (*ReconcileOutput, error)
+	}
+)
@@ -0,0 +1,119 @@
+package usecases
+
+import (
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	bookServices "github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	domainLedger "github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	domainReconciliation "github.com/juninhoitabh/clob-go/internal/domain/reconciliation"
+)
+
+type (
+	ReconcileUseCase struct {
+		AccountRepo  domainAccount.IAccountRepository
+		BookRepo     domainBook.IBookRepository
+		LedgerRepo   domainLedger.ILedgerRepository
+		FeeAccountID string
+	}
+)
+
+// Execute checks every account against the books and the ledger. The
+// snapshot is taken holding every book's lock and then every account's, the
+// order placing an order takes them in, so no order or settlement is half
+// applied while it is read.
+func (r *ReconcileUseCase) Execute() (*ReconcileOutput, error) {
+	books, accounts, unlock, err := r.lockAll()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	postings, _, err := r.LedgerRepo.ListPostings(domainLedger.Filter{})
+	if err != nil {
+		return nil, err
+	}
+
+	report := domainReconciliation.Reconcile(domainReconciliation.Snapshot{
+		Accounts:     accounts,
+		Books:        books,
+		Postings:     postings,
+		FeeAccountID: r.FeeAccountID,
+	})
+
+	return &ReconcileOutput{
+		CheckedAt:     report.CheckedAt,
+		Assets:        report.Assets,
+		Discrepancies: report.Discrepancies,
+		Balanced:      report.Balanced(),
+	}, nil
+}
+
+// lockAll locks every book and then every account. A book or account
+// created between listing and locking would be missing from the snapshot, so
+// the lists are taken again under the locks and the whole thing retried
+// until they agree.
+func (r *ReconcileUseCase) lockAll() ([]*domainBook.Book, []*domainAccount.Account, func(), error) {
+	for {
+		books, err := r.BookRepo.ListBooks()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		unlockBooks := bookServices.LockBooks(books...)
+
+		accounts, err := r.AccountRepo.List()
+		if err != nil {
+			unlockBooks()
+
+			return nil, nil, nil, err
+		}
+
+		unlockAccounts := accountServices.LockAccounts(accounts...)
+		unlock := func() {
+			unlockAccounts()
+			unlockBooks()
+		}
+
+		stable, err := r.unchanged(books, accounts)
+		if err != nil {
+			unlock()
+
+			return nil, nil, nil, err
+		}
+
+		if stable {
+			return books, accounts, unlock, nil
+		}
+
+		unlock()
+	}
+}
+
+func (r *ReconcileUseCase) unchanged(books []*domainBook.Book, accounts []*domainAccount.Account) (bool, error) {
+	currentBooks, err := r.BookRepo.ListBooks()
+	if err != nil {
+		return false, err
+	}
+
+	currentAccounts, err := r.AccountRepo.List()
+	if err != nil {
+		return false, err
+	}
+
+	return len(currentBooks) == len(books) && len(currentAccounts) == len(accounts), nil
+}
+
+func NewReconcileUseCase(
+	accountRepo domainAccount.IAccountRepository,
+	bookRepo domainBook.IBookRepository,
+	ledgerRepo domainLedger.ILedgerRepository,
+	feeAccountID string,
+) *ReconcileUseCase {
+	return &ReconcileUseCase{
+		AccountRepo:  accountRepo,
+		BookRepo:     bookRepo,
+		LedgerRepo:   ledgerRepo,
+		FeeAccountID: feeAccountID,
+	}
+}
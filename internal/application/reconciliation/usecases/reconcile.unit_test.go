@@ -0,0 +1,116 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	reconciliationUsecases "github.com/juninhoitabh/clob-go/internal/application/reconciliation/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	domainLedger "github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	domainReconciliation "github.com/juninhoitabh/clob-go/internal/domain/reconciliation"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	bookMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/book/mocks"
+	ledgerMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/ledger/mocks"
+)
+
+type ReconcileUseCaseUnitTestSuite struct {
+	suite.Suite
+	accountRepo *accountMocks.MockIAccountRepository
+	bookRepo    *bookMocks.MockIBookRepository
+	ledgerRepo  *ledgerMocks.MockILedgerRepository
+	ctrl        *gomock.Controller
+	usecase     *reconciliationUsecases.ReconcileUseCase
+	account     *domainAccount.Account
+}
+
+func (suite *ReconcileUseCaseUnitTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.bookRepo = bookMocks.NewMockIBookRepository(suite.ctrl)
+	suite.ledgerRepo = ledgerMocks.NewMockILedgerRepository(suite.ctrl)
+	suite.usecase = reconciliationUsecases.NewReconcileUseCase(suite.accountRepo, suite.bookRepo, suite.ledgerRepo, "fees")
+
+	suite.account, _ = domainAccount.NewAccount(domainAccount.AccountProps{Name: "alice"}, "Uuid")
+	_ = suite.account.Credit("USDT", 100)
+}
+
+func (suite *ReconcileUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *ReconcileUseCaseUnitTestSuite) TestExecute_Balanced() {
+	suite.accountRepo.EXPECT().List().Return([]*domainAccount.Account{suite.account}, nil).Times(2)
+	suite.bookRepo.EXPECT().ListBooks().Return([]*domainBook.Book{}, nil).Times(2)
+	suite.ledgerRepo.EXPECT().ListPostings(domainLedger.Filter{}).Return(suite.account.TakePostings(), 1, nil)
+
+	out, err := suite.usecase.Execute()
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), out.Balanced)
+	assert.Empty(suite.T(), out.Discrepancies)
+	assert.Equal(suite.T(), []domainReconciliation.AssetSummary{{Asset: "USDT", Holdings: 100, Deposits: 100}}, out.Assets)
+	assert.NotZero(suite.T(), out.CheckedAt)
+}
+
+func (suite *ReconcileUseCaseUnitTestSuite) TestExecute_ReportsDiscrepancies() {
+	suite.accountRepo.EXPECT().List().Return([]*domainAccount.Account{suite.account}, nil).Times(2)
+	suite.bookRepo.EXPECT().ListBooks().Return([]*domainBook.Book{}, nil).Times(2)
+	suite.ledgerRepo.EXPECT().ListPostings(domainLedger.Filter{}).Return(nil, 0, nil)
+
+	out, err := suite.usecase.Execute()
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), out.Balanced)
+	assert.Len(suite.T(), out.Discrepancies, 2)
+}
+
+func (suite *ReconcileUseCaseUnitTestSuite) TestExecute_ListAccountsError() {
+	suite.bookRepo.EXPECT().ListBooks().Return([]*domainBook.Book{}, nil)
+	suite.accountRepo.EXPECT().List().Return(nil, errors.New("list error"))
+
+	out, err := suite.usecase.Execute()
+	assert.EqualError(suite.T(), err, "list error")
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *ReconcileUseCaseUnitTestSuite) TestExecute_ListBooksError() {
+	suite.bookRepo.EXPECT().ListBooks().Return(nil, errors.New("books error"))
+
+	out, err := suite.usecase.Execute()
+	assert.EqualError(suite.T(), err, "books error")
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *ReconcileUseCaseUnitTestSuite) TestExecute_RetriesWhenBookCreatedWhileLocking() {
+	book, _ := domainBook.NewBook(domainBook.BookProps{Instrument: "BTC/USDT"}, "Uuid")
+
+	suite.accountRepo.EXPECT().List().Return([]*domainAccount.Account{suite.account}, nil).Times(4)
+	gomock.InOrder(
+		suite.bookRepo.EXPECT().ListBooks().Return([]*domainBook.Book{}, nil),
+		suite.bookRepo.EXPECT().ListBooks().Return([]*domainBook.Book{book}, nil).Times(3),
+	)
+	suite.ledgerRepo.EXPECT().ListPostings(domainLedger.Filter{}).Return(suite.account.TakePostings(), 1, nil)
+
+	out, err := suite.usecase.Execute()
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), out.Balanced)
+}
+
+func (suite *ReconcileUseCaseUnitTestSuite) TestExecute_ListPostingsError() {
+	suite.accountRepo.EXPECT().List().Return([]*domainAccount.Account{suite.account}, nil).Times(2)
+	suite.bookRepo.EXPECT().ListBooks().Return([]*domainBook.Book{}, nil).Times(2)
+	suite.ledgerRepo.EXPECT().ListPostings(domainLedger.Filter{}).Return(nil, 0, errors.New("ledger error"))
+
+	out, err := suite.usecase.Execute()
+	assert.EqualError(suite.T(), err, "ledger error")
+	assert.Nil(suite.T(), out)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(ReconcileUseCaseUnitTestSuite))
+}
@@ -0,0 +1,15 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	riskUsecases "github.com/juninhoitabh/clob-go/internal/application/risk/usecases"
+)
+
+func GetRiskLimitsInputFaker() riskUsecases.GetRiskLimitsInput {
+	faker := faker.New(0)
+
+	return riskUsecases.GetRiskLimitsInput{
+		AccountID: faker.UUID(),
+	}
+}
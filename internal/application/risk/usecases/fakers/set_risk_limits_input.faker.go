@@ -0,0 +1,22 @@
+package fakers
+
+import (
+	"time"
+
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	riskUsecases "github.com/juninhoitabh/clob-go/internal/application/risk/usecases"
+)
+
+func SetRiskLimitsInputFaker() riskUsecases.SetRiskLimitsInput {
+	faker := faker.New(0)
+
+	return riskUsecases.SetRiskLimitsInput{
+		AccountID:          faker.UUID(),
+		MaxOrderNotional:   int64(faker.Number(1000, 100000)),
+		MaxOpenOrders:      int64(faker.Number(1, 50)),
+		MaxPositions:       map[string]int64{"BTC": int64(faker.Number(1, 100))},
+		MaxOrdersPerWindow: int64(faker.Number(1, 100)),
+		RateWindow:         time.Second,
+	}
+}
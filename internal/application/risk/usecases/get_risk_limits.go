@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainRisk "github.com/juninhoitabh/clob-go/internal/domain/risk"
+)
+
+type (
+	GetRiskLimitsUseCase struct {
+		AccountRepo domainAccount.IAccountRepository
+		LimitsRepo  domainRisk.ILimitsRepository
+	}
+)
+
+func (g *GetRiskLimitsUseCase) Execute(input GetRiskLimitsInput) (*RiskLimitsOutput, error) {
+	_, err := g.AccountRepo.Get(input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := g.LimitsRepo.GetLimits(input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if limits == nil {
+		return &RiskLimitsOutput{
+			AccountID:    input.AccountID,
+			MaxPositions: map[string]int64{},
+		}, nil
+	}
+
+	return newRiskLimitsOutput(limits), nil
+}
+
+func NewGetRiskLimitsUseCase(
+	accountRepo domainAccount.IAccountRepository,
+	limitsRepo domainRisk.ILimitsRepository,
+) *GetRiskLimitsUseCase {
+	return &GetRiskLimitsUseCase{
+		AccountRepo: accountRepo,
+		LimitsRepo:  limitsRepo,
+	}
+}
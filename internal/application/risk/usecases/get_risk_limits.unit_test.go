@@ -0,0 +1,88 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	riskUsecases "github.com/juninhoitabh/clob-go/internal/application/risk/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/risk/usecases/fakers"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainRisk "github.com/juninhoitabh/clob-go/internal/domain/risk"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	riskMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/risk/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type GetRiskLimitsUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker  riskUsecases.GetRiskLimitsInput
+	accountRepo *accountMocks.MockIAccountRepository
+	limitsRepo  *riskMocks.MockILimitsRepository
+	ctrl        *gomock.Controller
+	usecase     *riskUsecases.GetRiskLimitsUseCase
+}
+
+func (suite *GetRiskLimitsUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.GetRiskLimitsInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.limitsRepo = riskMocks.NewMockILimitsRepository(suite.ctrl)
+	suite.usecase = riskUsecases.NewGetRiskLimitsUseCase(suite.accountRepo, suite.limitsRepo)
+}
+
+func (suite *GetRiskLimitsUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *GetRiskLimitsUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+
+	limits, _ := domainRisk.NewLimits(domainRisk.LimitsProps{AccountID: input.AccountID, MaxOpenOrders: 5})
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.limitsRepo.EXPECT().GetLimits(input.AccountID).Return(limits, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), input.AccountID, out.AccountID)
+	assert.Equal(suite.T(), int64(5), out.MaxOpenOrders)
+}
+
+func (suite *GetRiskLimitsUseCaseUnitTestSuite) TestExecute_NoLimitsConfigured() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.limitsRepo.EXPECT().GetLimits(input.AccountID).Return(nil, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), input.AccountID, out.AccountID)
+	assert.Equal(suite.T(), int64(0), out.MaxOrderNotional)
+	assert.Empty(suite.T(), out.MaxPositions)
+}
+
+func (suite *GetRiskLimitsUseCaseUnitTestSuite) TestExecute_AccountNotFound() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(nil, shared.ErrNotFound)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *GetRiskLimitsUseCaseUnitTestSuite) TestExecute_GetLimitsError() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.limitsRepo.EXPECT().GetLimits(input.AccountID).Return(nil, errors.New("repo error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "repo error")
+	assert.Nil(suite.T(), out)
+}
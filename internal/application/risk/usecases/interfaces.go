@@ -0,0 +1,32 @@
+package usecases
+
+import "time"
+
+type (
+	SetRiskLimitsInput struct {
+		MaxPositions       map[string]int64
+		AccountID          string
+		MaxOrderNotional   int64
+		MaxOpenOrders      int64
+		MaxOrdersPerWindow int64
+		RateWindow         time.Duration
+	}
+	GetRiskLimitsInput struct {
+		AccountID string
+	}
+	RiskLimitsOutput struct {
+		UpdatedAt          time.Time
+		MaxPositions       map[string]int64
+		AccountID          string
+		MaxOrderNotional   int64
+		MaxOpenOrders      int64
+		MaxOrdersPerWindow int64
+		RateWindow         time.Duration
+	}
+	ISetRiskLimitsUseCase interface {
+		Execute(input SetRiskLimitsInput) (*RiskLimitsOutput, error)
+	}
+	IGetRiskLimitsUseCase interface {
+		Execute(input GetRiskLimitsInput) (*RiskLimitsOutput, error)
+	}
+)
@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainRisk "github.com/juninhoitabh/clob-go/internal/domain/risk"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	SetRiskLimitsUseCase struct {
+		AccountRepo domainAccount.IAccountRepository
+		LimitsRepo  domainRisk.ILimitsRepository
+	}
+)
+
+func (s *SetRiskLimitsUseCase) Execute(input SetRiskLimitsInput) (*RiskLimitsOutput, error) {
+	_, err := s.AccountRepo.Get(input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := domainRisk.NewLimits(domainRisk.LimitsProps{
+		AccountID:          input.AccountID,
+		MaxOrderNotional:   input.MaxOrderNotional,
+		MaxOpenOrders:      input.MaxOpenOrders,
+		MaxPositions:       input.MaxPositions,
+		MaxOrdersPerWindow: input.MaxOrdersPerWindow,
+		RateWindow:         input.RateWindow,
+	})
+	if err != nil {
+		return nil, shared.ErrInvalidParam
+	}
+
+	err = s.LimitsRepo.SaveLimits(limits)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRiskLimitsOutput(limits), nil
+}
+
+func newRiskLimitsOutput(limits *domainRisk.Limits) *RiskLimitsOutput {
+	return &RiskLimitsOutput{
+		UpdatedAt:          limits.UpdatedAt,
+		MaxPositions:       limits.MaxPositions,
+		AccountID:          limits.AccountID,
+		MaxOrderNotional:   limits.MaxOrderNotional,
+		MaxOpenOrders:      limits.MaxOpenOrders,
+		MaxOrdersPerWindow: limits.MaxOrdersPerWindow,
+		RateWindow:         limits.RateWindow,
+	}
+}
+
+func NewSetRiskLimitsUseCase(
+	accountRepo domainAccount.IAccountRepository,
+	limitsRepo domainRisk.ILimitsRepository,
+) *SetRiskLimitsUseCase {
+	return &SetRiskLimitsUseCase{
+		AccountRepo: accountRepo,
+		LimitsRepo:  limitsRepo,
+	}
+}
@@ -0,0 +1,93 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	riskUsecases "github.com/juninhoitabh/clob-go/internal/application/risk/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/risk/usecases/fakers"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	riskMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/risk/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type SetRiskLimitsUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker  riskUsecases.SetRiskLimitsInput
+	accountRepo *accountMocks.MockIAccountRepository
+	limitsRepo  *riskMocks.MockILimitsRepository
+	ctrl        *gomock.Controller
+	usecase     *riskUsecases.SetRiskLimitsUseCase
+}
+
+func (suite *SetRiskLimitsUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.SetRiskLimitsInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.limitsRepo = riskMocks.NewMockILimitsRepository(suite.ctrl)
+	suite.usecase = riskUsecases.NewSetRiskLimitsUseCase(suite.accountRepo, suite.limitsRepo)
+}
+
+func (suite *SetRiskLimitsUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *SetRiskLimitsUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.limitsRepo.EXPECT().SaveLimits(gomock.Any()).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), input.AccountID, out.AccountID)
+	assert.Equal(suite.T(), input.MaxOrderNotional, out.MaxOrderNotional)
+	assert.Equal(suite.T(), input.MaxOpenOrders, out.MaxOpenOrders)
+	assert.Equal(suite.T(), input.MaxPositions, out.MaxPositions)
+	assert.Equal(suite.T(), input.RateWindow, out.RateWindow)
+	assert.NotEmpty(suite.T(), out.UpdatedAt)
+}
+
+func (suite *SetRiskLimitsUseCaseUnitTestSuite) TestExecute_AccountNotFound() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(nil, shared.ErrNotFound)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *SetRiskLimitsUseCaseUnitTestSuite) TestExecute_InvalidLimits() {
+	input := suite.inputFaker
+	input.MaxOrderNotional = -1
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *SetRiskLimitsUseCaseUnitTestSuite) TestExecute_SaveLimitsError() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+	suite.limitsRepo.EXPECT().SaveLimits(gomock.Any()).Return(errors.New("save error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "save error")
+	assert.Nil(suite.T(), out)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(SetRiskLimitsUseCaseUnitTestSuite))
+	suite.Run(t, new(GetRiskLimitsUseCaseUnitTestSuite))
+}
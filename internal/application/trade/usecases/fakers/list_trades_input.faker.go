@@ -0,0 +1,17 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	tradeUsecases "github.com/juninhoitabh/clob-go/internal/application/trade/usecases"
+)
+
+func ListTradesInputFaker() tradeUsecases.ListTradesInput {
+	faker := faker.New(0)
+
+	return tradeUsecases.ListTradesInput{
+		AccountID:  faker.UUID(),
+		Instrument: faker.CurrencyShort() + "/" + faker.CurrencyShort(),
+		Limit:      faker.Number(1, 100),
+	}
+}
@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+)
+
+type (
+	ListTradesInput struct {
+		AccountID       string
+		Instrument      string
+		CallerAccountID string
+		Limit           int
+	}
+	ListTradesOutput struct {
+		Trades []*services.Trade
+	}
+	IListTradesUseCase interface {
+		Execute(input ListTradesInput) (*ListTradesOutput, error)
+	}
+)
@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	domainTrade "github.com/juninhoitabh/clob-go/internal/domain/trade"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+const (
+	defaultListTradesLimit = 100
+	maxListTradesLimit     = 1000
+)
+
+type (
+	ListTradesUseCase struct {
+		TradeRepo   domainTrade.ITradeRepository
+		AccountRepo account.IAccountRepository
+	}
+)
+
+// Execute lists an account's trades for a caller allowed to act on it or,
+// without an account, the public tape, which anyone may read and so shows
+// no one's accounts, orders or fees.
+func (l *ListTradesUseCase) Execute(input ListTradesInput) (*ListTradesOutput, error) {
+	if input.Limit < 0 || input.Limit > maxListTradesLimit {
+		return nil, shared.ErrInvalidParam
+	}
+
+	if input.AccountID != "" {
+		err := accountServices.Authorize(l.AccountRepo, input.CallerAccountID, input.AccountID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultListTradesLimit
+	}
+
+	trades, err := l.TradeRepo.ListTrades(domainTrade.Filter{
+		AccountID:  input.AccountID,
+		Instrument: input.Instrument,
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if input.AccountID == "" {
+		for i, trade := range trades {
+			trades[i] = publicTrade(trade)
+		}
+	}
+
+	return &ListTradesOutput{
+		Trades: trades,
+	}, nil
+}
+
+// publicTrade is what the tape shows of t: when and what traded, and at
+// what price.
+func publicTrade(t *services.Trade) *services.Trade {
+	return &services.Trade{
+		ExecutedAt: t.ExecutedAt,
+		Instrument: t.Instrument,
+		Seq:        t.Seq,
+		Price:      t.Price,
+		Qty:        t.Qty,
+	}
+}
+
+func NewListTradesUseCase(
+	tradeRepo domainTrade.ITradeRepository,
+	accountRepo account.IAccountRepository,
+) *ListTradesUseCase {
+	return &ListTradesUseCase{
+		TradeRepo:   tradeRepo,
+		AccountRepo: accountRepo,
+	}
+}
@@ -0,0 +1,157 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	tradeUsecases "github.com/juninhoitabh/clob-go/internal/application/trade/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/trade/usecases/fakers"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	domainTrade "github.com/juninhoitabh/clob-go/internal/domain/trade"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	tradeMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type ListTradesUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker  tradeUsecases.ListTradesInput
+	tradeRepo   *tradeMocks.MockITradeRepository
+	accountRepo *accountMocks.MockIAccountRepository
+	ctrl        *gomock.Controller
+	usecase     *tradeUsecases.ListTradesUseCase
+}
+
+func (suite *ListTradesUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.ListTradesInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.tradeRepo = tradeMocks.NewMockITradeRepository(suite.ctrl)
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.usecase = tradeUsecases.NewListTradesUseCase(suite.tradeRepo, suite.accountRepo)
+}
+
+func (suite *ListTradesUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *ListTradesUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+	trades := []*services.Trade{{Seq: 1, Instrument: input.Instrument}}
+
+	suite.tradeRepo.EXPECT().ListTrades(domainTrade.Filter{
+		AccountID:  input.AccountID,
+		Instrument: input.Instrument,
+		Limit:      input.Limit,
+	}).Return(trades, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), trades, out.Trades)
+}
+
+func (suite *ListTradesUseCaseUnitTestSuite) TestExecute_OwnAccount() {
+	input := suite.inputFaker
+	input.CallerAccountID = input.AccountID
+	trades := []*services.Trade{{Seq: 1, Instrument: input.Instrument, BuyerID: input.AccountID}}
+
+	suite.tradeRepo.EXPECT().ListTrades(gomock.Any()).Return(trades, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), trades, out.Trades)
+}
+
+func (suite *ListTradesUseCaseUnitTestSuite) TestExecute_ForbiddenForOtherAccount() {
+	input := suite.inputFaker
+	input.CallerAccountID = "caller"
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(&domainAccount.Account{}, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrForbidden)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *ListTradesUseCaseUnitTestSuite) TestExecute_PublicTapeHidesAccounts() {
+	input := suite.inputFaker
+	input.AccountID = ""
+	input.CallerAccountID = ""
+	now := time.Now()
+	trades := []*services.Trade{{
+		ExecutedAt:    now,
+		Instrument:    input.Instrument,
+		TakerOrderID:  "taker",
+		MakerOrderID:  "maker",
+		BuyerID:       "buyer",
+		SellerID:      "seller",
+		MakerFeeAsset: "USDT",
+		TakerFeeAsset: "BTC",
+		TakerSide:     domainOrder.Buy,
+		Seq:           7,
+		Price:         100,
+		Qty:           2,
+		MakerFee:      -1,
+		TakerFee:      2,
+	}}
+
+	suite.tradeRepo.EXPECT().ListTrades(domainTrade.Filter{
+		Instrument: input.Instrument,
+		Limit:      input.Limit,
+	}).Return(trades, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*services.Trade{{
+		ExecutedAt: now,
+		Instrument: input.Instrument,
+		Seq:        7,
+		Price:      100,
+		Qty:        2,
+	}}, out.Trades)
+}
+
+func (suite *ListTradesUseCaseUnitTestSuite) TestExecute_DefaultLimit() {
+	input := suite.inputFaker
+	input.Limit = 0
+
+	suite.tradeRepo.EXPECT().ListTrades(domainTrade.Filter{
+		AccountID:  input.AccountID,
+		Instrument: input.Instrument,
+		Limit:      100,
+	}).Return(nil, nil)
+
+	_, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ListTradesUseCaseUnitTestSuite) TestExecute_InvalidLimit() {
+	input := suite.inputFaker
+	input.Limit = 1001
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *ListTradesUseCaseUnitTestSuite) TestExecute_ListTradesError() {
+	input := suite.inputFaker
+
+	suite.tradeRepo.EXPECT().ListTrades(gomock.Any()).Return(nil, errors.New("repo error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "repo error")
+	assert.Nil(suite.T(), out)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(ListTradesUseCaseUnitTestSuite))
+}
@@ -0,0 +1,19 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	transferUsecases "github.com/juninhoitabh/clob-go/internal/application/transfer/usecases"
+)
+
+func TransferInputFaker() transferUsecases.TransferInput {
+	faker := faker.New(0)
+
+	return transferUsecases.TransferInput{
+		IdempotencyKey: faker.UUID(),
+		FromAccountID:  faker.UUID(),
+		ToAccountID:    faker.UUID(),
+		Asset:          "USDT",
+		Amount:         int64(faker.Price(1, 1000)),
+	}
+}
@@ -0,0 +1,27 @@
+package usecases
+
+import "time"
+
+type (
+	TransferInput struct {
+		IdempotencyKey  string
+		FromAccountID   string
+		ToAccountID     string
+		CallerAccountID string
+		Asset           string
+		Amount          int64
+	}
+	TransferOutput struct {
+		CreatedAt      time.Time
+		ID             string
+		IdempotencyKey string
+		FromAccountID  string
+		ToAccountID    string
+		Asset          string
+		Amount         int64
+		Replayed       bool
+	}
+	ITransferUseCase interface {
+		Execute(input TransferInput) (*TransferOutput, error)
+	}
+)
@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"errors"
+	"fmt"
+
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	domainTransfer "github.com/juninhoitabh/clob-go/internal/domain/transfer"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type (
+	TransferUseCase struct {
+		AccountRepo  domainAccount.IAccountRepository
+		TransferRepo domainTransfer.ITransferRepository
+	}
+)
+
+func (t *TransferUseCase) Execute(input TransferInput) (*TransferOutput, error) {
+	props := domainTransfer.TransferProps{
+		IdempotencyKey: input.IdempotencyKey,
+		FromAccountID:  input.FromAccountID,
+		ToAccountID:    input.ToAccountID,
+		Asset:          input.Asset,
+		Amount:         input.Amount,
+	}
+
+	transfer, err := domainTransfer.NewTransfer(props, idObjValue.Uuid)
+	if err != nil {
+		return nil, shared.ErrInvalidParam
+	}
+
+	err = accountServices.Authorize(t.AccountRepo, input.CallerAccountID, input.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := t.AccountRepo.Get(input.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := t.AccountRepo.Get(input.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if (from.IsSubAccount() || to.IsSubAccount()) && !from.IsParentOf(to) && !to.IsParentOf(from) {
+		return nil, domainTransfer.ErrOutsideHierarchy
+	}
+
+	unlock := accountServices.LockAccounts(from, to)
+	defer unlock()
+
+	existing, err := t.TransferRepo.GetTransferByIdempotencyKey(input.FromAccountID, input.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		if !existing.Matches(props) {
+			return nil, fmt.Errorf("%w: %w", shared.ErrAlreadyExists, domainTransfer.ErrIdempotencyMismatch)
+		}
+
+		output := newTransferOutput(existing)
+		output.Replayed = true
+
+		return output, nil
+	}
+
+	err = accountServices.Transfer(from, to, transfer.Asset, transfer.Amount, ledger.TransferRef(transfer.GetID()))
+	if err != nil {
+		if errors.Is(err, domainAccount.ErrInsufficient) {
+			return nil, domainTransfer.ErrInsufficientFunds
+		}
+
+		return nil, err
+	}
+
+	err = t.AccountRepo.Save(from)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.AccountRepo.Save(to)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.TransferRepo.SaveTransfer(transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTransferOutput(transfer), nil
+}
+
+func newTransferOutput(transfer *domainTransfer.Transfer) *TransferOutput {
+	return &TransferOutput{
+		CreatedAt:      transfer.CreatedAt,
+		ID:             transfer.GetID(),
+		IdempotencyKey: transfer.IdempotencyKey,
+		FromAccountID:  transfer.FromAccountID,
+		ToAccountID:    transfer.ToAccountID,
+		Asset:          transfer.Asset,
+		Amount:         transfer.Amount,
+	}
+}
+
+func NewTransferUseCase(
+	accountRepo domainAccount.IAccountRepository,
+	transferRepo domainTransfer.ITransferRepository,
+) *TransferUseCase {
+	return &TransferUseCase{
+		AccountRepo:  accountRepo,
+		TransferRepo: transferRepo,
+	}
+}
@@ -0,0 +1,221 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	transferUsecases "github.com/juninhoitabh/clob-go/internal/application/transfer/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/transfer/usecases/fakers"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainLedger "github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	domainTransfer "github.com/juninhoitabh/clob-go/internal/domain/transfer"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	transferMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/transfer/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type TransferUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker   transferUsecases.TransferInput
+	accountRepo  *accountMocks.MockIAccountRepository
+	transferRepo *transferMocks.MockITransferRepository
+	ctrl         *gomock.Controller
+	usecase      *transferUsecases.TransferUseCase
+	from         *domainAccount.Account
+	to           *domainAccount.Account
+}
+
+func (suite *TransferUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.TransferInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.transferRepo = transferMocks.NewMockITransferRepository(suite.ctrl)
+	suite.usecase = transferUsecases.NewTransferUseCase(suite.accountRepo, suite.transferRepo)
+
+	suite.from = &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: suite.inputFaker.Amount, Reserved: 500},
+		},
+	}
+	suite.from.ID.ID = suite.inputFaker.FromAccountID
+	suite.to = &domainAccount.Account{Balances: map[string]*domainAccount.Balance{}}
+	suite.to.ID.ID = suite.inputFaker.ToAccountID
+}
+
+func (suite *TransferUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *TransferUseCaseUnitTestSuite) expectAccounts() {
+	suite.accountRepo.EXPECT().Get(suite.inputFaker.FromAccountID).Return(suite.from, nil)
+	suite.accountRepo.EXPECT().Get(suite.inputFaker.ToAccountID).Return(suite.to, nil)
+}
+
+func (suite *TransferUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+
+	suite.expectAccounts()
+	suite.transferRepo.EXPECT().GetTransferByIdempotencyKey(input.FromAccountID, input.IdempotencyKey).Return(nil, nil)
+	suite.accountRepo.EXPECT().Save(suite.from).Return(nil)
+	suite.accountRepo.EXPECT().Save(suite.to).Return(nil)
+	suite.transferRepo.EXPECT().SaveTransfer(gomock.Any()).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), out.ID)
+	assert.False(suite.T(), out.Replayed)
+	assert.Equal(suite.T(), int64(0), suite.from.Balances["USDT"].Available)
+	assert.Equal(suite.T(), int64(500), suite.from.Balances["USDT"].Reserved)
+	assert.Equal(suite.T(), input.Amount, suite.to.Balances["USDT"].Available)
+
+	postings := suite.to.PendingPostings()
+	assert.Len(suite.T(), postings, 1)
+	assert.Equal(suite.T(), domainLedger.TransferRef(out.ID), postings[0].Ref)
+}
+
+func (suite *TransferUseCaseUnitTestSuite) TestExecute_InsufficientAvailable() {
+	input := suite.inputFaker
+	input.Amount = suite.inputFaker.Amount + 1
+
+	suite.expectAccounts()
+	suite.transferRepo.EXPECT().GetTransferByIdempotencyKey(input.FromAccountID, input.IdempotencyKey).Return(nil, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, domainTransfer.ErrInsufficientFunds)
+	assert.Nil(suite.T(), out)
+	assert.Equal(suite.T(), suite.inputFaker.Amount, suite.from.Balances["USDT"].Available)
+	assert.Empty(suite.T(), suite.to.Balances)
+}
+
+func (suite *TransferUseCaseUnitTestSuite) TestExecute_ReplaysSameIdempotencyKey() {
+	input := suite.inputFaker
+	existing, _ := domainTransfer.NewTransfer(domainTransfer.TransferProps{
+		IdempotencyKey: input.IdempotencyKey,
+		FromAccountID:  input.FromAccountID,
+		ToAccountID:    input.ToAccountID,
+		Asset:          input.Asset,
+		Amount:         input.Amount,
+	}, "Uuid")
+
+	suite.expectAccounts()
+	suite.transferRepo.EXPECT().GetTransferByIdempotencyKey(input.FromAccountID, input.IdempotencyKey).Return(existing, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), out.Replayed)
+	assert.Equal(suite.T(), existing.GetID(), out.ID)
+	assert.Equal(suite.T(), input.Amount, suite.from.Balances["USDT"].Available)
+}
+
+func (suite *TransferUseCaseUnitTestSuite) TestExecute_IdempotencyKeyMismatch() {
+	input := suite.inputFaker
+	existing, _ := domainTransfer.NewTransfer(domainTransfer.TransferProps{
+		IdempotencyKey: input.IdempotencyKey,
+		FromAccountID:  input.FromAccountID,
+		ToAccountID:    input.ToAccountID,
+		Asset:          input.Asset,
+		Amount:         input.Amount + 1,
+	}, "Uuid")
+
+	suite.expectAccounts()
+	suite.transferRepo.EXPECT().GetTransferByIdempotencyKey(input.FromAccountID, input.IdempotencyKey).Return(existing, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrAlreadyExists)
+	assert.ErrorIs(suite.T(), err, domainTransfer.ErrIdempotencyMismatch)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *TransferUseCaseUnitTestSuite) TestExecute_ParentToSubAccount() {
+	input := suite.inputFaker
+	suite.to.ParentID = input.FromAccountID
+
+	suite.expectAccounts()
+	suite.transferRepo.EXPECT().GetTransferByIdempotencyKey(input.FromAccountID, input.IdempotencyKey).Return(nil, nil)
+	suite.accountRepo.EXPECT().Save(gomock.Any()).Return(nil).Times(2)
+	suite.transferRepo.EXPECT().SaveTransfer(gomock.Any()).Return(nil)
+
+	_, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), input.Amount, suite.to.Balances["USDT"].Available)
+}
+
+func (suite *TransferUseCaseUnitTestSuite) TestExecute_SubAccountToParent() {
+	input := suite.inputFaker
+	suite.from.ParentID = input.ToAccountID
+
+	suite.expectAccounts()
+	suite.transferRepo.EXPECT().GetTransferByIdempotencyKey(input.FromAccountID, input.IdempotencyKey).Return(nil, nil)
+	suite.accountRepo.EXPECT().Save(gomock.Any()).Return(nil).Times(2)
+	suite.transferRepo.EXPECT().SaveTransfer(gomock.Any()).Return(nil)
+
+	_, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *TransferUseCaseUnitTestSuite) TestExecute_SubAccountOutsideHierarchy() {
+	input := suite.inputFaker
+	suite.from.ParentID = "another-parent"
+
+	suite.expectAccounts()
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, domainTransfer.ErrOutsideHierarchy)
+	assert.Nil(suite.T(), out)
+	assert.Equal(suite.T(), input.Amount, suite.from.Balances["USDT"].Available)
+}
+
+func (suite *TransferUseCaseUnitTestSuite) TestExecute_ForbiddenForOtherAccount() {
+	input := suite.inputFaker
+	input.CallerAccountID = input.ToAccountID
+
+	suite.accountRepo.EXPECT().Get(input.FromAccountID).Return(suite.from, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrForbidden)
+	assert.Nil(suite.T(), out)
+	assert.Equal(suite.T(), input.Amount, suite.from.Balances["USDT"].Available)
+}
+
+func (suite *TransferUseCaseUnitTestSuite) TestExecute_InvalidParam() {
+	input := suite.inputFaker
+	input.ToAccountID = input.FromAccountID
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *TransferUseCaseUnitTestSuite) TestExecute_DestinationNotFound() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.FromAccountID).Return(suite.from, nil)
+	suite.accountRepo.EXPECT().Get(input.ToAccountID).Return(nil, shared.ErrNotFound)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *TransferUseCaseUnitTestSuite) TestExecute_SaveTransferError() {
+	input := suite.inputFaker
+
+	suite.expectAccounts()
+	suite.transferRepo.EXPECT().GetTransferByIdempotencyKey(input.FromAccountID, input.IdempotencyKey).Return(nil, nil)
+	suite.accountRepo.EXPECT().Save(gomock.Any()).Return(nil).Times(2)
+	suite.transferRepo.EXPECT().SaveTransfer(gomock.Any()).Return(errors.New("save error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "save error")
+	assert.Nil(suite.T(), out)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(TransferUseCaseUnitTestSuite))
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	domainWithdrawal "github.com/juninhoitabh/clob-go/internal/domain/withdrawal"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	CompleteWithdrawalUseCase struct {
+		WithdrawalRepo domainWithdrawal.IWithdrawalRepository
+	}
+)
+
+func (c *CompleteWithdrawalUseCase) Execute(input CompleteWithdrawalInput) (*WithdrawalOutput, error) {
+	withdrawal, err := c.WithdrawalRepo.GetWithdrawal(input.WithdrawalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if withdrawal == nil {
+		return nil, shared.ErrNotFound
+	}
+
+	completed := *withdrawal
+
+	err = completed.Complete()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.WithdrawalRepo.TransitionWithdrawal(&completed, withdrawal.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return newWithdrawalOutput(&completed), nil
+}
+
+func NewCompleteWithdrawalUseCase(
+	withdrawalRepo domainWithdrawal.IWithdrawalRepository,
+) *CompleteWithdrawalUseCase {
+	return &CompleteWithdrawalUseCase{
+		WithdrawalRepo: withdrawalRepo,
+	}
+}
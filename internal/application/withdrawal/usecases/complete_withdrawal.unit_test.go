@@ -0,0 +1,80 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	withdrawalUsecases "github.com/juninhoitabh/clob-go/internal/application/withdrawal/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/withdrawal/usecases/fakers"
+	domainWithdrawal "github.com/juninhoitabh/clob-go/internal/domain/withdrawal"
+	withdrawalMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/withdrawal/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type CompleteWithdrawalUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker     withdrawalUsecases.CompleteWithdrawalInput
+	withdrawalRepo *withdrawalMocks.MockIWithdrawalRepository
+	ctrl           *gomock.Controller
+	usecase        *withdrawalUsecases.CompleteWithdrawalUseCase
+}
+
+func (suite *CompleteWithdrawalUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.CompleteWithdrawalInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.withdrawalRepo = withdrawalMocks.NewMockIWithdrawalRepository(suite.ctrl)
+	suite.usecase = withdrawalUsecases.NewCompleteWithdrawalUseCase(suite.withdrawalRepo)
+}
+
+func (suite *CompleteWithdrawalUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *CompleteWithdrawalUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+	withdrawal := &domainWithdrawal.Withdrawal{Status: domainWithdrawal.Pending}
+
+	suite.withdrawalRepo.EXPECT().GetWithdrawal(input.WithdrawalID).Return(withdrawal, nil)
+	suite.withdrawalRepo.EXPECT().TransitionWithdrawal(gomock.Any(), domainWithdrawal.Pending).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), string(domainWithdrawal.Completed), out.Status)
+	assert.Equal(suite.T(), domainWithdrawal.Pending, withdrawal.Status)
+}
+
+func (suite *CompleteWithdrawalUseCaseUnitTestSuite) TestExecute_LostRace() {
+	input := suite.inputFaker
+	withdrawal := &domainWithdrawal.Withdrawal{Status: domainWithdrawal.Pending}
+
+	suite.withdrawalRepo.EXPECT().GetWithdrawal(input.WithdrawalID).Return(withdrawal, nil)
+	suite.withdrawalRepo.EXPECT().TransitionWithdrawal(gomock.Any(), domainWithdrawal.Pending).Return(domainWithdrawal.ErrInvalidTransition)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, domainWithdrawal.ErrInvalidTransition)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *CompleteWithdrawalUseCaseUnitTestSuite) TestExecute_NotPending() {
+	input := suite.inputFaker
+	withdrawal := &domainWithdrawal.Withdrawal{Status: domainWithdrawal.Failed}
+
+	suite.withdrawalRepo.EXPECT().GetWithdrawal(input.WithdrawalID).Return(withdrawal, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, domainWithdrawal.ErrInvalidTransition)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *CompleteWithdrawalUseCaseUnitTestSuite) TestExecute_NotFound() {
+	input := suite.inputFaker
+
+	suite.withdrawalRepo.EXPECT().GetWithdrawal(input.WithdrawalID).Return(nil, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
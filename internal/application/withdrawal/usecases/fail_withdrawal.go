@@ -0,0 +1,71 @@
+package usecases
+
+import (
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	domainWithdrawal "github.com/juninhoitabh/clob-go/internal/domain/withdrawal"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	FailWithdrawalUseCase struct {
+		AccountRepo    domainAccount.IAccountRepository
+		WithdrawalRepo domainWithdrawal.IWithdrawalRepository
+	}
+)
+
+func (f *FailWithdrawalUseCase) Execute(input FailWithdrawalInput) (*WithdrawalOutput, error) {
+	withdrawal, err := f.WithdrawalRepo.GetWithdrawal(input.WithdrawalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if withdrawal == nil {
+		return nil, shared.ErrNotFound
+	}
+
+	acct, err := f.AccountRepo.Get(withdrawal.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	failed := *withdrawal
+
+	err = failed.Fail(input.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	// The withdrawal leaves Pending before the refund, so a racing fail or
+	// complete is turned away instead of refunding or paying out again.
+	err = f.WithdrawalRepo.TransitionWithdrawal(&failed, withdrawal.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := accountServices.LockAccounts(acct)
+	defer unlock()
+
+	err = acct.Credit(failed.Asset, failed.Amount, ledger.WithdrawalRef(failed.GetID()))
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.AccountRepo.Save(acct)
+	if err != nil {
+		return nil, err
+	}
+
+	return newWithdrawalOutput(&failed), nil
+}
+
+func NewFailWithdrawalUseCase(
+	accountRepo domainAccount.IAccountRepository,
+	withdrawalRepo domainWithdrawal.IWithdrawalRepository,
+) *FailWithdrawalUseCase {
+	return &FailWithdrawalUseCase{
+		AccountRepo:    accountRepo,
+		WithdrawalRepo: withdrawalRepo,
+	}
+}
@@ -0,0 +1,113 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	withdrawalUsecases "github.com/juninhoitabh/clob-go/internal/application/withdrawal/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/withdrawal/usecases/fakers"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainWithdrawal "github.com/juninhoitabh/clob-go/internal/domain/withdrawal"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	withdrawalMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/withdrawal/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type FailWithdrawalUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker     withdrawalUsecases.FailWithdrawalInput
+	accountRepo    *accountMocks.MockIAccountRepository
+	withdrawalRepo *withdrawalMocks.MockIWithdrawalRepository
+	ctrl           *gomock.Controller
+	usecase        *withdrawalUsecases.FailWithdrawalUseCase
+}
+
+func (suite *FailWithdrawalUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.FailWithdrawalInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.withdrawalRepo = withdrawalMocks.NewMockIWithdrawalRepository(suite.ctrl)
+	suite.usecase = withdrawalUsecases.NewFailWithdrawalUseCase(suite.accountRepo, suite.withdrawalRepo)
+}
+
+func (suite *FailWithdrawalUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *FailWithdrawalUseCaseUnitTestSuite) TestExecute_ReturnsFundsToAvailable() {
+	input := suite.inputFaker
+	withdrawal := &domainWithdrawal.Withdrawal{
+		AccountID: "acc1",
+		Asset:     "USDT",
+		Amount:    100,
+		Status:    domainWithdrawal.Pending,
+	}
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: 5},
+		},
+	}
+
+	suite.withdrawalRepo.EXPECT().GetWithdrawal(input.WithdrawalID).Return(withdrawal, nil)
+	suite.accountRepo.EXPECT().Get("acc1").Return(account, nil)
+	suite.accountRepo.EXPECT().Save(account).Return(nil)
+	suite.withdrawalRepo.EXPECT().TransitionWithdrawal(gomock.Any(), domainWithdrawal.Pending).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), string(domainWithdrawal.Failed), out.Status)
+	assert.Equal(suite.T(), input.Reason, out.Reason)
+	assert.Equal(suite.T(), int64(105), account.Balances["USDT"].Available)
+}
+
+func (suite *FailWithdrawalUseCaseUnitTestSuite) TestExecute_NotPending() {
+	input := suite.inputFaker
+	withdrawal := &domainWithdrawal.Withdrawal{
+		AccountID: "acc1",
+		Asset:     "USDT",
+		Amount:    100,
+		Status:    domainWithdrawal.Completed,
+	}
+	account := &domainAccount.Account{Balances: map[string]*domainAccount.Balance{}}
+
+	suite.withdrawalRepo.EXPECT().GetWithdrawal(input.WithdrawalID).Return(withdrawal, nil)
+	suite.accountRepo.EXPECT().Get("acc1").Return(account, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, domainWithdrawal.ErrInvalidTransition)
+	assert.Nil(suite.T(), out)
+	assert.Empty(suite.T(), account.Balances)
+}
+
+func (suite *FailWithdrawalUseCaseUnitTestSuite) TestExecute_LostRaceDoesNotRefund() {
+	input := suite.inputFaker
+	withdrawal := &domainWithdrawal.Withdrawal{
+		AccountID: "acc1",
+		Asset:     "USDT",
+		Amount:    100,
+		Status:    domainWithdrawal.Pending,
+	}
+	account := &domainAccount.Account{Balances: map[string]*domainAccount.Balance{}}
+
+	suite.withdrawalRepo.EXPECT().GetWithdrawal(input.WithdrawalID).Return(withdrawal, nil)
+	suite.accountRepo.EXPECT().Get("acc1").Return(account, nil)
+	suite.withdrawalRepo.EXPECT().TransitionWithdrawal(gomock.Any(), domainWithdrawal.Pending).Return(domainWithdrawal.ErrInvalidTransition)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, domainWithdrawal.ErrInvalidTransition)
+	assert.Nil(suite.T(), out)
+	assert.Empty(suite.T(), account.Balances)
+}
+
+func (suite *FailWithdrawalUseCaseUnitTestSuite) TestExecute_NotFound() {
+	input := suite.inputFaker
+
+	suite.withdrawalRepo.EXPECT().GetWithdrawal(input.WithdrawalID).Return(nil, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
@@ -0,0 +1,15 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	withdrawalUsecases "github.com/juninhoitabh/clob-go/internal/application/withdrawal/usecases"
+)
+
+func CompleteWithdrawalInputFaker() withdrawalUsecases.CompleteWithdrawalInput {
+	faker := faker.New(0)
+
+	return withdrawalUsecases.CompleteWithdrawalInput{
+		WithdrawalID: faker.UUID(),
+	}
+}
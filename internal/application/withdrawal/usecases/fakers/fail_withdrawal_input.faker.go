@@ -0,0 +1,16 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	withdrawalUsecases "github.com/juninhoitabh/clob-go/internal/application/withdrawal/usecases"
+)
+
+func FailWithdrawalInputFaker() withdrawalUsecases.FailWithdrawalInput {
+	faker := faker.New(0)
+
+	return withdrawalUsecases.FailWithdrawalInput{
+		WithdrawalID: faker.UUID(),
+		Reason:       faker.Sentence(4),
+	}
+}
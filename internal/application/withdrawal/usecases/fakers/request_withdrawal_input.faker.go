@@ -0,0 +1,17 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	withdrawalUsecases "github.com/juninhoitabh/clob-go/internal/application/withdrawal/usecases"
+)
+
+func RequestWithdrawalInputFaker() withdrawalUsecases.RequestWithdrawalInput {
+	faker := faker.New(0)
+
+	return withdrawalUsecases.RequestWithdrawalInput{
+		AccountID: faker.UUID(),
+		Asset:     "USDT",
+		Amount:    int64(faker.Price(1, 1000)),
+	}
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	domainWithdrawal "github.com/juninhoitabh/clob-go/internal/domain/withdrawal"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	GetWithdrawalUseCase struct {
+		AccountRepo    domainAccount.IAccountRepository
+		WithdrawalRepo domainWithdrawal.IWithdrawalRepository
+	}
+)
+
+// Execute returns a withdrawal to the account that requested it or to one
+// above it. Anyone else gets ErrNotFound, so withdrawal IDs cannot be probed.
+func (g *GetWithdrawalUseCase) Execute(input GetWithdrawalInput) (*WithdrawalOutput, error) {
+	withdrawal, err := g.WithdrawalRepo.GetWithdrawal(input.WithdrawalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if withdrawal == nil {
+		return nil, shared.ErrNotFound
+	}
+
+	err = accountServices.Authorize(g.AccountRepo, input.CallerAccountID, withdrawal.AccountID)
+	if err != nil {
+		return nil, shared.ErrNotFound
+	}
+
+	return newWithdrawalOutput(withdrawal), nil
+}
+
+func NewGetWithdrawalUseCase(
+	accountRepo domainAccount.IAccountRepository,
+	withdrawalRepo domainWithdrawal.IWithdrawalRepository,
+) *GetWithdrawalUseCase {
+	return &GetWithdrawalUseCase{
+		AccountRepo:    accountRepo,
+		WithdrawalRepo: withdrawalRepo,
+	}
+}
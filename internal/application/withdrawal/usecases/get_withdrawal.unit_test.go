@@ -0,0 +1,88 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	withdrawalUsecases "github.com/juninhoitabh/clob-go/internal/application/withdrawal/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/withdrawal/usecases/fakers"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainWithdrawal "github.com/juninhoitabh/clob-go/internal/domain/withdrawal"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	withdrawalMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/withdrawal/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type GetWithdrawalUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker     withdrawalUsecases.GetWithdrawalInput
+	accountRepo    *accountMocks.MockIAccountRepository
+	withdrawalRepo *withdrawalMocks.MockIWithdrawalRepository
+	ctrl           *gomock.Controller
+	usecase        *withdrawalUsecases.GetWithdrawalUseCase
+}
+
+func (suite *GetWithdrawalUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.GetWithdrawalInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.withdrawalRepo = withdrawalMocks.NewMockIWithdrawalRepository(suite.ctrl)
+	suite.usecase = withdrawalUsecases.NewGetWithdrawalUseCase(suite.accountRepo, suite.withdrawalRepo)
+}
+
+func (suite *GetWithdrawalUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *GetWithdrawalUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+	withdrawal := &domainWithdrawal.Withdrawal{AccountID: "acc1", Asset: "BTC", Amount: 3, Status: domainWithdrawal.Pending}
+	withdrawal.ID.ID = input.WithdrawalID
+
+	suite.withdrawalRepo.EXPECT().GetWithdrawal(input.WithdrawalID).Return(withdrawal, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), input.WithdrawalID, out.ID)
+	assert.Equal(suite.T(), "acc1", out.AccountID)
+	assert.Equal(suite.T(), int64(3), out.Amount)
+}
+
+func (suite *GetWithdrawalUseCaseUnitTestSuite) TestExecute_OwnedByCallerSubAccount() {
+	input := suite.inputFaker
+	input.CallerAccountID = "parent"
+	withdrawal := &domainWithdrawal.Withdrawal{AccountID: "acc1", Asset: "BTC", Amount: 3, Status: domainWithdrawal.Pending}
+
+	suite.withdrawalRepo.EXPECT().GetWithdrawal(input.WithdrawalID).Return(withdrawal, nil)
+	suite.accountRepo.EXPECT().Get("acc1").Return(&domainAccount.Account{ParentID: "parent"}, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "acc1", out.AccountID)
+}
+
+func (suite *GetWithdrawalUseCaseUnitTestSuite) TestExecute_OtherAccountSeesNotFound() {
+	input := suite.inputFaker
+	input.CallerAccountID = "other"
+	withdrawal := &domainWithdrawal.Withdrawal{AccountID: "acc1", Asset: "BTC", Amount: 3, Status: domainWithdrawal.Pending}
+
+	suite.withdrawalRepo.EXPECT().GetWithdrawal(input.WithdrawalID).Return(withdrawal, nil)
+	suite.accountRepo.EXPECT().Get("acc1").Return(&domainAccount.Account{}, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *GetWithdrawalUseCaseUnitTestSuite) TestExecute_NotFound() {
+	input := suite.inputFaker
+
+	suite.withdrawalRepo.EXPECT().GetWithdrawal(input.WithdrawalID).Return(nil, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
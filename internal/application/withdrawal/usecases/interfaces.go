@@ -0,0 +1,45 @@
+package usecases
+
+import "time"
+
+type (
+	RequestWithdrawalInput struct {
+		CallerAccountID string
+		AccountID       string
+		Asset           string
+		Amount          int64
+	}
+	CompleteWithdrawalInput struct {
+		WithdrawalID string
+	}
+	FailWithdrawalInput struct {
+		WithdrawalID string
+		Reason       string
+	}
+	GetWithdrawalInput struct {
+		CallerAccountID string
+		WithdrawalID    string
+	}
+	WithdrawalOutput struct {
+		CreatedAt time.Time
+		UpdatedAt time.Time
+		ID        string
+		AccountID string
+		Asset     string
+		Status    string
+		Reason    string
+		Amount    int64
+	}
+	IRequestWithdrawalUseCase interface {
+		Execute(input RequestWithdrawalInput) (*WithdrawalOutput, error)
+	}
+	ICompleteWithdrawalUseCase interface {
+		Execute(input CompleteWithdrawalInput) (*WithdrawalOutput, error)
+	}
+	IFailWithdrawalUseCase interface {
+		Execute(input FailWithdrawalInput) (*WithdrawalOutput, error)
+	}
+	IGetWithdrawalUseCase interface {
+		Execute(input GetWithdrawalInput) (*WithdrawalOutput, error)
+	}
+)
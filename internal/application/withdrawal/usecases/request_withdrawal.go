@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"errors"
+
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	domainWithdrawal "github.com/juninhoitabh/clob-go/internal/domain/withdrawal"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type (
+	RequestWithdrawalUseCase struct {
+		AccountRepo    domainAccount.IAccountRepository
+		WithdrawalRepo domainWithdrawal.IWithdrawalRepository
+	}
+)
+
+func (r *RequestWithdrawalUseCase) Execute(input RequestWithdrawalInput) (*WithdrawalOutput, error) {
+	err := accountServices.Authorize(r.AccountRepo, input.CallerAccountID, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawal, err := domainWithdrawal.NewWithdrawal(domainWithdrawal.WithdrawalProps{
+		AccountID: input.AccountID,
+		Asset:     input.Asset,
+		Amount:    input.Amount,
+	}, idObjValue.Uuid)
+	if err != nil {
+		return nil, shared.ErrInvalidParam
+	}
+
+	acct, err := r.AccountRepo.Get(input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := accountServices.LockAccounts(acct)
+	defer unlock()
+
+	err = acct.Debit(withdrawal.Asset, withdrawal.Amount, ledger.WithdrawalRef(withdrawal.GetID()))
+	if err != nil {
+		if errors.Is(err, domainAccount.ErrInsufficient) {
+			return nil, domainWithdrawal.ErrInsufficientFunds
+		}
+
+		return nil, err
+	}
+
+	err = r.AccountRepo.Save(acct)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.WithdrawalRepo.SaveWithdrawal(withdrawal)
+	if err != nil {
+		return nil, err
+	}
+
+	return newWithdrawalOutput(withdrawal), nil
+}
+
+func newWithdrawalOutput(withdrawal *domainWithdrawal.Withdrawal) *WithdrawalOutput {
+	return &WithdrawalOutput{
+		CreatedAt: withdrawal.CreatedAt,
+		UpdatedAt: withdrawal.UpdatedAt,
+		ID:        withdrawal.GetID(),
+		AccountID: withdrawal.AccountID,
+		Asset:     withdrawal.Asset,
+		Status:    string(withdrawal.Status),
+		Reason:    withdrawal.Reason,
+		Amount:    withdrawal.Amount,
+	}
+}
+
+func NewRequestWithdrawalUseCase(
+	accountRepo domainAccount.IAccountRepository,
+	withdrawalRepo domainWithdrawal.IWithdrawalRepository,
+) *RequestWithdrawalUseCase {
+	return &RequestWithdrawalUseCase{
+		AccountRepo:    accountRepo,
+		WithdrawalRepo: withdrawalRepo,
+	}
+}
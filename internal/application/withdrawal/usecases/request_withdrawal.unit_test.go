@@ -0,0 +1,137 @@
+//go:build all || unit || usecase
+
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	withdrawalUsecases "github.com/juninhoitabh/clob-go/internal/application/withdrawal/usecases"
+	"github.com/juninhoitabh/clob-go/internal/application/withdrawal/usecases/fakers"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainWithdrawal "github.com/juninhoitabh/clob-go/internal/domain/withdrawal"
+	accountMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	withdrawalMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/withdrawal/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type RequestWithdrawalUseCaseUnitTestSuite struct {
+	suite.Suite
+	inputFaker     withdrawalUsecases.RequestWithdrawalInput
+	accountRepo    *accountMocks.MockIAccountRepository
+	withdrawalRepo *withdrawalMocks.MockIWithdrawalRepository
+	ctrl           *gomock.Controller
+	usecase        *withdrawalUsecases.RequestWithdrawalUseCase
+}
+
+func (suite *RequestWithdrawalUseCaseUnitTestSuite) SetupTest() {
+	suite.inputFaker = fakers.RequestWithdrawalInputFaker()
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.accountRepo = accountMocks.NewMockIAccountRepository(suite.ctrl)
+	suite.withdrawalRepo = withdrawalMocks.NewMockIWithdrawalRepository(suite.ctrl)
+	suite.usecase = withdrawalUsecases.NewRequestWithdrawalUseCase(suite.accountRepo, suite.withdrawalRepo)
+}
+
+func (suite *RequestWithdrawalUseCaseUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *RequestWithdrawalUseCaseUnitTestSuite) TestExecute_Success() {
+	input := suite.inputFaker
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: input.Amount, Reserved: 50},
+		},
+	}
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+	suite.accountRepo.EXPECT().Save(account).Return(nil)
+	suite.withdrawalRepo.EXPECT().SaveWithdrawal(gomock.Any()).Return(nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), out.ID)
+	assert.Equal(suite.T(), string(domainWithdrawal.Pending), out.Status)
+	assert.Equal(suite.T(), int64(0), account.Balances["USDT"].Available)
+	assert.Equal(suite.T(), int64(50), account.Balances["USDT"].Reserved)
+}
+
+func (suite *RequestWithdrawalUseCaseUnitTestSuite) TestExecute_ForbiddenForOtherAccount() {
+	input := suite.inputFaker
+	input.CallerAccountID = "other"
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: input.Amount},
+		},
+	}
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrForbidden)
+	assert.Nil(suite.T(), out)
+	assert.Equal(suite.T(), input.Amount, account.Balances["USDT"].Available)
+}
+
+func (suite *RequestWithdrawalUseCaseUnitTestSuite) TestExecute_InsufficientAvailable() {
+	input := suite.inputFaker
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: input.Amount - 1, Reserved: input.Amount},
+		},
+	}
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, domainWithdrawal.ErrInsufficientFunds)
+	assert.Nil(suite.T(), out)
+	assert.Equal(suite.T(), input.Amount-1, account.Balances["USDT"].Available)
+}
+
+func (suite *RequestWithdrawalUseCaseUnitTestSuite) TestExecute_InvalidParam() {
+	input := suite.inputFaker
+	input.Amount = 0
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrInvalidParam)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *RequestWithdrawalUseCaseUnitTestSuite) TestExecute_AccountNotFound() {
+	input := suite.inputFaker
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(nil, shared.ErrNotFound)
+
+	out, err := suite.usecase.Execute(input)
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), out)
+}
+
+func (suite *RequestWithdrawalUseCaseUnitTestSuite) TestExecute_SaveWithdrawalError() {
+	input := suite.inputFaker
+	account := &domainAccount.Account{
+		Balances: map[string]*domainAccount.Balance{
+			"USDT": {Available: input.Amount},
+		},
+	}
+
+	suite.accountRepo.EXPECT().Get(input.AccountID).Return(account, nil)
+	suite.accountRepo.EXPECT().Save(account).Return(nil)
+	suite.withdrawalRepo.EXPECT().SaveWithdrawal(gomock.Any()).Return(errors.New("save error"))
+
+	out, err := suite.usecase.Execute(input)
+	assert.EqualError(suite.T(), err, "save error")
+	assert.Nil(suite.T(), out)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(RequestWithdrawalUseCaseUnitTestSuite))
+	suite.Run(t, new(CompleteWithdrawalUseCaseUnitTestSuite))
+	suite.Run(t, new(FailWithdrawalUseCaseUnitTestSuite))
+	suite.Run(t, new(GetWithdrawalUseCaseUnitTestSuite))
+}
@@ -2,11 +2,19 @@ package account
 
 type (
 	AccountSnapshot struct {
-		Balances  map[string]Balance
-		AccountID string
+		Balances      map[string]Balance
+		AccountID     string
+		ParentID      string
+		SubAccountIDs []string
+	}
+	AggregatedAccountSnapshot struct {
+		Balances    map[string]Balance
+		AccountID   string
+		SubAccounts []AccountSnapshot
 	}
 )
 
 type IAccountDAO interface {
 	Snapshot(id string) (*AccountSnapshot, error)
+	AggregatedSnapshot(id string) (*AggregatedAccountSnapshot, error)
 }
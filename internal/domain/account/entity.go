@@ -3,8 +3,10 @@ package account
 import (
 	"errors"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
 	baseEntity "github.com/juninhoitabh/clob-go/internal/shared/domain/entities"
 	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
 )
@@ -16,7 +18,8 @@ var (
 
 type (
 	AccountProps struct {
-		Name string
+		Name     string
+		ParentID string
 	}
 	Balance struct {
 		Available int64
@@ -25,8 +28,16 @@ type (
 	Account struct {
 		CreatedAt time.Time
 		Balances  map[string]*Balance
+		postings  []ledger.Posting
 		baseEntity.BaseEntity
-		Name string
+		mu            sync.Mutex
+		Name          string
+		ParentID      string
+		FeeTier       string
+		FeeTierPinned bool
+		// System marks an account the exchange itself owns, such as the fee
+		// account. It is known by its ID alone, so its name is not reserved.
+		System bool
 	}
 )
 
@@ -53,7 +64,7 @@ func (a *Account) Validate() error {
 	return nil
 }
 
-func (a *Account) Credit(asset string, amount int64) error {
+func (a *Account) Credit(asset string, amount int64, ref ...ledger.Ref) error {
 	if amount <= 0 {
 		return ErrInvalidParam
 	}
@@ -61,10 +72,31 @@ func (a *Account) Credit(asset string, amount int64) error {
 	bal := a.ensureBalance(asset)
 	bal.Available += amount
 
+	r := ledger.FirstRef(ref)
+	a.record(ledger.OpCredit, asset, amount, ledger.Contra(r), a.bucket(ledger.Available), r)
+
+	return nil
+}
+
+func (a *Account) Debit(asset string, amount int64, ref ...ledger.Ref) error {
+	if amount <= 0 {
+		return ErrInvalidParam
+	}
+
+	bal := a.ensureBalance(asset)
+	if bal.Available < amount {
+		return ErrInsufficient
+	}
+
+	bal.Available -= amount
+
+	r := ledger.FirstRef(ref)
+	a.record(ledger.OpDebit, asset, amount, a.bucket(ledger.Available), ledger.Contra(r), r)
+
 	return nil
 }
 
-func (a *Account) Reserve(asset string, amount int64) error {
+func (a *Account) Reserve(asset string, amount int64, ref ...ledger.Ref) error {
 	if amount <= 0 {
 		return ErrInvalidParam
 	}
@@ -77,10 +109,12 @@ func (a *Account) Reserve(asset string, amount int64) error {
 	bal.Available -= amount
 	bal.Reserved += amount
 
+	a.record(ledger.OpReserve, asset, amount, a.bucket(ledger.Available), a.bucket(ledger.Reserved), ledger.FirstRef(ref))
+
 	return nil
 }
 
-func (a *Account) UseReserved(asset string, amount int64) error {
+func (a *Account) UseReserved(asset string, amount int64, ref ...ledger.Ref) error {
 	if amount < 0 {
 		return ErrInvalidParam
 	}
@@ -92,10 +126,13 @@ func (a *Account) UseReserved(asset string, amount int64) error {
 
 	bal.Reserved -= amount
 
+	r := ledger.FirstRef(ref)
+	a.record(ledger.OpUseReserved, asset, amount, a.bucket(ledger.Reserved), ledger.Contra(r), r)
+
 	return nil
 }
 
-func (a *Account) ReleaseReserved(asset string, amount int64) error {
+func (a *Account) ReleaseReserved(asset string, amount int64, ref ...ledger.Ref) error {
 	if amount < 0 {
 		return ErrInvalidParam
 	}
@@ -108,9 +145,59 @@ func (a *Account) ReleaseReserved(asset string, amount int64) error {
 	bal.Reserved -= amount
 	bal.Available += amount
 
+	a.record(ledger.OpReleaseReserved, asset, amount, a.bucket(ledger.Reserved), a.bucket(ledger.Available), ledger.FirstRef(ref))
+
 	return nil
 }
 
+func (a *Account) IsSubAccount() bool {
+	return a.ParentID != ""
+}
+
+func (a *Account) IsParentOf(other *Account) bool {
+	return other != nil && other.ParentID != "" && other.ParentID == a.GetID()
+}
+
+func (a *Account) Lock() {
+	a.mu.Lock()
+}
+
+func (a *Account) Unlock() {
+	a.mu.Unlock()
+}
+
+func (a *Account) PendingPostings() []ledger.Posting {
+	return a.postings
+}
+
+func (a *Account) TakePostings() []ledger.Posting {
+	postings := a.postings
+	a.postings = nil
+
+	return postings
+}
+
+func (a *Account) SetFeeTier(tier string) {
+	a.FeeTier = strings.ToLower(tier)
+}
+
+func (a *Account) PinFeeTier(tier string) {
+	a.SetFeeTier(tier)
+	a.FeeTierPinned = tier != ""
+}
+
+func (a *Account) bucket(bucket ledger.Bucket) ledger.Account {
+	return ledger.Account{Owner: a.GetID(), Bucket: bucket}
+}
+
+func (a *Account) record(op ledger.Operation, asset string, amount int64, debit, credit ledger.Account, ref ledger.Ref) {
+	if amount == 0 {
+		return
+	}
+
+	a.postings = append(a.postings, ledger.NewPosting(op, asset, amount, debit, credit, ref))
+}
+
 func (a *Account) ensureBalance(asset string) *Balance {
 	asset = strings.ToUpper(asset)
 
@@ -125,7 +212,8 @@ func (a *Account) ensureBalance(asset string) *Balance {
 
 func NewAccount(props AccountProps, typeId idObjValue.TypeIdEnum) (*Account, error) {
 	account := Account{
-		Name: props.Name,
+		Name:     props.Name,
+		ParentID: props.ParentID,
 	}
 
 	err := account.Prepare(typeId)
@@ -135,3 +223,20 @@ func NewAccount(props AccountProps, typeId idObjValue.TypeIdEnum) (*Account, err
 
 	return &account, nil
 }
+
+// NewSystemAccount builds the exchange-owned account with the given ID.
+func NewSystemAccount(id string) (*Account, error) {
+	if id == "" {
+		return nil, ErrInvalidParam
+	}
+
+	account, err := NewAccount(AccountProps{Name: id}, idObjValue.Str)
+	if err != nil {
+		return nil, err
+	}
+
+	account.ID.ID = id
+	account.System = true
+
+	return account, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/juninhoitabh/clob-go/internal/domain/account"
 	"github.com/juninhoitabh/clob-go/internal/domain/account/fakers"
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
 	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
 )
 
@@ -143,6 +144,76 @@ func (suite *AccountUnitTestSuite) TestNewAccount_InvalidName() {
 	suite.Nil(acc)
 }
 
+func (suite *AccountUnitTestSuite) TestDebit_OnlyAvailable() {
+	acc, _ := account.NewAccount(suite.propsFaker, idObjValue.Uuid)
+	_ = acc.Credit("BTC", 100)
+	_ = acc.Reserve("BTC", 60)
+
+	err := acc.Debit("BTC", 50)
+	suite.ErrorIs(err, account.ErrInsufficient)
+
+	err = acc.Debit("btc", 40)
+	suite.NoError(err)
+	suite.Equal(int64(0), acc.Balances["BTC"].Available)
+	suite.Equal(int64(60), acc.Balances["BTC"].Reserved)
+
+	err = acc.Debit("BTC", 0)
+	suite.ErrorIs(err, account.ErrInvalidParam)
+}
+
+func (suite *AccountUnitTestSuite) TestPostings_MirrorBalances() {
+	acc, _ := account.NewAccount(suite.propsFaker, idObjValue.Uuid)
+	ref := ledger.OrderRef("order-1")
+
+	_ = acc.Credit("BTC", 100)
+	_ = acc.Reserve("BTC", 60, ref)
+	_ = acc.ReleaseReserved("BTC", 20, ref)
+	_ = acc.UseReserved("BTC", 0, ref)
+	_ = acc.UseReserved("BTC", 10, ref)
+	_ = acc.Debit("BTC", 5)
+	_ = acc.Reserve("BTC", 1000)
+
+	postings := acc.PendingPostings()
+	suite.Len(postings, 5)
+	suite.Equal(ledger.OpReserve, postings[1].Operation)
+	suite.Equal(ref, postings[1].Ref)
+
+	available := ledger.Account{Owner: acc.GetID(), Bucket: ledger.Available}
+	reserved := ledger.Account{Owner: acc.GetID(), Bucket: ledger.Reserved}
+	suite.Equal(acc.Balances["BTC"].Available, ledger.Balance(postings, available, "BTC"))
+	suite.Equal(acc.Balances["BTC"].Reserved, ledger.Balance(postings, reserved, "BTC"))
+
+	suite.Len(acc.TakePostings(), 5)
+	suite.Empty(acc.PendingPostings())
+}
+
+func (suite *AccountUnitTestSuite) TestPinFeeTier() {
+	acc, _ := account.NewAccount(suite.propsFaker, idObjValue.Uuid)
+
+	acc.PinFeeTier("VIP")
+	suite.Equal("vip", acc.FeeTier)
+	suite.True(acc.FeeTierPinned)
+
+	acc.PinFeeTier("")
+	suite.Equal("", acc.FeeTier)
+	suite.False(acc.FeeTierPinned)
+}
+
+func (suite *AccountUnitTestSuite) TestSubAccount_Hierarchy() {
+	parent, _ := account.NewAccount(suite.propsFaker, idObjValue.Uuid)
+	child, _ := account.NewAccount(account.AccountProps{Name: "strategy-a", ParentID: parent.GetID()}, idObjValue.Uuid)
+	other, _ := account.NewAccount(account.AccountProps{Name: "other"}, idObjValue.Uuid)
+
+	suite.False(parent.IsSubAccount())
+	suite.True(child.IsSubAccount())
+	suite.Equal(parent.GetID(), child.ParentID)
+	suite.True(parent.IsParentOf(child))
+	suite.False(child.IsParentOf(parent))
+	suite.False(other.IsParentOf(child))
+	suite.False(parent.IsParentOf(other))
+	suite.False(parent.IsParentOf(nil))
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, new(AccountUnitTestSuite))
 }
@@ -4,4 +4,5 @@ type IAccountRepository interface {
 	Create(account *Account) error
 	Save(account *Account) error
 	Get(id string) (*Account, error)
+	List() ([]*Account, error)
 }
@@ -0,0 +1,34 @@
+package services
+
+import (
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+// Authorize lets a caller act on its own account and on every sub-account
+// below it. An empty caller means the request did not go through
+// authentication and is always allowed.
+func Authorize(repo account.IAccountRepository, callerID, accountID string) error {
+	if callerID == "" {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+
+	for id := accountID; id != "" && !seen[id]; {
+		if id == callerID {
+			return nil
+		}
+
+		seen[id] = true
+
+		acct, err := repo.Get(id)
+		if err != nil {
+			return err
+		}
+
+		id = acct.ParentID
+	}
+
+	return shared.ErrForbidden
+}
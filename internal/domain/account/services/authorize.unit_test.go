@@ -0,0 +1,72 @@
+//go:build all || unit || domain
+
+package services_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	"github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	"github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type AuthorizeUnitTestSuite struct {
+	suite.Suite
+	ctrl *gomock.Controller
+	repo *mocks.MockIAccountRepository
+}
+
+func (suite *AuthorizeUnitTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.repo = mocks.NewMockIAccountRepository(suite.ctrl)
+
+	accounts := map[string]*account.Account{
+		"firm":       {},
+		"strategy":   {ParentID: "firm"},
+		"sub-strat":  {ParentID: "strategy"},
+		"outsider":   {},
+		"loop-a":     {ParentID: "loop-b"},
+		"loop-b":     {ParentID: "loop-a"},
+		"orphan-sub": {ParentID: "missing"},
+	}
+
+	suite.repo.EXPECT().Get(gomock.Any()).DoAndReturn(func(id string) (*account.Account, error) {
+		acct, ok := accounts[id]
+		if !ok {
+			return nil, shared.ErrNotFound
+		}
+
+		return acct, nil
+	}).AnyTimes()
+}
+
+func (suite *AuthorizeUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *AuthorizeUnitTestSuite) TestAuthorize_Self() {
+	suite.NoError(services.Authorize(suite.repo, "outsider", "outsider"))
+}
+
+func (suite *AuthorizeUnitTestSuite) TestAuthorize_Ancestors() {
+	suite.NoError(services.Authorize(suite.repo, "firm", "strategy"))
+	suite.NoError(services.Authorize(suite.repo, "firm", "sub-strat"))
+	suite.NoError(services.Authorize(suite.repo, "strategy", "sub-strat"))
+}
+
+func (suite *AuthorizeUnitTestSuite) TestAuthorize_Forbidden() {
+	suite.ErrorIs(services.Authorize(suite.repo, "strategy", "firm"), shared.ErrForbidden)
+	suite.ErrorIs(services.Authorize(suite.repo, "outsider", "strategy"), shared.ErrForbidden)
+	suite.ErrorIs(services.Authorize(suite.repo, "firm", "loop-a"), shared.ErrForbidden)
+}
+
+func (suite *AuthorizeUnitTestSuite) TestAuthorize_Unauthenticated() {
+	suite.NoError(services.Authorize(suite.repo, "", "firm"))
+}
+
+func (suite *AuthorizeUnitTestSuite) TestAuthorize_NotFound() {
+	suite.ErrorIs(services.Authorize(suite.repo, "firm", "unknown"), shared.ErrNotFound)
+	suite.ErrorIs(services.Authorize(suite.repo, "firm", "orphan-sub"), shared.ErrNotFound)
+}
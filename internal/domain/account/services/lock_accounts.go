@@ -0,0 +1,39 @@
+package services
+
+import (
+	"sort"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+)
+
+// LockAccounts locks each distinct account in ID order and returns the
+// unlock. Every balance or fee tier change happens under it, and a caller
+// that also needs books locks them first, so two callers never wait on
+// each other's locks.
+func LockAccounts(accts ...*account.Account) func() {
+	unique := make([]*account.Account, 0, len(accts))
+	seen := make(map[*account.Account]bool, len(accts))
+
+	for _, acct := range accts {
+		if acct == nil || seen[acct] {
+			continue
+		}
+
+		seen[acct] = true
+		unique = append(unique, acct)
+	}
+
+	sort.Slice(unique, func(i, j int) bool {
+		return unique[i].GetID() < unique[j].GetID()
+	})
+
+	for _, acct := range unique {
+		acct.Lock()
+	}
+
+	return func() {
+		for i := len(unique) - 1; i >= 0; i-- {
+			unique[i].Unlock()
+		}
+	}
+}
@@ -0,0 +1,57 @@
+//go:build all || unit || domain
+
+package services_test
+
+import (
+	"sync"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	"github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type LockAccountsUnitTestSuite struct {
+	suite.Suite
+	a *account.Account
+	b *account.Account
+}
+
+func (suite *LockAccountsUnitTestSuite) SetupTest() {
+	suite.a, _ = account.NewAccount(account.AccountProps{Name: "a"}, idObjValue.Uuid)
+	suite.b, _ = account.NewAccount(account.AccountProps{Name: "b"}, idObjValue.Uuid)
+	_ = suite.a.Credit("BTC", 1000)
+	_ = suite.b.Credit("BTC", 1000)
+}
+
+func (suite *LockAccountsUnitTestSuite) TestLockAccounts_OppositeTransfersDoNotDeadlock() {
+	var wg sync.WaitGroup
+
+	move := func(from, to *account.Account) {
+		defer wg.Done()
+
+		for range 200 {
+			unlock := services.LockAccounts(from, to)
+			_ = services.Transfer(from, to, "BTC", 1)
+			unlock()
+		}
+	}
+
+	wg.Add(2)
+
+	go move(suite.a, suite.b)
+	go move(suite.b, suite.a)
+
+	wg.Wait()
+
+	suite.Equal(int64(2000), suite.a.Balances["BTC"].Available+suite.b.Balances["BTC"].Available)
+}
+
+func (suite *LockAccountsUnitTestSuite) TestLockAccounts_SameAccountTwice() {
+	unlock := services.LockAccounts(suite.a, suite.a, nil)
+	unlock()
+
+	unlock = services.LockAccounts(suite.a)
+	unlock()
+}
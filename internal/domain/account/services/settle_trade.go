@@ -1,53 +1,152 @@
 package services
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	"github.com/juninhoitabh/clob-go/internal/domain/fee"
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	"github.com/juninhoitabh/clob-go/internal/domain/order"
 	"github.com/juninhoitabh/clob-go/internal/shared"
 )
 
+var ErrFeeAccountTaken = errors.New("fee account id belongs to a user account")
+
+// SettleTrade moves the funds of one trade between its counterparties and
+// collects its fees. The fees are worked out and the fee account looked up
+// before either counterparty changes, so a fee account that cannot be used
+// fails the trade before any money moves rather than after.
 func SettleTrade(
 	repo account.IAccountRepository,
 	buyerID, sellerID string,
 	base, quote string,
 	price, qty int64,
-) error {
+	takerSide order.Side,
+	schedule *fee.Schedule,
+	feeAccountID string,
+	ref ...ledger.Ref,
+) (fee.TradeFees, error) {
 	cost := shared.Mul(price, qty)
 
 	buyerAcct, err := repo.Get(buyerID)
 	if err != nil {
-		return shared.ErrNotFound
+		return fee.TradeFees{}, shared.ErrNotFound
 	}
 
 	sellerAcct, err := repo.Get(sellerID)
 	if err != nil {
-		return shared.ErrNotFound
+		return fee.TradeFees{}, shared.ErrNotFound
+	}
+
+	var fees fee.TradeFees
+
+	if schedule != nil {
+		fees = schedule.Compute(fee.TradeParams{
+			BuyerTier:  buyerAcct.FeeTier,
+			SellerTier: sellerAcct.FeeTier,
+			Base:       base,
+			Quote:      quote,
+			TakerSide:  takerSide,
+			Price:      price,
+			Qty:        qty,
+		})
+	}
+
+	var feeAcct *account.Account
+
+	if fees.CollectedBase() != 0 || fees.CollectedQuote() != 0 {
+		feeAcct, err = EnsureFeeAccount(repo, feeAccountID)
+		if err != nil {
+			return fee.TradeFees{}, err
+		}
+	}
+
+	if err := buyerAcct.UseReserved(quote, cost, ref...); err != nil {
+		return fee.TradeFees{}, fmt.Errorf("buyer use reserved: %w", err)
 	}
 
-	if err := buyerAcct.UseReserved(quote, cost); err != nil {
-		return fmt.Errorf("buyer use reserved: %w", err)
+	if err := creditIfPositive(buyerAcct, base, qty-fees.BuyerFee, ref...); err != nil {
+		return fee.TradeFees{}, fmt.Errorf("transfer base to buyer: %w", err)
 	}
 
-	if err := buyerAcct.Credit(base, qty); err != nil {
-		return fmt.Errorf("transfer base to buyer: %w", err)
+	if err := creditIfPositive(buyerAcct, quote, fees.BuyerRebate, ref...); err != nil {
+		return fee.TradeFees{}, fmt.Errorf("rebate quote to buyer: %w", err)
 	}
 
 	if err := repo.Save(buyerAcct); err != nil {
-		return err
+		return fee.TradeFees{}, err
 	}
 
-	if err := sellerAcct.UseReserved(base, qty); err != nil {
-		return fmt.Errorf("seller use reserved: %w", err)
+	if err := sellerAcct.UseReserved(base, qty, ref...); err != nil {
+		return fee.TradeFees{}, fmt.Errorf("seller use reserved: %w", err)
 	}
 
-	if err := sellerAcct.Credit(quote, cost); err != nil {
-		return fmt.Errorf("transfer quote to seller: %w", err)
+	if err := creditIfPositive(sellerAcct, quote, cost-fees.SellerFee, ref...); err != nil {
+		return fee.TradeFees{}, fmt.Errorf("transfer quote to seller: %w", err)
+	}
+
+	if err := creditIfPositive(sellerAcct, base, fees.SellerRebate, ref...); err != nil {
+		return fee.TradeFees{}, fmt.Errorf("rebate base to seller: %w", err)
 	}
 
 	if err := repo.Save(sellerAcct); err != nil {
-		return err
+		return fee.TradeFees{}, err
+	}
+
+	if feeAcct == nil {
+		return fees, nil
+	}
+
+	if err := creditIfPositive(feeAcct, base, fees.CollectedBase(), ref...); err != nil {
+		return fee.TradeFees{}, fmt.Errorf("collect base fee: %w", err)
+	}
+
+	if err := creditIfPositive(feeAcct, quote, fees.CollectedQuote(), ref...); err != nil {
+		return fee.TradeFees{}, fmt.Errorf("collect quote fee: %w", err)
+	}
+
+	if err := repo.Save(feeAcct); err != nil {
+		return fee.TradeFees{}, err
+	}
+
+	return fees, nil
+}
+
+func creditIfPositive(acct *account.Account, asset string, amount int64, ref ...ledger.Ref) error {
+	if amount == 0 {
+		return nil
+	}
+
+	return acct.Credit(asset, amount, ref...)
+}
+
+// EnsureFeeAccount returns the fee account, creating it as a system
+// account if it does not exist yet. The server calls it at startup; an ID
+// already held by a user account is refused rather than collected into.
+func EnsureFeeAccount(repo account.IAccountRepository, feeAccountID string) (*account.Account, error) {
+	if feeAccountID == "" {
+		return nil, shared.ErrInvalidParam
+	}
+
+	feeAcct, err := repo.Get(feeAccountID)
+	if err == nil {
+		if !feeAcct.System {
+			return nil, ErrFeeAccountTaken
+		}
+
+		return feeAcct, nil
+	}
+
+	feeAcct, err = account.NewSystemAccount(feeAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = repo.Create(feeAcct)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return feeAcct, nil
 }
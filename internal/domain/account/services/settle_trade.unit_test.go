@@ -11,6 +11,9 @@ import (
 	"github.com/juninhoitabh/clob-go/internal/domain/account"
 	"github.com/juninhoitabh/clob-go/internal/domain/account/fakers"
 	"github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	"github.com/juninhoitabh/clob-go/internal/domain/fee"
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	"github.com/juninhoitabh/clob-go/internal/domain/order"
 	"github.com/juninhoitabh/clob-go/internal/infra/repositories/account/mocks"
 	"github.com/juninhoitabh/clob-go/internal/shared"
 	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
@@ -60,7 +63,7 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_Success() {
 	suite.accountRepoMock.EXPECT().Save(suite.buyer).Return(nil)
 	suite.accountRepoMock.EXPECT().Save(suite.seller).Return(nil)
 
-	err := services.SettleTrade(
+	_, err := services.SettleTrade(
 		suite.accountRepoMock,
 		params.BuyerID,
 		params.SellerID,
@@ -68,6 +71,9 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_Success() {
 		params.Quote,
 		params.Price,
 		params.Qty,
+		order.Buy,
+		nil,
+		"",
 	)
 	suite.NoError(err)
 }
@@ -77,7 +83,7 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_BuyerNotFound() {
 
 	suite.accountRepoMock.EXPECT().Get(params.BuyerID).Return(nil, shared.ErrNotFound)
 
-	err := services.SettleTrade(
+	_, err := services.SettleTrade(
 		suite.accountRepoMock,
 		params.BuyerID,
 		params.SellerID,
@@ -85,6 +91,9 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_BuyerNotFound() {
 		params.Quote,
 		params.Price,
 		params.Qty,
+		order.Buy,
+		nil,
+		"",
 	)
 	suite.ErrorIs(err, shared.ErrNotFound)
 }
@@ -95,7 +104,7 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_SellerNotFound() {
 	suite.accountRepoMock.EXPECT().Get(params.BuyerID).Return(suite.buyer, nil)
 	suite.accountRepoMock.EXPECT().Get(params.SellerID).Return(nil, shared.ErrNotFound)
 
-	err := services.SettleTrade(
+	_, err := services.SettleTrade(
 		suite.accountRepoMock,
 		params.BuyerID,
 		params.SellerID,
@@ -103,6 +112,9 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_SellerNotFound() {
 		params.Quote,
 		params.Price,
 		params.Qty,
+		order.Buy,
+		nil,
+		"",
 	)
 	suite.ErrorIs(err, shared.ErrNotFound)
 }
@@ -114,7 +126,7 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_SaveBuyerError() {
 	suite.accountRepoMock.EXPECT().Get(params.SellerID).Return(suite.seller, nil)
 	suite.accountRepoMock.EXPECT().Save(suite.buyer).Return(errors.New("save buyer error"))
 
-	err := services.SettleTrade(
+	_, err := services.SettleTrade(
 		suite.accountRepoMock,
 		params.BuyerID,
 		params.SellerID,
@@ -122,6 +134,9 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_SaveBuyerError() {
 		params.Quote,
 		params.Price,
 		params.Qty,
+		order.Buy,
+		nil,
+		"",
 	)
 	suite.ErrorContains(err, "save buyer error")
 }
@@ -134,7 +149,7 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_SaveSellerError() {
 	suite.accountRepoMock.EXPECT().Save(suite.buyer).Return(nil)
 	suite.accountRepoMock.EXPECT().Save(suite.seller).Return(errors.New("save seller error"))
 
-	err := services.SettleTrade(
+	_, err := services.SettleTrade(
 		suite.accountRepoMock,
 		params.BuyerID,
 		params.SellerID,
@@ -142,6 +157,9 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_SaveSellerError() {
 		params.Quote,
 		params.Price,
 		params.Qty,
+		order.Buy,
+		nil,
+		"",
 	)
 	suite.ErrorContains(err, "save seller error")
 }
@@ -153,7 +171,7 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_ErrorBuyerUseReserved() {
 	suite.accountRepoMock.EXPECT().Get(params.BuyerID).Return(suite.buyer, nil)
 	suite.accountRepoMock.EXPECT().Get(params.SellerID).Return(suite.seller, nil)
 
-	err := services.SettleTrade(
+	_, err := services.SettleTrade(
 		suite.accountRepoMock,
 		params.BuyerID,
 		params.SellerID,
@@ -161,6 +179,9 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_ErrorBuyerUseReserved() {
 		params.Quote,
 		params.Price,
 		params.Qty,
+		order.Buy,
+		nil,
+		"",
 	)
 	suite.Error(err)
 	suite.Contains(err.Error(), "buyer use reserved")
@@ -182,7 +203,7 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_ErrorSellerUseReserved()
 	suite.accountRepoMock.EXPECT().Get(params.SellerID).Return(suite.seller, nil)
 	suite.accountRepoMock.EXPECT().Save(suite.buyer).Return(nil)
 
-	err := services.SettleTrade(
+	_, err := services.SettleTrade(
 		suite.accountRepoMock,
 		params.BuyerID,
 		params.SellerID,
@@ -190,6 +211,9 @@ func (suite *SettleTradeUnitTestSuite) TestSettleTrade_ErrorSellerUseReserved()
 		params.Quote,
 		params.Price,
 		params.Qty,
+		order.Buy,
+		nil,
+		"",
 	)
 	suite.Error(err)
 	suite.Contains(err.Error(), "seller use reserved")
@@ -202,3 +226,192 @@ type sellerWithCreditError struct {
 func (a *sellerWithCreditError) Credit(asset string, amount int64) error {
 	return account.ErrInvalidParam
 }
+
+func (suite *SettleTradeUnitTestSuite) TestSettleTrade_TakerBuyerFees() {
+	suite.buyer.Balances["USDT"] = &account.Balance{Reserved: 10000}
+	suite.seller.Balances["BTC"] = &account.Balance{Reserved: 1000}
+
+	schedule, _ := fee.NewSchedule(fee.ScheduleProps{
+		Instrument: "BTC/USDT",
+		Rates:      fee.Rates{MakerBps: 10, TakerBps: 20},
+	})
+	feeAccount, _ := account.NewSystemAccount("fees")
+
+	suite.accountRepoMock.EXPECT().Get(suite.params.BuyerID).Return(suite.buyer, nil)
+	suite.accountRepoMock.EXPECT().Get(suite.params.SellerID).Return(suite.seller, nil)
+	suite.accountRepoMock.EXPECT().Get("fees").Return(feeAccount, nil)
+	suite.accountRepoMock.EXPECT().Save(suite.buyer).Return(nil)
+	suite.accountRepoMock.EXPECT().Save(suite.seller).Return(nil)
+	suite.accountRepoMock.EXPECT().Save(feeAccount).Return(nil)
+
+	fees, err := services.SettleTrade(
+		suite.accountRepoMock,
+		suite.params.BuyerID,
+		suite.params.SellerID,
+		"BTC",
+		"USDT",
+		10,
+		1000,
+		order.Buy,
+		schedule,
+		"fees",
+	)
+	suite.NoError(err)
+	suite.Equal(int64(2), fees.TakerFee)
+	suite.Equal(int64(10), fees.MakerFee)
+	suite.Equal(int64(998), suite.buyer.Balances["BTC"].Available)
+	suite.Equal(int64(9990), suite.seller.Balances["USDT"].Available)
+	suite.Equal(int64(2), feeAccount.Balances["BTC"].Available)
+	suite.Equal(int64(10), feeAccount.Balances["USDT"].Available)
+}
+
+func (suite *SettleTradeUnitTestSuite) TestSettleTrade_MakerBuyerRebate() {
+	suite.buyer.Balances["USDT"] = &account.Balance{Reserved: 10000}
+	suite.seller.Balances["BTC"] = &account.Balance{Reserved: 1000}
+	suite.buyer.SetFeeTier("VIP")
+
+	schedule, _ := fee.NewSchedule(fee.ScheduleProps{
+		Instrument: "BTC/USDT",
+		Rates:      fee.Rates{MakerBps: 10, TakerBps: 30},
+		Tiers:      map[string]fee.Rates{"vip": {MakerBps: -10, TakerBps: 20}},
+	})
+
+	suite.accountRepoMock.EXPECT().Get(suite.params.BuyerID).Return(suite.buyer, nil)
+	suite.accountRepoMock.EXPECT().Get(suite.params.SellerID).Return(suite.seller, nil)
+	suite.accountRepoMock.EXPECT().Get("fees").Return(nil, shared.ErrNotFound)
+	suite.accountRepoMock.EXPECT().Create(gomock.Any()).Return(nil)
+	suite.accountRepoMock.EXPECT().Save(gomock.Any()).Return(nil).Times(3)
+
+	fees, err := services.SettleTrade(
+		suite.accountRepoMock,
+		suite.params.BuyerID,
+		suite.params.SellerID,
+		"BTC",
+		"USDT",
+		10,
+		1000,
+		order.Sell,
+		schedule,
+		"fees",
+	)
+	suite.NoError(err)
+	suite.Equal(int64(30), fees.TakerFee)
+	suite.Equal("USDT", fees.TakerFeeAsset)
+	suite.Equal(int64(-10), fees.MakerFee)
+	suite.Equal("USDT", fees.MakerFeeAsset)
+	suite.Equal(int64(1000), suite.buyer.Balances["BTC"].Available)
+	suite.Equal(int64(10), suite.buyer.Balances["USDT"].Available)
+	suite.Equal(int64(9970), suite.seller.Balances["USDT"].Available)
+}
+
+func (suite *SettleTradeUnitTestSuite) TestSettleTrade_CreateFeeAccountError() {
+	suite.buyer.Balances["USDT"] = &account.Balance{Reserved: 10000}
+	suite.seller.Balances["BTC"] = &account.Balance{Reserved: 1000}
+
+	schedule, _ := fee.NewSchedule(fee.ScheduleProps{
+		Instrument: "BTC/USDT",
+		Rates:      fee.Rates{TakerBps: 20},
+	})
+
+	suite.accountRepoMock.EXPECT().Get(suite.params.BuyerID).Return(suite.buyer, nil)
+	suite.accountRepoMock.EXPECT().Get(suite.params.SellerID).Return(suite.seller, nil)
+	suite.accountRepoMock.EXPECT().Get("fees").Return(nil, shared.ErrNotFound)
+	suite.accountRepoMock.EXPECT().Create(gomock.Any()).Return(errors.New("create error"))
+
+	_, err := services.SettleTrade(
+		suite.accountRepoMock,
+		suite.params.BuyerID,
+		suite.params.SellerID,
+		"BTC",
+		"USDT",
+		10,
+		1000,
+		order.Buy,
+		schedule,
+		"fees",
+	)
+	suite.EqualError(err, "create error")
+
+	// Neither counterparty was touched.
+	suite.Equal(int64(10000), suite.buyer.Balances["USDT"].Reserved)
+	suite.Equal(int64(1000), suite.seller.Balances["BTC"].Reserved)
+}
+
+func (suite *SettleTradeUnitTestSuite) TestSettleTrade_FeeAccountIDHeldByUserAccount() {
+	suite.buyer.Balances["USDT"] = &account.Balance{Reserved: 10000}
+	suite.seller.Balances["BTC"] = &account.Balance{Reserved: 1000}
+
+	schedule, _ := fee.NewSchedule(fee.ScheduleProps{
+		Instrument: "BTC/USDT",
+		Rates:      fee.Rates{TakerBps: 20},
+	})
+	squatter, _ := account.NewAccount(account.AccountProps{Name: "fees"}, idObjValue.Str)
+
+	suite.accountRepoMock.EXPECT().Get(suite.params.BuyerID).Return(suite.buyer, nil)
+	suite.accountRepoMock.EXPECT().Get(suite.params.SellerID).Return(suite.seller, nil)
+	suite.accountRepoMock.EXPECT().Get("fees").Return(squatter, nil)
+
+	_, err := services.SettleTrade(
+		suite.accountRepoMock,
+		suite.params.BuyerID,
+		suite.params.SellerID,
+		"BTC",
+		"USDT",
+		10,
+		1000,
+		order.Buy,
+		schedule,
+		"fees",
+	)
+	suite.ErrorIs(err, services.ErrFeeAccountTaken)
+	suite.Equal(int64(10000), suite.buyer.Balances["USDT"].Reserved)
+	suite.Empty(squatter.Balances)
+}
+
+func (suite *SettleTradeUnitTestSuite) TestSettleTrade_PostsBalancedClearing() {
+	_ = suite.buyer.Credit("USDT", 10000)
+	_ = suite.buyer.Reserve("USDT", 10000)
+	_ = suite.seller.Credit("BTC", 1000)
+	_ = suite.seller.Reserve("BTC", 1000)
+
+	schedule, _ := fee.NewSchedule(fee.ScheduleProps{
+		Instrument: "BTC/USDT",
+		Rates:      fee.Rates{MakerBps: 10, TakerBps: 20},
+	})
+	feeAccount, _ := account.NewSystemAccount("fees")
+
+	suite.accountRepoMock.EXPECT().Get(suite.params.BuyerID).Return(suite.buyer, nil)
+	suite.accountRepoMock.EXPECT().Get(suite.params.SellerID).Return(suite.seller, nil)
+	suite.accountRepoMock.EXPECT().Get("fees").Return(feeAccount, nil)
+	suite.accountRepoMock.EXPECT().Save(gomock.Any()).Return(nil).Times(3)
+
+	_, err := services.SettleTrade(
+		suite.accountRepoMock,
+		suite.params.BuyerID,
+		suite.params.SellerID,
+		"BTC",
+		"USDT",
+		10,
+		1000,
+		order.Buy,
+		schedule,
+		"fees",
+		ledger.TradeRef("t1"),
+	)
+	suite.NoError(err)
+
+	var postings []ledger.Posting
+
+	for _, acct := range []*account.Account{suite.buyer, suite.seller, feeAccount} {
+		for _, p := range acct.PendingPostings() {
+			if p.Ref == ledger.TradeRef("t1") {
+				postings = append(postings, p)
+			}
+		}
+	}
+
+	clearing := ledger.Contra(ledger.TradeRef("t1"))
+	suite.Len(postings, 6)
+	suite.Equal(int64(0), ledger.Balance(postings, clearing, "BTC"))
+	suite.Equal(int64(0), ledger.Balance(postings, clearing, "USDT"))
+}
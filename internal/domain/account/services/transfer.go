@@ -1,15 +1,18 @@
 package services
 
-import "github.com/juninhoitabh/clob-go/internal/domain/account"
+import (
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
+)
 
-func Transfer(from, to *account.Account, asset string, amount int64) error {
-	if err := from.Reserve(asset, amount); err != nil {
+func Transfer(from, to *account.Account, asset string, amount int64, ref ...ledger.Ref) error {
+	if err := from.Reserve(asset, amount, ref...); err != nil {
 		return err
 	}
 
-	if err := from.UseReserved(asset, amount); err != nil {
+	if err := from.UseReserved(asset, amount, ref...); err != nil {
 		return err
 	}
 
-	return to.Credit(asset, amount)
+	return to.Credit(asset, amount, ref...)
 }
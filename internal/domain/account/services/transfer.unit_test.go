@@ -7,6 +7,7 @@ import (
 
 	"github.com/juninhoitabh/clob-go/internal/domain/account"
 	"github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
 	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
 	"github.com/stretchr/testify/suite"
 )
@@ -74,7 +75,23 @@ func (suite *TransferUnitTestSuite) TestTransfer_CreditError() {
 	suite.ErrorIs(err, account.ErrInvalidParam)
 }
 
+func (suite *TransferUnitTestSuite) TestTransfer_PostsThroughClearing() {
+	suite.from.Credit("BTC", 100)
+
+	err := services.Transfer(suite.from, suite.to, "BTC", 40, ledger.TransferRef("x1"))
+	suite.NoError(err)
+
+	postings := append(suite.from.PendingPostings(), suite.to.PendingPostings()...)
+	clearing := ledger.Contra(ledger.TransferRef("x1"))
+
+	suite.Equal(int64(0), ledger.Balance(postings, clearing, "BTC"))
+	suite.Equal(int64(60), ledger.Balance(postings, ledger.Account{Owner: suite.from.GetID(), Bucket: ledger.Available}, "BTC"))
+	suite.Equal(int64(40), ledger.Balance(postings, ledger.Account{Owner: suite.to.GetID(), Bucket: ledger.Available}, "BTC"))
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, new(SettleTradeUnitTestSuite))
 	suite.Run(t, new(TransferUnitTestSuite))
+	suite.Run(t, new(LockAccountsUnitTestSuite))
+	suite.Run(t, new(AuthorizeUnitTestSuite))
 }
@@ -0,0 +1,175 @@
+package apikey
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	baseEntity "github.com/juninhoitabh/clob-go/internal/shared/domain/entities"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+const (
+	HeaderKey       = "X-API-Key"
+	HeaderTimestamp = "X-API-Timestamp"
+	HeaderSignature = "X-API-Signature"
+
+	secretBytes = 32
+)
+
+const (
+	RoleReadOnly Role = "read-only"
+	RoleTrader   Role = "trader"
+	RoleOperator Role = "operator"
+	RoleAdmin    Role = "admin"
+)
+
+var (
+	ErrInvalidAPIKey = errors.New("invalid api key")
+	ErrInvalidRole   = errors.New("invalid role")
+)
+
+var roleRanks = map[Role]int{
+	RoleReadOnly: 1,
+	RoleTrader:   2,
+	RoleOperator: 3,
+	RoleAdmin:    4,
+}
+
+type (
+	Role        string
+	APIKeyProps struct {
+		AccountID string
+		Role      Role
+	}
+	APIKey struct {
+		CreatedAt time.Time
+		baseEntity.BaseEntity
+		AccountID string
+		Secret    string
+		Role      Role
+	}
+)
+
+func ParseRole(value string) (Role, error) {
+	role := Role(strings.ToLower(value))
+	if role == "" {
+		return RoleTrader, nil
+	}
+
+	if _, ok := roleRanks[role]; !ok {
+		return "", ErrInvalidRole
+	}
+
+	return role, nil
+}
+
+// Allows reports whether the role grants at least the permissions of required.
+// Roles are ordered read-only < trader < operator < admin.
+func (r Role) Allows(required Role) bool {
+	rank, ok := roleRanks[r]
+
+	return ok && rank >= roleRanks[required]
+}
+
+func (k *APIKey) Prepare(typeId idObjValue.TypeIdEnum) error {
+	err := k.Validate()
+	if err != nil {
+		return err
+	}
+
+	if k.Secret == "" {
+		secret := make([]byte, secretBytes)
+
+		_, err = rand.Read(secret)
+		if err != nil {
+			return err
+		}
+
+		k.Secret = hex.EncodeToString(secret)
+	}
+
+	k.BaseEntity.NewBaseEntity("", typeId)
+
+	k.CreatedAt = time.Now()
+
+	return nil
+}
+
+func (k *APIKey) Validate() error {
+	if k.AccountID == "" {
+		return ErrInvalidAPIKey
+	}
+
+	if _, ok := roleRanks[k.Role]; !ok {
+		return ErrInvalidRole
+	}
+
+	return nil
+}
+
+// Verify reports whether signature is the HMAC of the request under this key's
+// secret.
+func (k *APIKey) Verify(signature string, timestamp int64, method, path string, body []byte) bool {
+	expected := Sign(k.Secret, timestamp, method, path, body)
+
+	return hmac.Equal([]byte(expected), []byte(strings.ToLower(signature)))
+}
+
+// Sign returns the hex HMAC-SHA256 of
+// "<unix timestamp>\n<METHOD>\n<path with query>\n<hex sha256 of body>".
+func Sign(secret string, timestamp int64, method, path string, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("\n" + strings.ToUpper(method) + "\n" + path + "\n"))
+	mac.Write([]byte(hex.EncodeToString(bodyHash[:])))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func NewAPIKey(props APIKeyProps, typeId idObjValue.TypeIdEnum) (*APIKey, error) {
+	key := APIKey{
+		AccountID: props.AccountID,
+		Role:      props.Role,
+	}
+
+	if key.Role == "" {
+		key.Role = RoleTrader
+	}
+
+	err := key.Prepare(typeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// RestoreAPIKey rebuilds a key provisioned out of band, such as the bootstrap
+// admin key read from the environment.
+func RestoreAPIKey(id, secret string, props APIKeyProps) (*APIKey, error) {
+	if id == "" || secret == "" {
+		return nil, ErrInvalidAPIKey
+	}
+
+	key := APIKey{
+		BaseEntity: baseEntity.BaseEntity{ID: idObjValue.ID{ID: id}},
+		AccountID:  props.AccountID,
+		Secret:     secret,
+		Role:       props.Role,
+	}
+
+	err := key.Prepare(idObjValue.Str)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
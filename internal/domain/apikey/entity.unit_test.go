@@ -0,0 +1,113 @@
+//go:build all || unit || domain
+
+package apikey_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	"github.com/juninhoitabh/clob-go/internal/domain/apikey/fakers"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type APIKeyUnitTestSuite struct {
+	suite.Suite
+	propsFaker apikey.APIKeyProps
+}
+
+func (suite *APIKeyUnitTestSuite) SetupTest() {
+	suite.propsFaker = fakers.APIKeyPropsFaker()
+}
+
+func (suite *APIKeyUnitTestSuite) TestNewAPIKey_Success() {
+	key, err := apikey.NewAPIKey(suite.propsFaker, idObjValue.Uuid)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), key.GetID())
+	assert.Equal(suite.T(), suite.propsFaker.AccountID, key.AccountID)
+	assert.Len(suite.T(), key.Secret, 64)
+	assert.NotZero(suite.T(), key.CreatedAt)
+	assert.Equal(suite.T(), apikey.RoleTrader, key.Role)
+
+	other, _ := apikey.NewAPIKey(suite.propsFaker, idObjValue.Uuid)
+	assert.NotEqual(suite.T(), key.Secret, other.Secret)
+}
+
+func (suite *APIKeyUnitTestSuite) TestNewAPIKey_MissingAccount() {
+	key, err := apikey.NewAPIKey(apikey.APIKeyProps{}, idObjValue.Uuid)
+	assert.ErrorIs(suite.T(), err, apikey.ErrInvalidAPIKey)
+	assert.Nil(suite.T(), key)
+}
+
+func (suite *APIKeyUnitTestSuite) TestNewAPIKey_InvalidRole() {
+	props := suite.propsFaker
+	props.Role = "root"
+
+	key, err := apikey.NewAPIKey(props, idObjValue.Uuid)
+	assert.ErrorIs(suite.T(), err, apikey.ErrInvalidRole)
+	assert.Nil(suite.T(), key)
+}
+
+func (suite *APIKeyUnitTestSuite) TestRestoreAPIKey() {
+	key, err := apikey.RestoreAPIKey("bootstrap", "s3cret", apikey.APIKeyProps{AccountID: "admin", Role: apikey.RoleAdmin})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "bootstrap", key.GetID())
+	assert.Equal(suite.T(), "s3cret", key.Secret)
+	assert.Equal(suite.T(), apikey.RoleAdmin, key.Role)
+
+	_, err = apikey.RestoreAPIKey("", "s3cret", apikey.APIKeyProps{AccountID: "admin", Role: apikey.RoleAdmin})
+	assert.ErrorIs(suite.T(), err, apikey.ErrInvalidAPIKey)
+
+	_, err = apikey.RestoreAPIKey("bootstrap", "s3cret", apikey.APIKeyProps{AccountID: "admin"})
+	assert.ErrorIs(suite.T(), err, apikey.ErrInvalidRole)
+}
+
+func (suite *APIKeyUnitTestSuite) TestParseRole() {
+	role, err := apikey.ParseRole("")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), apikey.RoleTrader, role)
+
+	role, err = apikey.ParseRole("Operator")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), apikey.RoleOperator, role)
+
+	_, err = apikey.ParseRole("root")
+	assert.ErrorIs(suite.T(), err, apikey.ErrInvalidRole)
+}
+
+func (suite *APIKeyUnitTestSuite) TestRoleAllows() {
+	assert.True(suite.T(), apikey.RoleAdmin.Allows(apikey.RoleOperator))
+	assert.True(suite.T(), apikey.RoleOperator.Allows(apikey.RoleTrader))
+	assert.True(suite.T(), apikey.RoleTrader.Allows(apikey.RoleTrader))
+	assert.False(suite.T(), apikey.RoleTrader.Allows(apikey.RoleOperator))
+	assert.False(suite.T(), apikey.RoleReadOnly.Allows(apikey.RoleTrader))
+	assert.False(suite.T(), apikey.Role("root").Allows(apikey.RoleReadOnly))
+}
+
+func (suite *APIKeyUnitTestSuite) TestSign_CoversEveryField() {
+	signature := apikey.Sign("secret", 1700000000, "post", "/api/v1/orders", []byte(`{"qty":1}`))
+	assert.Len(suite.T(), signature, 64)
+	assert.Equal(suite.T(), signature, apikey.Sign("secret", 1700000000, "POST", "/api/v1/orders", []byte(`{"qty":1}`)))
+	assert.NotEqual(suite.T(), signature, apikey.Sign("secret", 1700000001, "POST", "/api/v1/orders", []byte(`{"qty":1}`)))
+	assert.NotEqual(suite.T(), signature, apikey.Sign("secret", 1700000000, "POST", "/api/v1/orders?x=1", []byte(`{"qty":1}`)))
+	assert.NotEqual(suite.T(), signature, apikey.Sign("secret", 1700000000, "POST", "/api/v1/orders", []byte(`{"qty":2}`)))
+	assert.NotEqual(suite.T(), signature, apikey.Sign("other", 1700000000, "POST", "/api/v1/orders", []byte(`{"qty":1}`)))
+}
+
+func (suite *APIKeyUnitTestSuite) TestVerify() {
+	key, _ := apikey.NewAPIKey(suite.propsFaker, idObjValue.Uuid)
+	body := []byte(`{"asset":"USDT","amount":10}`)
+	signature := apikey.Sign(key.Secret, 1700000000, "POST", "/api/v1/transfers", body)
+
+	assert.True(suite.T(), key.Verify(signature, 1700000000, "POST", "/api/v1/transfers", body))
+	assert.True(suite.T(), key.Verify(strings.ToUpper(signature), 1700000000, "POST", "/api/v1/transfers", body))
+	assert.False(suite.T(), key.Verify(signature, 1700000000, "POST", "/api/v1/transfers", []byte(`{}`)))
+	assert.False(suite.T(), key.Verify("", 1700000000, "POST", "/api/v1/transfers", body))
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyUnitTestSuite))
+}
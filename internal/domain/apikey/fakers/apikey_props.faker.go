@@ -0,0 +1,15 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/apikey"
+)
+
+func APIKeyPropsFaker() apikey.APIKeyProps {
+	faker := faker.New(0)
+
+	return apikey.APIKeyProps{
+		AccountID: faker.UUID(),
+	}
+}
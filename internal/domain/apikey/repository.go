@@ -0,0 +1,7 @@
+package apikey
+
+type IAPIKeyRepository interface {
+	SaveAPIKey(key *APIKey) error
+	GetAPIKey(id string) (*APIKey, error)
+	ListAPIKeysByAccount(accountID string) ([]*APIKey, error)
+}
@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	baseEntity "github.com/juninhoitabh/clob-go/internal/shared/domain/entities"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+const AnonymousActor = "anonymous"
+
+var (
+	ErrInvalidEntry = errors.New("invalid audit entry")
+)
+
+type (
+	EntryProps struct {
+		Actor     string
+		AccountID string
+		Role      string
+		Action    string
+		Target    string
+		Before    json.RawMessage
+		After     json.RawMessage
+	}
+	Entry struct {
+		CreatedAt time.Time
+		baseEntity.BaseEntity
+		Actor     string
+		AccountID string
+		Role      string
+		Action    string
+		Target    string
+		Before    json.RawMessage
+		After     json.RawMessage
+	}
+)
+
+func (e *Entry) Prepare(typeId idObjValue.TypeIdEnum) error {
+	if e.Actor == "" {
+		e.Actor = AnonymousActor
+	}
+
+	err := e.Validate()
+	if err != nil {
+		return err
+	}
+
+	e.BaseEntity.NewBaseEntity("", typeId)
+
+	e.CreatedAt = time.Now()
+
+	return nil
+}
+
+func (e *Entry) Validate() error {
+	if e.Action == "" || e.Target == "" {
+		return ErrInvalidEntry
+	}
+
+	return nil
+}
+
+func NewEntry(props EntryProps, typeId idObjValue.TypeIdEnum) (*Entry, error) {
+	entry := Entry{
+		Actor:     props.Actor,
+		AccountID: props.AccountID,
+		Role:      props.Role,
+		Action:    props.Action,
+		Target:    props.Target,
+		Before:    props.Before,
+		After:     props.After,
+	}
+
+	err := entry.Prepare(typeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
@@ -0,0 +1,62 @@
+//go:build all || unit || domain
+
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/audit"
+	"github.com/juninhoitabh/clob-go/internal/domain/audit/fakers"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type EntryUnitTestSuite struct {
+	suite.Suite
+	propsFaker audit.EntryProps
+}
+
+func (suite *EntryUnitTestSuite) SetupTest() {
+	suite.propsFaker = fakers.EntryPropsFaker()
+}
+
+func (suite *EntryUnitTestSuite) TestNewEntry_Success() {
+	entry, err := audit.NewEntry(suite.propsFaker, idObjValue.Uuid)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), entry.GetID())
+	assert.NotZero(suite.T(), entry.CreatedAt)
+	assert.Equal(suite.T(), suite.propsFaker.Actor, entry.Actor)
+	assert.Equal(suite.T(), suite.propsFaker.Action, entry.Action)
+	assert.JSONEq(suite.T(), string(suite.propsFaker.Before), string(entry.Before))
+	assert.JSONEq(suite.T(), string(suite.propsFaker.After), string(entry.After))
+}
+
+func (suite *EntryUnitTestSuite) TestNewEntry_AnonymousActor() {
+	props := suite.propsFaker
+	props.Actor = ""
+
+	entry, err := audit.NewEntry(props, idObjValue.Uuid)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), audit.AnonymousActor, entry.Actor)
+}
+
+func (suite *EntryUnitTestSuite) TestNewEntry_Invalid() {
+	props := suite.propsFaker
+	props.Action = ""
+
+	entry, err := audit.NewEntry(props, idObjValue.Uuid)
+	assert.ErrorIs(suite.T(), err, audit.ErrInvalidEntry)
+	assert.Nil(suite.T(), entry)
+
+	props = suite.propsFaker
+	props.Target = ""
+
+	_, err = audit.NewEntry(props, idObjValue.Uuid)
+	assert.ErrorIs(suite.T(), err, audit.ErrInvalidEntry)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(EntryUnitTestSuite))
+}
@@ -0,0 +1,23 @@
+package fakers
+
+import (
+	"encoding/json"
+
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/audit"
+)
+
+func EntryPropsFaker() audit.EntryProps {
+	faker := faker.New(0)
+
+	return audit.EntryProps{
+		Actor:     faker.UUID(),
+		AccountID: faker.UUID(),
+		Role:      "admin",
+		Action:    "account.credit",
+		Target:    "account:" + faker.UUID(),
+		Before:    json.RawMessage(`{"USDT":{"Available":0,"Reserved":0}}`),
+		After:     json.RawMessage(`{"USDT":{"Available":1000,"Reserved":0}}`),
+	}
+}
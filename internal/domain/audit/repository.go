@@ -0,0 +1,15 @@
+package audit
+
+type (
+	Filter struct {
+		Actor  string
+		Action string
+		Target string
+		Offset int
+		Limit  int
+	}
+	IAuditRepository interface {
+		SaveEntry(entry *Entry) error
+		ListEntries(filter Filter) ([]*Entry, int, error)
+	}
+)
@@ -0,0 +1,229 @@
+package book
+
+import (
+	"time"
+
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+const bpsDenominator = 10000
+
+var haltedIndefinitely = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+var (
+	ErrPriceOutOfBand   = shared.NewRejectError("PRICE_OUT_OF_BAND", "price outside allowed band")
+	ErrInstrumentHalted = shared.NewRejectError("INSTRUMENT_HALTED", "instrument halted")
+)
+
+type (
+	CircuitBreakerProps struct {
+		ReferencePrice int64
+		BandBps        int64
+		MoveBps        int64
+		Window         time.Duration
+		HaltDuration   time.Duration
+	}
+	tradePrint struct {
+		At    time.Time
+		Price int64
+	}
+	// printQueue holds prints oldest first. Prints leave the back in place
+	// and the front by moving head past them; the live prints are moved
+	// down only once head passes half the buffer, so each print is copied
+	// at most once on average and the buffer is reused.
+	printQueue struct {
+		prints []tradePrint
+		head   int
+	}
+	// CircuitBreaker watches the prints of its window through two monotonic
+	// queues, one of rising and one of falling prices, whose fronts are the
+	// window's lowest and highest print. A trade that stays within MoveBps
+	// of both is within it of every print, so the check costs O(1)
+	// amortized however busy the window is.
+	CircuitBreaker struct {
+		HaltedUntil    time.Time
+		lows           printQueue
+		highs          printQueue
+		ReferencePrice int64
+		LastPrice      int64
+		BandBps        int64
+		MoveBps        int64
+		Window         time.Duration
+		HaltDuration   time.Duration
+	}
+)
+
+func (cb *CircuitBreaker) Configure(props CircuitBreakerProps) {
+	cb.ReferencePrice = props.ReferencePrice
+	cb.BandBps = props.BandBps
+	cb.MoveBps = props.MoveBps
+	cb.Window = props.Window
+	cb.HaltDuration = props.HaltDuration
+}
+
+func (cb *CircuitBreaker) Reference() int64 {
+	if cb.ReferencePrice > 0 {
+		return cb.ReferencePrice
+	}
+
+	return cb.LastPrice
+}
+
+func (cb *CircuitBreaker) IsHalted(now time.Time) bool {
+	return now.Before(cb.HaltedUntil)
+}
+
+func (cb *CircuitBreaker) Halt() {
+	cb.HaltedUntil = haltedIndefinitely
+}
+
+func (cb *CircuitBreaker) Trip(now time.Time) {
+	if cb.HaltDuration <= 0 {
+		cb.Halt()
+
+		return
+	}
+
+	cb.HaltedUntil = now.Add(cb.HaltDuration)
+}
+
+func (cb *CircuitBreaker) Resume() {
+	cb.HaltedUntil = time.Time{}
+	cb.lows.reset()
+	cb.highs.reset()
+}
+
+func (cb *CircuitBreaker) CheckPrice(price int64, now time.Time) error {
+	if cb.IsHalted(now) {
+		return ErrInstrumentHalted
+	}
+
+	reference := cb.Reference()
+	if cb.BandBps <= 0 || reference <= 0 {
+		return nil
+	}
+
+	if !withinBps(reference, price, cb.BandBps) {
+		return ErrPriceOutOfBand
+	}
+
+	return nil
+}
+
+func (cb *CircuitBreaker) AllowTrade(price int64, now time.Time) bool {
+	if cb.IsHalted(now) {
+		return false
+	}
+
+	if cb.MoveBps <= 0 {
+		return true
+	}
+
+	cb.evict(now)
+
+	if cb.lows.len() == 0 {
+		return true
+	}
+
+	if !withinBps(cb.lows.front().Price, price, cb.MoveBps) || !withinBps(cb.highs.front().Price, price, cb.MoveBps) {
+		cb.Trip(now)
+
+		return false
+	}
+
+	return true
+}
+
+func (cb *CircuitBreaker) RecordTrade(price int64, now time.Time) {
+	cb.LastPrice = price
+
+	if cb.MoveBps <= 0 {
+		return
+	}
+
+	latest := tradePrint{At: now, Price: price}
+
+	// A print no lower than the new one can never again be the window's
+	// lowest, nor one no higher its highest, so they leave their queues.
+	for cb.lows.len() > 0 && cb.lows.back().Price >= price {
+		cb.lows.popBack()
+	}
+
+	for cb.highs.len() > 0 && cb.highs.back().Price <= price {
+		cb.highs.popBack()
+	}
+
+	cb.lows.push(latest)
+	cb.highs.push(latest)
+	cb.evict(now)
+}
+
+func (cb *CircuitBreaker) evict(now time.Time) {
+	cutoff := now.Add(-cb.Window)
+
+	cb.lows.evictBefore(cutoff)
+	cb.highs.evictBefore(cutoff)
+}
+
+func (q *printQueue) len() int {
+	return len(q.prints) - q.head
+}
+
+func (q *printQueue) front() tradePrint {
+	return q.prints[q.head]
+}
+
+func (q *printQueue) back() tradePrint {
+	return q.prints[len(q.prints)-1]
+}
+
+func (q *printQueue) push(p tradePrint) {
+	q.prints = append(q.prints, p)
+}
+
+func (q *printQueue) popBack() {
+	q.prints = q.prints[:len(q.prints)-1]
+}
+
+func (q *printQueue) reset() {
+	q.prints = q.prints[:0]
+	q.head = 0
+}
+
+// evictBefore drops the prints older than cutoff from the front. It costs
+// O(1) per print dropped: the survivors are moved down only when head has
+// passed half the buffer, and then there are no more of them than prints
+// dropped since the last move.
+func (q *printQueue) evictBefore(cutoff time.Time) {
+	for q.head < len(q.prints) && q.prints[q.head].At.Before(cutoff) {
+		q.head++
+	}
+
+	if q.head == len(q.prints) {
+		q.reset()
+
+		return
+	}
+
+	if q.head > 0 && 2*q.head >= cap(q.prints) {
+		n := copy(q.prints, q.prints[q.head:])
+		q.prints = q.prints[:n]
+		q.head = 0
+	}
+}
+
+func withinBps(reference, price, bps int64) bool {
+	diff := price - reference
+	if diff < 0 {
+		diff = -diff
+	}
+
+	return diff*bpsDenominator <= reference*bps
+}
+
+func NewCircuitBreaker(props CircuitBreakerProps) *CircuitBreaker {
+	cb := &CircuitBreaker{}
+	cb.Configure(props)
+
+	return cb
+}
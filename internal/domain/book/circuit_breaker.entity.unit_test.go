@@ -0,0 +1,214 @@
+//go:build all || unit || domain
+
+package book_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/book"
+)
+
+type CircuitBreakerUnitTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func (suite *CircuitBreakerUnitTestSuite) SetupTest() {
+	suite.now = time.Now()
+}
+
+func (suite *CircuitBreakerUnitTestSuite) TestCheckPrice_NoReferenceAllowsAnyPrice() {
+	cb := book.NewCircuitBreaker(book.CircuitBreakerProps{BandBps: 100})
+
+	assert.NoError(suite.T(), cb.CheckPrice(1, suite.now))
+	assert.NoError(suite.T(), cb.CheckPrice(1000000, suite.now))
+}
+
+func (suite *CircuitBreakerUnitTestSuite) TestCheckPrice_WithinBand() {
+	cb := book.NewCircuitBreaker(book.CircuitBreakerProps{ReferencePrice: 1000, BandBps: 1000})
+
+	assert.NoError(suite.T(), cb.CheckPrice(1100, suite.now))
+	assert.NoError(suite.T(), cb.CheckPrice(900, suite.now))
+}
+
+func (suite *CircuitBreakerUnitTestSuite) TestCheckPrice_OutOfBand() {
+	cb := book.NewCircuitBreaker(book.CircuitBreakerProps{ReferencePrice: 1000, BandBps: 1000})
+
+	assert.ErrorIs(suite.T(), cb.CheckPrice(1101, suite.now), book.ErrPriceOutOfBand)
+	assert.ErrorIs(suite.T(), cb.CheckPrice(899, suite.now), book.ErrPriceOutOfBand)
+}
+
+func (suite *CircuitBreakerUnitTestSuite) TestCheckPrice_UsesLastPriceWithoutReference() {
+	cb := book.NewCircuitBreaker(book.CircuitBreakerProps{BandBps: 500})
+	cb.RecordTrade(2000, suite.now)
+
+	assert.Equal(suite.T(), int64(2000), cb.Reference())
+	assert.NoError(suite.T(), cb.CheckPrice(2100, suite.now))
+	assert.ErrorIs(suite.T(), cb.CheckPrice(2101, suite.now), book.ErrPriceOutOfBand)
+}
+
+func (suite *CircuitBreakerUnitTestSuite) TestCheckPrice_Halted() {
+	cb := book.NewCircuitBreaker(book.CircuitBreakerProps{})
+	cb.Halt()
+
+	assert.True(suite.T(), cb.IsHalted(suite.now))
+	assert.ErrorIs(suite.T(), cb.CheckPrice(100, suite.now), book.ErrInstrumentHalted)
+
+	cb.Resume()
+
+	assert.False(suite.T(), cb.IsHalted(suite.now))
+	assert.NoError(suite.T(), cb.CheckPrice(100, suite.now))
+}
+
+func (suite *CircuitBreakerUnitTestSuite) TestAllowTrade_TripsOnLargeMove() {
+	cb := book.NewCircuitBreaker(book.CircuitBreakerProps{
+		MoveBps:      1000,
+		Window:       time.Minute,
+		HaltDuration: 5 * time.Minute,
+	})
+
+	assert.True(suite.T(), cb.AllowTrade(1000, suite.now))
+	cb.RecordTrade(1000, suite.now)
+
+	assert.True(suite.T(), cb.AllowTrade(1100, suite.now))
+	assert.False(suite.T(), cb.AllowTrade(1101, suite.now))
+	assert.True(suite.T(), cb.IsHalted(suite.now))
+	assert.Equal(suite.T(), suite.now.Add(5*time.Minute), cb.HaltedUntil)
+	assert.False(suite.T(), cb.IsHalted(suite.now.Add(5*time.Minute)))
+}
+
+func (suite *CircuitBreakerUnitTestSuite) TestAllowTrade_IgnoresPrintsOutsideWindow() {
+	cb := book.NewCircuitBreaker(book.CircuitBreakerProps{
+		MoveBps: 1000,
+		Window:  time.Minute,
+	})
+	cb.RecordTrade(1000, suite.now)
+
+	assert.True(suite.T(), cb.AllowTrade(2000, suite.now.Add(2*time.Minute)))
+}
+
+func (suite *CircuitBreakerUnitTestSuite) TestAllowTrade_ChecksLowestAndHighestPrintInWindow() {
+	cb := book.NewCircuitBreaker(book.CircuitBreakerProps{
+		MoveBps:      1000,
+		Window:       time.Minute,
+		HaltDuration: time.Nanosecond,
+	})
+	cb.RecordTrade(1000, suite.now)
+	cb.RecordTrade(1080, suite.now.Add(10*time.Second))
+	cb.RecordTrade(1040, suite.now.Add(20*time.Second))
+
+	assert.True(suite.T(), cb.AllowTrade(1100, suite.now.Add(30*time.Second)))
+	assert.False(suite.T(), cb.AllowTrade(1101, suite.now.Add(30*time.Second)))
+
+	// Once 1000 leaves the window, 1040 is the lowest print.
+	assert.True(suite.T(), cb.AllowTrade(1144, suite.now.Add(65*time.Second)))
+	assert.False(suite.T(), cb.AllowTrade(1145, suite.now.Add(65*time.Second)))
+}
+
+func (suite *CircuitBreakerUnitTestSuite) TestAllowTrade_MatchesEveryPrintCheck() {
+	const moveBps = 500
+
+	window := 10 * time.Second
+	rng := rand.New(rand.NewSource(1))
+
+	for run := 0; run < 50; run++ {
+		cb := book.NewCircuitBreaker(book.CircuitBreakerProps{
+			MoveBps:      moveBps,
+			Window:       window,
+			HaltDuration: time.Nanosecond,
+		})
+
+		type windowPrint struct {
+			at    time.Time
+			price int64
+		}
+
+		var prints []windowPrint
+
+		now := suite.now
+
+		for i := 0; i < 200; i++ {
+			now = now.Add(time.Duration(rng.Intn(2000)) * time.Millisecond)
+			price := int64(950 + rng.Intn(100))
+
+			want := true
+
+			for _, p := range prints {
+				if p.at.Before(now.Add(-window)) {
+					continue
+				}
+
+				diff := price - p.price
+				if diff < 0 {
+					diff = -diff
+				}
+
+				if diff*10000 > p.price*moveBps {
+					want = false
+				}
+			}
+
+			assert.Equal(suite.T(), want, cb.AllowTrade(price, now), "run %d trade %d", run, i)
+
+			// Skip past the trip and record the print either way, so every
+			// run keeps exercising the window.
+			now = now.Add(time.Nanosecond)
+			cb.RecordTrade(price, now)
+			prints = append(prints, windowPrint{at: now, price: price})
+		}
+	}
+}
+
+func (suite *CircuitBreakerUnitTestSuite) TestRecordTrade_SlidingWindowReusesItsBuffer() {
+	cb := book.NewCircuitBreaker(book.CircuitBreakerProps{
+		MoveBps: 10000,
+		Window:  100 * time.Second,
+	})
+
+	// Rising prints all stay in the lows queue until they age out, so the
+	// window holds a hundred of them and drops one per trade.
+	now := suite.now
+	price := int64(1000)
+	trade := func() {
+		now = now.Add(time.Second)
+		price++
+		assert.True(suite.T(), cb.AllowTrade(price, now))
+		cb.RecordTrade(price, now)
+	}
+
+	for i := 0; i < 1000; i++ {
+		trade()
+	}
+
+	assert.Zero(suite.T(), testing.AllocsPerRun(1000, trade))
+}
+
+func (suite *CircuitBreakerUnitTestSuite) TestTrip_WithoutHaltDurationHaltsUntilResumed() {
+	cb := book.NewCircuitBreaker(book.CircuitBreakerProps{MoveBps: 100, Window: time.Minute})
+	cb.RecordTrade(1000, suite.now)
+
+	assert.False(suite.T(), cb.AllowTrade(2000, suite.now))
+	assert.True(suite.T(), cb.IsHalted(suite.now.Add(24*time.Hour)))
+}
+
+func (suite *CircuitBreakerUnitTestSuite) TestConfigure() {
+	cb := book.NewCircuitBreaker(book.CircuitBreakerProps{})
+	cb.Configure(book.CircuitBreakerProps{
+		ReferencePrice: 10,
+		BandBps:        20,
+		MoveBps:        30,
+		Window:         time.Second,
+		HaltDuration:   time.Minute,
+	})
+
+	assert.Equal(suite.T(), int64(10), cb.ReferencePrice)
+	assert.Equal(suite.T(), int64(20), cb.BandBps)
+	assert.Equal(suite.T(), int64(30), cb.MoveBps)
+	assert.Equal(suite.T(), time.Second, cb.Window)
+	assert.Equal(suite.T(), time.Minute, cb.HaltDuration)
+}
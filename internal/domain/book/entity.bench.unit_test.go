@@ -0,0 +1,157 @@
+//go:build all || unit || domain
+
+package book_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/domain/order"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+// The benchmarks below compare the book against sortedSliceSide, the
+// price-sorted slice and per-level order slices it used before, on deep
+// books: one order added at a new level and removed again, and one order
+// cancelled from the middle of a long queue and queued again.
+//
+//	go test -tags=all -run xxx -bench . ./internal/domain/book/
+
+var benchmarkDepths = []int{100, 1_000, 10_000}
+
+type sortedSliceSide struct {
+	levels map[int64][]*order.Order
+	prices []int64
+}
+
+func (s *sortedSliceSide) add(o *order.Order) {
+	if _, ok := s.levels[o.Price]; !ok {
+		s.prices = append(s.prices, o.Price)
+		sort.Slice(s.prices, func(i, j int) bool { return s.prices[i] < s.prices[j] })
+	}
+
+	s.levels[o.Price] = append(s.levels[o.Price], o)
+}
+
+func (s *sortedSliceSide) remove(o *order.Order) {
+	orders := s.levels[o.Price]
+
+	for i, oo := range orders {
+		if oo == o {
+			orders = append(orders[:i], orders[i+1:]...)
+
+			break
+		}
+	}
+
+	s.levels[o.Price] = orders
+
+	if len(orders) == 0 {
+		delete(s.levels, o.Price)
+
+		for i, p := range s.prices {
+			if p == o.Price {
+				s.prices = append(s.prices[:i], s.prices[i+1:]...)
+
+				break
+			}
+		}
+	}
+}
+
+// deepLevels rests one ask at every even price from 2 to 2*depth, leaving
+// the odd prices free for new levels.
+func deepLevels(depth int) []*order.Order {
+	orders := make([]*order.Order, depth)
+
+	for i := range orders {
+		orders[i] = newRestingOrder(fmt.Sprintf("level-%d", i), order.Sell, int64(2*(i+1)))
+	}
+
+	return orders
+}
+
+// deepQueue rests depth asks at one price.
+func deepQueue(depth int) []*order.Order {
+	orders := make([]*order.Order, depth)
+
+	for i := range orders {
+		orders[i] = newRestingOrder(fmt.Sprintf("queued-%d", i), order.Sell, 100)
+	}
+
+	return orders
+}
+
+func BenchmarkAddRemoveLevel(b *testing.B) {
+	for _, depth := range benchmarkDepths {
+		incoming := newRestingOrder("incoming", order.Sell, int64(depth)|1)
+
+		b.Run(fmt.Sprintf("index/levels=%d", depth), func(b *testing.B) {
+			bk, _ := book.NewBook(book.BookProps{Instrument: "BTC/USDT"}, idObjValue.Uuid)
+			for _, o := range deepLevels(depth) {
+				bk.AddOrder(o)
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				bk.AddOrder(incoming)
+				bk.RemoveOrder(incoming)
+			}
+		})
+
+		b.Run(fmt.Sprintf("sorted-slice/levels=%d", depth), func(b *testing.B) {
+			side := &sortedSliceSide{levels: map[int64][]*order.Order{}}
+			for _, o := range deepLevels(depth) {
+				side.add(o)
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				side.add(incoming)
+				side.remove(incoming)
+			}
+		})
+	}
+}
+
+func BenchmarkCancelFromQueue(b *testing.B) {
+	for _, depth := range benchmarkDepths {
+		b.Run(fmt.Sprintf("index/orders=%d", depth), func(b *testing.B) {
+			orders := deepQueue(depth)
+
+			bk, _ := book.NewBook(book.BookProps{Instrument: "BTC/USDT"}, idObjValue.Uuid)
+			for _, o := range orders {
+				bk.AddOrder(o)
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				o := orders[(depth/2+i)%depth]
+				bk.RemoveOrder(o)
+				bk.AddOrder(o)
+			}
+		})
+
+		b.Run(fmt.Sprintf("sorted-slice/orders=%d", depth), func(b *testing.B) {
+			orders := deepQueue(depth)
+
+			side := &sortedSliceSide{levels: map[int64][]*order.Order{}}
+			for _, o := range orders {
+				side.add(o)
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				o := orders[(depth/2+i)%depth]
+				side.remove(o)
+				side.add(o)
+			}
+		})
+	}
+}
@@ -2,28 +2,45 @@ package book
 
 import (
 	"errors"
-	"sort"
+	"sync"
 
 	"github.com/juninhoitabh/clob-go/internal/domain/order"
 	baseEntity "github.com/juninhoitabh/clob-go/internal/shared/domain/entities"
 	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
 )
 
+// maxFree bounds how many order nodes and price levels a book keeps for
+// reuse once a burst of orders has left it.
+const maxFree = 4096
+
 var (
 	ErrInvalidInstrumentBook = errors.New("invalid instrument book")
 )
 
 type (
 	BookProps struct {
-		Instrument string
+		Instrument     string
+		CircuitBreaker CircuitBreakerProps
 	}
+	// Book keeps each side's levels by price and in a price index, and
+	// every resting order by its Seq, so adding a level costs O(log n) and
+	// removing an order O(1) plus, when it empties its level, O(log n).
+	// Nodes and levels that leave the book are kept for the next orders, so
+	// a book at steady depth rests and removes orders without allocating.
 	Book struct {
 		baseEntity.BaseEntity
-		Instrument string
-		bids       map[int64]*PriceLevel
-		asks       map[int64]*PriceLevel
-		bidPrices  []int64
-		askPrices  []int64
+		CircuitBreaker *CircuitBreaker
+		Instrument     string
+		bids           map[int64]*PriceLevel
+		asks           map[int64]*PriceLevel
+		orders         map[uint64]*orderNode
+		freeNodes      []*orderNode
+		freeLevels     []*PriceLevel
+		bidIndex       priceIndex
+		askIndex       priceIndex
+		orderSeq       uint64
+		tradeSeq       uint64
+		mu             sync.Mutex
 	}
 )
 
@@ -37,6 +54,13 @@ func (b *Book) Prepare(typeId idObjValue.TypeIdEnum) error {
 
 	b.bids = make(map[int64]*PriceLevel)
 	b.asks = make(map[int64]*PriceLevel)
+	b.orders = make(map[uint64]*orderNode)
+	b.bidIndex = newPriceIndex(true)
+	b.askIndex = newPriceIndex(false)
+
+	if b.CircuitBreaker == nil {
+		b.CircuitBreaker = NewCircuitBreaker(CircuitBreakerProps{})
+	}
 
 	return nil
 }
@@ -49,87 +73,104 @@ func (b *Book) Validate() error {
 	return nil
 }
 
+// AddOrder queues o at its price, numbering it first if it has no Seq yet.
+// An order that comes back numbered keeps its Seq, and the book numbers
+// past it.
 func (b *Book) AddOrder(o *order.Order) {
-	if o.Side == order.Buy {
-		pl := b.bids[o.Price]
-		if pl == nil {
-			pl = NewPriceLevel(o.Price)
-			b.bids[o.Price] = pl
-			b.bidPrices = append(b.bidPrices, o.Price)
-			sort.Slice(b.bidPrices, func(i, j int) bool { return b.bidPrices[i] > b.bidPrices[j] })
-		}
+	switch {
+	case o.Seq == 0:
+		b.orderSeq++
+		o.Seq = b.orderSeq
+	case o.Seq > b.orderSeq:
+		b.orderSeq = o.Seq
+	}
 
-		pl.Orders = append(pl.Orders, o)
-	} else {
-		pl := b.asks[o.Price]
-		if pl == nil {
-			pl = NewPriceLevel(o.Price)
-			b.asks[o.Price] = pl
-			b.askPrices = append(b.askPrices, o.Price)
-			sort.Slice(b.askPrices, func(i, j int) bool { return b.askPrices[i] < b.askPrices[j] })
-		}
+	levels, index := b.side(o.Side)
 
-		pl.Orders = append(pl.Orders, o)
+	pl := levels[o.Price]
+	if pl == nil {
+		pl = b.newLevel(o.Price)
+		levels[o.Price] = pl
+		index.insert(pl)
 	}
+
+	n := b.newNode(o)
+	pl.pushBack(n)
+	b.orders[o.Seq] = n
 }
 
+// RemoveOrder takes the order with o's Seq off the book, dropping its
+// level once empty; orders not resting are ignored.
 func (b *Book) RemoveOrder(o *order.Order) {
-	m := b.asks
-	prices := &b.askPrices
-
-	if o.Side == order.Buy {
-		m = b.bids
-		prices = &b.bidPrices
-	}
-
-	pl := m[o.Price]
-	if pl == nil {
+	n := b.orders[o.Seq]
+	if n == nil {
 		return
 	}
 
-	for i, oo := range pl.Orders {
-		if oo == o {
-			pl.Orders = append(pl.Orders[:i], pl.Orders[i+1:]...)
+	b.remove(n)
+}
 
-			break
-		}
+// RemoveFront takes the order first in time priority off pl, the way a
+// filled maker leaves the book, without looking it up.
+func (b *Book) RemoveFront(pl *PriceLevel) {
+	if pl.head != nil {
+		b.remove(pl.head)
 	}
+}
 
-	if len(pl.Orders) == 0 {
-		delete(m, o.Price)
+func (b *Book) remove(n *orderNode) {
+	if b.orders[n.order.Seq] == n {
+		delete(b.orders, n.order.Seq)
+	}
 
-		for i, p := range *prices {
-			if p == o.Price {
-				*prices = append((*prices)[:i], (*prices)[i+1:]...)
+	pl := n.level
+	side := n.order.Side
 
-				break
-			}
-		}
+	pl.unlink(n)
+	b.freeNode(n)
+
+	if pl.Len() == 0 {
+		levels, index := b.side(side)
+
+		delete(levels, pl.Price)
+		index.remove(pl.Price)
+		b.freeLevel(pl)
 	}
 }
 
-func (b *Book) BestBid() *PriceLevel {
-	if len(b.bidPrices) == 0 {
+// Order returns the resting order numbered seq, or nil.
+func (b *Book) Order(seq uint64) *order.Order {
+	n := b.orders[seq]
+	if n == nil {
 		return nil
 	}
 
-	return b.bids[b.bidPrices[0]]
+	return n.order
 }
 
-func (b *Book) BestAsk() *PriceLevel {
-	if len(b.askPrices) == 0 {
-		return nil
-	}
+// NextTradeSeq numbers the book's next trade.
+func (b *Book) NextTradeSeq() uint64 {
+	b.tradeSeq++
 
-	return b.asks[b.askPrices[0]]
+	return b.tradeSeq
+}
+
+func (b *Book) BestBid() *PriceLevel {
+	return b.bidIndex.first()
+}
+
+func (b *Book) BestAsk() *PriceLevel {
+	return b.askIndex.first()
 }
 
+// BidPrices lists the bid prices, best first.
 func (b *Book) BidPrices() []int64 {
-	return b.bidPrices
+	return b.bidIndex.prices()
 }
 
+// AskPrices lists the ask prices, best first.
 func (b *Book) AskPrices() []int64 {
-	return b.askPrices
+	return b.askIndex.prices()
 }
 
 func (b *Book) Bids() map[int64]*PriceLevel {
@@ -140,9 +181,64 @@ func (b *Book) Asks() map[int64]*PriceLevel {
 	return b.asks
 }
 
+func (b *Book) side(side order.Side) (map[int64]*PriceLevel, *priceIndex) {
+	if side == order.Buy {
+		return b.bids, &b.bidIndex
+	}
+
+	return b.asks, &b.askIndex
+}
+
+func (b *Book) newNode(o *order.Order) *orderNode {
+	if last := len(b.freeNodes) - 1; last >= 0 {
+		n := b.freeNodes[last]
+		b.freeNodes = b.freeNodes[:last]
+		n.order = o
+
+		return n
+	}
+
+	return &orderNode{order: o}
+}
+
+func (b *Book) freeNode(n *orderNode) {
+	n.order = nil
+
+	if len(b.freeNodes) < maxFree {
+		b.freeNodes = append(b.freeNodes, n)
+	}
+}
+
+func (b *Book) newLevel(price int64) *PriceLevel {
+	if last := len(b.freeLevels) - 1; last >= 0 {
+		pl := b.freeLevels[last]
+		b.freeLevels = b.freeLevels[:last]
+		pl.Price = price
+
+		return pl
+	}
+
+	return NewPriceLevel(price)
+}
+
+func (b *Book) freeLevel(pl *PriceLevel) {
+	if len(b.freeLevels) < maxFree {
+		b.freeLevels = append(b.freeLevels, pl)
+	}
+}
+
+func (b *Book) Lock() {
+	b.mu.Lock()
+}
+
+func (b *Book) Unlock() {
+	b.mu.Unlock()
+}
+
 func NewBook(props BookProps, typeId idObjValue.TypeIdEnum) (*Book, error) {
 	book := Book{
-		Instrument: props.Instrument,
+		Instrument:     props.Instrument,
+		CircuitBreaker: NewCircuitBreaker(props.CircuitBreaker),
 	}
 
 	err := book.Prepare(typeId)
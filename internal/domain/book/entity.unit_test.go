@@ -3,6 +3,9 @@
 package book_test
 
 import (
+	"fmt"
+	"math/rand"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -30,6 +33,7 @@ func (suite *BookUnitTestSuite) TestNewBook_Success() {
 	assert.Equal(suite.T(), suite.propsFaker.Instrument, b.Instrument)
 	assert.NotNil(suite.T(), b.Bids())
 	assert.NotNil(suite.T(), b.Asks())
+	assert.NotNil(suite.T(), b.CircuitBreaker)
 }
 
 func (suite *BookUnitTestSuite) TestNewBook_InvalidInstrument() {
@@ -41,34 +45,207 @@ func (suite *BookUnitTestSuite) TestNewBook_InvalidInstrument() {
 
 func (suite *BookUnitTestSuite) TestAddOrder_BidAndAsk() {
 	b, _ := book.NewBook(suite.propsFaker, idObjValue.Uuid)
-	bidOrder := &order.Order{Side: order.Buy, Price: 100}
-	askOrder := &order.Order{Side: order.Sell, Price: 110}
+	bidOrder := newRestingOrder("bid1", order.Buy, 100)
+	askOrder := newRestingOrder("ask1", order.Sell, 110)
 
 	b.AddOrder(bidOrder)
 	b.AddOrder(askOrder)
 
 	assert.Contains(suite.T(), b.Bids(), int64(100))
 	assert.Contains(suite.T(), b.Asks(), int64(110))
-	assert.Equal(suite.T(), bidOrder, b.Bids()[100].Orders[0])
-	assert.Equal(suite.T(), askOrder, b.Asks()[110].Orders[0])
+	assert.Equal(suite.T(), bidOrder, b.Bids()[100].Front())
+	assert.Equal(suite.T(), askOrder, b.Asks()[110].Front())
 }
 
 func (suite *BookUnitTestSuite) TestRemoveOrder_RemovesOrderAndPriceLevel() {
 	b, _ := book.NewBook(suite.propsFaker, idObjValue.Uuid)
-	bidOrder := &order.Order{Side: order.Buy, Price: 100}
+	bidOrder := newRestingOrder("bid1", order.Buy, 100)
 	b.AddOrder(bidOrder)
 
 	assert.Contains(suite.T(), b.Bids(), int64(100))
 	b.RemoveOrder(bidOrder)
 	assert.NotContains(suite.T(), b.Bids(), int64(100))
+	assert.Nil(suite.T(), b.BestBid())
+	assert.Empty(suite.T(), b.BidPrices())
+	assert.Nil(suite.T(), b.Order(bidOrder.Seq))
+}
+
+func (suite *BookUnitTestSuite) TestAddOrder_KeepsTimePriority() {
+	b, _ := book.NewBook(suite.propsFaker, idObjValue.Uuid)
+	first := newRestingOrder("o1", order.Sell, 100)
+	second := newRestingOrder("o2", order.Sell, 100)
+	third := newRestingOrder("o3", order.Sell, 100)
+
+	b.AddOrder(first)
+	b.AddOrder(second)
+	b.AddOrder(third)
+
+	pl := b.BestAsk()
+	assert.Equal(suite.T(), 3, pl.Len())
+	assert.Equal(suite.T(), []*order.Order{first, second, third}, pl.Orders())
+	assert.Equal(suite.T(), second, b.Order(second.Seq))
+}
+
+func (suite *BookUnitTestSuite) TestRemoveOrder_FromMiddleAndEndsOfQueue() {
+	b, _ := book.NewBook(suite.propsFaker, idObjValue.Uuid)
+	orders := []*order.Order{
+		newRestingOrder("o1", order.Buy, 100),
+		newRestingOrder("o2", order.Buy, 100),
+		newRestingOrder("o3", order.Buy, 100),
+		newRestingOrder("o4", order.Buy, 100),
+	}
+
+	for _, o := range orders {
+		b.AddOrder(o)
+	}
+
+	b.RemoveOrder(orders[1])
+	assert.Equal(suite.T(), []*order.Order{orders[0], orders[2], orders[3]}, b.BestBid().Orders())
+
+	b.RemoveOrder(orders[0])
+	b.RemoveOrder(orders[3])
+	assert.Equal(suite.T(), []*order.Order{orders[2]}, b.BestBid().Orders())
+
+	// Removing an order twice, or one that never rested, changes nothing.
+	b.RemoveOrder(orders[0])
+	b.RemoveOrder(newRestingOrder("unknown", order.Buy, 100))
+	assert.Equal(suite.T(), 1, b.BestBid().Len())
+
+	b.RemoveOrder(orders[2])
+	assert.Nil(suite.T(), b.BestBid())
+	assert.Empty(suite.T(), b.Bids())
+}
+
+func (suite *BookUnitTestSuite) TestAddOrder_NumbersOrdersInArrivalOrder() {
+	b, _ := book.NewBook(suite.propsFaker, idObjValue.Uuid)
+	first := newRestingOrder("o1", order.Buy, 100)
+	second := newRestingOrder("o2", order.Sell, 110)
+
+	b.AddOrder(first)
+	b.AddOrder(second)
+
+	assert.Equal(suite.T(), uint64(1), first.Seq)
+	assert.Equal(suite.T(), uint64(2), second.Seq)
+	assert.Equal(suite.T(), first, b.Order(1))
+	assert.Nil(suite.T(), b.Order(3))
+
+	// A cancelled order queued again keeps its number, and one numbered
+	// elsewhere pushes the book's numbering past it.
+	b.RemoveOrder(first)
+	b.AddOrder(first)
+	assert.Equal(suite.T(), uint64(1), first.Seq)
+
+	numbered := newRestingOrder("o3", order.Buy, 100)
+	numbered.Seq = 10
+	b.AddOrder(numbered)
+
+	next := newRestingOrder("o4", order.Buy, 100)
+	b.AddOrder(next)
+	assert.Equal(suite.T(), uint64(11), next.Seq)
+}
+
+func (suite *BookUnitTestSuite) TestNextTradeSeq_Increments() {
+	b, _ := book.NewBook(suite.propsFaker, idObjValue.Uuid)
+
+	assert.Equal(suite.T(), uint64(1), b.NextTradeSeq())
+	assert.Equal(suite.T(), uint64(2), b.NextTradeSeq())
+}
+
+func (suite *BookUnitTestSuite) TestAddOrder_ReusesFreedLevelAtNewPrice() {
+	b, _ := book.NewBook(suite.propsFaker, idObjValue.Uuid)
+	for _, p := range []int64{100, 102, 104} {
+		b.AddOrder(newRestingOrder(fmt.Sprintf("ask-%d", p), order.Sell, p))
+	}
+
+	gone := newRestingOrder("gone", order.Sell, 103)
+	b.AddOrder(gone)
+	b.RemoveOrder(gone)
+
+	again := newRestingOrder("again", order.Sell, 101)
+	b.AddOrder(again)
+
+	assert.Equal(suite.T(), []int64{100, 101, 102, 104}, b.AskPrices())
+	assert.Equal(suite.T(), again, b.Asks()[101].Front())
+	assert.Equal(suite.T(), 1, b.Asks()[101].Len())
+}
+
+func (suite *BookUnitTestSuite) TestRemoveFront_DropsOldestOrderAndEmptyLevel() {
+	b, _ := book.NewBook(suite.propsFaker, idObjValue.Uuid)
+	first := newRestingOrder("o1", order.Sell, 100)
+	second := newRestingOrder("o2", order.Sell, 100)
+	b.AddOrder(first)
+	b.AddOrder(second)
+	b.AddOrder(newRestingOrder("o3", order.Sell, 101))
+
+	b.RemoveFront(b.BestAsk())
+	assert.Nil(suite.T(), b.Order(first.Seq))
+	assert.Equal(suite.T(), second, b.BestAsk().Front())
+
+	b.RemoveFront(b.BestAsk())
+	assert.Equal(suite.T(), int64(101), b.BestAsk().Price)
+	assert.Equal(suite.T(), []int64{101}, b.AskPrices())
+}
+
+func (suite *BookUnitTestSuite) TestPrices_StaySortedThroughAddsAndRemoves() {
+	b, _ := book.NewBook(suite.propsFaker, idObjValue.Uuid)
+	rng := rand.New(rand.NewSource(1))
+	resting := map[string]*order.Order{}
+
+	for i := range 2000 {
+		if len(resting) > 0 && rng.Intn(3) == 0 {
+			for id, o := range resting {
+				b.RemoveOrder(o)
+				delete(resting, id)
+
+				break
+			}
+
+			continue
+		}
+
+		side := order.Buy
+		if rng.Intn(2) == 0 {
+			side = order.Sell
+		}
+
+		o := newRestingOrder(fmt.Sprintf("o%d", i), side, 1+rng.Int63n(300))
+		b.AddOrder(o)
+		resting[o.GetID()] = o
+	}
+
+	bidPrices := b.BidPrices()
+	askPrices := b.AskPrices()
+
+	assert.True(suite.T(), slices.IsSortedFunc(bidPrices, func(x, y int64) int { return int(y - x) }))
+	assert.True(suite.T(), slices.IsSorted(askPrices))
+	assert.Len(suite.T(), bidPrices, len(b.Bids()))
+	assert.Len(suite.T(), askPrices, len(b.Asks()))
+
+	count := 0
+
+	for _, levels := range []map[int64]*book.PriceLevel{b.Bids(), b.Asks()} {
+		for _, pl := range levels {
+			count += pl.Len()
+		}
+	}
+
+	assert.Equal(suite.T(), len(resting), count)
+
+	if len(bidPrices) > 0 {
+		assert.Equal(suite.T(), bidPrices[0], b.BestBid().Price)
+	}
+
+	if len(askPrices) > 0 {
+		assert.Equal(suite.T(), askPrices[0], b.BestAsk().Price)
+	}
 }
 
 func (suite *BookUnitTestSuite) TestBestBidAndBestAsk() {
 	b, _ := book.NewBook(suite.propsFaker, idObjValue.Uuid)
-	b.AddOrder(&order.Order{Side: order.Buy, Price: 100})
-	b.AddOrder(&order.Order{Side: order.Buy, Price: 101})
-	b.AddOrder(&order.Order{Side: order.Sell, Price: 110})
-	b.AddOrder(&order.Order{Side: order.Sell, Price: 109})
+	b.AddOrder(newRestingOrder("bid1", order.Buy, 100))
+	b.AddOrder(newRestingOrder("bid2", order.Buy, 101))
+	b.AddOrder(newRestingOrder("ask1", order.Sell, 110))
+	b.AddOrder(newRestingOrder("ask2", order.Sell, 109))
 
 	assert.Equal(suite.T(), int64(101), b.BestBid().Price)
 	assert.Equal(suite.T(), int64(109), b.BestAsk().Price)
@@ -76,13 +253,13 @@ func (suite *BookUnitTestSuite) TestBestBidAndBestAsk() {
 
 func (suite *BookUnitTestSuite) TestBidPricesAndAskPrices() {
 	b, _ := book.NewBook(suite.propsFaker, idObjValue.Uuid)
-	b.AddOrder(&order.Order{Side: order.Buy, Price: 100})
-	b.AddOrder(&order.Order{Side: order.Buy, Price: 101})
-	b.AddOrder(&order.Order{Side: order.Sell, Price: 110})
-	b.AddOrder(&order.Order{Side: order.Sell, Price: 109})
+	b.AddOrder(newRestingOrder("bid1", order.Buy, 100))
+	b.AddOrder(newRestingOrder("bid2", order.Buy, 101))
+	b.AddOrder(newRestingOrder("ask1", order.Sell, 110))
+	b.AddOrder(newRestingOrder("ask2", order.Sell, 109))
 
-	assert.ElementsMatch(suite.T(), []int64{101, 100}, b.BidPrices())
-	assert.ElementsMatch(suite.T(), []int64{109, 110}, b.AskPrices())
+	assert.Equal(suite.T(), []int64{101, 100}, b.BidPrices())
+	assert.Equal(suite.T(), []int64{109, 110}, b.AskPrices())
 }
 
 func (suite *BookUnitTestSuite) TestBestBid_EmptyReturnsNil() {
@@ -95,7 +272,15 @@ func (suite *BookUnitTestSuite) TestBestAsk_EmptyReturnsNil() {
 	suite.Nil(b.BestAsk())
 }
 
+func newRestingOrder(id string, side order.Side, price int64) *order.Order {
+	o := &order.Order{Side: side, Price: price, Qty: 1, Remaining: 1}
+	o.ID = idObjValue.NewID(id, idObjValue.Str)
+
+	return o
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, new(BookUnitTestSuite))
 	suite.Run(t, new(PriceLevelUnitTestSuite))
+	suite.Run(t, new(CircuitBreakerUnitTestSuite))
 }
@@ -0,0 +1,146 @@
+package book
+
+const (
+	// maxIndexHeight bounds the towers of the skip list; with a 1/4 chance
+	// of growing each level it covers books far deeper than any real one.
+	maxIndexHeight = 16
+	indexSeed      = 0x9e3779b97f4a7c15
+)
+
+// priceIndex keeps one side's price levels in a skip list, best price
+// first: ascending for asks, descending for bids. The levels are the nodes,
+// so inserting and removing a level costs O(log n) and allocates at most its
+// tower, and the best level is always the first one.
+type priceIndex struct {
+	head       [maxIndexHeight]*PriceLevel
+	height     int
+	random     uint64
+	descending bool
+}
+
+func newPriceIndex(descending bool) priceIndex {
+	return priceIndex{height: 1, random: indexSeed, descending: descending}
+}
+
+func (idx *priceIndex) first() *PriceLevel {
+	return idx.head[0]
+}
+
+// insert links pl in at its price; the price must not be in the index.
+func (idx *priceIndex) insert(pl *PriceLevel) {
+	update := idx.predecessors(pl.Price)
+
+	height := idx.randomHeight()
+	if height > idx.height {
+		for i := idx.height; i < height; i++ {
+			update[i] = nil
+		}
+
+		idx.height = height
+	}
+
+	// A level's tower is sized for the tallest height once, so a level
+	// reused at another price never needs a new one.
+	if cap(pl.forward) < maxIndexHeight {
+		pl.forward = make([]*PriceLevel, height, maxIndexHeight)
+	} else {
+		pl.forward = pl.forward[:height]
+	}
+
+	for i := range height {
+		pl.forward[i] = idx.next(update[i], i)
+		idx.setNext(update[i], i, pl)
+	}
+}
+
+// remove unlinks the level at price, if there is one.
+func (idx *priceIndex) remove(price int64) {
+	update := idx.predecessors(price)
+
+	target := idx.next(update[0], 0)
+	if target == nil || target.Price != price {
+		return
+	}
+
+	for i := range target.forward {
+		idx.setNext(update[i], i, target.forward[i])
+	}
+
+	clear(target.forward)
+	target.forward = target.forward[:0]
+
+	for idx.height > 1 && idx.head[idx.height-1] == nil {
+		idx.height--
+	}
+}
+
+// prices lists every price in the index, best first.
+func (idx *priceIndex) prices() []int64 {
+	var prices []int64
+
+	for pl := idx.head[0]; pl != nil; pl = pl.forward[0] {
+		prices = append(prices, pl.Price)
+	}
+
+	return prices
+}
+
+// predecessors returns, for every height, the last level before price; nil
+// stands for the head.
+func (idx *priceIndex) predecessors(price int64) [maxIndexHeight]*PriceLevel {
+	var update [maxIndexHeight]*PriceLevel
+
+	var x *PriceLevel
+
+	for i := idx.height - 1; i >= 0; i-- {
+		for next := idx.next(x, i); next != nil && idx.before(next.Price, price); next = idx.next(x, i) {
+			x = next
+		}
+
+		update[i] = x
+	}
+
+	return update
+}
+
+func (idx *priceIndex) before(a, b int64) bool {
+	if idx.descending {
+		return a > b
+	}
+
+	return a < b
+}
+
+func (idx *priceIndex) next(pl *PriceLevel, i int) *PriceLevel {
+	if pl == nil {
+		return idx.head[i]
+	}
+
+	return pl.forward[i]
+}
+
+func (idx *priceIndex) setNext(pl *PriceLevel, i int, next *PriceLevel) {
+	if pl == nil {
+		idx.head[i] = next
+
+		return
+	}
+
+	pl.forward[i] = next
+}
+
+// randomHeight draws a tower height from a xorshift generator, so the index
+// needs no locking and lays out the same way on every run.
+func (idx *priceIndex) randomHeight() int {
+	idx.random ^= idx.random << 13
+	idx.random ^= idx.random >> 7
+	idx.random ^= idx.random << 17
+
+	height := 1
+
+	for r := idx.random; height < maxIndexHeight && r&3 == 0; r >>= 2 {
+		height++
+	}
+
+	return height
+}
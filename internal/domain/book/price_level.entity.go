@@ -2,24 +2,94 @@ package book
 
 import "github.com/juninhoitabh/clob-go/internal/domain/order"
 
-type PriceLevel struct {
-	Orders []*order.Order
-	Price  int64
+type (
+	// orderNode links a resting order into its level's queue.
+	orderNode struct {
+		order *order.Order
+		level *PriceLevel
+		prev  *orderNode
+		next  *orderNode
+	}
+	// PriceLevel is the queue of orders resting at one price, oldest first.
+	// It is also a node of its side's price index.
+	PriceLevel struct {
+		head    *orderNode
+		tail    *orderNode
+		forward []*PriceLevel
+		Price   int64
+		count   int
+	}
+)
+
+// Front returns the order first in time priority, or nil when the level is
+// empty.
+func (pl *PriceLevel) Front() *order.Order {
+	if pl.head == nil {
+		return nil
+	}
+
+	return pl.head.order
+}
+
+func (pl *PriceLevel) Len() int {
+	return pl.count
+}
+
+// Orders lists the level's orders in time priority.
+func (pl *PriceLevel) Orders() []*order.Order {
+	orders := make([]*order.Order, 0, pl.count)
+
+	for n := pl.head; n != nil; n = n.next {
+		orders = append(orders, n.order)
+	}
+
+	return orders
 }
 
 func (pl *PriceLevel) TotalQty() int64 {
 	var t int64
 
-	for _, o := range pl.Orders {
-		t += o.Remaining
+	for n := pl.head; n != nil; n = n.next {
+		t += n.order.Remaining
 	}
 
 	return t
 }
 
+func (pl *PriceLevel) pushBack(n *orderNode) {
+	n.level = pl
+	n.prev = pl.tail
+	n.next = nil
+
+	if pl.tail == nil {
+		pl.head = n
+	} else {
+		pl.tail.next = n
+	}
+
+	pl.tail = n
+	pl.count++
+}
+
+func (pl *PriceLevel) unlink(n *orderNode) {
+	if n.prev == nil {
+		pl.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+
+	if n.next == nil {
+		pl.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+
+	n.level, n.prev, n.next = nil, nil, nil
+	pl.count--
+}
+
 func NewPriceLevel(price int64) *PriceLevel {
 	return &PriceLevel{
-		Price:  price,
-		Orders: []*order.Order{},
+		Price: price,
 	}
 }
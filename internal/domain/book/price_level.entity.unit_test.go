@@ -3,11 +3,14 @@
 package book_test
 
 import (
+	"fmt"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/juninhoitabh/clob-go/internal/domain/book"
 	"github.com/juninhoitabh/clob-go/internal/domain/order"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
 )
 
 type PriceLevelUnitTestSuite struct {
@@ -18,7 +21,9 @@ func (suite *PriceLevelUnitTestSuite) TestNewPriceLevel() {
 	pl := book.NewPriceLevel(100)
 	assert.NotNil(suite.T(), pl)
 	assert.Equal(suite.T(), int64(100), pl.Price)
-	assert.Empty(suite.T(), pl.Orders)
+	assert.Empty(suite.T(), pl.Orders())
+	assert.Zero(suite.T(), pl.Len())
+	assert.Nil(suite.T(), pl.Front())
 }
 
 func (suite *PriceLevelUnitTestSuite) TestTotalQty_Empty() {
@@ -27,11 +32,14 @@ func (suite *PriceLevelUnitTestSuite) TestTotalQty_Empty() {
 }
 
 func (suite *PriceLevelUnitTestSuite) TestTotalQty_WithOrders() {
-	pl := book.NewPriceLevel(300)
-	pl.Orders = []*order.Order{
-		{Remaining: 10},
-		{Remaining: 20},
-		{Remaining: 5},
+	b, _ := book.NewBook(book.BookProps{Instrument: "BTC/USDT"}, idObjValue.Uuid)
+
+	for i, remaining := range []int64{10, 20, 5} {
+		o := newRestingOrder(fmt.Sprintf("o%d", i), order.Buy, 300)
+		o.Remaining = remaining
+		b.AddOrder(o)
 	}
-	assert.Equal(suite.T(), int64(35), pl.TotalQty())
+
+	assert.Equal(suite.T(), int64(35), b.Bids()[300].TotalQty())
+	assert.Equal(suite.T(), 3, b.Bids()[300].Len())
 }
@@ -2,5 +2,10 @@ package book
 
 type IBookRepository interface {
 	GetBook(instrument string) (*Book, error)
+	// GetOrCreateBook stores book unless one already exists for its
+	// instrument, and returns whichever is stored. Two callers racing to
+	// create the same book end up with the same one.
+	GetOrCreateBook(book *Book) (*Book, error)
 	SaveBook(book *Book) error
+	ListBooks() ([]*Book, error)
 }
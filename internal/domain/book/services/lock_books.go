@@ -0,0 +1,37 @@
+package services
+
+import (
+	"sort"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/book"
+)
+
+// LockBooks locks each distinct book in instrument order so that callers
+// holding several books at once cannot deadlock each other.
+func LockBooks(books ...*book.Book) func() {
+	unique := make([]*book.Book, 0, len(books))
+	seen := make(map[*book.Book]bool, len(books))
+
+	for _, b := range books {
+		if b == nil || seen[b] {
+			continue
+		}
+
+		seen[b] = true
+		unique = append(unique, b)
+	}
+
+	sort.Slice(unique, func(i, j int) bool {
+		return unique[i].Instrument < unique[j].Instrument
+	})
+
+	for _, b := range unique {
+		b.Lock()
+	}
+
+	return func() {
+		for i := len(unique) - 1; i >= 0; i-- {
+			unique[i].Unlock()
+		}
+	}
+}
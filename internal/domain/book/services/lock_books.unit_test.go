@@ -0,0 +1,63 @@
+//go:build all || unit || domain
+
+package services_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type LockBooksUnitTestSuite struct {
+	suite.Suite
+	btc *book.Book
+	eth *book.Book
+}
+
+func (suite *LockBooksUnitTestSuite) SetupTest() {
+	suite.btc, _ = book.NewBook(book.BookProps{Instrument: "BTC/USDT"}, idObjValue.Uuid)
+	suite.eth, _ = book.NewBook(book.BookProps{Instrument: "ETH/USDT"}, idObjValue.Uuid)
+}
+
+func (suite *LockBooksUnitTestSuite) TestLockBooks_OppositeOrderDoesNotDeadlock() {
+	var (
+		wg      sync.WaitGroup
+		counter int
+	)
+
+	run := func(first, second *book.Book) {
+		defer wg.Done()
+
+		for range 200 {
+			unlock := services.LockBooks(first, second)
+			counter++
+			unlock()
+		}
+	}
+
+	wg.Add(2)
+
+	go run(suite.btc, suite.eth)
+	go run(suite.eth, suite.btc)
+
+	wg.Wait()
+
+	suite.Equal(400, counter)
+}
+
+func (suite *LockBooksUnitTestSuite) TestLockBooks_SameBookTwice() {
+	unlock := services.LockBooks(suite.btc, suite.btc, nil)
+	unlock()
+
+	unlock = services.LockBooks(suite.btc)
+	unlock()
+}
+
+func TestLockBooksUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(LockBooksUnitTestSuite))
+}
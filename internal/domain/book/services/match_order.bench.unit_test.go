@@ -0,0 +1,109 @@
+//go:build all || unit || domain
+
+package services_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	"github.com/juninhoitabh/clob-go/internal/domain/order"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+// The benchmarks below run the matcher the way a steady market does: each
+// iteration rests the same makers again and sends a taker against them, a
+// second later than the last, with the circuit breaker watching a minute
+// of prints. Once the book and the report have warmed up, a match should
+// allocate nothing.
+//
+//	go test -tags=all -run xxx -bench MatchOrder -benchmem ./internal/domain/book/services/
+
+// matchBench is a book, its makers and a taker that sweeps them.
+type matchBench struct {
+	book   *book.Book
+	makers []*order.Order
+	taker  *order.Order
+	report *services.TradeReport
+	now    time.Time
+}
+
+func newMatchBench(levels int) *matchBench {
+	bk, _ := book.NewBook(book.BookProps{
+		Instrument: "BTC/USDT",
+		CircuitBreaker: book.CircuitBreakerProps{
+			MoveBps:      1000,
+			Window:       time.Minute,
+			HaltDuration: time.Minute,
+		},
+	}, idObjValue.Uuid)
+
+	m := &matchBench{
+		book:   bk,
+		makers: make([]*order.Order, levels),
+		report: &services.TradeReport{},
+		now:    time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	for i := range m.makers {
+		m.makers[i] = &order.Order{AccountID: "seller", Side: order.Sell, Price: int64(100 + i), Qty: 1}
+		m.makers[i].ID = idObjValue.NewID(fmt.Sprintf("maker-%d", i), idObjValue.Str)
+	}
+
+	m.taker = &order.Order{AccountID: "buyer", Side: order.Buy, Price: int64(100 + levels), Qty: int64(levels)}
+	m.taker.ID = idObjValue.NewID("taker", idObjValue.Str)
+
+	return m
+}
+
+// match rests the makers again and sweeps them with the taker.
+func (m *matchBench) match() {
+	for _, maker := range m.makers {
+		maker.Remaining = maker.Qty
+		m.book.AddOrder(maker)
+	}
+
+	m.taker.Remaining = m.taker.Qty
+	m.now = m.now.Add(time.Second)
+
+	m.report.Reset()
+	services.MatchOrderInto(m.report, m.book, m.taker, m.now)
+}
+
+func BenchmarkMatchOrder(b *testing.B) {
+	for _, levels := range []int{1, 10} {
+		b.Run(fmt.Sprintf("levels=%d", levels), func(b *testing.B) {
+			m := newMatchBench(levels)
+			m.match()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				m.match()
+			}
+		})
+	}
+}
+
+func TestMatchOrderInto_DoesNotAllocate(t *testing.T) {
+	for _, levels := range []int{1, 10} {
+		m := newMatchBench(levels)
+
+		// Warm up past the breaker's window, so its print buffer has
+		// reached the size it keeps.
+		for range 120 {
+			m.match()
+		}
+
+		if allocs := testing.AllocsPerRun(100, m.match); allocs != 0 {
+			t.Errorf("levels=%d: %v allocations per match, want 0", levels, allocs)
+		}
+
+		if len(m.report.Trades) != levels || m.report.Halted {
+			t.Errorf("levels=%d: %d trades, halted %v", levels, len(m.report.Trades), m.report.Halted)
+		}
+	}
+}
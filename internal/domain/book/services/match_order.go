@@ -1,99 +1,182 @@
 package services
 
 import (
+	"strconv"
+	"sync"
+	"time"
+
 	"github.com/juninhoitabh/clob-go/internal/domain/book"
 	"github.com/juninhoitabh/clob-go/internal/domain/order"
 )
 
+// Trade is one fill. The matcher numbers it with Seq, in its book's order,
+// and that number is all the engine keeps; ID writes it out for the world.
 type Trade struct {
-	TakerOrderID string
-	MakerOrderID string
-	BuyerID      string
-	SellerID     string
-	Price        int64
-	Qty          int64
+	ExecutedAt    time.Time
+	Instrument    string
+	TakerOrderID  string
+	MakerOrderID  string
+	BuyerID       string
+	SellerID      string
+	MakerFeeAsset string
+	TakerFeeAsset string
+	TakerSide     order.Side
+	Seq           uint64
+	Price         int64
+	Qty           int64
+	MakerFee      int64
+	TakerFee      int64
+}
+
+// ID is the trade's external identifier: its instrument and its number in
+// that instrument's book.
+func (t *Trade) ID() string {
+	return t.Instrument + "-" + strconv.FormatUint(t.Seq, 10)
 }
 
 type TradeReport struct {
 	Trades []Trade
+	Halted bool
+}
+
+// Reset empties the report, keeping its trade buffer for the next match.
+func (r *TradeReport) Reset() {
+	r.Trades = r.Trades[:0]
+	r.Halted = false
+}
+
+// Clone copies r and its trades, for keeping once r goes back to the pool.
+func (r *TradeReport) Clone() *TradeReport {
+	return &TradeReport{
+		Trades: append([]Trade(nil), r.Trades...),
+		Halted: r.Halted,
+	}
+}
+
+var tradeReports = sync.Pool{
+	New: func() any { return &TradeReport{} },
+}
+
+// AcquireTradeReport takes an empty report from the pool, for callers that
+// match into it with MatchOrderInto and are done with its trades before
+// they give it back.
+func AcquireTradeReport() *TradeReport {
+	return tradeReports.Get().(*TradeReport)
+}
+
+// ReleaseTradeReport returns r to the pool; neither r nor its trades may be
+// used afterwards.
+func ReleaseTradeReport(r *TradeReport) {
+	r.Reset()
+	tradeReports.Put(r)
 }
 
 func MatchOrder(b *book.Book, o *order.Order) *TradeReport {
+	return MatchOrderAt(b, o, time.Now())
+}
+
+// MatchOrderAt matches o as if it arrived at now, which stamps its trades
+// and drives the circuit breaker, so recorded flow can be replayed.
+func MatchOrderAt(b *book.Book, o *order.Order, now time.Time) *TradeReport {
 	report := &TradeReport{}
+	MatchOrderInto(report, b, o, now)
+
+	return report
+}
 
+// MatchOrderInto is MatchOrderAt appending to report, which the caller
+// resets between matches. Once report's buffer has grown to the largest
+// match, matching allocates nothing.
+func MatchOrderInto(report *TradeReport, b *book.Book, o *order.Order, now time.Time) {
 	if o.Side == order.Buy {
-		for o.Remaining > 0 {
+		for o.Remaining > 0 && !report.Halted {
 			ask := b.BestAsk()
 			if ask == nil || ask.Price > o.Price {
 				break
 			}
 
-			for len(ask.Orders) > 0 && o.Remaining > 0 && ask.Price <= o.Price {
-				maker := ask.Orders[0]
+			for maker := ask.Front(); maker != nil && o.Remaining > 0; maker = ask.Front() {
 				tradeQty := min(o.Remaining, maker.Remaining)
 				execPrice := maker.Price
 
+				if !b.CircuitBreaker.AllowTrade(execPrice, now) {
+					report.Halted = true
+
+					break
+				}
+
+				b.CircuitBreaker.RecordTrade(execPrice, now)
+
 				report.Trades = append(report.Trades, Trade{
+					ExecutedAt:   now,
+					Seq:          b.NextTradeSeq(),
+					Instrument:   b.Instrument,
 					TakerOrderID: o.GetID(),
 					MakerOrderID: maker.GetID(),
-					Price:        execPrice,
-					Qty:          tradeQty,
 					BuyerID:      o.AccountID,
 					SellerID:     maker.AccountID,
+					TakerSide:    o.Side,
+					Price:        execPrice,
+					Qty:          tradeQty,
 				})
 
 				o.Remaining -= tradeQty
 				maker.Remaining -= tradeQty
 
 				if maker.Remaining == 0 {
-					b.RemoveOrder(maker)
-				} else {
-					ask.Orders[0] = maker
+					b.RemoveFront(ask)
 				}
 			}
 		}
 
-		if o.Remaining > 0 {
+		if o.Remaining > 0 && !report.Halted {
 			b.AddOrder(o)
 		}
 	} else {
-		for o.Remaining > 0 {
+		for o.Remaining > 0 && !report.Halted {
 			bid := b.BestBid()
 			if bid == nil || bid.Price < o.Price {
 				break
 			}
 
-			for len(bid.Orders) > 0 && o.Remaining > 0 && bid.Price >= o.Price {
-				maker := bid.Orders[0]
+			for maker := bid.Front(); maker != nil && o.Remaining > 0; maker = bid.Front() {
 				tradeQty := min(o.Remaining, maker.Remaining)
 				execPrice := maker.Price
 
+				if !b.CircuitBreaker.AllowTrade(execPrice, now) {
+					report.Halted = true
+
+					break
+				}
+
+				b.CircuitBreaker.RecordTrade(execPrice, now)
+
 				report.Trades = append(report.Trades, Trade{
+					ExecutedAt:   now,
+					Seq:          b.NextTradeSeq(),
+					Instrument:   b.Instrument,
 					TakerOrderID: o.GetID(),
 					MakerOrderID: maker.GetID(),
-					Price:        execPrice,
-					Qty:          tradeQty,
 					BuyerID:      maker.AccountID,
 					SellerID:     o.AccountID,
+					TakerSide:    o.Side,
+					Price:        execPrice,
+					Qty:          tradeQty,
 				})
 
 				o.Remaining -= tradeQty
 				maker.Remaining -= tradeQty
 
 				if maker.Remaining == 0 {
-					b.RemoveOrder(maker)
-				} else {
-					bid.Orders[0] = maker
+					b.RemoveFront(bid)
 				}
 			}
 		}
 
-		if o.Remaining > 0 {
+		if o.Remaining > 0 && !report.Halted {
 			b.AddOrder(o)
 		}
 	}
-
-	return report
 }
 
 func min(a, b int64) int64 {
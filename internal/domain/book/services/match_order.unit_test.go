@@ -4,6 +4,7 @@ package services_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -80,7 +81,7 @@ func (suite *MatchOrderUnitTestSuite) TestMatchOrder_PartialMatchBuy() {
 	assert.Equal(suite.T(), int64(5), buy.Remaining)
 	assert.Equal(suite.T(), int64(0), ask.Remaining)
 	// Buy order deve ser adicionada ao book
-	assert.Contains(suite.T(), suite.book.Bids()[100].Orders, buy)
+	assert.Contains(suite.T(), suite.book.Bids()[100].Orders(), buy)
 }
 
 func (suite *MatchOrderUnitTestSuite) TestMatchOrder_NoMatchBuy() {
@@ -94,7 +95,7 @@ func (suite *MatchOrderUnitTestSuite) TestMatchOrder_NoMatchBuy() {
 	report := services.MatchOrder(suite.book, buy)
 	assert.Len(suite.T(), report.Trades, 0)
 	assert.Equal(suite.T(), int64(10), buy.Remaining)
-	assert.Contains(suite.T(), suite.book.Bids()[99].Orders, buy)
+	assert.Contains(suite.T(), suite.book.Bids()[99].Orders(), buy)
 }
 
 func (suite *MatchOrderUnitTestSuite) TestMatchOrder_FullMatchSell() {
@@ -180,7 +181,7 @@ func (suite *MatchOrderUnitTestSuite) TestMatchOrder_BuyOrderAddedWhenPartialFil
 
 	services.MatchOrder(b, buy)
 	assert.Equal(suite.T(), int64(5), buy.Remaining)
-	assert.Contains(suite.T(), b.Bids()[100].Orders, buy)
+	assert.Contains(suite.T(), b.Bids()[100].Orders(), buy)
 }
 
 func (suite *MatchOrderUnitTestSuite) TestMatchOrder_SellOrderAddedWhenPartialFill() {
@@ -204,7 +205,7 @@ func (suite *MatchOrderUnitTestSuite) TestMatchOrder_SellOrderAddedWhenPartialFi
 
 	services.MatchOrder(b, sell)
 	assert.Equal(suite.T(), int64(5), sell.Remaining)
-	assert.Contains(suite.T(), b.Asks()[100].Orders, sell)
+	assert.Contains(suite.T(), b.Asks()[100].Orders(), sell)
 }
 
 func (suite *MatchOrderUnitTestSuite) TestMatchOrder_SellPartialFillMakerRemains() {
@@ -228,7 +229,110 @@ func (suite *MatchOrderUnitTestSuite) TestMatchOrder_SellPartialFillMakerRemains
 
 	services.MatchOrder(b, sell)
 	assert.Equal(suite.T(), int64(5), bid.Remaining)
-	assert.Equal(suite.T(), bid, b.Bids()[100].Orders[0])
+	assert.Equal(suite.T(), bid, b.Bids()[100].Front())
+}
+
+func (suite *MatchOrderUnitTestSuite) TestMatchOrder_CircuitBreakerHaltsMatching() {
+	b, _ := book.NewBook(book.BookProps{
+		Instrument: "BTC/USDT",
+		CircuitBreaker: book.CircuitBreakerProps{
+			MoveBps:      1000,
+			Window:       time.Minute,
+			HaltDuration: time.Minute,
+		},
+	}, idObjValue.Uuid)
+	b.AddOrder(&order.Order{AccountID: "seller1", Side: order.Sell, Price: 100, Qty: 1, Remaining: 1})
+	b.AddOrder(&order.Order{AccountID: "seller2", Side: order.Sell, Price: 200, Qty: 1, Remaining: 1})
+
+	buy := &order.Order{
+		AccountID: "buyer1",
+		Side:      order.Buy,
+		Price:     200,
+		Qty:       2,
+		Remaining: 2,
+	}
+
+	report := services.MatchOrder(b, buy)
+	assert.True(suite.T(), report.Halted)
+	assert.Len(suite.T(), report.Trades, 1)
+	assert.Equal(suite.T(), int64(100), report.Trades[0].Price)
+	assert.Equal(suite.T(), int64(1), buy.Remaining)
+	assert.Nil(suite.T(), b.BestBid())
+	assert.Equal(suite.T(), int64(200), b.BestAsk().Price)
+	assert.True(suite.T(), b.CircuitBreaker.IsHalted(time.Now()))
+}
+
+func (suite *MatchOrderUnitTestSuite) TestMatchOrderAt_UsesGivenTime() {
+	b, _ := book.NewBook(book.BookProps{
+		Instrument: "BTC/USDT",
+		CircuitBreaker: book.CircuitBreakerProps{
+			MoveBps:      1000,
+			Window:       time.Minute,
+			HaltDuration: time.Minute,
+		},
+	}, idObjValue.Uuid)
+	b.AddOrder(&order.Order{AccountID: "seller1", Side: order.Sell, Price: 100, Qty: 1, Remaining: 1})
+	b.AddOrder(&order.Order{AccountID: "seller2", Side: order.Sell, Price: 200, Qty: 1, Remaining: 1})
+
+	at := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	first := services.MatchOrderAt(b, &order.Order{AccountID: "buyer1", Side: order.Buy, Price: 100, Qty: 1, Remaining: 1}, at)
+	assert.Len(suite.T(), first.Trades, 1)
+	assert.Equal(suite.T(), at, first.Trades[0].ExecutedAt)
+
+	// Outside the window the first print no longer counts, so the move to
+	// 200 does not trip the breaker.
+	later := at.Add(2 * time.Minute)
+
+	second := services.MatchOrderAt(b, &order.Order{AccountID: "buyer1", Side: order.Buy, Price: 200, Qty: 1, Remaining: 1}, later)
+	assert.False(suite.T(), second.Halted)
+	assert.Len(suite.T(), second.Trades, 1)
+	assert.Equal(suite.T(), later, second.Trades[0].ExecutedAt)
+	assert.Equal(suite.T(), int64(200), b.CircuitBreaker.LastPrice)
+}
+
+func (suite *MatchOrderUnitTestSuite) TestMatchOrder_NumbersTradesInTheirBook() {
+	suite.book.AddOrder(&order.Order{AccountID: "seller1", Side: order.Sell, Price: 100, Qty: 1, Remaining: 1})
+	suite.book.AddOrder(&order.Order{AccountID: "seller2", Side: order.Sell, Price: 101, Qty: 1, Remaining: 1})
+
+	first := services.MatchOrder(suite.book, &order.Order{AccountID: "buyer1", Side: order.Buy, Price: 100, Qty: 1, Remaining: 1})
+	second := services.MatchOrder(suite.book, &order.Order{AccountID: "buyer1", Side: order.Buy, Price: 101, Qty: 1, Remaining: 1})
+
+	assert.Equal(suite.T(), uint64(1), first.Trades[0].Seq)
+	assert.Equal(suite.T(), uint64(2), second.Trades[0].Seq)
+	assert.Equal(suite.T(), "BTC/USDT-1", first.Trades[0].ID())
+	assert.Equal(suite.T(), "BTC/USDT-2", second.Trades[0].ID())
+}
+
+func (suite *MatchOrderUnitTestSuite) TestMatchOrderInto_ReusesReport() {
+	report := services.AcquireTradeReport()
+	defer services.ReleaseTradeReport(report)
+
+	suite.book.AddOrder(&order.Order{AccountID: "seller1", Side: order.Sell, Price: 100, Qty: 2, Remaining: 2})
+
+	services.MatchOrderInto(report, suite.book, &order.Order{AccountID: "buyer1", Side: order.Buy, Price: 100, Qty: 1, Remaining: 1}, time.Now())
+	assert.Len(suite.T(), report.Trades, 1)
+
+	report.Reset()
+	services.MatchOrderInto(report, suite.book, &order.Order{AccountID: "buyer2", Side: order.Buy, Price: 100, Qty: 1, Remaining: 1}, time.Now())
+	assert.Len(suite.T(), report.Trades, 1)
+	assert.Equal(suite.T(), "buyer2", report.Trades[0].BuyerID)
+	assert.False(suite.T(), report.Halted)
+	assert.Nil(suite.T(), suite.book.BestAsk())
+}
+
+func (suite *MatchOrderUnitTestSuite) TestTradeReport_CloneOutlivesRelease() {
+	report := services.AcquireTradeReport()
+
+	suite.book.AddOrder(&order.Order{AccountID: "seller1", Side: order.Sell, Price: 100, Qty: 1, Remaining: 1})
+	services.MatchOrderInto(report, suite.book, &order.Order{AccountID: "buyer1", Side: order.Buy, Price: 100, Qty: 1, Remaining: 1}, time.Now())
+
+	clone := report.Clone()
+	services.ReleaseTradeReport(report)
+
+	assert.Len(suite.T(), clone.Trades, 1)
+	assert.Equal(suite.T(), "buyer1", clone.Trades[0].BuyerID)
+	assert.Empty(suite.T(), report.Trades)
 }
 
 func TestMatchOrderUnitTestSuite(t *testing.T) {
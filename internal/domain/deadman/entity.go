@@ -0,0 +1,61 @@
+package deadman
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	MinTimeout = time.Second
+	MaxTimeout = time.Hour
+)
+
+var (
+	ErrInvalidSwitch = errors.New("invalid dead man's switch")
+)
+
+type (
+	SwitchProps struct {
+		AccountID string
+		Timeout   time.Duration
+	}
+	// Switch cancels all of an account's open orders unless it is refreshed
+	// within Timeout.
+	Switch struct {
+		ExpiresAt time.Time
+		UpdatedAt time.Time
+		AccountID string
+		Timeout   time.Duration
+	}
+)
+
+func (s *Switch) Validate() error {
+	if s.AccountID == "" || s.Timeout < MinTimeout || s.Timeout > MaxTimeout {
+		return ErrInvalidSwitch
+	}
+
+	return nil
+}
+
+func (s *Switch) Expired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
+
+// NewSwitch arms a switch that expires Timeout after now. Heartbeats re-arm
+// by building a new switch rather than mutating the stored one.
+func NewSwitch(props SwitchProps, now time.Time) (*Switch, error) {
+	s := Switch{
+		AccountID: props.AccountID,
+		Timeout:   props.Timeout,
+	}
+
+	err := s.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	s.UpdatedAt = now
+	s.ExpiresAt = now.Add(s.Timeout)
+
+	return &s, nil
+}
@@ -0,0 +1,55 @@
+//go:build all || unit || domain
+
+package deadman_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/deadman"
+)
+
+type SwitchUnitTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func (suite *SwitchUnitTestSuite) SetupTest() {
+	suite.now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (suite *SwitchUnitTestSuite) TestNewSwitch_Success() {
+	s, err := deadman.NewSwitch(deadman.SwitchProps{AccountID: "acc1", Timeout: 10 * time.Second}, suite.now)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "acc1", s.AccountID)
+	assert.Equal(suite.T(), suite.now, s.UpdatedAt)
+	assert.Equal(suite.T(), suite.now.Add(10*time.Second), s.ExpiresAt)
+}
+
+func (suite *SwitchUnitTestSuite) TestNewSwitch_Invalid() {
+	for _, props := range []deadman.SwitchProps{
+		{Timeout: 10 * time.Second},
+		{AccountID: "acc1", Timeout: deadman.MinTimeout - time.Millisecond},
+		{AccountID: "acc1", Timeout: deadman.MaxTimeout + time.Second},
+	} {
+		s, err := deadman.NewSwitch(props, suite.now)
+		assert.ErrorIs(suite.T(), err, deadman.ErrInvalidSwitch)
+		assert.Nil(suite.T(), s)
+	}
+}
+
+func (suite *SwitchUnitTestSuite) TestExpired() {
+	s, err := deadman.NewSwitch(deadman.SwitchProps{AccountID: "acc1", Timeout: 5 * time.Second}, suite.now)
+	assert.NoError(suite.T(), err)
+
+	assert.False(suite.T(), s.Expired(suite.now.Add(4*time.Second)))
+	assert.True(suite.T(), s.Expired(suite.now.Add(5*time.Second)))
+	assert.True(suite.T(), s.Expired(suite.now.Add(time.Minute)))
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(SwitchUnitTestSuite))
+}
@@ -0,0 +1,16 @@
+package deadman
+
+import "time"
+
+type ISwitchRepository interface {
+	GetSwitch(accountID string) (*Switch, error)
+	SaveSwitch(s *Switch) error
+	DeleteSwitch(accountID string) error
+	// ListExpired returns the switches expired at now, oldest first. They
+	// stay stored, so a switch whose cancel fails fires again on the next
+	// sweep.
+	ListExpired(now time.Time) ([]*Switch, error)
+	// DeleteFired removes s once its cancel succeeded. A switch the account
+	// re-armed in the meantime is a different one and is kept.
+	DeleteFired(s *Switch) error
+}
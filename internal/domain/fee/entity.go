@@ -0,0 +1,113 @@
+package fee
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const bpsDenominator = 10000
+
+var (
+	ErrInvalidSchedule = errors.New("invalid fee schedule")
+)
+
+type (
+	Rates struct {
+		MakerBps int64
+		TakerBps int64
+	}
+	ScheduleProps struct {
+		Tiers      map[string]Rates
+		Instrument string
+		Rates
+	}
+	Schedule struct {
+		UpdatedAt  time.Time
+		Tiers      map[string]Rates
+		Instrument string
+		Rates
+	}
+	FeeProps struct {
+		AccountID   string
+		VolumeTiers VolumeTiers
+		Rates
+	}
+)
+
+func (r Rates) Validate() error {
+	if r.TakerBps < 0 || r.TakerBps > bpsDenominator {
+		return ErrInvalidSchedule
+	}
+
+	if r.MakerBps > bpsDenominator || -r.MakerBps > r.TakerBps {
+		return ErrInvalidSchedule
+	}
+
+	return nil
+}
+
+func (s *Schedule) Prepare() error {
+	err := s.Validate()
+	if err != nil {
+		return err
+	}
+
+	tiers := make(map[string]Rates, len(s.Tiers))
+	for tier, rates := range s.Tiers {
+		tiers[strings.ToLower(tier)] = rates
+	}
+
+	s.Instrument = strings.ToUpper(s.Instrument)
+	s.Tiers = tiers
+	s.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (s *Schedule) Validate() error {
+	if s.Instrument == "" {
+		return ErrInvalidSchedule
+	}
+
+	err := s.Rates.Validate()
+	if err != nil {
+		return err
+	}
+
+	for tier, rates := range s.Tiers {
+		if tier == "" {
+			return ErrInvalidSchedule
+		}
+
+		err = rates.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Schedule) RatesFor(tier string) Rates {
+	if rates, ok := s.Tiers[strings.ToLower(tier)]; ok {
+		return rates
+	}
+
+	return s.Rates
+}
+
+func NewSchedule(props ScheduleProps) (*Schedule, error) {
+	schedule := Schedule{
+		Instrument: props.Instrument,
+		Rates:      props.Rates,
+		Tiers:      props.Tiers,
+	}
+
+	err := schedule.Prepare()
+	if err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
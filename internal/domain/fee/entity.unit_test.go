@@ -0,0 +1,73 @@
+//go:build all || unit || domain
+
+package fee_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/fee"
+	"github.com/juninhoitabh/clob-go/internal/domain/fee/fakers"
+)
+
+type ScheduleUnitTestSuite struct {
+	suite.Suite
+	propsFaker fee.ScheduleProps
+}
+
+func (suite *ScheduleUnitTestSuite) SetupTest() {
+	suite.propsFaker = fakers.SchedulePropsFaker()
+}
+
+func (suite *ScheduleUnitTestSuite) TestNewSchedule_Success() {
+	schedule, err := fee.NewSchedule(suite.propsFaker)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), strings.ToUpper(suite.propsFaker.Instrument), schedule.Instrument)
+	assert.Equal(suite.T(), fee.Rates{MakerBps: 10, TakerBps: 20}, schedule.Rates)
+	assert.NotEmpty(suite.T(), schedule.UpdatedAt)
+}
+
+func (suite *ScheduleUnitTestSuite) TestNewSchedule_MissingInstrument() {
+	props := suite.propsFaker
+	props.Instrument = ""
+
+	schedule, err := fee.NewSchedule(props)
+	assert.ErrorIs(suite.T(), err, fee.ErrInvalidSchedule)
+	assert.Nil(suite.T(), schedule)
+}
+
+func (suite *ScheduleUnitTestSuite) TestNewSchedule_RebateAboveTakerFee() {
+	props := suite.propsFaker
+	props.Rates = fee.Rates{MakerBps: -30, TakerBps: 20}
+
+	schedule, err := fee.NewSchedule(props)
+	assert.ErrorIs(suite.T(), err, fee.ErrInvalidSchedule)
+	assert.Nil(suite.T(), schedule)
+}
+
+func (suite *ScheduleUnitTestSuite) TestNewSchedule_InvalidTier() {
+	props := suite.propsFaker
+	props.Tiers = map[string]fee.Rates{"vip": {TakerBps: -1}}
+
+	schedule, err := fee.NewSchedule(props)
+	assert.ErrorIs(suite.T(), err, fee.ErrInvalidSchedule)
+	assert.Nil(suite.T(), schedule)
+}
+
+func (suite *ScheduleUnitTestSuite) TestRatesFor() {
+	schedule, _ := fee.NewSchedule(suite.propsFaker)
+
+	assert.Equal(suite.T(), fee.Rates{MakerBps: -5, TakerBps: 10}, schedule.RatesFor("vip"))
+	assert.Equal(suite.T(), fee.Rates{MakerBps: -5, TakerBps: 10}, schedule.RatesFor("VIP"))
+	assert.Equal(suite.T(), fee.Rates{MakerBps: 10, TakerBps: 20}, schedule.RatesFor(""))
+	assert.Equal(suite.T(), fee.Rates{MakerBps: 10, TakerBps: 20}, schedule.RatesFor("unknown"))
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleUnitTestSuite))
+	suite.Run(t, new(TradeFeesUnitTestSuite))
+	suite.Run(t, new(VolumeTiersUnitTestSuite))
+}
@@ -0,0 +1,17 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/fee"
+)
+
+func SchedulePropsFaker() fee.ScheduleProps {
+	faker := faker.New(0)
+
+	return fee.ScheduleProps{
+		Instrument: faker.CurrencyShort() + "/" + faker.CurrencyShort(),
+		Rates:      fee.Rates{MakerBps: 10, TakerBps: 20},
+		Tiers:      map[string]fee.Rates{"VIP": {MakerBps: -5, TakerBps: 10}},
+	}
+}
@@ -0,0 +1,6 @@
+package fee
+
+type IScheduleRepository interface {
+	GetSchedule(instrument string) (*Schedule, error)
+	SaveSchedule(schedule *Schedule) error
+}
@@ -0,0 +1,91 @@
+package fee
+
+import (
+	"github.com/juninhoitabh/clob-go/internal/domain/order"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	TradeParams struct {
+		BuyerTier  string
+		SellerTier string
+		Base       string
+		Quote      string
+		TakerSide  order.Side
+		Price      int64
+		Qty        int64
+	}
+	TradeFees struct {
+		MakerFeeAsset string
+		TakerFeeAsset string
+		MakerFee      int64
+		TakerFee      int64
+		BuyerFee      int64
+		SellerFee     int64
+		BuyerRebate   int64
+		SellerRebate  int64
+	}
+)
+
+// Fees are charged in the asset each side receives: base for the buyer and
+// quote for the seller. A maker rebate is funded from the taker fee of the
+// same trade, so it is paid in the taker's fee asset and capped by it.
+func (s *Schedule) Compute(params TradeParams) TradeFees {
+	notional := shared.Mul(params.Price, params.Qty)
+
+	if params.TakerSide == order.Buy {
+		takerBps := s.RatesFor(params.BuyerTier).TakerBps
+		makerBps := s.RatesFor(params.SellerTier).MakerBps
+
+		fees := TradeFees{
+			TakerFeeAsset: params.Base,
+			TakerFee:      applyBps(params.Qty, takerBps),
+			MakerFeeAsset: params.Quote,
+		}
+		fees.BuyerFee = fees.TakerFee
+
+		if makerBps >= 0 {
+			fees.MakerFee = applyBps(notional, makerBps)
+			fees.SellerFee = fees.MakerFee
+		} else {
+			fees.SellerRebate = min(applyBps(params.Qty, -makerBps), fees.TakerFee)
+			fees.MakerFee = -fees.SellerRebate
+			fees.MakerFeeAsset = params.Base
+		}
+
+		return fees
+	}
+
+	takerBps := s.RatesFor(params.SellerTier).TakerBps
+	makerBps := s.RatesFor(params.BuyerTier).MakerBps
+
+	fees := TradeFees{
+		TakerFeeAsset: params.Quote,
+		TakerFee:      applyBps(notional, takerBps),
+		MakerFeeAsset: params.Base,
+	}
+	fees.SellerFee = fees.TakerFee
+
+	if makerBps >= 0 {
+		fees.MakerFee = applyBps(params.Qty, makerBps)
+		fees.BuyerFee = fees.MakerFee
+	} else {
+		fees.BuyerRebate = min(applyBps(notional, -makerBps), fees.TakerFee)
+		fees.MakerFee = -fees.BuyerRebate
+		fees.MakerFeeAsset = params.Quote
+	}
+
+	return fees
+}
+
+func (f TradeFees) CollectedBase() int64 {
+	return f.BuyerFee - f.SellerRebate
+}
+
+func (f TradeFees) CollectedQuote() int64 {
+	return f.SellerFee - f.BuyerRebate
+}
+
+func applyBps(amount, bps int64) int64 {
+	return amount * bps / bpsDenominator
+}
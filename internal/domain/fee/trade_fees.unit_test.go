@@ -0,0 +1,85 @@
+//go:build all || unit || domain
+
+package fee_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/fee"
+	"github.com/juninhoitabh/clob-go/internal/domain/order"
+)
+
+type TradeFeesUnitTestSuite struct {
+	suite.Suite
+	schedule *fee.Schedule
+	params   fee.TradeParams
+}
+
+func (suite *TradeFeesUnitTestSuite) SetupTest() {
+	suite.schedule, _ = fee.NewSchedule(fee.ScheduleProps{
+		Instrument: "BTC/USDT",
+		Rates:      fee.Rates{MakerBps: 10, TakerBps: 20},
+		Tiers:      map[string]fee.Rates{"vip": {MakerBps: -20, TakerBps: 20}},
+	})
+	suite.params = fee.TradeParams{
+		Base:  "BTC",
+		Quote: "USDT",
+		Price: 10,
+		Qty:   1000,
+	}
+}
+
+func (suite *TradeFeesUnitTestSuite) TestCompute_TakerBuyer() {
+	params := suite.params
+	params.TakerSide = order.Buy
+
+	fees := suite.schedule.Compute(params)
+	assert.Equal(suite.T(), int64(2), fees.TakerFee)
+	assert.Equal(suite.T(), "BTC", fees.TakerFeeAsset)
+	assert.Equal(suite.T(), int64(10), fees.MakerFee)
+	assert.Equal(suite.T(), "USDT", fees.MakerFeeAsset)
+	assert.Equal(suite.T(), int64(2), fees.CollectedBase())
+	assert.Equal(suite.T(), int64(10), fees.CollectedQuote())
+}
+
+func (suite *TradeFeesUnitTestSuite) TestCompute_TakerSeller() {
+	params := suite.params
+	params.TakerSide = order.Sell
+
+	fees := suite.schedule.Compute(params)
+	assert.Equal(suite.T(), int64(20), fees.TakerFee)
+	assert.Equal(suite.T(), "USDT", fees.TakerFeeAsset)
+	assert.Equal(suite.T(), int64(1), fees.MakerFee)
+	assert.Equal(suite.T(), "BTC", fees.MakerFeeAsset)
+}
+
+func (suite *TradeFeesUnitTestSuite) TestCompute_MakerRebate() {
+	params := suite.params
+	params.TakerSide = order.Buy
+	params.SellerTier = "vip"
+
+	fees := suite.schedule.Compute(params)
+	assert.Equal(suite.T(), int64(-2), fees.MakerFee)
+	assert.Equal(suite.T(), "BTC", fees.MakerFeeAsset)
+	assert.Equal(suite.T(), int64(2), fees.SellerRebate)
+	assert.Equal(suite.T(), int64(0), fees.CollectedBase())
+}
+
+func (suite *TradeFeesUnitTestSuite) TestCompute_RebateCappedByTakerFee() {
+	schedule, _ := fee.NewSchedule(fee.ScheduleProps{
+		Instrument: "BTC/USDT",
+		Rates:      fee.Rates{MakerBps: 0, TakerBps: 10},
+		Tiers:      map[string]fee.Rates{"vip": {MakerBps: -20, TakerBps: 20}},
+	})
+
+	params := suite.params
+	params.TakerSide = order.Sell
+	params.BuyerTier = "vip"
+
+	fees := schedule.Compute(params)
+	assert.Equal(suite.T(), int64(10), fees.TakerFee)
+	assert.Equal(suite.T(), int64(-10), fees.MakerFee)
+	assert.Equal(suite.T(), int64(10), fees.BuyerRebate)
+	assert.Equal(suite.T(), int64(0), fees.CollectedQuote())
+}
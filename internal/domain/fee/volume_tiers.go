@@ -0,0 +1,67 @@
+package fee
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+const VolumeWindow = 30 * 24 * time.Hour
+
+type (
+	VolumeTier struct {
+		Name      string
+		MinVolume int64
+	}
+	VolumeTiers []VolumeTier
+)
+
+func (v VolumeTiers) TierFor(volume int64) string {
+	tier := ""
+
+	for _, t := range v {
+		if volume < t.MinVolume {
+			break
+		}
+
+		tier = t.Name
+	}
+
+	return tier
+}
+
+func NewVolumeTiers(tiers map[string]int64) (VolumeTiers, error) {
+	volumeTiers := make(VolumeTiers, 0, len(tiers))
+
+	for name, minVolume := range tiers {
+		if name == "" || minVolume < 0 {
+			return nil, ErrInvalidSchedule
+		}
+
+		volumeTiers = append(volumeTiers, VolumeTier{
+			Name:      strings.ToLower(name),
+			MinVolume: minVolume,
+		})
+	}
+
+	sort.Slice(volumeTiers, func(i, j int) bool {
+		return volumeTiers[i].MinVolume < volumeTiers[j].MinVolume
+	})
+
+	return volumeTiers, nil
+}
+
+func RollingVolume(trades []*services.Trade, accountID string) int64 {
+	var volume int64
+
+	for _, t := range trades {
+		if t.BuyerID == accountID || t.SellerID == accountID {
+			volume += shared.Mul(t.Price, t.Qty)
+		}
+	}
+
+	return volume
+}
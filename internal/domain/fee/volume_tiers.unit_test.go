@@ -0,0 +1,55 @@
+//go:build all || unit || domain
+
+package fee_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	"github.com/juninhoitabh/clob-go/internal/domain/fee"
+)
+
+type VolumeTiersUnitTestSuite struct {
+	suite.Suite
+	tiers fee.VolumeTiers
+}
+
+func (suite *VolumeTiersUnitTestSuite) SetupTest() {
+	suite.tiers, _ = fee.NewVolumeTiers(map[string]int64{"GOLD": 10000, "silver": 1000})
+}
+
+func (suite *VolumeTiersUnitTestSuite) TestNewVolumeTiers_SortedAndLowercased() {
+	assert.Equal(suite.T(), fee.VolumeTiers{
+		{Name: "silver", MinVolume: 1000},
+		{Name: "gold", MinVolume: 10000},
+	}, suite.tiers)
+}
+
+func (suite *VolumeTiersUnitTestSuite) TestNewVolumeTiers_Invalid() {
+	_, err := fee.NewVolumeTiers(map[string]int64{"": 10})
+	assert.ErrorIs(suite.T(), err, fee.ErrInvalidSchedule)
+
+	_, err = fee.NewVolumeTiers(map[string]int64{"silver": -1})
+	assert.ErrorIs(suite.T(), err, fee.ErrInvalidSchedule)
+}
+
+func (suite *VolumeTiersUnitTestSuite) TestTierFor() {
+	assert.Equal(suite.T(), "", suite.tiers.TierFor(999))
+	assert.Equal(suite.T(), "silver", suite.tiers.TierFor(1000))
+	assert.Equal(suite.T(), "silver", suite.tiers.TierFor(9999))
+	assert.Equal(suite.T(), "gold", suite.tiers.TierFor(50000))
+	assert.Equal(suite.T(), "", fee.VolumeTiers{}.TierFor(50000))
+}
+
+func (suite *VolumeTiersUnitTestSuite) TestRollingVolume() {
+	trades := []*services.Trade{
+		{BuyerID: "a", SellerID: "b", Price: 10, Qty: 100},
+		{BuyerID: "b", SellerID: "a", Price: 20, Qty: 5},
+		{BuyerID: "b", SellerID: "c", Price: 30, Qty: 7},
+	}
+
+	assert.Equal(suite.T(), int64(1100), fee.RollingVolume(trades, "a"))
+	assert.Equal(suite.T(), int64(1310), fee.RollingVolume(trades, "b"))
+	assert.Equal(suite.T(), int64(0), fee.RollingVolume(trades, "d"))
+}
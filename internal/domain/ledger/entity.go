@@ -0,0 +1,135 @@
+package ledger
+
+import (
+	"strings"
+	"time"
+
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type (
+	Bucket    string
+	Operation string
+	RefType   string
+)
+
+const (
+	Available Bucket = "available"
+	Reserved  Bucket = "reserved"
+	External  Bucket = "external"
+	Clearing  Bucket = "clearing"
+)
+
+const (
+	OpCredit          Operation = "credit"
+	OpDebit           Operation = "debit"
+	OpReserve         Operation = "reserve"
+	OpUseReserved     Operation = "use_reserved"
+	OpReleaseReserved Operation = "release_reserved"
+)
+
+const (
+	RefOrder      RefType = "order"
+	RefTrade      RefType = "trade"
+	RefWithdrawal RefType = "withdrawal"
+	RefTransfer   RefType = "transfer"
+)
+
+type (
+	Ref struct {
+		Type RefType
+		ID   string
+	}
+	// Account is a ledger account: a balance bucket of an owner. User
+	// balances are exchange liabilities, so a credit leg increases them and
+	// a debit leg decreases them.
+	Account struct {
+		Owner  string
+		Bucket Bucket
+	}
+	Posting struct {
+		CreatedAt time.Time
+		Ref       Ref
+		Debit     Account
+		Credit    Account
+		ID        string
+		Asset     string
+		Operation Operation
+		Amount    int64
+		Seq       int64
+	}
+)
+
+func OrderRef(id string) Ref {
+	return Ref{Type: RefOrder, ID: id}
+}
+
+func TradeRef(id string) Ref {
+	return Ref{Type: RefTrade, ID: id}
+}
+
+func WithdrawalRef(id string) Ref {
+	return Ref{Type: RefWithdrawal, ID: id}
+}
+
+func TransferRef(id string) Ref {
+	return Ref{Type: RefTransfer, ID: id}
+}
+
+func FirstRef(refs []Ref) Ref {
+	if len(refs) == 0 {
+		return Ref{}
+	}
+
+	return refs[0]
+}
+
+// Contra returns the account on the other side of a posting that moves funds
+// in or out of a user: trades and transfers settle through a clearing account
+// per reference, everything else comes from or goes to the outside world.
+func Contra(ref Ref) Account {
+	if ref.Type == RefTrade || ref.Type == RefTransfer {
+		return Account{Owner: string(ref.Type) + ":" + ref.ID, Bucket: Clearing}
+	}
+
+	return Account{Bucket: External}
+}
+
+func (p Posting) Involves(owner string) bool {
+	return p.Debit.Owner == owner || p.Credit.Owner == owner
+}
+
+func Balance(postings []Posting, account Account, asset string) int64 {
+	asset = strings.ToUpper(asset)
+
+	var balance int64
+
+	for _, p := range postings {
+		if p.Asset != asset {
+			continue
+		}
+
+		if p.Credit == account {
+			balance += p.Amount
+		}
+
+		if p.Debit == account {
+			balance -= p.Amount
+		}
+	}
+
+	return balance
+}
+
+func NewPosting(op Operation, asset string, amount int64, debit, credit Account, ref Ref) Posting {
+	return Posting{
+		CreatedAt: time.Now(),
+		Ref:       ref,
+		Debit:     debit,
+		Credit:    credit,
+		ID:        idObjValue.NewID("", idObjValue.Uuid).ID,
+		Asset:     strings.ToUpper(asset),
+		Operation: op,
+		Amount:    amount,
+	}
+}
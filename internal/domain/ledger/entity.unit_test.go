@@ -0,0 +1,61 @@
+//go:build all || unit || domain
+
+package ledger_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
+)
+
+type LedgerUnitTestSuite struct {
+	suite.Suite
+}
+
+func (suite *LedgerUnitTestSuite) TestNewPosting() {
+	debit := ledger.Account{Bucket: ledger.External}
+	credit := ledger.Account{Owner: "acc1", Bucket: ledger.Available}
+
+	p := ledger.NewPosting(ledger.OpCredit, "btc", 10, debit, credit, ledger.OrderRef("o1"))
+	assert.NotEmpty(suite.T(), p.ID)
+	assert.Equal(suite.T(), "BTC", p.Asset)
+	assert.Equal(suite.T(), ledger.Ref{Type: ledger.RefOrder, ID: "o1"}, p.Ref)
+	assert.True(suite.T(), p.Involves("acc1"))
+	assert.False(suite.T(), p.Involves("acc2"))
+}
+
+func (suite *LedgerUnitTestSuite) TestContra() {
+	assert.Equal(suite.T(), ledger.Account{Bucket: ledger.External}, ledger.Contra(ledger.Ref{}))
+	assert.Equal(suite.T(), ledger.Account{Bucket: ledger.External}, ledger.Contra(ledger.WithdrawalRef("w1")))
+	assert.Equal(suite.T(), ledger.Account{Owner: "trade:t1", Bucket: ledger.Clearing}, ledger.Contra(ledger.TradeRef("t1")))
+	assert.Equal(suite.T(), ledger.Account{Owner: "transfer:x1", Bucket: ledger.Clearing}, ledger.Contra(ledger.TransferRef("x1")))
+}
+
+func (suite *LedgerUnitTestSuite) TestBalance() {
+	external := ledger.Account{Bucket: ledger.External}
+	available := ledger.Account{Owner: "acc1", Bucket: ledger.Available}
+	reserved := ledger.Account{Owner: "acc1", Bucket: ledger.Reserved}
+
+	postings := []ledger.Posting{
+		ledger.NewPosting(ledger.OpCredit, "BTC", 100, external, available, ledger.Ref{}),
+		ledger.NewPosting(ledger.OpReserve, "BTC", 30, available, reserved, ledger.Ref{}),
+		ledger.NewPosting(ledger.OpCredit, "USDT", 5, external, available, ledger.Ref{}),
+	}
+
+	assert.Equal(suite.T(), int64(70), ledger.Balance(postings, available, "btc"))
+	assert.Equal(suite.T(), int64(30), ledger.Balance(postings, reserved, "BTC"))
+	assert.Equal(suite.T(), int64(-100), ledger.Balance(postings, external, "BTC"))
+	assert.Equal(suite.T(), int64(5), ledger.Balance(postings, available, "USDT"))
+}
+
+func (suite *LedgerUnitTestSuite) TestFirstRef() {
+	assert.Equal(suite.T(), ledger.Ref{}, ledger.FirstRef(nil))
+	assert.Equal(suite.T(), ledger.TradeRef("t1"), ledger.FirstRef([]ledger.Ref{ledger.TradeRef("t1")}))
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(LedgerUnitTestSuite))
+}
@@ -0,0 +1,14 @@
+package ledger
+
+type (
+	Filter struct {
+		AccountID string
+		Asset     string
+		Offset    int
+		Limit     int
+	}
+	ILedgerRepository interface {
+		Append(postings ...Posting) error
+		ListPostings(filter Filter) ([]Posting, int, error)
+	}
+)
@@ -2,15 +2,23 @@ package order
 
 import (
 	"errors"
+	"strconv"
+	"sync/atomic"
 	"time"
 
+	"github.com/juninhoitabh/clob-go/internal/shared"
 	baseEntity "github.com/juninhoitabh/clob-go/internal/shared/domain/entities"
 	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
 )
 
+const MaxClientOrderIDLength = 64
+
 var (
-	ErrInvalidOrder     = errors.New("invalid order")
-	ErrInvalidSideOrder = errors.New("invalid side order")
+	ErrInvalidOrder           = errors.New("invalid order")
+	ErrInvalidSideOrder       = errors.New("invalid side order")
+	ErrClientOrderIDMismatch  = errors.New("client order id already used with different parameters")
+	ErrDuplicateClientOrderID = errors.New("client order id already used")
+	ErrOrderNotOpen           = shared.NewRejectError("ORDER_NOT_OPEN", "order is not open")
 )
 
 type Side int
@@ -21,23 +29,50 @@ const (
 )
 
 type OrderProps struct {
-	AccountID  string
-	Instrument string
-	Side       Side
-	Price      int64
-	Qty        int64
-	Remaining  int64
+	AccountID     string
+	ClientOrderID string
+	Instrument    string
+	Side          Side
+	Price         int64
+	Qty           int64
+	Remaining     int64
 }
 
+// Order is known outside the engine by its ID. Inside its book it goes by
+// Seq, a number it is given when created with a Seq ID, or else by the book
+// when it first rests there, so the book's index needs neither the ID's
+// hashing nor its allocation. CanceledQty is what it had left when it was
+// canceled, so a Remaining of 0 tells a filled order from a canceled one.
 type Order struct {
 	CreatedAt time.Time
 	baseEntity.BaseEntity
-	AccountID  string
-	Instrument string
-	Side       Side
-	Price      int64
-	Qty        int64
-	Remaining  int64
+	AccountID     string
+	ClientOrderID string
+	Instrument    string
+	Side          Side
+	Price         int64
+	Qty           int64
+	Remaining     int64
+	CanceledQty   int64
+	Seq           uint64
+}
+
+// lastSeq is the number last given to an order created with a Seq ID.
+// Those are numbered across every book, so no two share a number in one.
+// It lives in the process and starts from zero, so a process that keeps
+// orders from an earlier one must SeedSeq it first.
+var lastSeq atomic.Uint64
+
+// SeedSeq makes the orders created with a Seq ID from now on number after
+// seq, so none takes the ID of an order stored before the process started.
+// It never lowers the count.
+func SeedSeq(seq uint64) {
+	for {
+		last := lastSeq.Load()
+		if last >= seq || lastSeq.CompareAndSwap(last, seq) {
+			return
+		}
+	}
 }
 
 func (o *Order) Prepare(typeId idObjValue.TypeIdEnum) error {
@@ -46,7 +81,13 @@ func (o *Order) Prepare(typeId idObjValue.TypeIdEnum) error {
 		return err
 	}
 
-	o.BaseEntity.NewBaseEntity("", typeId)
+	id := ""
+	if typeId == idObjValue.Seq {
+		o.Seq = lastSeq.Add(1)
+		id = strconv.FormatUint(o.Seq, 10)
+	}
+
+	o.BaseEntity.NewBaseEntity(id, typeId)
 
 	o.CreatedAt = time.Now()
 
@@ -58,6 +99,10 @@ func (o *Order) Validate() error {
 		return ErrInvalidOrder
 	}
 
+	if len(o.ClientOrderID) > MaxClientOrderIDLength {
+		return ErrInvalidOrder
+	}
+
 	if o.Side != Buy && o.Side != Sell {
 		return ErrInvalidSideOrder
 	}
@@ -69,13 +114,43 @@ func (o *Order) Validate() error {
 	return nil
 }
 
+// Cancel takes o off the market and returns the quantity it had left.
+func (o *Order) Cancel() int64 {
+	canceled := o.Remaining
+	o.CanceledQty += canceled
+	o.Remaining = 0
+
+	return canceled
+}
+
+// Canceled reports whether o left the market before it filled completely.
+func (o *Order) Canceled() bool {
+	return o.CanceledQty > 0
+}
+
+// Filled is the quantity of o that traded.
+func (o *Order) Filled() int64 {
+	return o.Qty - o.Remaining - o.CanceledQty
+}
+
+// Matches reports whether props describe the same submission as o, so a
+// retry with the same client order ID can be told apart from a reuse.
+func (o *Order) Matches(props OrderProps) bool {
+	return o.ClientOrderID == props.ClientOrderID &&
+		o.AccountID == props.AccountID &&
+		o.Instrument == props.Instrument &&
+		o.Side == props.Side &&
+		o.Price == props.Price &&
+		o.Qty == props.Qty
+}
+
 func (o *Order) Public() map[string]any {
 	side := "buy"
 	if o.Side == Sell {
 		side = "sell"
 	}
 
-	return map[string]any{
+	public := map[string]any{
 		"id":         o.BaseEntity.ID.ID,
 		"account_id": o.AccountID,
 		"instrument": o.Instrument,
@@ -85,16 +160,23 @@ func (o *Order) Public() map[string]any {
 		"remaining":  o.Remaining,
 		"created_at": o.CreatedAt.UTC().Format(time.RFC3339Nano),
 	}
+
+	if o.ClientOrderID != "" {
+		public["client_order_id"] = o.ClientOrderID
+	}
+
+	return public
 }
 
 func NewOrder(props OrderProps, typeId idObjValue.TypeIdEnum) (*Order, error) {
 	order := Order{
-		AccountID:  props.AccountID,
-		Instrument: props.Instrument,
-		Side:       props.Side,
-		Price:      props.Price,
-		Qty:        props.Qty,
-		Remaining:  props.Remaining,
+		AccountID:     props.AccountID,
+		ClientOrderID: props.ClientOrderID,
+		Instrument:    props.Instrument,
+		Side:          props.Side,
+		Price:         props.Price,
+		Qty:           props.Qty,
+		Remaining:     props.Remaining,
 	}
 
 	err := order.Prepare(typeId)
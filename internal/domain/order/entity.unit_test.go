@@ -3,6 +3,8 @@
 package order_test
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -44,6 +46,50 @@ func (suite *OrderUnitTestSuite) TestNewOrder_Success() {
 	assert.WithinDuration(suite.T(), time.Now(), o.CreatedAt, time.Second)
 }
 
+func (suite *OrderUnitTestSuite) TestNewOrder_SeqIDsAreIncreasingNumbers() {
+	props := order.OrderProps{
+		AccountID:  "acc123",
+		Instrument: "BTC/USDT",
+		Side:       order.Buy,
+		Price:      100,
+		Qty:        10,
+		Remaining:  10,
+	}
+
+	first, err := order.NewOrder(props, id.Seq)
+	assert.NoError(suite.T(), err)
+
+	second, err := order.NewOrder(props, id.Seq)
+	assert.NoError(suite.T(), err)
+
+	assert.NotZero(suite.T(), first.Seq)
+	assert.Greater(suite.T(), second.Seq, first.Seq)
+	assert.Equal(suite.T(), strconv.FormatUint(first.Seq, 10), first.GetID())
+	assert.Equal(suite.T(), strconv.FormatUint(second.Seq, 10), second.GetID())
+}
+
+func (suite *OrderUnitTestSuite) TestNewOrder_SeqIDsCountFromSeed() {
+	props := order.OrderProps{
+		AccountID:  "acc123",
+		Instrument: "BTC/USDT",
+		Side:       order.Buy,
+		Price:      100,
+		Qty:        10,
+		Remaining:  10,
+	}
+
+	first, err := order.NewOrder(props, id.Seq)
+	assert.NoError(suite.T(), err)
+
+	order.SeedSeq(first.Seq + 1000)
+	order.SeedSeq(first.Seq)
+
+	seeded, err := order.NewOrder(props, id.Seq)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), first.Seq+1001, seeded.Seq)
+	assert.Equal(suite.T(), strconv.FormatUint(seeded.Seq, 10), seeded.GetID())
+}
+
 func (suite *OrderUnitTestSuite) TestNewOrder_InvalidAccountID() {
 	props := suite.propsFaker
 	props.AccountID = ""
@@ -126,6 +172,66 @@ func (suite *OrderUnitTestSuite) TestOrder_Public() {
 	} else {
 		assert.Equal(suite.T(), "sell", pub["side"])
 	}
+	assert.NotContains(suite.T(), pub, "client_order_id")
+
+	props.ClientOrderID = "my-order-1"
+	o, err = order.NewOrder(props, id.Uuid)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "my-order-1", o.Public()["client_order_id"])
+}
+
+func (suite *OrderUnitTestSuite) TestNewOrder_ClientOrderIDTooLong() {
+	props := suite.propsFaker
+	props.Remaining = props.Qty
+	props.ClientOrderID = strings.Repeat("x", order.MaxClientOrderIDLength+1)
+	o, err := order.NewOrder(props, id.Uuid)
+	assert.ErrorIs(suite.T(), err, order.ErrInvalidOrder)
+	assert.Nil(suite.T(), o)
+}
+
+func (suite *OrderUnitTestSuite) TestOrder_Cancel() {
+	o := &order.Order{Qty: 10, Remaining: 10}
+	assert.False(suite.T(), o.Canceled())
+
+	o.Remaining = 4
+	assert.Equal(suite.T(), int64(6), o.Filled())
+
+	assert.Equal(suite.T(), int64(4), o.Cancel())
+	assert.True(suite.T(), o.Canceled())
+	assert.Zero(suite.T(), o.Remaining)
+	assert.Equal(suite.T(), int64(4), o.CanceledQty)
+	assert.Equal(suite.T(), int64(6), o.Filled())
+
+	filled := &order.Order{Qty: 10}
+	assert.False(suite.T(), filled.Canceled())
+	assert.Equal(suite.T(), int64(10), filled.Filled())
+}
+
+func (suite *OrderUnitTestSuite) TestOrder_Matches() {
+	props := order.OrderProps{
+		AccountID:     "acc123",
+		ClientOrderID: "my-order-1",
+		Instrument:    "BTC/USDT",
+		Side:          order.Sell,
+		Price:         100,
+		Qty:           10,
+		Remaining:     10,
+	}
+	o, err := order.NewOrder(props, id.Uuid)
+	assert.NoError(suite.T(), err)
+
+	assert.True(suite.T(), o.Matches(props))
+
+	o.Remaining = 0
+	assert.True(suite.T(), o.Matches(props))
+
+	changed := props
+	changed.Price = 101
+	assert.False(suite.T(), o.Matches(changed))
+
+	changed = props
+	changed.Side = order.Buy
+	assert.False(suite.T(), o.Matches(changed))
 }
 
 func TestSuite(t *testing.T) {
@@ -2,6 +2,14 @@ package order
 
 type IOrderRepository interface {
 	GetOrder(orderID string) (*Order, error)
+	GetOrderByClientOrderID(accountID, clientOrderID string) (*Order, error)
+	// SaveOrder stores o. It is called under o's book lock whenever o's
+	// Remaining changes, which keeps the open orders listed up to date.
 	SaveOrder(o *Order) error
 	RemoveOrder(orderID string) error
+	// ListOpenOrdersByAccount lists copies of the account's open orders as
+	// they were last saved, oldest first.
+	ListOpenOrdersByAccount(accountID string) ([]*Order, error)
+	// LastSeq is the highest Seq among the orders saved, or 0 without any.
+	LastSeq() (uint64, error)
 }
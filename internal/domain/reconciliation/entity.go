@@ -0,0 +1,236 @@
+package reconciliation
+
+import (
+	"sort"
+	"time"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	"github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	"github.com/juninhoitabh/clob-go/internal/domain/order"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type Kind string
+
+const (
+	// AssetSupply: the sum of every account's holdings differs from what
+	// entered the exchange minus what left it.
+	AssetSupply Kind = "asset_supply"
+	// ReservedObligations: an account's Reserved differs from what its open
+	// orders in the books still need.
+	ReservedObligations Kind = "reserved_obligations"
+	// LedgerBalance: a balance differs from the sum of its ledger postings.
+	LedgerBalance Kind = "ledger_balance"
+	// UnsettledClearing: a trade or transfer left funds in its clearing account.
+	UnsettledClearing Kind = "unsettled_clearing"
+)
+
+type (
+	Snapshot struct {
+		Accounts     []*account.Account
+		Books        []*book.Book
+		Postings     []ledger.Posting
+		FeeAccountID string
+	}
+	AssetSummary struct {
+		Asset       string
+		Holdings    int64
+		Deposits    int64
+		Withdrawals int64
+		Fees        int64
+	}
+	Discrepancy struct {
+		Kind      Kind
+		AccountID string
+		Asset     string
+		Expected  int64
+		Actual    int64
+	}
+	Report struct {
+		CheckedAt     time.Time
+		Assets        []AssetSummary
+		Discrepancies []Discrepancy
+	}
+)
+
+func (s AssetSummary) Expected() int64 {
+	return s.Deposits - s.Withdrawals
+}
+
+func (r *Report) Balanced() bool {
+	return len(r.Discrepancies) == 0
+}
+
+func Reconcile(snapshot Snapshot) *Report {
+	report := &Report{CheckedAt: time.Now()}
+
+	summaries := make(map[string]*AssetSummary)
+	summary := func(asset string) *AssetSummary {
+		s, ok := summaries[asset]
+		if !ok {
+			s = &AssetSummary{Asset: asset}
+			summaries[asset] = s
+		}
+
+		return s
+	}
+
+	posted := make(map[ledger.Account]map[string]int64)
+	post := func(acct ledger.Account, asset string, amount int64) {
+		if posted[acct] == nil {
+			posted[acct] = make(map[string]int64)
+		}
+
+		posted[acct][asset] += amount
+	}
+
+	for _, p := range snapshot.Postings {
+		if p.Debit.Bucket == ledger.External {
+			summary(p.Asset).Deposits += p.Amount
+		}
+
+		if p.Credit.Bucket == ledger.External {
+			summary(p.Asset).Withdrawals += p.Amount
+		}
+
+		post(p.Credit, p.Asset, p.Amount)
+		post(p.Debit, p.Asset, -p.Amount)
+	}
+
+	obligations := openOrderObligations(snapshot.Books)
+
+	for _, acct := range sortedAccounts(snapshot.Accounts) {
+		id := acct.GetID()
+
+		for _, asset := range sortedKeys(acct.Balances) {
+			bal := acct.Balances[asset]
+
+			s := summary(asset)
+			s.Holdings += bal.Available + bal.Reserved
+
+			if id == snapshot.FeeAccountID {
+				s.Fees += bal.Available + bal.Reserved
+			}
+
+			report.check(LedgerBalance, id, asset,
+				posted[ledger.Account{Owner: id, Bucket: ledger.Available}][asset]+
+					posted[ledger.Account{Owner: id, Bucket: ledger.Reserved}][asset],
+				bal.Available+bal.Reserved,
+			)
+		}
+
+		owed := obligations[id]
+
+		for _, asset := range sortedUnion(acct.Balances, owed) {
+			var reserved int64
+			if bal := acct.Balances[asset]; bal != nil {
+				reserved = bal.Reserved
+			}
+
+			report.check(ReservedObligations, id, asset, owed[asset], reserved)
+		}
+	}
+
+	for _, asset := range sortedKeys(summaries) {
+		s := summaries[asset]
+		report.Assets = append(report.Assets, *s)
+		report.check(AssetSupply, "", asset, s.Expected(), s.Holdings)
+	}
+
+	for _, acct := range sortedClearing(posted) {
+		for _, asset := range sortedKeys(posted[acct]) {
+			report.check(UnsettledClearing, acct.Owner, asset, 0, posted[acct][asset])
+		}
+	}
+
+	return report
+}
+
+func (r *Report) check(kind Kind, accountID, asset string, expected, actual int64) {
+	if expected == actual {
+		return
+	}
+
+	r.Discrepancies = append(r.Discrepancies, Discrepancy{
+		Kind:      kind,
+		AccountID: accountID,
+		Asset:     asset,
+		Expected:  expected,
+		Actual:    actual,
+	})
+}
+
+func openOrderObligations(books []*book.Book) map[string]map[string]int64 {
+	obligations := make(map[string]map[string]int64)
+
+	for _, b := range books {
+		base, quote, err := book.SplitInstrument(b.Instrument)
+		if err != nil {
+			continue
+		}
+
+		for _, levels := range []map[int64]*book.PriceLevel{b.Bids(), b.Asks()} {
+			for _, pl := range levels {
+				for _, o := range pl.Orders() {
+					if obligations[o.AccountID] == nil {
+						obligations[o.AccountID] = make(map[string]int64)
+					}
+
+					if o.Side == order.Buy {
+						obligations[o.AccountID][quote] += shared.Mul(o.Price, o.Remaining)
+					} else {
+						obligations[o.AccountID][base] += o.Remaining
+					}
+				}
+			}
+		}
+	}
+
+	return obligations
+}
+
+func sortedAccounts(accounts []*account.Account) []*account.Account {
+	sorted := append([]*account.Account(nil), accounts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].GetID() < sorted[j].GetID() })
+
+	return sorted
+}
+
+func sortedClearing(posted map[ledger.Account]map[string]int64) []ledger.Account {
+	accounts := make([]ledger.Account, 0)
+
+	for acct := range posted {
+		if acct.Bucket == ledger.Clearing {
+			accounts = append(accounts, acct)
+		}
+	}
+
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Owner < accounts[j].Owner })
+
+	return accounts
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func sortedUnion[A, B any](a map[string]A, b map[string]B) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	for k := range a {
+		seen[k] = true
+	}
+
+	for k := range b {
+		seen[k] = true
+	}
+
+	return sortedKeys(seen)
+}
@@ -0,0 +1,202 @@
+//go:build all || unit || domain
+
+package reconciliation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	"github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	"github.com/juninhoitabh/clob-go/internal/domain/order"
+	"github.com/juninhoitabh/clob-go/internal/domain/reconciliation"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type ReconciliationUnitTestSuite struct {
+	suite.Suite
+	alice    *account.Account
+	bob      *account.Account
+	book     *book.Book
+	postings []ledger.Posting
+}
+
+func (suite *ReconciliationUnitTestSuite) SetupTest() {
+	t := suite.T()
+
+	var err error
+
+	suite.alice, err = account.NewAccount(account.AccountProps{Name: "alice"}, idObjValue.Uuid)
+	require.NoError(t, err)
+
+	suite.bob, err = account.NewAccount(account.AccountProps{Name: "bob"}, idObjValue.Uuid)
+	require.NoError(t, err)
+
+	suite.book, err = book.NewBook(book.BookProps{Instrument: "BTC/USDT"}, idObjValue.Uuid)
+	require.NoError(t, err)
+
+	suite.postings = nil
+}
+
+func (suite *ReconciliationUnitTestSuite) flush() {
+	for _, acct := range []*account.Account{suite.alice, suite.bob} {
+		suite.postings = append(suite.postings, acct.TakePostings()...)
+	}
+}
+
+func (suite *ReconciliationUnitTestSuite) snapshot() reconciliation.Snapshot {
+	suite.flush()
+
+	return reconciliation.Snapshot{
+		Accounts: []*account.Account{suite.alice, suite.bob},
+		Books:    []*book.Book{suite.book},
+		Postings: suite.postings,
+	}
+}
+
+func (suite *ReconciliationUnitTestSuite) placeBuy(acct *account.Account, price, qty int64) {
+	t := suite.T()
+
+	o, err := order.NewOrder(order.OrderProps{
+		AccountID:  acct.GetID(),
+		Instrument: "BTC/USDT",
+		Side:       order.Buy,
+		Price:      price,
+		Qty:        qty,
+		Remaining:  qty,
+	}, idObjValue.Uuid)
+	require.NoError(t, err)
+	require.NoError(t, acct.Reserve("USDT", price*qty, ledger.OrderRef(o.GetID())))
+
+	suite.book.AddOrder(o)
+}
+
+func (suite *ReconciliationUnitTestSuite) TestReconcile_Balanced() {
+	t := suite.T()
+
+	require.NoError(t, suite.alice.Credit("USDT", 1000))
+	require.NoError(t, suite.bob.Credit("USDT", 500))
+	require.NoError(t, suite.bob.Debit("USDT", 200, ledger.WithdrawalRef("w-1")))
+	require.NoError(t, accountServices.Transfer(suite.alice, suite.bob, "USDT", 100, ledger.TransferRef("t-1")))
+	suite.placeBuy(suite.alice, 10, 30)
+
+	report := reconciliation.Reconcile(suite.snapshot())
+	assert.True(t, report.Balanced(), "%+v", report.Discrepancies)
+	require.Len(t, report.Assets, 1)
+	assert.Equal(t, reconciliation.AssetSummary{
+		Asset:       "USDT",
+		Holdings:    1300,
+		Deposits:    1500,
+		Withdrawals: 200,
+	}, report.Assets[0])
+	assert.NotZero(t, report.CheckedAt)
+}
+
+func (suite *ReconciliationUnitTestSuite) TestReconcile_CountsFeeAccountHoldings() {
+	t := suite.T()
+
+	require.NoError(t, suite.alice.Credit("USDT", 1000))
+	require.NoError(t, accountServices.Transfer(suite.alice, suite.bob, "USDT", 10, ledger.TransferRef("t-1")))
+
+	snapshot := suite.snapshot()
+	snapshot.FeeAccountID = suite.bob.GetID()
+
+	report := reconciliation.Reconcile(snapshot)
+	assert.True(t, report.Balanced())
+	assert.Equal(t, int64(10), report.Assets[0].Fees)
+	assert.Equal(t, int64(1000), report.Assets[0].Holdings)
+}
+
+func (suite *ReconciliationUnitTestSuite) TestReconcile_DetectsUnbackedBalance() {
+	t := suite.T()
+
+	require.NoError(t, suite.alice.Credit("USDT", 1000))
+	suite.flush()
+
+	suite.alice.Balances["USDT"].Available += 50
+
+	report := reconciliation.Reconcile(suite.snapshot())
+	assert.False(t, report.Balanced())
+	assert.Contains(t, report.Discrepancies, reconciliation.Discrepancy{
+		Kind:      reconciliation.LedgerBalance,
+		AccountID: suite.alice.GetID(),
+		Asset:     "USDT",
+		Expected:  1000,
+		Actual:    1050,
+	})
+	assert.Contains(t, report.Discrepancies, reconciliation.Discrepancy{
+		Kind:     reconciliation.AssetSupply,
+		Asset:    "USDT",
+		Expected: 1000,
+		Actual:   1050,
+	})
+}
+
+func (suite *ReconciliationUnitTestSuite) TestReconcile_DetectsOrphanReserve() {
+	t := suite.T()
+
+	require.NoError(t, suite.alice.Credit("USDT", 1000))
+	suite.placeBuy(suite.alice, 10, 30)
+	require.NoError(t, suite.alice.Reserve("USDT", 20, ledger.OrderRef("gone")))
+
+	report := reconciliation.Reconcile(suite.snapshot())
+	assert.Equal(t, []reconciliation.Discrepancy{{
+		Kind:      reconciliation.ReservedObligations,
+		AccountID: suite.alice.GetID(),
+		Asset:     "USDT",
+		Expected:  300,
+		Actual:    320,
+	}}, report.Discrepancies)
+}
+
+func (suite *ReconciliationUnitTestSuite) TestReconcile_DetectsMissingReserve() {
+	t := suite.T()
+
+	require.NoError(t, suite.bob.Credit("BTC", 5))
+
+	o, err := order.NewOrder(order.OrderProps{
+		AccountID:  suite.bob.GetID(),
+		Instrument: "BTC/USDT",
+		Side:       order.Sell,
+		Price:      10,
+		Qty:        5,
+		Remaining:  5,
+	}, idObjValue.Uuid)
+	require.NoError(t, err)
+	suite.book.AddOrder(o)
+
+	report := reconciliation.Reconcile(suite.snapshot())
+	assert.Equal(t, []reconciliation.Discrepancy{{
+		Kind:      reconciliation.ReservedObligations,
+		AccountID: suite.bob.GetID(),
+		Asset:     "BTC",
+		Expected:  5,
+		Actual:    0,
+	}}, report.Discrepancies)
+}
+
+func (suite *ReconciliationUnitTestSuite) TestReconcile_DetectsUnsettledClearing() {
+	t := suite.T()
+
+	require.NoError(t, suite.alice.Credit("USDT", 1000))
+	require.NoError(t, suite.alice.Reserve("USDT", 100, ledger.OrderRef("o-1")))
+	require.NoError(t, suite.alice.UseReserved("USDT", 100, ledger.TradeRef("t-1")))
+
+	report := reconciliation.Reconcile(suite.snapshot())
+	assert.Contains(t, report.Discrepancies, reconciliation.Discrepancy{
+		Kind:      reconciliation.UnsettledClearing,
+		AccountID: "trade:t-1",
+		Asset:     "USDT",
+		Expected:  0,
+		Actual:    100,
+	})
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(ReconciliationUnitTestSuite))
+}
@@ -0,0 +1,55 @@
+package risk
+
+type (
+	IRiskChecker interface {
+		Check(ctx OrderContext) error
+	}
+	Chain struct {
+		LimitsRepo ILimitsRepository
+		checks     []IRiskCheck
+	}
+)
+
+func (c *Chain) Check(ctx OrderContext) error {
+	limits, err := c.LimitsRepo.GetLimits(ctx.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if limits == nil {
+		return nil
+	}
+
+	if ctx.ListOpenOrders != nil && limits.WatchesOpenOrders() {
+		ctx.OpenOrders, err = ctx.ListOpenOrders()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, check := range c.checks {
+		err = check.Check(limits, ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewChain(limitsRepo ILimitsRepository, checks ...IRiskCheck) *Chain {
+	return &Chain{
+		LimitsRepo: limitsRepo,
+		checks:     checks,
+	}
+}
+
+func NewDefaultChain(limitsRepo ILimitsRepository) *Chain {
+	return NewChain(
+		limitsRepo,
+		&MaxOrderNotionalCheck{},
+		&MaxOpenOrdersCheck{},
+		&MaxPositionCheck{},
+		NewOrderRateCheck(),
+	)
+}
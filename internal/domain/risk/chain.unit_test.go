@@ -0,0 +1,177 @@
+//go:build all || unit || domain
+
+package risk_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	"github.com/juninhoitabh/clob-go/internal/domain/order"
+	"github.com/juninhoitabh/clob-go/internal/domain/risk"
+	"github.com/juninhoitabh/clob-go/internal/domain/risk/fakers"
+	riskMocks "github.com/juninhoitabh/clob-go/internal/infra/repositories/risk/mocks"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type ChainUnitTestSuite struct {
+	suite.Suite
+	limits     *risk.Limits
+	limitsRepo *riskMocks.MockILimitsRepository
+	ctrl       *gomock.Controller
+	chain      *risk.Chain
+	ctx        risk.OrderContext
+}
+
+func (suite *ChainUnitTestSuite) SetupTest() {
+	suite.limits, _ = risk.NewLimits(fakers.LimitsPropsFaker())
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.limitsRepo = riskMocks.NewMockILimitsRepository(suite.ctrl)
+	suite.limitsRepo.EXPECT().GetLimits(suite.limits.AccountID).Return(suite.limits, nil).AnyTimes()
+	suite.chain = risk.NewDefaultChain(suite.limitsRepo)
+	suite.ctx = risk.OrderContext{
+		Now:        time.Now(),
+		Balances:   map[string]*account.Balance{},
+		AccountID:  suite.limits.AccountID,
+		Instrument: "BTC/USDT",
+		Base:       "BTC",
+		Quote:      "USDT",
+		Side:       order.Buy,
+		Price:      100,
+		Qty:        5,
+	}
+}
+
+func (suite *ChainUnitTestSuite) TearDownTest() {
+	suite.ctrl.Finish()
+}
+
+func (suite *ChainUnitTestSuite) TestCheck_Passes() {
+	err := suite.chain.Check(suite.ctx)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ChainUnitTestSuite) TestCheck_NoLimits() {
+	limitsRepo := riskMocks.NewMockILimitsRepository(suite.ctrl)
+	limitsRepo.EXPECT().GetLimits(suite.ctx.AccountID).Return(nil, nil)
+	chain := risk.NewDefaultChain(limitsRepo)
+	suite.ctx.Qty = 1000
+
+	err := chain.Check(suite.ctx)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ChainUnitTestSuite) TestCheck_RepositoryError() {
+	limitsRepo := riskMocks.NewMockILimitsRepository(suite.ctrl)
+	limitsRepo.EXPECT().GetLimits(suite.ctx.AccountID).Return(nil, errors.New("repo error"))
+	chain := risk.NewDefaultChain(limitsRepo)
+
+	err := chain.Check(suite.ctx)
+	assert.EqualError(suite.T(), err, "repo error")
+}
+
+func (suite *ChainUnitTestSuite) TestCheck_MaxOrderNotional() {
+	suite.ctx.Qty = 11
+
+	err := suite.chain.Check(suite.ctx)
+	assert.ErrorIs(suite.T(), err, risk.ErrMaxOrderNotional)
+
+	var rejectErr *shared.RejectError
+	assert.True(suite.T(), errors.As(err, &rejectErr))
+	assert.Equal(suite.T(), "RISK_MAX_ORDER_NOTIONAL", rejectErr.Code)
+}
+
+func (suite *ChainUnitTestSuite) TestCheck_MaxOpenOrders() {
+	suite.ctx.OpenOrders = []*order.Order{
+		{Instrument: "BTC/USDT", Side: order.Sell, Remaining: 1},
+		{Instrument: "BTC/USDT", Side: order.Sell, Remaining: 1},
+	}
+
+	err := suite.chain.Check(suite.ctx)
+	assert.ErrorIs(suite.T(), err, risk.ErrMaxOpenOrders)
+}
+
+func (suite *ChainUnitTestSuite) TestCheck_ListsOpenOrdersWhenLimited() {
+	suite.ctx.ListOpenOrders = func() ([]*order.Order, error) {
+		return []*order.Order{
+			{Instrument: "BTC/USDT", Side: order.Sell, Remaining: 1},
+			{Instrument: "BTC/USDT", Side: order.Sell, Remaining: 1},
+		}, nil
+	}
+
+	err := suite.chain.Check(suite.ctx)
+	assert.ErrorIs(suite.T(), err, risk.ErrMaxOpenOrders)
+}
+
+func (suite *ChainUnitTestSuite) TestCheck_ListOpenOrdersError() {
+	suite.ctx.ListOpenOrders = func() ([]*order.Order, error) {
+		return nil, errors.New("list error")
+	}
+
+	err := suite.chain.Check(suite.ctx)
+	assert.EqualError(suite.T(), err, "list error")
+}
+
+func (suite *ChainUnitTestSuite) TestCheck_SkipsListingWithoutOpenOrderLimits() {
+	limits, _ := risk.NewLimits(risk.LimitsProps{AccountID: suite.ctx.AccountID, MaxOrderNotional: 1000})
+	limitsRepo := riskMocks.NewMockILimitsRepository(suite.ctrl)
+	limitsRepo.EXPECT().GetLimits(suite.ctx.AccountID).Return(limits, nil)
+	chain := risk.NewDefaultChain(limitsRepo)
+	suite.ctx.ListOpenOrders = func() ([]*order.Order, error) {
+		suite.T().Error("open orders listed without a limit on them")
+
+		return nil, nil
+	}
+
+	err := chain.Check(suite.ctx)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ChainUnitTestSuite) TestCheck_MaxOpenOrdersOtherInstrument() {
+	suite.ctx.OpenOrders = []*order.Order{
+		{Instrument: "ETH/USDT", Side: order.Sell, Remaining: 1},
+		{Instrument: "ETH/USDT", Side: order.Sell, Remaining: 1},
+	}
+
+	err := suite.chain.Check(suite.ctx)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ChainUnitTestSuite) TestCheck_MaxPosition() {
+	suite.ctx.Price = 1
+	suite.ctx.Balances["BTC"] = &account.Balance{Available: 3, Reserved: 1}
+	suite.ctx.OpenOrders = []*order.Order{
+		{Instrument: "BTC/EUR", Side: order.Buy, Remaining: 2},
+	}
+
+	err := suite.chain.Check(suite.ctx)
+	assert.ErrorIs(suite.T(), err, risk.ErrMaxPosition)
+}
+
+func (suite *ChainUnitTestSuite) TestCheck_MaxPositionIgnoresSell() {
+	suite.ctx.Side = order.Sell
+	suite.ctx.Price = 1
+	suite.ctx.Balances["BTC"] = &account.Balance{Available: 50}
+
+	err := suite.chain.Check(suite.ctx)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ChainUnitTestSuite) TestCheck_OrderRate() {
+	for range 3 {
+		err := suite.chain.Check(suite.ctx)
+		assert.NoError(suite.T(), err)
+	}
+
+	err := suite.chain.Check(suite.ctx)
+	assert.ErrorIs(suite.T(), err, risk.ErrOrderRateExceeded)
+
+	suite.ctx.Now = suite.ctx.Now.Add(time.Second)
+
+	err = suite.chain.Check(suite.ctx)
+	assert.NoError(suite.T(), err)
+}
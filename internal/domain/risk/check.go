@@ -0,0 +1,148 @@
+package risk
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	"github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/domain/order"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+var (
+	ErrMaxOrderNotional  = shared.NewRejectError("RISK_MAX_ORDER_NOTIONAL", "order notional exceeds limit")
+	ErrMaxOpenOrders     = shared.NewRejectError("RISK_MAX_OPEN_ORDERS", "open orders per instrument exceed limit")
+	ErrMaxPosition       = shared.NewRejectError("RISK_MAX_POSITION", "position exceeds limit")
+	ErrOrderRateExceeded = shared.NewRejectError("RISK_ORDER_RATE", "order rate exceeds limit")
+)
+
+type (
+	// OrderContext is what the checks see of an order. OpenOrders is filled
+	// by the chain from ListOpenOrders, and only when the account's limits
+	// look at open orders.
+	OrderContext struct {
+		Now            time.Time
+		Balances       map[string]*account.Balance
+		ListOpenOrders func() ([]*order.Order, error)
+		AccountID      string
+		Instrument     string
+		Base           string
+		Quote          string
+		OpenOrders     []*order.Order
+		Side           order.Side
+		Price          int64
+		Qty            int64
+	}
+	IRiskCheck interface {
+		Check(limits *Limits, ctx OrderContext) error
+	}
+	MaxOrderNotionalCheck struct{}
+	MaxOpenOrdersCheck    struct{}
+	MaxPositionCheck      struct{}
+	OrderRateCheck        struct {
+		submissions map[string][]time.Time
+		mu          sync.Mutex
+	}
+)
+
+func (c *MaxOrderNotionalCheck) Check(limits *Limits, ctx OrderContext) error {
+	if limits.MaxOrderNotional == 0 {
+		return nil
+	}
+
+	notional := shared.Mul(ctx.Price, ctx.Qty)
+	if notional > limits.MaxOrderNotional {
+		return fmt.Errorf("%w: %d > %d", ErrMaxOrderNotional, notional, limits.MaxOrderNotional)
+	}
+
+	return nil
+}
+
+func (c *MaxOpenOrdersCheck) Check(limits *Limits, ctx OrderContext) error {
+	if limits.MaxOpenOrders == 0 {
+		return nil
+	}
+
+	var open int64
+
+	for _, o := range ctx.OpenOrders {
+		if o.Instrument == ctx.Instrument {
+			open++
+		}
+	}
+
+	if open >= limits.MaxOpenOrders {
+		return fmt.Errorf("%w: %d open on %s, limit %d", ErrMaxOpenOrders, open, ctx.Instrument, limits.MaxOpenOrders)
+	}
+
+	return nil
+}
+
+func (c *MaxPositionCheck) Check(limits *Limits, ctx OrderContext) error {
+	if ctx.Side != order.Buy {
+		return nil
+	}
+
+	max := limits.MaxPosition(ctx.Base)
+	if max == 0 {
+		return nil
+	}
+
+	position := ctx.Qty
+	if balance := ctx.Balances[ctx.Base]; balance != nil {
+		position += balance.Available + balance.Reserved
+	}
+
+	for _, o := range ctx.OpenOrders {
+		if o.Side != order.Buy {
+			continue
+		}
+
+		if base, _, err := book.SplitInstrument(o.Instrument); err == nil && base == ctx.Base {
+			position += o.Remaining
+		}
+	}
+
+	if position > max {
+		return fmt.Errorf("%w: %s %d > %d", ErrMaxPosition, ctx.Base, position, max)
+	}
+
+	return nil
+}
+
+func (c *OrderRateCheck) Check(limits *Limits, ctx OrderContext) error {
+	if limits.MaxOrdersPerWindow == 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cutoff := ctx.Now.Add(-limits.RateWindow)
+	submissions := c.submissions[ctx.AccountID]
+
+	i := 0
+	for i < len(submissions) && !submissions[i].After(cutoff) {
+		i++
+	}
+
+	submissions = submissions[i:]
+
+	if int64(len(submissions)) >= limits.MaxOrdersPerWindow {
+		c.submissions[ctx.AccountID] = submissions
+
+		return fmt.Errorf("%w: %d orders in %s", ErrOrderRateExceeded, limits.MaxOrdersPerWindow, limits.RateWindow)
+	}
+
+	c.submissions[ctx.AccountID] = append(submissions, ctx.Now)
+
+	return nil
+}
+
+func NewOrderRateCheck() *OrderRateCheck {
+	return &OrderRateCheck{
+		submissions: make(map[string][]time.Time),
+	}
+}
@@ -0,0 +1,98 @@
+package risk
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidLimits = errors.New("invalid risk limits")
+)
+
+type (
+	LimitsProps struct {
+		MaxPositions       map[string]int64
+		AccountID          string
+		MaxOrderNotional   int64
+		MaxOpenOrders      int64
+		MaxOrdersPerWindow int64
+		RateWindow         time.Duration
+	}
+	Limits struct {
+		UpdatedAt          time.Time
+		MaxPositions       map[string]int64
+		AccountID          string
+		MaxOrderNotional   int64
+		MaxOpenOrders      int64
+		MaxOrdersPerWindow int64
+		RateWindow         time.Duration
+	}
+)
+
+func (l *Limits) Prepare() error {
+	err := l.Validate()
+	if err != nil {
+		return err
+	}
+
+	positions := make(map[string]int64, len(l.MaxPositions))
+	for asset, max := range l.MaxPositions {
+		positions[strings.ToUpper(asset)] = max
+	}
+
+	l.MaxPositions = positions
+	l.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// WatchesOpenOrders reports whether any limit counts the account's open
+// orders, which are otherwise not worth listing.
+func (l *Limits) WatchesOpenOrders() bool {
+	return l.MaxOpenOrders > 0 || len(l.MaxPositions) > 0
+}
+
+func (l *Limits) Validate() error {
+	if l.AccountID == "" {
+		return ErrInvalidLimits
+	}
+
+	if l.MaxOrderNotional < 0 || l.MaxOpenOrders < 0 || l.MaxOrdersPerWindow < 0 || l.RateWindow < 0 {
+		return ErrInvalidLimits
+	}
+
+	if l.MaxOrdersPerWindow > 0 && l.RateWindow == 0 {
+		return ErrInvalidLimits
+	}
+
+	for asset, max := range l.MaxPositions {
+		if asset == "" || max < 0 {
+			return ErrInvalidLimits
+		}
+	}
+
+	return nil
+}
+
+func (l *Limits) MaxPosition(asset string) int64 {
+	return l.MaxPositions[strings.ToUpper(asset)]
+}
+
+func NewLimits(props LimitsProps) (*Limits, error) {
+	limits := Limits{
+		AccountID:          props.AccountID,
+		MaxOrderNotional:   props.MaxOrderNotional,
+		MaxOpenOrders:      props.MaxOpenOrders,
+		MaxPositions:       props.MaxPositions,
+		MaxOrdersPerWindow: props.MaxOrdersPerWindow,
+		RateWindow:         props.RateWindow,
+	}
+
+	err := limits.Prepare()
+	if err != nil {
+		return nil, err
+	}
+
+	return &limits, nil
+}
@@ -0,0 +1,73 @@
+//go:build all || unit || domain
+
+package risk_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/risk"
+	"github.com/juninhoitabh/clob-go/internal/domain/risk/fakers"
+)
+
+type LimitsUnitTestSuite struct {
+	suite.Suite
+	propsFaker risk.LimitsProps
+}
+
+func (suite *LimitsUnitTestSuite) SetupTest() {
+	suite.propsFaker = fakers.LimitsPropsFaker()
+}
+
+func (suite *LimitsUnitTestSuite) TestNewLimits_Success() {
+	limits, err := risk.NewLimits(suite.propsFaker)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.propsFaker.AccountID, limits.AccountID)
+	assert.Equal(suite.T(), int64(1000), limits.MaxOrderNotional)
+	assert.Equal(suite.T(), int64(10), limits.MaxPosition("BTC"))
+	assert.Equal(suite.T(), int64(10), limits.MaxPosition("btc"))
+	assert.NotEmpty(suite.T(), limits.UpdatedAt)
+}
+
+func (suite *LimitsUnitTestSuite) TestNewLimits_MissingAccount() {
+	props := suite.propsFaker
+	props.AccountID = ""
+
+	limits, err := risk.NewLimits(props)
+	assert.ErrorIs(suite.T(), err, risk.ErrInvalidLimits)
+	assert.Nil(suite.T(), limits)
+}
+
+func (suite *LimitsUnitTestSuite) TestNewLimits_NegativeValue() {
+	props := suite.propsFaker
+	props.MaxOpenOrders = -1
+
+	limits, err := risk.NewLimits(props)
+	assert.ErrorIs(suite.T(), err, risk.ErrInvalidLimits)
+	assert.Nil(suite.T(), limits)
+}
+
+func (suite *LimitsUnitTestSuite) TestNewLimits_NegativePosition() {
+	props := suite.propsFaker
+	props.MaxPositions = map[string]int64{"BTC": -1}
+
+	limits, err := risk.NewLimits(props)
+	assert.ErrorIs(suite.T(), err, risk.ErrInvalidLimits)
+	assert.Nil(suite.T(), limits)
+}
+
+func (suite *LimitsUnitTestSuite) TestNewLimits_RateWithoutWindow() {
+	props := suite.propsFaker
+	props.RateWindow = 0
+
+	limits, err := risk.NewLimits(props)
+	assert.ErrorIs(suite.T(), err, risk.ErrInvalidLimits)
+	assert.Nil(suite.T(), limits)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(LimitsUnitTestSuite))
+	suite.Run(t, new(ChainUnitTestSuite))
+}
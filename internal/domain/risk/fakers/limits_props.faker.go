@@ -0,0 +1,22 @@
+package fakers
+
+import (
+	"time"
+
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/risk"
+)
+
+func LimitsPropsFaker() risk.LimitsProps {
+	faker := faker.New(0)
+
+	return risk.LimitsProps{
+		AccountID:          faker.UUID(),
+		MaxOrderNotional:   1000,
+		MaxOpenOrders:      2,
+		MaxPositions:       map[string]int64{"btc": 10},
+		MaxOrdersPerWindow: 3,
+		RateWindow:         time.Second,
+	}
+}
@@ -0,0 +1,6 @@
+package risk
+
+type ILimitsRepository interface {
+	GetLimits(accountID string) (*Limits, error)
+	SaveLimits(limits *Limits) error
+}
@@ -0,0 +1,23 @@
+package trade
+
+import (
+	"time"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+)
+
+type (
+	Filter struct {
+		Since      time.Time
+		AccountID  string
+		Instrument string
+		Limit      int
+	}
+	ITradeRepository interface {
+		SaveTrade(t *services.Trade) error
+		ListTrades(filter Filter) ([]*services.Trade, error)
+		// RollingVolume is the notional accountID has traded since since,
+		// kept up to date as trades are saved rather than summed per call.
+		RollingVolume(accountID string, since time.Time) (int64, error)
+	}
+)
@@ -0,0 +1,89 @@
+package transfer
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/juninhoitabh/clob-go/internal/shared"
+	baseEntity "github.com/juninhoitabh/clob-go/internal/shared/domain/entities"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+var (
+	ErrInvalidTransfer     = errors.New("invalid transfer")
+	ErrInsufficientFunds   = shared.NewRejectError("INSUFFICIENT_AVAILABLE_BALANCE", "insufficient available balance")
+	ErrIdempotencyMismatch = errors.New("idempotency key already used with different parameters")
+	ErrOutsideHierarchy    = shared.NewRejectError("TRANSFER_OUTSIDE_HIERARCHY", "sub-accounts can only transfer to or from their parent")
+)
+
+type (
+	TransferProps struct {
+		IdempotencyKey string
+		FromAccountID  string
+		ToAccountID    string
+		Asset          string
+		Amount         int64
+	}
+	Transfer struct {
+		CreatedAt time.Time
+		baseEntity.BaseEntity
+		IdempotencyKey string
+		FromAccountID  string
+		ToAccountID    string
+		Asset          string
+		Amount         int64
+	}
+)
+
+func (t *Transfer) Prepare(typeId idObjValue.TypeIdEnum) error {
+	t.Asset = strings.ToUpper(t.Asset)
+
+	err := t.Validate()
+	if err != nil {
+		return err
+	}
+
+	t.BaseEntity.NewBaseEntity("", typeId)
+
+	t.CreatedAt = time.Now()
+
+	return nil
+}
+
+func (t *Transfer) Validate() error {
+	if t.IdempotencyKey == "" || t.FromAccountID == "" || t.ToAccountID == "" || t.Asset == "" {
+		return ErrInvalidTransfer
+	}
+
+	if t.FromAccountID == t.ToAccountID || t.Amount <= 0 {
+		return ErrInvalidTransfer
+	}
+
+	return nil
+}
+
+func (t *Transfer) Matches(props TransferProps) bool {
+	return t.IdempotencyKey == props.IdempotencyKey &&
+		t.FromAccountID == props.FromAccountID &&
+		t.ToAccountID == props.ToAccountID &&
+		t.Asset == strings.ToUpper(props.Asset) &&
+		t.Amount == props.Amount
+}
+
+func NewTransfer(props TransferProps, typeId idObjValue.TypeIdEnum) (*Transfer, error) {
+	transfer := Transfer{
+		IdempotencyKey: props.IdempotencyKey,
+		FromAccountID:  props.FromAccountID,
+		ToAccountID:    props.ToAccountID,
+		Asset:          props.Asset,
+		Amount:         props.Amount,
+	}
+
+	err := transfer.Prepare(typeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transfer, nil
+}
@@ -0,0 +1,63 @@
+//go:build all || unit || domain
+
+package transfer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/transfer"
+	"github.com/juninhoitabh/clob-go/internal/domain/transfer/fakers"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type TransferUnitTestSuite struct {
+	suite.Suite
+	propsFaker transfer.TransferProps
+}
+
+func (suite *TransferUnitTestSuite) SetupTest() {
+	suite.propsFaker = fakers.TransferPropsFaker()
+}
+
+func (suite *TransferUnitTestSuite) TestNewTransfer_Success() {
+	tr, err := transfer.NewTransfer(suite.propsFaker, idObjValue.Uuid)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), tr.GetID())
+	assert.Equal(suite.T(), "USDT", tr.Asset)
+	assert.NotZero(suite.T(), tr.CreatedAt)
+}
+
+func (suite *TransferUnitTestSuite) TestNewTransfer_Invalid() {
+	cases := []func(p *transfer.TransferProps){
+		func(p *transfer.TransferProps) { p.IdempotencyKey = "" },
+		func(p *transfer.TransferProps) { p.Asset = "" },
+		func(p *transfer.TransferProps) { p.Amount = 0 },
+		func(p *transfer.TransferProps) { p.ToAccountID = p.FromAccountID },
+	}
+
+	for _, mutate := range cases {
+		props := suite.propsFaker
+		mutate(&props)
+
+		tr, err := transfer.NewTransfer(props, idObjValue.Uuid)
+		assert.ErrorIs(suite.T(), err, transfer.ErrInvalidTransfer)
+		assert.Nil(suite.T(), tr)
+	}
+}
+
+func (suite *TransferUnitTestSuite) TestMatches() {
+	tr, _ := transfer.NewTransfer(suite.propsFaker, idObjValue.Uuid)
+
+	assert.True(suite.T(), tr.Matches(suite.propsFaker))
+
+	props := suite.propsFaker
+	props.Amount++
+	assert.False(suite.T(), tr.Matches(props))
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(TransferUnitTestSuite))
+}
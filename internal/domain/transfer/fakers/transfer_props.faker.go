@@ -0,0 +1,19 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/transfer"
+)
+
+func TransferPropsFaker() transfer.TransferProps {
+	faker := faker.New(0)
+
+	return transfer.TransferProps{
+		IdempotencyKey: faker.UUID(),
+		FromAccountID:  faker.UUID(),
+		ToAccountID:    faker.UUID(),
+		Asset:          "usdt",
+		Amount:         int64(faker.Price(1, 1000)),
+	}
+}
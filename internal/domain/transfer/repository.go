@@ -0,0 +1,6 @@
+package transfer
+
+type ITransferRepository interface {
+	SaveTransfer(transfer *Transfer) error
+	GetTransferByIdempotencyKey(fromAccountID, key string) (*Transfer, error)
+}
@@ -0,0 +1,103 @@
+package withdrawal
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/juninhoitabh/clob-go/internal/shared"
+	baseEntity "github.com/juninhoitabh/clob-go/internal/shared/domain/entities"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+var (
+	ErrInvalidWithdrawal = errors.New("invalid withdrawal")
+	ErrInvalidTransition = shared.NewRejectError("WITHDRAWAL_NOT_PENDING", "withdrawal is not pending")
+	ErrInsufficientFunds = shared.NewRejectError("INSUFFICIENT_AVAILABLE_BALANCE", "insufficient available balance")
+)
+
+type Status string
+
+const (
+	Pending   Status = "pending"
+	Completed Status = "completed"
+	Failed    Status = "failed"
+)
+
+type (
+	WithdrawalProps struct {
+		AccountID string
+		Asset     string
+		Amount    int64
+	}
+	Withdrawal struct {
+		CreatedAt time.Time
+		UpdatedAt time.Time
+		baseEntity.BaseEntity
+		AccountID string
+		Asset     string
+		Status    Status
+		Reason    string
+		Amount    int64
+	}
+)
+
+func (w *Withdrawal) Prepare(typeId idObjValue.TypeIdEnum) error {
+	w.Asset = strings.ToUpper(w.Asset)
+
+	err := w.Validate()
+	if err != nil {
+		return err
+	}
+
+	w.BaseEntity.NewBaseEntity("", typeId)
+
+	w.Status = Pending
+	w.CreatedAt = time.Now()
+	w.UpdatedAt = w.CreatedAt
+
+	return nil
+}
+
+func (w *Withdrawal) Validate() error {
+	if w.AccountID == "" || w.Asset == "" || w.Amount <= 0 {
+		return ErrInvalidWithdrawal
+	}
+
+	return nil
+}
+
+func (w *Withdrawal) Complete() error {
+	return w.transition(Completed, "")
+}
+
+func (w *Withdrawal) Fail(reason string) error {
+	return w.transition(Failed, reason)
+}
+
+func (w *Withdrawal) transition(status Status, reason string) error {
+	if w.Status != Pending {
+		return ErrInvalidTransition
+	}
+
+	w.Status = status
+	w.Reason = reason
+	w.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func NewWithdrawal(props WithdrawalProps, typeId idObjValue.TypeIdEnum) (*Withdrawal, error) {
+	withdrawal := Withdrawal{
+		AccountID: props.AccountID,
+		Asset:     props.Asset,
+		Amount:    props.Amount,
+	}
+
+	err := withdrawal.Prepare(typeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &withdrawal, nil
+}
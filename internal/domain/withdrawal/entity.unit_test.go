@@ -0,0 +1,71 @@
+//go:build all || unit || domain
+
+package withdrawal_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/withdrawal"
+	"github.com/juninhoitabh/clob-go/internal/domain/withdrawal/fakers"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type WithdrawalUnitTestSuite struct {
+	suite.Suite
+	propsFaker withdrawal.WithdrawalProps
+}
+
+func (suite *WithdrawalUnitTestSuite) SetupTest() {
+	suite.propsFaker = fakers.WithdrawalPropsFaker()
+}
+
+func (suite *WithdrawalUnitTestSuite) TestNewWithdrawal_Success() {
+	w, err := withdrawal.NewWithdrawal(suite.propsFaker, idObjValue.Uuid)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), w.GetID())
+	assert.Equal(suite.T(), withdrawal.Pending, w.Status)
+	assert.Equal(suite.T(), strings.ToUpper(suite.propsFaker.Asset), w.Asset)
+	assert.Equal(suite.T(), suite.propsFaker.Amount, w.Amount)
+	assert.NotZero(suite.T(), w.CreatedAt)
+}
+
+func (suite *WithdrawalUnitTestSuite) TestNewWithdrawal_Invalid() {
+	props := suite.propsFaker
+	props.Amount = 0
+
+	w, err := withdrawal.NewWithdrawal(props, idObjValue.Uuid)
+	assert.ErrorIs(suite.T(), err, withdrawal.ErrInvalidWithdrawal)
+	assert.Nil(suite.T(), w)
+
+	props = suite.propsFaker
+	props.Asset = ""
+
+	_, err = withdrawal.NewWithdrawal(props, idObjValue.Uuid)
+	assert.ErrorIs(suite.T(), err, withdrawal.ErrInvalidWithdrawal)
+}
+
+func (suite *WithdrawalUnitTestSuite) TestComplete() {
+	w, _ := withdrawal.NewWithdrawal(suite.propsFaker, idObjValue.Uuid)
+
+	assert.NoError(suite.T(), w.Complete())
+	assert.Equal(suite.T(), withdrawal.Completed, w.Status)
+	assert.ErrorIs(suite.T(), w.Fail("late"), withdrawal.ErrInvalidTransition)
+	assert.Equal(suite.T(), withdrawal.Completed, w.Status)
+}
+
+func (suite *WithdrawalUnitTestSuite) TestFail() {
+	w, _ := withdrawal.NewWithdrawal(suite.propsFaker, idObjValue.Uuid)
+
+	assert.NoError(suite.T(), w.Fail("rejected by custodian"))
+	assert.Equal(suite.T(), withdrawal.Failed, w.Status)
+	assert.Equal(suite.T(), "rejected by custodian", w.Reason)
+	assert.ErrorIs(suite.T(), w.Complete(), withdrawal.ErrInvalidTransition)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(WithdrawalUnitTestSuite))
+}
@@ -0,0 +1,17 @@
+package fakers
+
+import (
+	faker "github.com/brianvoe/gofakeit/v7"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/withdrawal"
+)
+
+func WithdrawalPropsFaker() withdrawal.WithdrawalProps {
+	faker := faker.New(0)
+
+	return withdrawal.WithdrawalProps{
+		AccountID: faker.UUID(),
+		Asset:     faker.CurrencyShort(),
+		Amount:    int64(faker.Price(1, 1000)),
+	}
+}
@@ -0,0 +1,11 @@
+package withdrawal
+
+type IWithdrawalRepository interface {
+	SaveWithdrawal(withdrawal *Withdrawal) error
+	// TransitionWithdrawal stores withdrawal only if the stored one still
+	// has status from, and fails with ErrInvalidTransition otherwise, so of
+	// two racing transitions out of Pending only one takes effect.
+	TransitionWithdrawal(withdrawal *Withdrawal, from Status) error
+	GetWithdrawal(id string) (*Withdrawal, error)
+	ListWithdrawalsByAccount(accountID string) ([]*Withdrawal, error)
+}
@@ -3,12 +3,45 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
-	ApiHost     string
-	ApiPort     string
-	Environment string
+	FeeVolumeTiers           map[string]int64
+	ApiHost                  string
+	ApiPort                  string
+	Environment              string
+	PriceBandBps             int64
+	CircuitBreakerMoveBps    int64
+	CircuitBreakerWindow     time.Duration
+	CircuitBreakerHalt       time.Duration
+	FeeAccountID             string
+	AdminAPIKey              string
+	AdminAPISecret           string
+	MakerFeeBps              int64
+	TakerFeeBps              int64
+	ReconciliationInterval   time.Duration
+	AuthMaxSkew              time.Duration
+	DeadManSwitchInterval    time.Duration
+	SessionHeartbeatInterval time.Duration
+	FixPort                  string
+	FixCompID                string
+	FixFillPollInterval      time.Duration
+	BinaryPort               string
+	BinaryFillPollInterval   time.Duration
+	GrpcPort                 string
+	GrpcStreamPollInterval   time.Duration
+	RateLimitOrders          int64
+	RateLimitOrdersBurst     int64
+	RateLimitCancels         int64
+	RateLimitCancelsBurst    int64
+	RateLimitMarketData      int64
+	RateLimitMarketDataBurst int64
+	BatchMaxOrders           int64
+	AuthEnabled              bool
+	RateLimitEnabled         bool
 }
 
 func getEnv(key, defaultValue string) string {
@@ -20,11 +53,90 @@ func getEnv(key, defaultValue string) string {
 	return value
 }
 
+func getEnvInt64(key string, defaultValue int64) int64 {
+	value, err := strconv.ParseInt(getEnv(key, ""), 10, 64)
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(getEnv(key, ""))
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+func getEnvBool(key string, defaultValue bool) bool {
+	value, err := strconv.ParseBool(getEnv(key, ""))
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+func getEnvInt64Map(key string) map[string]int64 {
+	result := make(map[string]int64)
+
+	for _, pair := range strings.Split(getEnv(key, ""), ",") {
+		name, value, found := strings.Cut(strings.TrimSpace(pair), ":")
+		if !found {
+			continue
+		}
+
+		parsed, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		result[strings.TrimSpace(name)] = parsed
+	}
+
+	return result
+}
+
 func LoadConfig() *Config {
+	environment := getEnv("ENVIRONMENT", "development")
+
 	return &Config{
-		ApiHost:     getEnv("API_HOST", "localhost"),
-		ApiPort:     getEnv("API_PORT", "3000"),
-		Environment: getEnv("ENVIRONMENT", "development"),
+		ApiHost:                  getEnv("API_HOST", "localhost"),
+		ApiPort:                  getEnv("API_PORT", "3000"),
+		Environment:              environment,
+		PriceBandBps:             getEnvInt64("PRICE_BAND_BPS", 1000),
+		CircuitBreakerMoveBps:    getEnvInt64("CIRCUIT_BREAKER_MOVE_BPS", 1000),
+		CircuitBreakerWindow:     getEnvDuration("CIRCUIT_BREAKER_WINDOW", time.Minute),
+		CircuitBreakerHalt:       getEnvDuration("CIRCUIT_BREAKER_HALT", 5*time.Minute),
+		FeeAccountID:             getEnv("FEE_ACCOUNT_ID", "fees"),
+		MakerFeeBps:              getEnvInt64("MAKER_FEE_BPS", 0),
+		TakerFeeBps:              getEnvInt64("TAKER_FEE_BPS", 0),
+		FeeVolumeTiers:           getEnvInt64Map("FEE_VOLUME_TIERS"),
+		ReconciliationInterval:   getEnvDuration("RECONCILIATION_INTERVAL", time.Minute),
+		AuthEnabled:              getEnvBool("AUTH_ENABLED", environment != "development"),
+		AuthMaxSkew:              getEnvDuration("AUTH_MAX_SKEW", 30*time.Second),
+		AdminAPIKey:              getEnv("ADMIN_API_KEY", ""),
+		AdminAPISecret:           getEnv("ADMIN_API_SECRET", ""),
+		RateLimitEnabled:         getEnvBool("RATE_LIMIT_ENABLED", true),
+		RateLimitOrders:          getEnvInt64("RATE_LIMIT_ORDERS", 50),
+		RateLimitOrdersBurst:     getEnvInt64("RATE_LIMIT_ORDERS_BURST", 100),
+		RateLimitCancels:         getEnvInt64("RATE_LIMIT_CANCELS", 100),
+		RateLimitCancelsBurst:    getEnvInt64("RATE_LIMIT_CANCELS_BURST", 200),
+		RateLimitMarketData:      getEnvInt64("RATE_LIMIT_MARKET_DATA", 20),
+		RateLimitMarketDataBurst: getEnvInt64("RATE_LIMIT_MARKET_DATA_BURST", 50),
+		BatchMaxOrders:           getEnvInt64("BATCH_MAX_ORDERS", 50),
+		DeadManSwitchInterval:    getEnvDuration("DEAD_MAN_SWITCH_INTERVAL", time.Second),
+		SessionHeartbeatInterval: getEnvDuration("SESSION_HEARTBEAT_INTERVAL", 15*time.Second),
+		FixPort:                  getEnv("FIX_PORT", "9878"),
+		FixCompID:                getEnv("FIX_COMP_ID", "CLOB"),
+		FixFillPollInterval:      getEnvDuration("FIX_FILL_POLL_INTERVAL", 100*time.Millisecond),
+		BinaryPort:               getEnv("BINARY_PORT", "9879"),
+		BinaryFillPollInterval:   getEnvDuration("BINARY_FILL_POLL_INTERVAL", 10*time.Millisecond),
+		GrpcPort:                 getEnv("GRPC_PORT", "9090"),
+		GrpcStreamPollInterval:   getEnvDuration("GRPC_STREAM_POLL_INTERVAL", 100*time.Millisecond),
 	}
 }
 
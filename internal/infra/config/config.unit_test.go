@@ -3,6 +3,7 @@ package config_test
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -78,4 +79,189 @@ func TestConfig_DefaultValues(t *testing.T) {
 	assert.Equal(t, "localhost", cfg.ApiHost)
 	assert.Equal(t, "3000", cfg.ApiPort)
 	assert.Equal(t, "development", cfg.Environment)
+	assert.Equal(t, int64(1000), cfg.PriceBandBps)
+	assert.Equal(t, int64(1000), cfg.CircuitBreakerMoveBps)
+	assert.Equal(t, time.Minute, cfg.CircuitBreakerWindow)
+	assert.Equal(t, 5*time.Minute, cfg.CircuitBreakerHalt)
+	assert.Equal(t, "fees", cfg.FeeAccountID)
+	assert.Equal(t, int64(0), cfg.MakerFeeBps)
+	assert.Equal(t, int64(0), cfg.TakerFeeBps)
+	assert.Empty(t, cfg.FeeVolumeTiers)
+	assert.Equal(t, time.Minute, cfg.ReconciliationInterval)
+	assert.False(t, cfg.AuthEnabled)
+	assert.Equal(t, 30*time.Second, cfg.AuthMaxSkew)
+	assert.Empty(t, cfg.AdminAPIKey)
+	assert.Empty(t, cfg.AdminAPISecret)
+	assert.True(t, cfg.RateLimitEnabled)
+	assert.Equal(t, int64(50), cfg.RateLimitOrders)
+	assert.Equal(t, int64(100), cfg.RateLimitOrdersBurst)
+	assert.Equal(t, int64(100), cfg.RateLimitCancels)
+	assert.Equal(t, int64(200), cfg.RateLimitCancelsBurst)
+	assert.Equal(t, int64(20), cfg.RateLimitMarketData)
+	assert.Equal(t, int64(50), cfg.RateLimitMarketDataBurst)
+	assert.Equal(t, int64(50), cfg.BatchMaxOrders)
+	assert.Equal(t, time.Second, cfg.DeadManSwitchInterval)
+	assert.Equal(t, 15*time.Second, cfg.SessionHeartbeatInterval)
+	assert.Equal(t, "9878", cfg.FixPort)
+	assert.Equal(t, "CLOB", cfg.FixCompID)
+	assert.Equal(t, 100*time.Millisecond, cfg.FixFillPollInterval)
+	assert.Equal(t, "9879", cfg.BinaryPort)
+	assert.Equal(t, 10*time.Millisecond, cfg.BinaryFillPollInterval)
+	assert.Equal(t, "9090", cfg.GrpcPort)
+	assert.Equal(t, 100*time.Millisecond, cfg.GrpcStreamPollInterval)
+}
+
+func TestLoadConfig_CircuitBreaker(t *testing.T) {
+	t.Setenv("PRICE_BAND_BPS", "250")
+	t.Setenv("CIRCUIT_BREAKER_MOVE_BPS", "500")
+	t.Setenv("CIRCUIT_BREAKER_WINDOW", "30s")
+	t.Setenv("CIRCUIT_BREAKER_HALT", "2m")
+
+	cfg := config.LoadConfig()
+
+	assert.Equal(t, int64(250), cfg.PriceBandBps)
+	assert.Equal(t, int64(500), cfg.CircuitBreakerMoveBps)
+	assert.Equal(t, 30*time.Second, cfg.CircuitBreakerWindow)
+	assert.Equal(t, 2*time.Minute, cfg.CircuitBreakerHalt)
+}
+
+func TestLoadConfig_InvalidNumbersUseDefaults(t *testing.T) {
+	t.Setenv("PRICE_BAND_BPS", "abc")
+	t.Setenv("CIRCUIT_BREAKER_WINDOW", "soon")
+
+	cfg := config.LoadConfig()
+
+	assert.Equal(t, int64(1000), cfg.PriceBandBps)
+	assert.Equal(t, time.Minute, cfg.CircuitBreakerWindow)
+}
+
+func TestLoadConfig_Fees(t *testing.T) {
+	t.Setenv("FEE_ACCOUNT_ID", "exchange-fees")
+	t.Setenv("MAKER_FEE_BPS", "-2")
+	t.Setenv("TAKER_FEE_BPS", "10")
+
+	cfg := config.LoadConfig()
+
+	assert.Equal(t, "exchange-fees", cfg.FeeAccountID)
+	assert.Equal(t, int64(-2), cfg.MakerFeeBps)
+	assert.Equal(t, int64(10), cfg.TakerFeeBps)
+}
+
+func TestLoadConfig_FeeVolumeTiers(t *testing.T) {
+	t.Setenv("FEE_VOLUME_TIERS", "silver:1000000, gold:10000000,broken,bad:abc")
+
+	cfg := config.LoadConfig()
+
+	assert.Equal(t, map[string]int64{"silver": 1000000, "gold": 10000000}, cfg.FeeVolumeTiers)
+}
+
+func TestLoadConfig_ReconciliationInterval(t *testing.T) {
+	t.Setenv("RECONCILIATION_INTERVAL", "15s")
+
+	cfg := config.LoadConfig()
+
+	assert.Equal(t, 15*time.Second, cfg.ReconciliationInterval)
+
+	t.Setenv("RECONCILIATION_INTERVAL", "0")
+
+	cfg = config.LoadConfig()
+
+	assert.Equal(t, time.Duration(0), cfg.ReconciliationInterval)
+}
+
+func TestLoadConfig_Auth(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "production")
+
+	cfg := config.LoadConfig()
+
+	assert.True(t, cfg.AuthEnabled)
+
+	t.Setenv("AUTH_ENABLED", "false")
+	t.Setenv("AUTH_MAX_SKEW", "5s")
+
+	cfg = config.LoadConfig()
+
+	assert.False(t, cfg.AuthEnabled)
+	assert.Equal(t, 5*time.Second, cfg.AuthMaxSkew)
+
+	t.Setenv("ENVIRONMENT", "development")
+	t.Setenv("AUTH_ENABLED", "true")
+	t.Setenv("ADMIN_API_KEY", "bootstrap")
+	t.Setenv("ADMIN_API_SECRET", "s3cret")
+
+	cfg = config.LoadConfig()
+
+	assert.True(t, cfg.AuthEnabled)
+	assert.Equal(t, "bootstrap", cfg.AdminAPIKey)
+	assert.Equal(t, "s3cret", cfg.AdminAPISecret)
+}
+
+func TestLoadConfig_RateLimit(t *testing.T) {
+	t.Setenv("RATE_LIMIT_ENABLED", "false")
+	t.Setenv("RATE_LIMIT_ORDERS", "5")
+	t.Setenv("RATE_LIMIT_ORDERS_BURST", "10")
+	t.Setenv("RATE_LIMIT_CANCELS", "7")
+	t.Setenv("RATE_LIMIT_CANCELS_BURST", "14")
+	t.Setenv("RATE_LIMIT_MARKET_DATA", "2")
+	t.Setenv("RATE_LIMIT_MARKET_DATA_BURST", "abc")
+
+	cfg := config.LoadConfig()
+
+	assert.False(t, cfg.RateLimitEnabled)
+	assert.Equal(t, int64(5), cfg.RateLimitOrders)
+	assert.Equal(t, int64(10), cfg.RateLimitOrdersBurst)
+	assert.Equal(t, int64(7), cfg.RateLimitCancels)
+	assert.Equal(t, int64(14), cfg.RateLimitCancelsBurst)
+	assert.Equal(t, int64(2), cfg.RateLimitMarketData)
+	assert.Equal(t, int64(50), cfg.RateLimitMarketDataBurst)
+}
+
+func TestLoadConfig_BatchMaxOrders(t *testing.T) {
+	t.Setenv("BATCH_MAX_ORDERS", "20")
+
+	cfg := config.LoadConfig()
+
+	assert.Equal(t, int64(20), cfg.BatchMaxOrders)
+}
+
+func TestLoadConfig_DeadManSwitch(t *testing.T) {
+	t.Setenv("DEAD_MAN_SWITCH_INTERVAL", "250ms")
+	t.Setenv("SESSION_HEARTBEAT_INTERVAL", "5s")
+
+	cfg := config.LoadConfig()
+
+	assert.Equal(t, 250*time.Millisecond, cfg.DeadManSwitchInterval)
+	assert.Equal(t, 5*time.Second, cfg.SessionHeartbeatInterval)
+}
+
+func TestLoadConfig_Fix(t *testing.T) {
+	t.Setenv("FIX_PORT", "")
+	t.Setenv("FIX_COMP_ID", "EXCHANGE")
+	t.Setenv("FIX_FILL_POLL_INTERVAL", "1s")
+
+	cfg := config.LoadConfig()
+
+	assert.Empty(t, cfg.FixPort)
+	assert.Equal(t, "EXCHANGE", cfg.FixCompID)
+	assert.Equal(t, time.Second, cfg.FixFillPollInterval)
+}
+
+func TestLoadConfig_Binary(t *testing.T) {
+	t.Setenv("BINARY_PORT", "")
+	t.Setenv("BINARY_FILL_POLL_INTERVAL", "50ms")
+
+	cfg := config.LoadConfig()
+
+	assert.Empty(t, cfg.BinaryPort)
+	assert.Equal(t, 50*time.Millisecond, cfg.BinaryFillPollInterval)
+}
+
+func TestLoadConfig_Grpc(t *testing.T) {
+	t.Setenv("GRPC_PORT", "")
+	t.Setenv("GRPC_STREAM_POLL_INTERVAL", "1s")
+
+	cfg := config.LoadConfig()
+
+	assert.Empty(t, cfg.GrpcPort)
+	assert.Equal(t, time.Second, cfg.GrpcStreamPollInterval)
 }
@@ -23,14 +23,23 @@ type (
 	createOutputDtoTest struct {
 		AccountId string `json:"account_id"`
 		Status    string `json:"status"`
+		APIKey    string `json:"api_key"`
+		APISecret string `json:"api_secret"`
 	}
 	getAllByIdBalanceOutputDtoTest struct {
 		Available int64 `json:"available"`
 		Reserved  int64 `json:"reserved"`
 	}
 	getAllByIdOutputDtoTest struct {
-		Balances  map[string]getAllByIdBalanceOutputDtoTest `json:"balances"`
-		AccountID string                                    `json:"account_id"`
+		Balances        map[string]getAllByIdBalanceOutputDtoTest `json:"balances"`
+		AccountID       string                                    `json:"account_id"`
+		ParentAccountID string                                    `json:"parent_account_id"`
+		SubAccountIDs   []string                                  `json:"sub_account_ids"`
+	}
+	aggregatedOutputDtoTest struct {
+		Balances    map[string]getAllByIdBalanceOutputDtoTest `json:"balances"`
+		AccountID   string                                    `json:"account_id"`
+		SubAccounts []getAllByIdOutputDtoTest                 `json:"sub_accounts"`
 	}
 	creditInputDtoTest struct {
 		Asset  string `json:"asset"`
@@ -49,12 +58,14 @@ type (
 		httpClient    httpAdapter.HttpClient
 		e2eTestHandle *httpServer.E2eTestHandle
 		basePath      string
+		adminPath     string
 	}
 )
 
 func (suite *AccountControllerTestSuite) SetupTest() {
 	suite.e2eTestHandle = httpServer.NewE2eTestHandle()
 	suite.basePath = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1/accounts"
+	suite.adminPath = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1/admin/accounts"
 	suite.httpClient = httpAdapter.NewDefaultHttpClient(10 * time.Second)
 }
 
@@ -79,6 +90,8 @@ func (suite *AccountControllerTestSuite) TestCreate_Success() {
 	require.NoError(t, err)
 	require.NotEmpty(t, out.AccountId)
 	assert.Equal(t, "created", out.Status)
+	assert.NotEmpty(t, out.APIKey)
+	assert.Len(t, out.APISecret, 64)
 }
 
 func (suite *AccountControllerTestSuite) TestCreate_MissingName_ReturnsBadRequest() {
@@ -156,7 +169,7 @@ func (suite *AccountControllerTestSuite) TestGetAllById_Success() {
 	creditBody, err := json.Marshal(creditInput)
 	require.NoError(t, err)
 
-	creditURL := suite.basePath + "/" + createOut.AccountId + "/credit"
+	creditURL := suite.adminPath + "/" + createOut.AccountId + "/credit"
 	response, err := http.Post(creditURL, "application/json", bytes.NewReader(creditBody))
 	require.NoError(t, err)
 
@@ -217,7 +230,7 @@ func (suite *AccountControllerTestSuite) TestCredit_Success() {
 	creditBody, err := json.Marshal(creditInput)
 	require.NoError(t, err)
 
-	creditURL := suite.basePath + "/" + createOut.AccountId + "/credit"
+	creditURL := suite.adminPath + "/" + createOut.AccountId + "/credit"
 	creditRes, err := http.Post(creditURL, "application/json", bytes.NewReader(creditBody))
 	require.NoError(t, err)
 
@@ -250,7 +263,7 @@ func (suite *AccountControllerTestSuite) TestCredit_InvalidJSON() {
 	err = json.NewDecoder(createRes.Body).Decode(&createOut)
 	require.NoError(t, err)
 
-	creditURL := suite.basePath + "/" + createOut.AccountId + "/credit"
+	creditURL := suite.adminPath + "/" + createOut.AccountId + "/credit"
 	creditRes, err := http.Post(creditURL, "application/json", bytes.NewBufferString("{invalid-json"))
 	require.NoError(t, err)
 
@@ -281,7 +294,7 @@ func (suite *AccountControllerTestSuite) TestCredit_InvalidAmount() {
 	creditBody, err := json.Marshal(creditInput)
 	require.NoError(t, err)
 
-	creditURL := suite.basePath + "/" + createOut.AccountId + "/credit"
+	creditURL := suite.adminPath + "/" + createOut.AccountId + "/credit"
 	creditRes, err := http.Post(creditURL, "application/json", bytes.NewReader(creditBody))
 	require.NoError(t, err)
 
@@ -300,7 +313,7 @@ func (suite *AccountControllerTestSuite) TestCredit_AccountNotFound() {
 	creditBody, err := json.Marshal(creditInput)
 	require.NoError(t, err)
 
-	creditURL := suite.basePath + "/non-existent-id/credit"
+	creditURL := suite.adminPath + "/non-existent-id/credit"
 	creditRes, err := http.Post(creditURL, "application/json", bytes.NewReader(creditBody))
 	require.NoError(t, err)
 
@@ -309,6 +322,135 @@ func (suite *AccountControllerTestSuite) TestCredit_AccountNotFound() {
 	assert.Equal(t, http.StatusNotFound, creditRes.StatusCode)
 }
 
+func (suite *AccountControllerTestSuite) post(url string, body any) *http.Response {
+	t := suite.Suite.T()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	res, err := http.Post(url, "application/json", bytes.NewReader(payload))
+	require.NoError(t, err)
+
+	return res
+}
+
+func (suite *AccountControllerTestSuite) createFunded(input createInputDtoTest, parentID, asset string, amount int64) string {
+	t := suite.Suite.T()
+
+	url := suite.basePath
+	if parentID != "" {
+		url = suite.basePath + "/" + parentID + "/sub-accounts"
+	}
+
+	res := suite.post(url, input)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	var out createOutputDtoTest
+	err := json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+
+	if amount > 0 {
+		creditRes := suite.post(suite.adminPath+"/"+out.AccountId+"/credit", creditInputDtoTest{Asset: asset, Amount: amount})
+		defer creditRes.Body.Close()
+		require.Equal(t, http.StatusOK, creditRes.StatusCode)
+	}
+
+	return out.AccountId
+}
+
+func (suite *AccountControllerTestSuite) TestSubAccounts_AggregatedBalances() {
+	t := suite.Suite.T()
+
+	parentID := suite.createFunded(createInputDtoTest{AccountName: "firm"}, "", "USDT", 1000)
+	strategyA := suite.createFunded(createInputDtoTest{AccountName: "firm-strategy-a"}, parentID, "USDT", 200)
+	strategyB := suite.createFunded(createInputDtoTest{AccountName: "firm-strategy-b"}, parentID, "BTC", 3)
+
+	getRes, err := http.Get(suite.basePath + "/" + strategyA)
+	require.NoError(t, err)
+	defer getRes.Body.Close()
+
+	var sub getAllByIdOutputDtoTest
+	err = json.NewDecoder(getRes.Body).Decode(&sub)
+	require.NoError(t, err)
+	assert.Equal(t, parentID, sub.ParentAccountID)
+	assert.Empty(t, sub.SubAccountIDs)
+
+	aggRes, err := http.Get(suite.basePath + "/" + parentID + "/aggregated-balances")
+	require.NoError(t, err)
+	defer aggRes.Body.Close()
+	require.Equal(t, http.StatusOK, aggRes.StatusCode)
+
+	var agg aggregatedOutputDtoTest
+	err = json.NewDecoder(aggRes.Body).Decode(&agg)
+	require.NoError(t, err)
+	assert.Equal(t, parentID, agg.AccountID)
+	assert.Equal(t, int64(1200), agg.Balances["USDT"].Available)
+	assert.Equal(t, int64(3), agg.Balances["BTC"].Available)
+	require.Len(t, agg.SubAccounts, 2)
+	assert.ElementsMatch(t, []string{strategyA, strategyB}, []string{agg.SubAccounts[0].AccountID, agg.SubAccounts[1].AccountID})
+}
+
+func (suite *AccountControllerTestSuite) TestSubAccounts_TransfersStayInHierarchy() {
+	t := suite.Suite.T()
+
+	transfersPath := suite.e2eTestHandle.HttpServerTest.URL + "/api/v1/transfers"
+	transfer := func(key string, body map[string]any) int {
+		payload, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, transfersPath, bytes.NewReader(payload))
+		require.NoError(t, err)
+		req.Header.Set("Idempotency-Key", key)
+
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		return res.StatusCode
+	}
+
+	parentID := suite.createFunded(createInputDtoTest{AccountName: "desk"}, "", "USDT", 1000)
+	subID := suite.createFunded(createInputDtoTest{AccountName: "desk-strategy"}, parentID, "", 0)
+	outsiderID := suite.createFunded(createInputDtoTest{AccountName: "desk-outsider"}, "", "", 0)
+
+	assert.Equal(t, http.StatusCreated, transfer("desk-1", map[string]any{
+		"from_account_id": parentID, "to_account_id": subID, "asset": "USDT", "amount": 300,
+	}))
+	assert.Equal(t, http.StatusCreated, transfer("desk-2", map[string]any{
+		"from_account_id": subID, "to_account_id": parentID, "asset": "USDT", "amount": 100,
+	}))
+	assert.Equal(t, http.StatusUnprocessableEntity, transfer("desk-3", map[string]any{
+		"from_account_id": subID, "to_account_id": outsiderID, "asset": "USDT", "amount": 100,
+	}))
+
+	getRes, err := http.Get(suite.basePath + "/" + subID)
+	require.NoError(t, err)
+	defer getRes.Body.Close()
+
+	var sub getAllByIdOutputDtoTest
+	err = json.NewDecoder(getRes.Body).Decode(&sub)
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), sub.Balances["USDT"].Available)
+}
+
+func (suite *AccountControllerTestSuite) TestSubAccounts_ParentNotFound() {
+	t := suite.Suite.T()
+
+	res := suite.post(suite.basePath+"/non-existent-id/sub-accounts", createInputDtoTest{AccountName: "orphan"})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func (suite *AccountControllerTestSuite) TestGetAggregated_NotFound() {
+	t := suite.Suite.T()
+
+	res, err := http.Get(suite.basePath + "/non-existent-id/aggregated-balances")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, new(AccountControllerTestSuite))
 }
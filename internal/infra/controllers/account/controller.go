@@ -6,7 +6,9 @@ import (
 	"net/http"
 
 	accountUsecases "github.com/juninhoitabh/clob-go/internal/application/account/usecases"
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
 	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
 	"github.com/juninhoitabh/clob-go/internal/shared"
 )
 
@@ -17,14 +19,28 @@ type (
 	createOutputDto struct {
 		AccountId string `json:"account_id" example:"123e4567-e89b-12d3-a456-426614174000"`
 		Status    string `json:"status" example:"created"`
+		APIKey    string `json:"api_key,omitempty" example:"123e4567-e89b-12d3-a456-426614174002"`
+		APISecret string `json:"api_secret,omitempty" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	}
+	createSubAccountOutputDto struct {
+		AccountId       string `json:"account_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		ParentAccountID string `json:"parent_account_id" example:"123e4567-e89b-12d3-a456-426614174001"`
+		Status          string `json:"status" example:"created"`
 	}
 	getAllByIdBalanceOutputDto struct {
 		Available int64 `json:"available" example:"1000"`
 		Reserved  int64 `json:"reserved" example:"0"`
 	}
 	getAllByIdOutputDto struct {
-		Balances  map[string]getAllByIdBalanceOutputDto `json:"balances"`
-		AccountID string                                `json:"account_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		Balances        map[string]getAllByIdBalanceOutputDto `json:"balances"`
+		AccountID       string                                `json:"account_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		ParentAccountID string                                `json:"parent_account_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174001"`
+		SubAccountIDs   []string                              `json:"sub_account_ids"`
+	}
+	aggregatedOutputDto struct {
+		Balances    map[string]getAllByIdBalanceOutputDto `json:"balances"`
+		AccountID   string                                `json:"account_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		SubAccounts []getAllByIdOutputDto                 `json:"sub_accounts"`
 	}
 	creditInputDto struct {
 		Asset  string `json:"asset" example:"USD" validate:"required"`
@@ -41,6 +57,7 @@ type (
 	AccountController struct {
 		accountDAO  domainAccount.IAccountDAO
 		accountRepo domainAccount.IAccountRepository
+		apiKeyRepo  domainAPIKey.IAPIKeyRepository
 	}
 )
 
@@ -52,9 +69,8 @@ type (
 // @Produce      json
 // @Param        request   body      createInputDto  true  "createInputDto request"
 // @Success      200       {object}  createOutputDto
-// @Success      201       {object}  createOutputDto
+// @Success      201       {object}  createOutputDto "Created, with the first API key and its secret"
 // @Failure      400       {object}  shared.Errors "Bad Request"
-// @Failure      404       {object}  shared.Errors "Not Found"
 // @Failure      500       {object}  shared.Errors "Internal Server Error"
 // @Router       /accounts [post]
 func (a *AccountController) Create(w http.ResponseWriter, req *http.Request) {
@@ -88,7 +104,80 @@ func (a *AccountController) Create(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	shared.WriteJSON(w, http.StatusCreated, createOutputDto{AccountId: createAccountOutput.ID, Status: "created"})
+	issueAPIKeyUseCase := apikeyUsecases.NewIssueAPIKeyUseCase(a.accountRepo, a.apiKeyRepo)
+
+	issueAPIKeyOutput, err := issueAPIKeyUseCase.Execute(apikeyUsecases.IssueAPIKeyInput{
+		AccountID: createAccountOutput.ID,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusCreated, createOutputDto{
+		AccountId: createAccountOutput.ID,
+		Status:    "created",
+		APIKey:    issueAPIKeyOutput.ID,
+		APISecret: issueAPIKeyOutput.Secret,
+	})
+}
+
+// CreateSubAccount godoc
+// @Summary      Create Sub-Account
+// @Description  Create a sub-account under the account in the path. The parent's API keys can act on the sub-account.
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string          true  "parent account_id" Format(uuid)
+// @Param        request   body      createInputDto  true  "createInputDto request"
+// @Success      200       {object}  createSubAccountOutputDto
+// @Success      201       {object}  createSubAccountOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      401       {object}  shared.Errors "Unauthorized"
+// @Failure      403       {object}  shared.Errors "Forbidden"
+// @Failure      404       {object}  shared.Errors "Parent account not found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/sub-accounts [post]
+func (a *AccountController) CreateSubAccount(w http.ResponseWriter, req *http.Request) {
+	parentID := req.PathValue("id")
+
+	var body createInputDto
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		shared.BadRequestError(w, "Invalid JSON", err.Error())
+
+		return
+	}
+
+	if body.AccountName == "" {
+		shared.BadRequestError(w, "account_name is required")
+
+		return
+	}
+
+	createAccountUseCase := accountUsecases.NewCreateAccountUseCase(a.accountRepo)
+
+	createAccountOutput, err := createAccountUseCase.Execute(accountUsecases.CreateAccountInput{
+		AccountName:     body.AccountName,
+		ParentAccountID: parentID,
+	})
+	if err != nil {
+		if errors.Is(err, shared.ErrAlreadyExists) {
+			shared.WriteJSON(w, http.StatusOK, createSubAccountOutputDto{Status: "exists"})
+
+			return
+		}
+
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusCreated, createSubAccountOutputDto{
+		AccountId:       createAccountOutput.ID,
+		ParentAccountID: createAccountOutput.ParentID,
+		Status:          "created",
+	})
 }
 
 // GetAllById godoc
@@ -113,19 +202,39 @@ func (a *AccountController) GetAllById(w http.ResponseWriter, req *http.Request)
 		return
 	}
 
-	getAllByIdOutputDtoResponse := getAllByIdOutputDto{
-		AccountID: acct.AccountID,
-		Balances:  make(map[string]getAllByIdBalanceOutputDto),
+	shared.WriteJSON(w, http.StatusOK, newGetAllByIdOutputDto(*acct))
+}
+
+// GetAggregated godoc
+// @Summary      Get Aggregated Balances
+// @Description  Get the balances of an account summed with every sub-account below it, along with each sub-account's own balances
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string               true  "account_id" Format(uuid)
+// @Success      200       {object}  aggregatedOutputDto
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/aggregated-balances [get]
+func (a *AccountController) GetAggregated(w http.ResponseWriter, req *http.Request) {
+	acct, err := a.accountDAO.AggregatedSnapshot(req.PathValue("id"))
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	aggregatedOutputDtoResponse := aggregatedOutputDto{
+		AccountID:   acct.AccountID,
+		Balances:    newBalancesOutputDto(acct.Balances),
+		SubAccounts: make([]getAllByIdOutputDto, 0, len(acct.SubAccounts)),
 	}
 
-	for asset, balance := range acct.Balances {
-		getAllByIdOutputDtoResponse.Balances[asset] = getAllByIdBalanceOutputDto{
-			Available: balance.Available,
-			Reserved:  balance.Reserved,
-		}
+	for _, sub := range acct.SubAccounts {
+		aggregatedOutputDtoResponse.SubAccounts = append(aggregatedOutputDtoResponse.SubAccounts, newGetAllByIdOutputDto(sub))
 	}
 
-	shared.WriteJSON(w, http.StatusOK, getAllByIdOutputDtoResponse)
+	shared.WriteJSON(w, http.StatusOK, aggregatedOutputDtoResponse)
 }
 
 // Credit godoc
@@ -140,7 +249,7 @@ func (a *AccountController) GetAllById(w http.ResponseWriter, req *http.Request)
 // @Failure      400       {object}  shared.Errors "Bad Request"
 // @Failure      404       {object}  shared.Errors "Not Found"
 // @Failure      500       {object}  shared.Errors "Internal Server Error"
-// @Router       /accounts/{id}/credit [post]
+// @Router       /admin/accounts/{id}/credit [post]
 func (a *AccountController) Credit(w http.ResponseWriter, req *http.Request) {
 	id := req.PathValue("id")
 	if id == "" {
@@ -193,12 +302,41 @@ func (a *AccountController) Credit(w http.ResponseWriter, req *http.Request) {
 	shared.WriteJSON(w, http.StatusOK, creditOutputDtoResponse)
 }
 
+func newGetAllByIdOutputDto(acct domainAccount.AccountSnapshot) getAllByIdOutputDto {
+	subAccountIDs := acct.SubAccountIDs
+	if subAccountIDs == nil {
+		subAccountIDs = []string{}
+	}
+
+	return getAllByIdOutputDto{
+		AccountID:       acct.AccountID,
+		ParentAccountID: acct.ParentID,
+		SubAccountIDs:   subAccountIDs,
+		Balances:        newBalancesOutputDto(acct.Balances),
+	}
+}
+
+func newBalancesOutputDto(balances map[string]domainAccount.Balance) map[string]getAllByIdBalanceOutputDto {
+	out := make(map[string]getAllByIdBalanceOutputDto, len(balances))
+
+	for asset, balance := range balances {
+		out[asset] = getAllByIdBalanceOutputDto{
+			Available: balance.Available,
+			Reserved:  balance.Reserved,
+		}
+	}
+
+	return out
+}
+
 func NewAccountController(
 	accountDAO domainAccount.IAccountDAO,
 	accountRepo domainAccount.IAccountRepository,
+	apiKeyRepo domainAPIKey.IAPIKeyRepository,
 ) *AccountController {
 	return &AccountController{
 		accountDAO:  accountDAO,
 		accountRepo: accountRepo,
+		apiKeyRepo:  apiKeyRepo,
 	}
 }
@@ -0,0 +1,335 @@
+package apikey_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	httpServer "github.com/juninhoitabh/clob-go/internal/infra/http-server"
+)
+
+type (
+	credentialsTest struct {
+		AccountID string `json:"account_id"`
+		APIKey    string `json:"api_key"`
+		APISecret string `json:"api_secret"`
+	}
+	APIKeyControllerTestSuite struct {
+		suite.Suite
+		e2eTestHandle *httpServer.E2eTestHandle
+		baseURL       string
+		admin         credentialsTest
+	}
+)
+
+const (
+	adminAPIKeyTest    = "e2e-admin-key"
+	adminAPISecretTest = "e2e-admin-secret"
+)
+
+func (suite *APIKeyControllerTestSuite) SetupSuite() {
+	os.Setenv("AUTH_ENABLED", "true")
+	os.Setenv("ADMIN_API_KEY", adminAPIKeyTest)
+	os.Setenv("ADMIN_API_SECRET", adminAPISecretTest)
+
+	suite.e2eTestHandle = httpServer.NewE2eTestHandle()
+	suite.baseURL = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1"
+	suite.admin = credentialsTest{APIKey: adminAPIKeyTest, APISecret: adminAPISecretTest}
+}
+
+func (suite *APIKeyControllerTestSuite) TearDownSuite() {
+	os.Unsetenv("AUTH_ENABLED")
+	os.Unsetenv("ADMIN_API_KEY")
+	os.Unsetenv("ADMIN_API_SECRET")
+	suite.e2eTestHandle.HttpServerTest.Close()
+}
+
+func (suite *APIKeyControllerTestSuite) do(method, path string, body any, creds *credentialsTest, sign func(secret string, ts int64, method, path string, body []byte) string) *http.Response {
+	t := suite.Suite.T()
+
+	var payload []byte
+
+	if body != nil {
+		var err error
+
+		payload, err = json.Marshal(body)
+		require.NoError(t, err)
+	}
+
+	req, err := http.NewRequest(method, suite.baseURL+path, bytes.NewReader(payload))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	if creds != nil {
+		ts := time.Now().Unix()
+		req.Header.Set(domainAPIKey.HeaderKey, creds.APIKey)
+		req.Header.Set(domainAPIKey.HeaderTimestamp, strconv.FormatInt(ts, 10))
+		req.Header.Set(domainAPIKey.HeaderSignature, sign(creds.APISecret, ts, method, "/api/v1"+path, payload))
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	return res
+}
+
+func (suite *APIKeyControllerTestSuite) signed(method, path string, body any, creds credentialsTest) *http.Response {
+	return suite.do(method, path, body, &creds, domainAPIKey.Sign)
+}
+
+func (suite *APIKeyControllerTestSuite) createAccount(name string) credentialsTest {
+	t := suite.Suite.T()
+
+	res := suite.do(http.MethodPost, "/accounts", map[string]string{"account_name": name}, nil, nil)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	var creds credentialsTest
+	err := json.NewDecoder(res.Body).Decode(&creds)
+	require.NoError(t, err)
+	require.NotEmpty(t, creds.APIKey)
+
+	return creds
+}
+
+func (suite *APIKeyControllerTestSuite) TestUnsignedRequest_Unauthorized() {
+	t := suite.Suite.T()
+
+	alice := suite.createAccount("auth-unsigned")
+
+	res := suite.do(http.MethodGet, "/accounts/"+alice.AccountID, nil, nil, nil)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
+
+func (suite *APIKeyControllerTestSuite) TestBadSignature_Unauthorized() {
+	t := suite.Suite.T()
+
+	alice := suite.createAccount("auth-bad-signature")
+
+	res := suite.do(http.MethodGet, "/accounts/"+alice.AccountID, nil, &alice, func(string, int64, string, string, []byte) string {
+		return domainAPIKey.Sign("wrong-secret", time.Now().Unix(), http.MethodGet, "/api/v1/accounts/"+alice.AccountID, nil)
+	})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
+
+func (suite *APIKeyControllerTestSuite) TestPublicRoutes_NoSignature() {
+	t := suite.Suite.T()
+
+	res := suite.do(http.MethodGet, "/books?instrument=BTC/USDT", nil, nil, nil)
+	defer res.Body.Close()
+	assert.NotEqual(t, http.StatusUnauthorized, res.StatusCode)
+}
+
+func (suite *APIKeyControllerTestSuite) TestOwnAccount_OtherAccountForbidden() {
+	t := suite.Suite.T()
+
+	alice := suite.createAccount("auth-alice")
+	bob := suite.createAccount("auth-bob")
+
+	res := suite.signed(http.MethodGet, "/accounts/"+alice.AccountID, nil, alice)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	res = suite.signed(http.MethodGet, "/accounts/"+bob.AccountID, nil, alice)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	res = suite.signed(http.MethodPost, "/accounts/"+bob.AccountID+"/sub-accounts", map[string]string{"account_name": "auth-bob-child"}, alice)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+}
+
+func (suite *APIKeyControllerTestSuite) TestCancelOtherAccountsOrder_Forbidden() {
+	t := suite.Suite.T()
+
+	alice := suite.createAccount("auth-maker")
+	mallory := suite.createAccount("auth-mallory")
+
+	res := suite.signed(http.MethodPost, "/admin/accounts/"+alice.AccountID+"/credit", map[string]any{"asset": "USDT", "amount": 100000}, suite.admin)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	order := map[string]any{"account_id": alice.AccountID, "instrument": "BTC/USDT", "side": "buy", "price": 100, "qty": 1}
+
+	res = suite.signed(http.MethodPost, "/orders", order, mallory)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	res = suite.signed(http.MethodPost, "/orders", order, alice)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	var placeOut struct {
+		Order map[string]any `json:"order"`
+	}
+	err := json.NewDecoder(res.Body).Decode(&placeOut)
+	require.NoError(t, err)
+
+	orderID := placeOut.Order["id"].(string)
+
+	res = suite.signed(http.MethodPost, "/orders/"+orderID+"/cancel", nil, mallory)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	res = suite.signed(http.MethodPost, "/orders/"+orderID+"/cancel", nil, alice)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func (suite *APIKeyControllerTestSuite) TestSubAccountKeys() {
+	t := suite.Suite.T()
+
+	parent := suite.createAccount("auth-parent")
+	outsider := suite.createAccount("auth-outsider")
+
+	res := suite.signed(http.MethodPost, "/accounts/"+outsider.AccountID+"/sub-accounts", map[string]string{"account_name": "auth-hijack"}, parent)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	res = suite.signed(http.MethodPost, "/accounts/"+parent.AccountID+"/sub-accounts", map[string]string{"account_name": "auth-child"}, parent)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	var child credentialsTest
+	err := json.NewDecoder(res.Body).Decode(&child)
+	require.NoError(t, err)
+
+	res = suite.signed(http.MethodGet, "/accounts/"+child.AccountID, nil, parent)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	res = suite.signed(http.MethodPost, "/accounts/"+child.AccountID+"/api-keys", nil, parent)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	err = json.NewDecoder(res.Body).Decode(&child)
+	require.NoError(t, err)
+	assert.NotEmpty(t, child.APISecret)
+
+	res = suite.signed(http.MethodGet, "/accounts/"+child.AccountID, nil, child)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	res = suite.signed(http.MethodGet, "/accounts/"+parent.AccountID, nil, child)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+}
+
+func (suite *APIKeyControllerTestSuite) TestTraderKey_AdminRouteForbidden() {
+	t := suite.Suite.T()
+
+	alice := suite.createAccount("auth-trader")
+
+	res := suite.signed(http.MethodPost, "/admin/accounts/"+alice.AccountID+"/credit", map[string]any{"asset": "USDT", "amount": 100}, alice)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	res = suite.signed(http.MethodPost, "/admin/books/halt?instrument=BTC/USDT", nil, alice)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+
+	res = suite.signed(http.MethodPost, "/accounts/"+alice.AccountID+"/api-keys", map[string]string{"role": "admin"}, alice)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+}
+
+func (suite *APIKeyControllerTestSuite) TestReadOnlyKey_CannotTrade() {
+	t := suite.Suite.T()
+
+	alice := suite.createAccount("auth-read-only")
+
+	res := suite.signed(http.MethodPost, "/accounts/"+alice.AccountID+"/api-keys", map[string]string{"role": "read-only"}, alice)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	var readOnly credentialsTest
+	err := json.NewDecoder(res.Body).Decode(&readOnly)
+	require.NoError(t, err)
+
+	res = suite.signed(http.MethodGet, "/accounts/"+alice.AccountID, nil, readOnly)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	order := map[string]any{"account_id": alice.AccountID, "instrument": "BTC/USDT", "side": "buy", "price": 100, "qty": 1}
+
+	res = suite.signed(http.MethodPost, "/orders", order, readOnly)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+}
+
+func (suite *APIKeyControllerTestSuite) TestOperatorKey_IssuedByAdmin() {
+	t := suite.Suite.T()
+
+	ops := suite.createAccount("auth-operator")
+
+	res := suite.signed(http.MethodPost, "/admin/accounts/"+ops.AccountID+"/api-keys", map[string]string{"role": "operator"}, suite.admin)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	var operator credentialsTest
+	err := json.NewDecoder(res.Body).Decode(&operator)
+	require.NoError(t, err)
+
+	res = suite.signed(http.MethodPost, "/admin/books/halt?instrument=ETH/USDT", nil, operator)
+	defer res.Body.Close()
+	assert.NotEqual(t, http.StatusUnauthorized, res.StatusCode)
+	assert.NotEqual(t, http.StatusForbidden, res.StatusCode)
+
+	res = suite.signed(http.MethodPost, "/admin/accounts/"+ops.AccountID+"/credit", map[string]any{"asset": "USDT", "amount": 100}, operator)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+}
+
+func (suite *APIKeyControllerTestSuite) TestAdminCredit_RecordsAuditEntry() {
+	t := suite.Suite.T()
+
+	alice := suite.createAccount("auth-audited")
+
+	res := suite.signed(http.MethodPost, "/admin/accounts/"+alice.AccountID+"/credit", map[string]any{"asset": "USDT", "amount": 250}, suite.admin)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	res = suite.signed(http.MethodGet, "/admin/audit-log?action=account.credit&target=account:"+alice.AccountID, nil, suite.admin)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var auditOut struct {
+		Entries []struct {
+			Actor  string          `json:"actor"`
+			Role   string          `json:"role"`
+			Action string          `json:"action"`
+			Target string          `json:"target"`
+			Before json.RawMessage `json:"before"`
+			After  json.RawMessage `json:"after"`
+		} `json:"entries"`
+		Total int `json:"total"`
+	}
+	err := json.NewDecoder(res.Body).Decode(&auditOut)
+	require.NoError(t, err)
+	require.Equal(t, 1, auditOut.Total)
+
+	entry := auditOut.Entries[0]
+	assert.Equal(t, adminAPIKeyTest, entry.Actor)
+	assert.Equal(t, string(domainAPIKey.RoleAdmin), entry.Role)
+	assert.NotContains(t, string(entry.Before), "250")
+	assert.Contains(t, string(entry.After), "250")
+
+	res = suite.signed(http.MethodGet, "/admin/audit-log", nil, alice)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyControllerTestSuite))
+}
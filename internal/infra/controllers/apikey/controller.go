@@ -0,0 +1,87 @@
+package apikey
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	issueInputDto struct {
+		Role string `json:"role,omitempty" example:"trader" enums:"read-only,trader,operator,admin"`
+	}
+	issueOutputDto struct {
+		APIKey    string `json:"api_key" example:"123e4567-e89b-12d3-a456-426614174002"`
+		APISecret string `json:"api_secret" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+		AccountID string `json:"account_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		Role      string `json:"role" example:"trader"`
+		CreatedAt string `json:"created_at" example:"2025-01-01T00:00:00Z"`
+	}
+	APIKeyController struct {
+		accountRepo domainAccount.IAccountRepository
+		apiKeyRepo  domainAPIKey.IAPIKeyRepository
+	}
+)
+
+// Issue godoc
+// @Summary      Issue API Key
+// @Description  Issue a new API key and secret for the account. The secret is only returned once; sign requests with HMAC-SHA256 over "timestamp\nMETHOD\npath\nhex(sha256(body))" and send X-API-Key, X-API-Timestamp and X-API-Signature. A key can only issue keys with a role up to its own; role defaults to trader
+// @Tags         API Keys
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string         true   "account_id" Format(uuid)
+// @Param        request   body      issueInputDto  false  "issueInputDto request"
+// @Success      201       {object}  issueOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      401       {object}  shared.Errors "Unauthorized"
+// @Failure      403       {object}  shared.Errors "Forbidden"
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/api-keys [post]
+// @Router       /admin/accounts/{id}/api-keys [post]
+func (c *APIKeyController) Issue(w http.ResponseWriter, req *http.Request) {
+	var body issueInputDto
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		shared.BadRequestError(w, "Invalid JSON", err.Error())
+
+		return
+	}
+
+	issueAPIKeyUseCase := apikeyUsecases.NewIssueAPIKeyUseCase(c.accountRepo, c.apiKeyRepo)
+
+	output, err := issueAPIKeyUseCase.Execute(apikeyUsecases.IssueAPIKeyInput{
+		AccountID:  req.PathValue("id"),
+		Role:       body.Role,
+		IssuerRole: shared.CallerDetailsFromContext(req.Context()).Role,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusCreated, issueOutputDto{
+		APIKey:    output.ID,
+		APISecret: output.Secret,
+		AccountID: output.AccountID,
+		Role:      output.Role,
+		CreatedAt: output.CreatedAt.UTC().Format(time.RFC3339Nano),
+	})
+}
+
+func NewAPIKeyController(
+	accountRepo domainAccount.IAccountRepository,
+	apiKeyRepo domainAPIKey.IAPIKeyRepository,
+) *APIKeyController {
+	return &APIKeyController{
+		accountRepo: accountRepo,
+		apiKeyRepo:  apiKeyRepo,
+	}
+}
@@ -0,0 +1,147 @@
+package audit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	domainAudit "github.com/juninhoitabh/clob-go/internal/domain/audit"
+	httpServer "github.com/juninhoitabh/clob-go/internal/infra/http-server"
+)
+
+type (
+	entryOutputDtoTest struct {
+		Before json.RawMessage `json:"before"`
+		After  json.RawMessage `json:"after"`
+		Actor  string          `json:"actor"`
+		Action string          `json:"action"`
+		Target string          `json:"target"`
+	}
+	listOutputDtoTest struct {
+		Entries []entryOutputDtoTest `json:"entries"`
+		Total   int                  `json:"total"`
+		Limit   int                  `json:"limit"`
+		Offset  int                  `json:"offset"`
+	}
+	AuditControllerTestSuite struct {
+		suite.Suite
+		e2eTestHandle *httpServer.E2eTestHandle
+		basePath      string
+	}
+)
+
+func (suite *AuditControllerTestSuite) SetupTest() {
+	suite.e2eTestHandle = httpServer.NewE2eTestHandle()
+	suite.basePath = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1"
+}
+
+func (suite *AuditControllerTestSuite) TearDownSuite() {
+	suite.e2eTestHandle.HttpServerTest.Close()
+}
+
+func (suite *AuditControllerTestSuite) post(path string, body any) *http.Response {
+	t := suite.Suite.T()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	res, err := http.Post(suite.basePath+path, "application/json", bytes.NewReader(payload))
+	require.NoError(t, err)
+
+	return res
+}
+
+func (suite *AuditControllerTestSuite) list(query string) listOutputDtoTest {
+	t := suite.Suite.T()
+
+	res, err := http.Get(suite.basePath + "/admin/audit-log" + query)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var out listOutputDtoTest
+	err = json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+
+	return out
+}
+
+func (suite *AuditControllerTestSuite) TestHalt_RecordsBeforeAndAfter() {
+	t := suite.Suite.T()
+
+	res := suite.post("/accounts", map[string]string{"account_name": "audit-halt"})
+	defer res.Body.Close()
+
+	var account map[string]string
+	err := json.NewDecoder(res.Body).Decode(&account)
+	require.NoError(t, err)
+
+	res = suite.post("/admin/accounts/"+account["account_id"]+"/credit", map[string]any{"asset": "USDT", "amount": 1000})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	res = suite.post("/orders", map[string]any{
+		"account_id": account["account_id"],
+		"instrument": "AUD/USDT",
+		"side":       "buy",
+		"price":      10,
+		"qty":        1,
+	})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	res = suite.post("/admin/books/halt?instrument=AUD/USDT", nil)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	out := suite.list("?action=book.halt&target=instrument:AUD/USDT")
+	require.Equal(t, 1, out.Total)
+
+	entry := out.Entries[0]
+	assert.Equal(t, domainAudit.AnonymousActor, entry.Actor)
+	assert.Contains(t, string(entry.Before), `"halted":false`)
+	assert.Contains(t, string(entry.After), `"halted":true`)
+}
+
+func (suite *AuditControllerTestSuite) TestFailedRequest_NotRecorded() {
+	t := suite.Suite.T()
+
+	res := suite.post("/admin/accounts/unknown-account/credit", map[string]any{"asset": "USDT", "amount": 10})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	out := suite.list("?target=account:unknown-account")
+	assert.Equal(t, 0, out.Total)
+	assert.Empty(t, out.Entries)
+}
+
+func (suite *AuditControllerTestSuite) TestList_Pagination() {
+	t := suite.Suite.T()
+
+	for _, name := range []string{"audit-page-1", "audit-page-2", "audit-page-3"} {
+		res := suite.post("/accounts", map[string]string{"account_name": name})
+
+		var account map[string]string
+		err := json.NewDecoder(res.Body).Decode(&account)
+		res.Body.Close()
+		require.NoError(t, err)
+
+		res = suite.post("/admin/accounts/"+account["account_id"]+"/credit", map[string]any{"asset": "BTC", "amount": 1})
+		res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+	}
+
+	out := suite.list("?action=account.credit&limit=2")
+	assert.GreaterOrEqual(t, out.Total, 3)
+	assert.Len(t, out.Entries, 2)
+	assert.Equal(t, 2, out.Limit)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(AuditControllerTestSuite))
+}
@@ -0,0 +1,184 @@
+package audit
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	auditUsecases "github.com/juninhoitabh/clob-go/internal/application/audit/usecases"
+	domainAudit "github.com/juninhoitabh/clob-go/internal/domain/audit"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	// Snapshot returns the resource an admin request acts on and its current
+	// state, so the audit log can keep it before and after the change.
+	Snapshot       func(req *http.Request) (target string, state any)
+	entryOutputDto struct {
+		Before    json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+		After     json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+		EntryID   string          `json:"entry_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		Actor     string          `json:"actor" example:"123e4567-e89b-12d3-a456-426614174002"`
+		AccountID string          `json:"account_id,omitempty" example:"admin"`
+		Role      string          `json:"role,omitempty" example:"admin"`
+		Action    string          `json:"action" example:"account.credit"`
+		Target    string          `json:"target" example:"account:123e4567-e89b-12d3-a456-426614174000"`
+		CreatedAt string          `json:"created_at" example:"2025-01-01T00:00:00Z"`
+	}
+	listOutputDto struct {
+		Entries []entryOutputDto `json:"entries"`
+		Total   int              `json:"total" example:"250"`
+		Limit   int              `json:"limit" example:"100"`
+		Offset  int              `json:"offset" example:"0"`
+	}
+	statusRecorder struct {
+		http.ResponseWriter
+		statusCode int
+	}
+	AuditController struct {
+		auditRepo domainAudit.IAuditRepository
+	}
+)
+
+func (rw *statusRecorder) WriteHeader(code int) {
+	rw.statusCode = code
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+// Audited records action in the audit log when next succeeds, with the state of
+// the target captured by snapshot before and after the request.
+func (a *AuditController) Audited(action string, snapshot Snapshot, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		target, state := snapshot(req)
+
+		var before any
+
+		if state != nil {
+			raw, err := json.Marshal(state)
+			if err != nil {
+				shared.HandleError(w, err)
+
+				return
+			}
+
+			before = json.RawMessage(raw)
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+
+		next(recorder, req)
+
+		if recorder.statusCode >= http.StatusBadRequest {
+			return
+		}
+
+		_, state = snapshot(req)
+		caller := shared.CallerDetailsFromContext(req.Context())
+
+		recordEntryUseCase := auditUsecases.NewRecordEntryUseCase(a.auditRepo)
+
+		_, err := recordEntryUseCase.Execute(auditUsecases.RecordEntryInput{
+			Before:    before,
+			After:     state,
+			Actor:     caller.APIKeyID,
+			AccountID: caller.AccountID,
+			Role:      caller.Role,
+			Action:    action,
+			Target:    target,
+		})
+		if err != nil {
+			log.Printf("ALERT audit: %s on %s by %q not recorded: %v", action, target, caller.APIKeyID, err)
+		}
+	}
+}
+
+// List godoc
+// @Summary      Audit Log
+// @Description  Admin actions with their actor and the state of the target before and after, oldest first
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        actor     query     string  false  "API key that performed the action"
+// @Param        action    query     string  false  "action" example:"account.credit"
+// @Param        target    query     string  false  "target" example:"account:123e4567-e89b-12d3-a456-426614174000"
+// @Param        limit     query     int     false  "limit" example:"100"
+// @Param        offset    query     int     false  "offset" example:"0"
+// @Success      200       {object}  listOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      401       {object}  shared.Errors "Unauthorized"
+// @Failure      403       {object}  shared.Errors "Forbidden"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /admin/audit-log [get]
+func (a *AuditController) List(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+
+	limit, err := intQuery(query.Get("limit"))
+	if err != nil {
+		shared.BadRequestError(w, "invalid limit", err.Error())
+
+		return
+	}
+
+	offset, err := intQuery(query.Get("offset"))
+	if err != nil {
+		shared.BadRequestError(w, "invalid offset", err.Error())
+
+		return
+	}
+
+	listEntriesUseCase := auditUsecases.NewListEntriesUseCase(a.auditRepo)
+
+	output, err := listEntriesUseCase.Execute(auditUsecases.ListEntriesInput{
+		Actor:  query.Get("actor"),
+		Action: query.Get("action"),
+		Target: query.Get("target"),
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	listOutputDtoResponse := listOutputDto{
+		Entries: make([]entryOutputDto, 0, len(output.Entries)),
+		Total:   output.Total,
+		Limit:   output.Limit,
+		Offset:  output.Offset,
+	}
+
+	for _, e := range output.Entries {
+		listOutputDtoResponse.Entries = append(listOutputDtoResponse.Entries, entryOutputDto{
+			Before:    e.Before,
+			After:     e.After,
+			EntryID:   e.ID,
+			Actor:     e.Actor,
+			AccountID: e.AccountID,
+			Role:      e.Role,
+			Action:    e.Action,
+			Target:    e.Target,
+			CreatedAt: e.CreatedAt.UTC().Format(time.RFC3339Nano),
+		})
+	}
+
+	shared.WriteJSON(w, http.StatusOK, listOutputDtoResponse)
+}
+
+func intQuery(raw string) (int, error) {
+	if raw == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(raw)
+}
+
+func NewAuditController(
+	auditRepo domainAudit.IAuditRepository,
+) *AuditController {
+	return &AuditController{
+		auditRepo: auditRepo,
+	}
+}
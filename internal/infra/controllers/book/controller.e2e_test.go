@@ -24,16 +24,30 @@ type (
 		Bids       []getByInstrumentLevelOutputDtoTest `json:"bids"`
 		Asks       []getByInstrumentLevelOutputDtoTest `json:"asks"`
 	}
+	circuitBreakerOutputDtoTest struct {
+		Instrument     string `json:"instrument"`
+		HaltedUntil    string `json:"halted_until"`
+		ReferencePrice int64  `json:"reference_price"`
+		BandBps        int64  `json:"band_bps"`
+		Halted         bool   `json:"halted"`
+	}
+	errorOutputDtoTest struct {
+		Message string `json:"message"`
+		Code    string `json:"code"`
+		Status  int    `json:"status"`
+	}
 	BookControllerTestSuite struct {
 		suite.Suite
 		e2eTestHandle *httpServer.E2eTestHandle
 		basePath      string
+		adminPath     string
 	}
 )
 
 func (suite *BookControllerTestSuite) SetupTest() {
 	suite.e2eTestHandle = httpServer.NewE2eTestHandle()
 	suite.basePath = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1/books"
+	suite.adminPath = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1/admin"
 }
 
 func (suite *BookControllerTestSuite) TearDownSuite() {
@@ -64,7 +78,7 @@ func (suite *BookControllerTestSuite) TestGet_Success() {
 	creditBody, err := json.Marshal(creditInput)
 	require.NoError(t, err)
 
-	creditURL := accountsPath + "/" + accountID + "/credit"
+	creditURL := suite.adminPath + "/accounts/" + accountID + "/credit"
 	creditRes, err := http.Post(creditURL, "application/json", bytes.NewReader(creditBody))
 	require.NoError(t, err)
 
@@ -168,7 +182,7 @@ func (suite *BookControllerTestSuite) TestGet_CaseInsensitiveInstrument() {
 	creditBody, err := json.Marshal(creditInput)
 	require.NoError(t, err)
 
-	creditURL := accountsPath + "/" + accountID + "/credit"
+	creditURL := suite.adminPath + "/accounts/" + accountID + "/credit"
 	response, err := http.Post(creditURL, "application/json", bytes.NewReader(creditBody))
 	require.NoError(t, err)
 
@@ -235,7 +249,7 @@ func (suite *BookControllerTestSuite) TestGet_WithAsks() {
 	creditBody, err := json.Marshal(creditInput)
 	require.NoError(t, err)
 
-	creditURL := accountsPath + "/" + accountID + "/credit"
+	creditURL := suite.adminPath + "/accounts/" + accountID + "/credit"
 	creditRes, err := http.Post(creditURL, "application/json", bytes.NewReader(creditBody))
 	require.NoError(t, err)
 
@@ -291,6 +305,130 @@ func (suite *BookControllerTestSuite) TestGet_WithAsks() {
 	}
 }
 
+func (suite *BookControllerTestSuite) placeOrder(accountID string, instrument string, price int64) *http.Response {
+	t := suite.Suite.T()
+
+	ordersPath := suite.e2eTestHandle.HttpServerTest.URL + "/api/v1/orders"
+	createOrderInput := map[string]interface{}{
+		"account_id": accountID,
+		"instrument": instrument,
+		"side":       "buy",
+		"qty":        1,
+		"price":      price,
+	}
+	createOrderBody, err := json.Marshal(createOrderInput)
+	require.NoError(t, err)
+
+	orderRes, err := http.Post(ordersPath, "application/json", bytes.NewReader(createOrderBody))
+	require.NoError(t, err)
+
+	return orderRes
+}
+
+func (suite *BookControllerTestSuite) TestCircuitBreaker_BandAndHalt() {
+	t := suite.Suite.T()
+
+	accountsPath := suite.e2eTestHandle.HttpServerTest.URL + "/api/v1/accounts"
+	createAccountBody, err := json.Marshal(map[string]string{"account_name": "circuit-breaker-account"})
+	require.NoError(t, err)
+
+	createAccountRes, err := http.Post(accountsPath, "application/json", bytes.NewReader(createAccountBody))
+	require.NoError(t, err)
+	defer createAccountRes.Body.Close()
+
+	var createAccountOut map[string]string
+	err = json.NewDecoder(createAccountRes.Body).Decode(&createAccountOut)
+	require.NoError(t, err)
+
+	accountID := createAccountOut["account_id"]
+
+	configureBody, err := json.Marshal(map[string]int64{
+		"reference_price": 100,
+		"band_bps":        1000,
+	})
+	require.NoError(t, err)
+
+	configureReq, err := http.NewRequest(http.MethodPut, suite.adminPath+"/books/circuit-breaker?instrument=sol/usdt", bytes.NewReader(configureBody))
+	require.NoError(t, err)
+
+	configureRes, err := http.DefaultClient.Do(configureReq)
+	require.NoError(t, err)
+	defer configureRes.Body.Close()
+
+	assert.Equal(t, http.StatusOK, configureRes.StatusCode)
+
+	var configureOut circuitBreakerOutputDtoTest
+	err = json.NewDecoder(configureRes.Body).Decode(&configureOut)
+	require.NoError(t, err)
+	assert.Equal(t, "SOL/USDT", configureOut.Instrument)
+	assert.Equal(t, int64(100), configureOut.ReferencePrice)
+	assert.Equal(t, int64(1000), configureOut.BandBps)
+
+	orderRes := suite.placeOrder(accountID, "SOL/USDT", 500)
+	defer orderRes.Body.Close()
+
+	assert.Equal(t, http.StatusUnprocessableEntity, orderRes.StatusCode)
+
+	var orderErr errorOutputDtoTest
+	err = json.NewDecoder(orderRes.Body).Decode(&orderErr)
+	require.NoError(t, err)
+	assert.Equal(t, "PRICE_OUT_OF_BAND", orderErr.Code)
+
+	haltRes, err := http.Post(suite.adminPath+"/books/halt?instrument=SOL/USDT", "application/json", nil)
+	require.NoError(t, err)
+	defer haltRes.Body.Close()
+
+	assert.Equal(t, http.StatusOK, haltRes.StatusCode)
+
+	var haltOut circuitBreakerOutputDtoTest
+	err = json.NewDecoder(haltRes.Body).Decode(&haltOut)
+	require.NoError(t, err)
+	assert.True(t, haltOut.Halted)
+	assert.NotEmpty(t, haltOut.HaltedUntil)
+
+	haltedOrderRes := suite.placeOrder(accountID, "SOL/USDT", 100)
+	defer haltedOrderRes.Body.Close()
+
+	assert.Equal(t, http.StatusUnprocessableEntity, haltedOrderRes.StatusCode)
+
+	var haltedOrderErr errorOutputDtoTest
+	err = json.NewDecoder(haltedOrderRes.Body).Decode(&haltedOrderErr)
+	require.NoError(t, err)
+	assert.Equal(t, "INSTRUMENT_HALTED", haltedOrderErr.Code)
+
+	resumeRes, err := http.Post(suite.adminPath+"/books/resume?instrument=SOL/USDT", "application/json", nil)
+	require.NoError(t, err)
+	defer resumeRes.Body.Close()
+
+	var resumeOut circuitBreakerOutputDtoTest
+	err = json.NewDecoder(resumeRes.Body).Decode(&resumeOut)
+	require.NoError(t, err)
+	assert.False(t, resumeOut.Halted)
+}
+
+func (suite *BookControllerTestSuite) TestHalt_InstrumentNotFound() {
+	t := suite.Suite.T()
+
+	haltRes, err := http.Post(suite.adminPath+"/books/halt?instrument=XRP/DOGE", "application/json", nil)
+	require.NoError(t, err)
+	defer haltRes.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, haltRes.StatusCode)
+}
+
+func (suite *BookControllerTestSuite) TestConfigureCircuitBreaker_MissingInstrument() {
+	t := suite.Suite.T()
+
+	req, err := http.NewRequest(http.MethodPut, suite.adminPath+"/books/circuit-breaker", bytes.NewBufferString("{}"))
+	require.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, new(BookControllerTestSuite))
 }
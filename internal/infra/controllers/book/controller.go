@@ -1,8 +1,10 @@
 package book
 
 import (
+	"encoding/json"
 	"net/http"
 	"strings"
+	"time"
 
 	bookUsecases "github.com/juninhoitabh/clob-go/internal/application/book/usecases"
 	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
@@ -18,6 +20,26 @@ type (
 		Instrument string                          `json:"instrument" example:"BTC/USDT"`
 		Bids       []getByInstrumentLevelOutputDto `json:"bids"`
 		Asks       []getByInstrumentLevelOutputDto `json:"asks"`
+		LastPrice  int64                           `json:"last_price" example:"50000"`
+		Halted     bool                            `json:"halted" example:"false"`
+	}
+	configureCircuitBreakerInputDto struct {
+		ReferencePrice int64 `json:"reference_price" example:"50000" validate:"gte=0"`
+		BandBps        int64 `json:"band_bps" example:"1000" validate:"gte=0"`
+		MoveBps        int64 `json:"move_bps" example:"1000" validate:"gte=0"`
+		WindowSeconds  int64 `json:"window_seconds" example:"60" validate:"gte=0"`
+		HaltSeconds    int64 `json:"halt_seconds" example:"300" validate:"gte=0"`
+	}
+	circuitBreakerOutputDto struct {
+		Instrument     string `json:"instrument" example:"BTC/USDT"`
+		HaltedUntil    string `json:"halted_until,omitempty" example:"2025-01-01T00:05:00Z"`
+		ReferencePrice int64  `json:"reference_price" example:"50000"`
+		LastPrice      int64  `json:"last_price" example:"50000"`
+		BandBps        int64  `json:"band_bps" example:"1000"`
+		MoveBps        int64  `json:"move_bps" example:"1000"`
+		WindowSeconds  int64  `json:"window_seconds" example:"60"`
+		HaltSeconds    int64  `json:"halt_seconds" example:"300"`
+		Halted         bool   `json:"halted" example:"false"`
 	}
 	BookController struct {
 		bookRepo domainBook.IBookRepository
@@ -34,6 +56,7 @@ type (
 // @Success      200       {object}  getByInstrumentOutputDto
 // @Failure      400       {object}  shared.Errors "Bad Request"
 // @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      429       {object}  shared.Errors "Too Many Requests"
 // @Failure      500       {object}  shared.Errors "Internal Server Error"
 // @Router       /books [get]
 func (b *BookController) Get(w http.ResponseWriter, req *http.Request) {
@@ -67,6 +90,8 @@ func (b *BookController) Get(w http.ResponseWriter, req *http.Request) {
 		Instrument: book.Instrument,
 		Bids:       []getByInstrumentLevelOutputDto{},
 		Asks:       []getByInstrumentLevelOutputDto{},
+		LastPrice:  book.LastPrice,
+		Halted:     book.Halted,
 	}
 
 	for _, bid := range book.Bids {
@@ -86,6 +111,126 @@ func (b *BookController) Get(w http.ResponseWriter, req *http.Request) {
 	shared.WriteJSON(w, http.StatusOK, getByInstrumentOutputDtoResponse)
 }
 
+// ConfigureCircuitBreaker godoc
+// @Summary      Configure Circuit Breaker
+// @Description  Configure the price band and circuit breaker of an instrument
+// @Tags         Books
+// @Accept       json
+// @Produce      json
+// @Param        instrument query     string                           true  "instrument" example:"BTC/USDT"
+// @Param        request    body      configureCircuitBreakerInputDto  true  "configureCircuitBreakerInputDto request"
+// @Success      200       {object}  circuitBreakerOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /admin/books/circuit-breaker [put]
+func (b *BookController) ConfigureCircuitBreaker(w http.ResponseWriter, req *http.Request) {
+	inst := strings.ToUpper(req.URL.Query().Get("instrument"))
+	if inst == "" {
+		shared.BadRequestError(w, "instrument required")
+
+		return
+	}
+
+	var body configureCircuitBreakerInputDto
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		shared.BadRequestError(w, "Invalid JSON", err.Error())
+
+		return
+	}
+
+	configureCircuitBreakerUseCase := bookUsecases.NewConfigureCircuitBreakerUseCase(b.bookRepo)
+
+	output, err := configureCircuitBreakerUseCase.Execute(bookUsecases.ConfigureCircuitBreakerInput{
+		Instrument:     inst,
+		ReferencePrice: body.ReferencePrice,
+		BandBps:        body.BandBps,
+		MoveBps:        body.MoveBps,
+		Window:         time.Duration(body.WindowSeconds) * time.Second,
+		HaltDuration:   time.Duration(body.HaltSeconds) * time.Second,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newCircuitBreakerOutputDto(output))
+}
+
+// Halt godoc
+// @Summary      Halt
+// @Description  Halt trading on an instrument until it is resumed
+// @Tags         Books
+// @Accept       json
+// @Produce      json
+// @Param        instrument query     string true "instrument" example:"BTC/USDT"
+// @Success      200       {object}  circuitBreakerOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /admin/books/halt [post]
+func (b *BookController) Halt(w http.ResponseWriter, req *http.Request) {
+	b.setHalted(w, req, true)
+}
+
+// Resume godoc
+// @Summary      Resume
+// @Description  Resume trading on a halted instrument
+// @Tags         Books
+// @Accept       json
+// @Produce      json
+// @Param        instrument query     string true "instrument" example:"BTC/USDT"
+// @Success      200       {object}  circuitBreakerOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /admin/books/resume [post]
+func (b *BookController) Resume(w http.ResponseWriter, req *http.Request) {
+	b.setHalted(w, req, false)
+}
+
+func (b *BookController) setHalted(w http.ResponseWriter, req *http.Request, halted bool) {
+	inst := strings.ToUpper(req.URL.Query().Get("instrument"))
+	if inst == "" {
+		shared.BadRequestError(w, "instrument required")
+
+		return
+	}
+
+	haltBookUseCase := bookUsecases.NewHaltBookUseCase(b.bookRepo)
+
+	output, err := haltBookUseCase.Execute(bookUsecases.HaltBookInput{
+		Instrument: inst,
+		Halted:     halted,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newCircuitBreakerOutputDto(output))
+}
+
+func newCircuitBreakerOutputDto(output *bookUsecases.CircuitBreakerOutput) circuitBreakerOutputDto {
+	circuitBreakerOutputDtoResponse := circuitBreakerOutputDto{
+		Instrument:     output.Instrument,
+		ReferencePrice: output.ReferencePrice,
+		LastPrice:      output.LastPrice,
+		BandBps:        output.BandBps,
+		MoveBps:        output.MoveBps,
+		WindowSeconds:  int64(output.Window / time.Second),
+		HaltSeconds:    int64(output.HaltDuration / time.Second),
+		Halted:         output.Halted,
+	}
+
+	if output.Halted {
+		circuitBreakerOutputDtoResponse.HaltedUntil = output.HaltedUntil.UTC().Format(time.RFC3339)
+	}
+
+	return circuitBreakerOutputDtoResponse
+}
+
 func NewBookController(
 	bookRepo domainBook.IBookRepository,
 ) *BookController {
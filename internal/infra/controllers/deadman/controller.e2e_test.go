@@ -0,0 +1,293 @@
+package deadman_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	deadmanUsecases "github.com/juninhoitabh/clob-go/internal/application/deadman/usecases"
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	httpServer "github.com/juninhoitabh/clob-go/internal/infra/http-server"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesBook "github.com/juninhoitabh/clob-go/internal/infra/repositories/book"
+	repositoriesDeadman "github.com/juninhoitabh/clob-go/internal/infra/repositories/deadman"
+	repositoriesOrder "github.com/juninhoitabh/clob-go/internal/infra/repositories/order"
+)
+
+type (
+	switchOutputDtoTest struct {
+		AccountID      string `json:"account_id"`
+		ExpiresAt      string `json:"expires_at"`
+		TimeoutSeconds int64  `json:"timeout_seconds"`
+	}
+	DeadManControllerTestSuite struct {
+		suite.Suite
+		e2eTestHandle *httpServer.E2eTestHandle
+		basePath      string
+	}
+)
+
+type failingMassCancelUseCase struct{}
+
+func (failingMassCancelUseCase) Execute(orderUsecases.MassCancelOrdersInput) (*orderUsecases.MassCancelOrdersOutput, error) {
+	return nil, errors.New("mass cancel failed")
+}
+
+func (suite *DeadManControllerTestSuite) SetupTest() {
+	suite.e2eTestHandle = httpServer.NewE2eTestHandle()
+	suite.basePath = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1"
+}
+
+func (suite *DeadManControllerTestSuite) TearDownSuite() {
+	suite.e2eTestHandle.HttpServerTest.Close()
+}
+
+func (suite *DeadManControllerTestSuite) do(method, path string, body any) *http.Response {
+	t := suite.Suite.T()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(method, suite.basePath+path, bytes.NewReader(payload))
+	require.NoError(t, err)
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	return res
+}
+
+func (suite *DeadManControllerTestSuite) setupAccountWithOrder(name string) string {
+	t := suite.Suite.T()
+
+	res := suite.do(http.MethodPost, "/accounts", map[string]string{"account_name": name})
+	defer res.Body.Close()
+
+	var account map[string]string
+	err := json.NewDecoder(res.Body).Decode(&account)
+	require.NoError(t, err)
+
+	accountID := account["account_id"]
+
+	res = suite.do(http.MethodPost, "/admin/accounts/"+accountID+"/credit", map[string]any{"asset": "USDT", "amount": 1000})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	res = suite.do(http.MethodPost, "/orders", map[string]any{
+		"account_id": accountID,
+		"instrument": "XRP/USDT",
+		"side":       "buy",
+		"price":      10,
+		"qty":        10,
+	})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	require.Equal(t, float64(900), suite.availableUSDT(accountID))
+
+	return accountID
+}
+
+func (suite *DeadManControllerTestSuite) availableUSDT(accountID string) float64 {
+	t := suite.Suite.T()
+
+	res, err := http.Get(suite.basePath + "/accounts/" + accountID)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	var out struct {
+		Balances map[string]map[string]float64 `json:"balances"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+
+	return out.Balances["USDT"]["available"]
+}
+
+func (suite *DeadManControllerTestSuite) TestSwitch_SetHeartbeatDisarm() {
+	t := suite.Suite.T()
+
+	accountID := suite.setupAccountWithOrder("deadman-lifecycle")
+	switchPath := "/accounts/" + accountID + "/dead-man-switch"
+
+	res := suite.do(http.MethodPut, switchPath, map[string]any{"timeout_seconds": 0})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	res = suite.do(http.MethodPut, switchPath, map[string]any{"timeout_seconds": 30})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var armed switchOutputDtoTest
+	err := json.NewDecoder(res.Body).Decode(&armed)
+	require.NoError(t, err)
+	assert.Equal(t, accountID, armed.AccountID)
+	assert.Equal(t, int64(30), armed.TimeoutSeconds)
+
+	res = suite.do(http.MethodPost, switchPath+"/heartbeat", nil)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var refreshed switchOutputDtoTest
+	err = json.NewDecoder(res.Body).Decode(&refreshed)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, refreshed.ExpiresAt, armed.ExpiresAt)
+
+	res = suite.do(http.MethodDelete, switchPath, nil)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	res = suite.do(http.MethodPost, switchPath+"/heartbeat", nil)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	assert.Equal(t, float64(900), suite.availableUSDT(accountID))
+}
+
+func (suite *DeadManControllerTestSuite) TestSwitch_ExpiryCancelsOrders() {
+	t := suite.Suite.T()
+
+	accountID := suite.setupAccountWithOrder("deadman-expiry")
+
+	res := suite.do(http.MethodPut, "/accounts/"+accountID+"/dead-man-switch", map[string]any{"timeout_seconds": 1})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	fireExpiredSwitchesUseCase := deadmanUsecases.NewFireExpiredSwitchesUseCase(
+		repositoriesDeadman.NewInMemorySwitchRepository(),
+		orderUsecases.NewMassCancelOrdersUseCase(
+			repositoriesBook.NewInMemoryBookRepository(),
+			repositoriesOrder.NewInMemoryOrderRepository(),
+			repositoriesAccount.NewInMemoryAccountRepository(),
+		),
+	)
+
+	out, err := fireExpiredSwitchesUseCase.Execute(deadmanUsecases.FireExpiredSwitchesInput{Now: time.Now().Add(2 * time.Second)})
+	require.NoError(t, err)
+
+	var fired *deadmanUsecases.FiredSwitch
+
+	for i := range out.Fired {
+		if out.Fired[i].AccountID == accountID {
+			fired = &out.Fired[i]
+		}
+	}
+
+	require.NotNil(t, fired)
+	assert.NoError(t, fired.Err)
+	assert.Equal(t, 1, fired.Canceled)
+	assert.Equal(t, float64(1000), suite.availableUSDT(accountID))
+
+	res = suite.do(http.MethodPost, "/accounts/"+accountID+"/dead-man-switch/heartbeat", nil)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func (suite *DeadManControllerTestSuite) TestSwitch_FailedCancelFiresAgain() {
+	t := suite.Suite.T()
+
+	accountID := suite.setupAccountWithOrder("deadman-retry")
+
+	res := suite.do(http.MethodPut, "/accounts/"+accountID+"/dead-man-switch", map[string]any{"timeout_seconds": 1})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	switchRepo := repositoriesDeadman.NewInMemorySwitchRepository()
+	now := time.Now().Add(2 * time.Second)
+
+	failing := deadmanUsecases.NewFireExpiredSwitchesUseCase(switchRepo, failingMassCancelUseCase{})
+	out, err := failing.Execute(deadmanUsecases.FireExpiredSwitchesInput{Now: now})
+	require.NoError(t, err)
+	assert.NotEmpty(t, out.Fired)
+	assert.Equal(t, float64(900), suite.availableUSDT(accountID))
+
+	retry := deadmanUsecases.NewFireExpiredSwitchesUseCase(
+		switchRepo,
+		orderUsecases.NewMassCancelOrdersUseCase(
+			repositoriesBook.NewInMemoryBookRepository(),
+			repositoriesOrder.NewInMemoryOrderRepository(),
+			repositoriesAccount.NewInMemoryAccountRepository(),
+		),
+	)
+	out, err = retry.Execute(deadmanUsecases.FireExpiredSwitchesInput{Now: now})
+	require.NoError(t, err)
+
+	var fired *deadmanUsecases.FiredSwitch
+
+	for i := range out.Fired {
+		if out.Fired[i].AccountID == accountID {
+			fired = &out.Fired[i]
+		}
+	}
+
+	require.NotNil(t, fired)
+	assert.NoError(t, fired.Err)
+	assert.Equal(t, 1, fired.Canceled)
+	assert.Equal(t, float64(1000), suite.availableUSDT(accountID))
+
+	got, err := switchRepo.GetSwitch(accountID)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func (suite *DeadManControllerTestSuite) openSession(accountID, query string) *http.Response {
+	t := suite.Suite.T()
+
+	res, err := http.Post(suite.basePath+"/accounts/"+accountID+"/sessions"+query, "application/json", nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	line, err := bufio.NewReader(res.Body).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "event: session", strings.TrimSpace(line))
+
+	return res
+}
+
+func (suite *DeadManControllerTestSuite) TestSession_CancelOnDisconnect() {
+	t := suite.Suite.T()
+
+	accountID := suite.setupAccountWithOrder("deadman-session")
+
+	res := suite.openSession(accountID, "?cancel_on_disconnect=true")
+	res.Body.Close()
+
+	assert.Eventually(t, func() bool {
+		return suite.availableUSDT(accountID) == float64(1000)
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func (suite *DeadManControllerTestSuite) TestSession_WithoutOptInKeepsOrders() {
+	t := suite.Suite.T()
+
+	accountID := suite.setupAccountWithOrder("deadman-session-keep")
+
+	res := suite.openSession(accountID, "")
+	res.Body.Close()
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, float64(900), suite.availableUSDT(accountID))
+}
+
+func (suite *DeadManControllerTestSuite) TestSession_UnknownAccount() {
+	t := suite.Suite.T()
+
+	res, err := http.Post(suite.basePath+"/accounts/unknown-account/sessions?cancel_on_disconnect=true", "application/json", nil)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(DeadManControllerTestSuite))
+}
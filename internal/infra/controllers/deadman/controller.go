@@ -0,0 +1,254 @@
+package deadman
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	deadmanUsecases "github.com/juninhoitabh/clob-go/internal/application/deadman/usecases"
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	domainDeadman "github.com/juninhoitabh/clob-go/internal/domain/deadman"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	setSwitchInputDto struct {
+		TimeoutSeconds int64 `json:"timeout_seconds" example:"30" validate:"required,gte=1,lte=3600"`
+	}
+	switchOutputDto struct {
+		AccountID      string `json:"account_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		ExpiresAt      string `json:"expires_at" example:"2025-01-01T00:00:30Z"`
+		UpdatedAt      string `json:"updated_at" example:"2025-01-01T00:00:00Z"`
+		TimeoutSeconds int64  `json:"timeout_seconds" example:"30"`
+	}
+	sessionEventDto struct {
+		AccountID          string `json:"account_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		Time               string `json:"time" example:"2025-01-01T00:00:00Z"`
+		CancelOnDisconnect bool   `json:"cancel_on_disconnect" example:"true"`
+	}
+	DeadManController struct {
+		accountRepo       domainAccount.IAccountRepository
+		bookRepo          domainBook.IBookRepository
+		orderRepo         domainOrder.IOrderRepository
+		switchRepo        domainDeadman.ISwitchRepository
+		heartbeatInterval time.Duration
+	}
+)
+
+// SetSwitch godoc
+// @Summary      Set Dead Man's Switch
+// @Description  Arm the account's dead man's switch. Unless it is refreshed with a heartbeat within timeout_seconds, all of the account's open orders are cancelled and their reservations released. Setting it again replaces the timeout
+// @Tags         DeadManSwitch
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string             true  "account_id" Format(uuid)
+// @Param        request   body      setSwitchInputDto  true  "setSwitchInputDto request"
+// @Success      200       {object}  switchOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      401       {object}  shared.Errors "Unauthorized"
+// @Failure      403       {object}  shared.Errors "Account not owned by the caller"
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/dead-man-switch [put]
+func (d *DeadManController) SetSwitch(w http.ResponseWriter, req *http.Request) {
+	var body setSwitchInputDto
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		shared.BadRequestError(w, "Invalid JSON", err.Error())
+
+		return
+	}
+
+	setSwitchUseCase := deadmanUsecases.NewSetSwitchUseCase(d.accountRepo, d.switchRepo)
+
+	output, err := setSwitchUseCase.Execute(deadmanUsecases.SetSwitchInput{
+		AccountID:       req.PathValue("id"),
+		CallerAccountID: shared.CallerFromContext(req.Context()),
+		Timeout:         time.Duration(body.TimeoutSeconds) * time.Second,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newSwitchOutputDto(output))
+}
+
+// Heartbeat godoc
+// @Summary      Dead Man's Switch Heartbeat
+// @Description  Refresh the account's dead man's switch for another full timeout. Returns 404 once the switch has fired or if it was never set
+// @Tags         DeadManSwitch
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string  true  "account_id" Format(uuid)
+// @Success      200       {object}  switchOutputDto
+// @Failure      401       {object}  shared.Errors "Unauthorized"
+// @Failure      403       {object}  shared.Errors "Account not owned by the caller"
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/dead-man-switch/heartbeat [post]
+func (d *DeadManController) Heartbeat(w http.ResponseWriter, req *http.Request) {
+	heartbeatUseCase := deadmanUsecases.NewHeartbeatUseCase(d.accountRepo, d.switchRepo)
+
+	output, err := heartbeatUseCase.Execute(deadmanUsecases.HeartbeatInput{
+		AccountID:       req.PathValue("id"),
+		CallerAccountID: shared.CallerFromContext(req.Context()),
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newSwitchOutputDto(output))
+}
+
+// DisarmSwitch godoc
+// @Summary      Disarm Dead Man's Switch
+// @Description  Disarm the account's dead man's switch without cancelling any orders
+// @Tags         DeadManSwitch
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string  true  "account_id" Format(uuid)
+// @Success      204
+// @Failure      401       {object}  shared.Errors "Unauthorized"
+// @Failure      403       {object}  shared.Errors "Account not owned by the caller"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/dead-man-switch [delete]
+func (d *DeadManController) DisarmSwitch(w http.ResponseWriter, req *http.Request) {
+	disarmSwitchUseCase := deadmanUsecases.NewDisarmSwitchUseCase(d.accountRepo, d.switchRepo)
+
+	err := disarmSwitchUseCase.Execute(deadmanUsecases.DisarmSwitchInput{
+		AccountID:       req.PathValue("id"),
+		CallerAccountID: shared.CallerFromContext(req.Context()),
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Session godoc
+// @Summary      Streaming Session
+// @Description  Open a server-sent events session that emits a "heartbeat" event periodically. With cancel_on_disconnect=true, all of the account's open orders are cancelled when the session ends for any reason
+// @Tags         DeadManSwitch
+// @Produce      text/event-stream
+// @Param        id                    path      string  true   "account_id" Format(uuid)
+// @Param        cancel_on_disconnect  query     bool    false  "cancel the account's open orders when the session ends"
+// @Success      200                   {object}  sessionEventDto
+// @Failure      401                   {object}  shared.Errors "Unauthorized"
+// @Failure      403                   {object}  shared.Errors "Account not owned by the caller"
+// @Failure      404                   {object}  shared.Errors "Not Found"
+// @Failure      500                   {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/sessions [post]
+func (d *DeadManController) Session(w http.ResponseWriter, req *http.Request) {
+	accountID := req.PathValue("id")
+	callerAccountID := shared.CallerFromContext(req.Context())
+	cancelOnDisconnect := req.URL.Query().Get("cancel_on_disconnect") == "true"
+
+	openSessionUseCase := deadmanUsecases.NewOpenSessionUseCase(d.accountRepo)
+
+	err := openSessionUseCase.Execute(deadmanUsecases.OpenSessionInput{
+		AccountID:       accountID,
+		CallerAccountID: callerAccountID,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	if cancelOnDisconnect {
+		defer d.cancelOnDisconnect(accountID, callerAccountID)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	controller := http.NewResponseController(w)
+	event := sessionEventDto{AccountID: accountID, CancelOnDisconnect: cancelOnDisconnect}
+
+	if writeEvent(w, controller, "session", event) != nil {
+		return
+	}
+
+	ticker := time.NewTicker(d.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case now := <-ticker.C:
+			event.Time = now.UTC().Format(time.RFC3339Nano)
+
+			if writeEvent(w, controller, "heartbeat", event) != nil {
+				return
+			}
+		}
+	}
+}
+
+func (d *DeadManController) cancelOnDisconnect(accountID, callerAccountID string) {
+	massCancelOrdersUseCase := orderUsecases.NewMassCancelOrdersUseCase(d.bookRepo, d.orderRepo, d.accountRepo)
+
+	output, err := massCancelOrdersUseCase.Execute(orderUsecases.MassCancelOrdersInput{
+		AccountID:       accountID,
+		CallerAccountID: callerAccountID,
+	})
+	if err != nil {
+		log.Printf("ALERT cancel on disconnect: account=%q cancel failed: %v", accountID, err)
+
+		return
+	}
+
+	log.Printf("cancel on disconnect: account=%q canceled=%d", accountID, len(output.Orders))
+}
+
+func writeEvent(w http.ResponseWriter, controller *http.ResponseController, name string, event sessionEventDto) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data)
+	if err != nil {
+		return err
+	}
+
+	return controller.Flush()
+}
+
+func newSwitchOutputDto(output *deadmanUsecases.SwitchOutput) switchOutputDto {
+	return switchOutputDto{
+		AccountID:      output.AccountID,
+		ExpiresAt:      output.ExpiresAt.UTC().Format(time.RFC3339Nano),
+		UpdatedAt:      output.UpdatedAt.UTC().Format(time.RFC3339Nano),
+		TimeoutSeconds: int64(output.Timeout / time.Second),
+	}
+}
+
+func NewDeadManController(
+	accountRepo domainAccount.IAccountRepository,
+	bookRepo domainBook.IBookRepository,
+	orderRepo domainOrder.IOrderRepository,
+	switchRepo domainDeadman.ISwitchRepository,
+	heartbeatInterval time.Duration,
+) *DeadManController {
+	return &DeadManController{
+		accountRepo:       accountRepo,
+		bookRepo:          bookRepo,
+		orderRepo:         orderRepo,
+		switchRepo:        switchRepo,
+		heartbeatInterval: heartbeatInterval,
+	}
+}
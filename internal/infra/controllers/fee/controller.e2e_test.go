@@ -0,0 +1,277 @@
+package fee_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	httpServer "github.com/juninhoitabh/clob-go/internal/infra/http-server"
+)
+
+type (
+	ratesDtoTest struct {
+		MakerBps int64 `json:"maker_bps"`
+		TakerBps int64 `json:"taker_bps"`
+	}
+	scheduleOutputDtoTest struct {
+		Tiers      map[string]ratesDtoTest `json:"tiers"`
+		Instrument string                  `json:"instrument"`
+		MakerBps   int64                   `json:"maker_bps"`
+		TakerBps   int64                   `json:"taker_bps"`
+	}
+	tradeOutputDtoTest struct {
+		TradeID       string `json:"trade_id"`
+		MakerFeeAsset string `json:"maker_fee_asset"`
+		TakerFeeAsset string `json:"taker_fee_asset"`
+		MakerFee      int64  `json:"maker_fee"`
+		TakerFee      int64  `json:"taker_fee"`
+	}
+	placeOutputDtoTest struct {
+		Report struct {
+			Trades []tradeOutputDtoTest `json:"trades"`
+		} `json:"report"`
+	}
+	listTradesOutputDtoTest struct {
+		Trades []tradeOutputDtoTest `json:"trades"`
+	}
+	balanceOutputDtoTest struct {
+		Available int64 `json:"available"`
+		Reserved  int64 `json:"reserved"`
+	}
+	tierOutputDtoTest struct {
+		AccountID string `json:"account_id"`
+		Tier      string `json:"tier"`
+		Volume    int64  `json:"volume_30d"`
+		Pinned    bool   `json:"pinned"`
+	}
+	accountOutputDtoTest struct {
+		Balances map[string]balanceOutputDtoTest `json:"balances"`
+	}
+	FeeControllerTestSuite struct {
+		suite.Suite
+		e2eTestHandle *httpServer.E2eTestHandle
+		basePath      string
+	}
+)
+
+func (suite *FeeControllerTestSuite) SetupTest() {
+	suite.e2eTestHandle = httpServer.NewE2eTestHandle()
+	suite.basePath = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1"
+}
+
+func (suite *FeeControllerTestSuite) TearDownSuite() {
+	suite.e2eTestHandle.HttpServerTest.Close()
+}
+
+func (suite *FeeControllerTestSuite) put(path string, body any) *http.Response {
+	t := suite.Suite.T()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPut, suite.basePath+path, bytes.NewReader(payload))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	return res
+}
+
+func (suite *FeeControllerTestSuite) post(path string, body any) *http.Response {
+	t := suite.Suite.T()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	res, err := http.Post(suite.basePath+path, "application/json", bytes.NewReader(payload))
+	require.NoError(t, err)
+
+	return res
+}
+
+func (suite *FeeControllerTestSuite) createAccount(name string, asset string, amount int64) string {
+	t := suite.Suite.T()
+
+	res := suite.post("/accounts", map[string]string{"account_name": name})
+	defer res.Body.Close()
+
+	var out map[string]string
+	err := json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+
+	creditRes := suite.post("/admin/accounts/"+out["account_id"]+"/credit", map[string]any{"asset": asset, "amount": amount})
+	defer creditRes.Body.Close()
+	require.Equal(t, http.StatusOK, creditRes.StatusCode)
+
+	return out["account_id"]
+}
+
+func (suite *FeeControllerTestSuite) TestFeesAppliedAtSettlement() {
+	t := suite.Suite.T()
+
+	scheduleRes := suite.put("/admin/fees/schedules?instrument=dot/usdt", map[string]any{
+		"maker_bps": 10,
+		"taker_bps": 20,
+		"tiers":     map[string]any{"vip": map[string]int64{"maker_bps": -10, "taker_bps": 10}},
+	})
+	defer scheduleRes.Body.Close()
+	require.Equal(t, http.StatusOK, scheduleRes.StatusCode)
+
+	sellerID := suite.createAccount("fee-seller", "DOT", 1000)
+	buyerID := suite.createAccount("fee-buyer", "USDT", 10000)
+
+	tierRes := suite.put("/admin/accounts/"+sellerID+"/fee-tier", map[string]string{"tier": "VIP"})
+	defer tierRes.Body.Close()
+	require.Equal(t, http.StatusOK, tierRes.StatusCode)
+
+	sellRes := suite.post("/orders", map[string]any{
+		"account_id": sellerID,
+		"instrument": "DOT/USDT",
+		"side":       "sell",
+		"price":      10,
+		"qty":        1000,
+	})
+	defer sellRes.Body.Close()
+	require.Equal(t, http.StatusCreated, sellRes.StatusCode)
+
+	buyRes := suite.post("/orders", map[string]any{
+		"account_id": buyerID,
+		"instrument": "DOT/USDT",
+		"side":       "buy",
+		"price":      10,
+		"qty":        1000,
+	})
+	defer buyRes.Body.Close()
+	require.Equal(t, http.StatusCreated, buyRes.StatusCode)
+
+	var placeOut placeOutputDtoTest
+	err := json.NewDecoder(buyRes.Body).Decode(&placeOut)
+	require.NoError(t, err)
+	require.Len(t, placeOut.Report.Trades, 1)
+
+	trade := placeOut.Report.Trades[0]
+	assert.NotEmpty(t, trade.TradeID)
+	assert.Equal(t, int64(2), trade.TakerFee)
+	assert.Equal(t, "DOT", trade.TakerFeeAsset)
+	assert.Equal(t, int64(-1), trade.MakerFee)
+	assert.Equal(t, "DOT", trade.MakerFeeAsset)
+
+	historyRes, err := http.Get(suite.basePath + "/trades?account_id=" + buyerID)
+	require.NoError(t, err)
+	defer historyRes.Body.Close()
+	require.Equal(t, http.StatusOK, historyRes.StatusCode)
+
+	var historyOut listTradesOutputDtoTest
+	err = json.NewDecoder(historyRes.Body).Decode(&historyOut)
+	require.NoError(t, err)
+	require.Len(t, historyOut.Trades, 1)
+	assert.Equal(t, trade, historyOut.Trades[0])
+
+	buyerRes, err := http.Get(suite.basePath + "/accounts/" + buyerID)
+	require.NoError(t, err)
+	defer buyerRes.Body.Close()
+
+	var buyerOut accountOutputDtoTest
+	err = json.NewDecoder(buyerRes.Body).Decode(&buyerOut)
+	require.NoError(t, err)
+	assert.Equal(t, int64(998), buyerOut.Balances["DOT"].Available)
+
+	sellerRes, err := http.Get(suite.basePath + "/accounts/" + sellerID)
+	require.NoError(t, err)
+	defer sellerRes.Body.Close()
+
+	var sellerOut accountOutputDtoTest
+	err = json.NewDecoder(sellerRes.Body).Decode(&sellerOut)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10000), sellerOut.Balances["USDT"].Available)
+	assert.Equal(t, int64(1), sellerOut.Balances["DOT"].Available)
+
+	sellerTierRes, err := http.Get(suite.basePath + "/accounts/" + sellerID + "/fee-tier")
+	require.NoError(t, err)
+	defer sellerTierRes.Body.Close()
+	require.Equal(t, http.StatusOK, sellerTierRes.StatusCode)
+
+	var sellerTier tierOutputDtoTest
+	err = json.NewDecoder(sellerTierRes.Body).Decode(&sellerTier)
+	require.NoError(t, err)
+	assert.Equal(t, sellerID, sellerTier.AccountID)
+	assert.Equal(t, "vip", sellerTier.Tier)
+	assert.Equal(t, int64(10000), sellerTier.Volume)
+	assert.True(t, sellerTier.Pinned)
+
+	buyerTierRes, err := http.Get(suite.basePath + "/accounts/" + buyerID + "/fee-tier")
+	require.NoError(t, err)
+	defer buyerTierRes.Body.Close()
+	require.Equal(t, http.StatusOK, buyerTierRes.StatusCode)
+
+	var buyerTier tierOutputDtoTest
+	err = json.NewDecoder(buyerTierRes.Body).Decode(&buyerTier)
+	require.NoError(t, err)
+	assert.Equal(t, "", buyerTier.Tier)
+	assert.Equal(t, int64(10000), buyerTier.Volume)
+	assert.False(t, buyerTier.Pinned)
+}
+
+func (suite *FeeControllerTestSuite) TestGetSchedule_Success() {
+	t := suite.Suite.T()
+
+	res := suite.put("/admin/fees/schedules?instrument=LINK/USDT", map[string]any{"maker_bps": 1, "taker_bps": 3})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	getRes, err := http.Get(suite.basePath + "/fees/schedules?instrument=link/usdt")
+	require.NoError(t, err)
+	defer getRes.Body.Close()
+	require.Equal(t, http.StatusOK, getRes.StatusCode)
+
+	var out scheduleOutputDtoTest
+	err = json.NewDecoder(getRes.Body).Decode(&out)
+	require.NoError(t, err)
+	assert.Equal(t, "LINK/USDT", out.Instrument)
+	assert.Equal(t, int64(1), out.MakerBps)
+	assert.Equal(t, int64(3), out.TakerBps)
+}
+
+func (suite *FeeControllerTestSuite) TestSetSchedule_InvalidRebate() {
+	t := suite.Suite.T()
+
+	res := suite.put("/admin/fees/schedules?instrument=LINK/USDT", map[string]any{"maker_bps": -5, "taker_bps": 1})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func (suite *FeeControllerTestSuite) TestSetSchedule_MissingInstrument() {
+	t := suite.Suite.T()
+
+	res := suite.put("/admin/fees/schedules", map[string]any{"maker_bps": 1, "taker_bps": 1})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func (suite *FeeControllerTestSuite) TestSetTier_AccountNotFound() {
+	t := suite.Suite.T()
+
+	res := suite.put("/admin/accounts/unknown-account/fee-tier", map[string]string{"tier": "vip"})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func (suite *FeeControllerTestSuite) TestGetTier_AccountNotFound() {
+	t := suite.Suite.T()
+
+	res, err := http.Get(suite.basePath + "/accounts/unknown-account/fee-tier")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(FeeControllerTestSuite))
+}
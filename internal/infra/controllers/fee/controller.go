@@ -0,0 +1,252 @@
+package fee
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	feeUsecases "github.com/juninhoitabh/clob-go/internal/application/fee/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	domainTrade "github.com/juninhoitabh/clob-go/internal/domain/trade"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	ratesDto struct {
+		MakerBps int64 `json:"maker_bps" example:"-2"`
+		TakerBps int64 `json:"taker_bps" example:"10"`
+	}
+	setScheduleInputDto struct {
+		Tiers    map[string]ratesDto `json:"tiers"`
+		MakerBps int64               `json:"maker_bps" example:"5"`
+		TakerBps int64               `json:"taker_bps" example:"10" validate:"gte=0"`
+	}
+	scheduleOutputDto struct {
+		Tiers      map[string]ratesDto `json:"tiers"`
+		Instrument string              `json:"instrument" example:"BTC/USDT"`
+		UpdatedAt  string              `json:"updated_at,omitempty" example:"2025-01-01T00:00:00Z"`
+		MakerBps   int64               `json:"maker_bps" example:"5"`
+		TakerBps   int64               `json:"taker_bps" example:"10"`
+	}
+	setTierInputDto struct {
+		Tier string `json:"tier" example:"vip"`
+	}
+	tierOutputDto struct {
+		AccountID string `json:"account_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		Tier      string `json:"tier" example:"vip"`
+		Volume    int64  `json:"volume_30d" example:"1500000"`
+		Pinned    bool   `json:"pinned" example:"false"`
+	}
+	FeeController struct {
+		scheduleRepo domainFee.IScheduleRepository
+		accountRepo  domainAccount.IAccountRepository
+		tradeRepo    domainTrade.ITradeRepository
+		defaultRates domainFee.Rates
+		volumeTiers  domainFee.VolumeTiers
+	}
+)
+
+// SetSchedule godoc
+// @Summary      Set Fee Schedule
+// @Description  Set the maker/taker fee schedule of an instrument, a negative maker_bps is a rebate
+// @Tags         Fees
+// @Accept       json
+// @Produce      json
+// @Param        instrument query     string               true  "instrument" example:"BTC/USDT"
+// @Param        request    body      setScheduleInputDto  true  "setScheduleInputDto request"
+// @Success      200       {object}  scheduleOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /admin/fees/schedules [put]
+func (f *FeeController) SetSchedule(w http.ResponseWriter, req *http.Request) {
+	inst := strings.ToUpper(req.URL.Query().Get("instrument"))
+	if inst == "" {
+		shared.BadRequestError(w, "instrument required")
+
+		return
+	}
+
+	var body setScheduleInputDto
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		shared.BadRequestError(w, "Invalid JSON", err.Error())
+
+		return
+	}
+
+	tiers := make(map[string]domainFee.Rates, len(body.Tiers))
+	for tier, rates := range body.Tiers {
+		tiers[tier] = domainFee.Rates{MakerBps: rates.MakerBps, TakerBps: rates.TakerBps}
+	}
+
+	setFeeScheduleUseCase := feeUsecases.NewSetFeeScheduleUseCase(f.scheduleRepo)
+
+	output, err := setFeeScheduleUseCase.Execute(feeUsecases.SetFeeScheduleInput{
+		Instrument: inst,
+		MakerBps:   body.MakerBps,
+		TakerBps:   body.TakerBps,
+		Tiers:      tiers,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newScheduleOutputDto(output))
+}
+
+// GetSchedule godoc
+// @Summary      Get Fee Schedule
+// @Description  Get the maker/taker fee schedule of an instrument
+// @Tags         Fees
+// @Accept       json
+// @Produce      json
+// @Param        instrument query     string true "instrument" example:"BTC/USDT"
+// @Success      200       {object}  scheduleOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /fees/schedules [get]
+func (f *FeeController) GetSchedule(w http.ResponseWriter, req *http.Request) {
+	inst := strings.ToUpper(req.URL.Query().Get("instrument"))
+	if inst == "" {
+		shared.BadRequestError(w, "instrument required")
+
+		return
+	}
+
+	getFeeScheduleUseCase := feeUsecases.NewGetFeeScheduleUseCase(f.scheduleRepo, f.defaultRates)
+
+	output, err := getFeeScheduleUseCase.Execute(feeUsecases.GetFeeScheduleInput{
+		Instrument: inst,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newScheduleOutputDto(output))
+}
+
+// SetTier godoc
+// @Summary      Set Account Fee Tier
+// @Description  Pin the fee tier of an account, overriding the volume-based tier; an empty tier unpins it
+// @Tags         Fees
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string           true  "account_id" Format(uuid)
+// @Param        request   body      setTierInputDto  true  "setTierInputDto request"
+// @Success      200       {object}  tierOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /admin/accounts/{id}/fee-tier [put]
+func (f *FeeController) SetTier(w http.ResponseWriter, req *http.Request) {
+	id := req.PathValue("id")
+	if id == "" {
+		shared.BadRequestError(w, "missing account_id")
+
+		return
+	}
+
+	var body setTierInputDto
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		shared.BadRequestError(w, "Invalid JSON", err.Error())
+
+		return
+	}
+
+	setAccountFeeTierUseCase := feeUsecases.NewSetAccountFeeTierUseCase(f.accountRepo)
+
+	output, err := setAccountFeeTierUseCase.Execute(feeUsecases.SetAccountFeeTierInput{
+		AccountID: id,
+		Tier:      body.Tier,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newTierOutputDto(output))
+}
+
+// GetTier godoc
+// @Summary      Get Account Fee Tier
+// @Description  Get the current fee tier of an account and its traded notional over the rolling 30-day window
+// @Tags         Fees
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string  true  "account_id" Format(uuid)
+// @Success      200       {object}  tierOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/fee-tier [get]
+func (f *FeeController) GetTier(w http.ResponseWriter, req *http.Request) {
+	id := req.PathValue("id")
+	if id == "" {
+		shared.BadRequestError(w, "missing account_id")
+
+		return
+	}
+
+	getAccountFeeTierUseCase := feeUsecases.NewGetAccountFeeTierUseCase(f.accountRepo, f.tradeRepo, f.volumeTiers)
+
+	output, err := getAccountFeeTierUseCase.Execute(feeUsecases.GetAccountFeeTierInput{
+		AccountID: id,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newTierOutputDto(output))
+}
+
+func newTierOutputDto(output *feeUsecases.AccountFeeTierOutput) tierOutputDto {
+	return tierOutputDto{
+		AccountID: output.AccountID,
+		Tier:      output.Tier,
+		Volume:    output.Volume,
+		Pinned:    output.Pinned,
+	}
+}
+
+func newScheduleOutputDto(output *feeUsecases.FeeScheduleOutput) scheduleOutputDto {
+	dto := scheduleOutputDto{
+		Tiers:      make(map[string]ratesDto, len(output.Tiers)),
+		Instrument: output.Instrument,
+		MakerBps:   output.MakerBps,
+		TakerBps:   output.TakerBps,
+	}
+
+	for tier, rates := range output.Tiers {
+		dto.Tiers[tier] = ratesDto{MakerBps: rates.MakerBps, TakerBps: rates.TakerBps}
+	}
+
+	if !output.UpdatedAt.IsZero() {
+		dto.UpdatedAt = output.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	return dto
+}
+
+func NewFeeController(
+	scheduleRepo domainFee.IScheduleRepository,
+	accountRepo domainAccount.IAccountRepository,
+	tradeRepo domainTrade.ITradeRepository,
+	defaultRates domainFee.Rates,
+	volumeTiers domainFee.VolumeTiers,
+) *FeeController {
+	return &FeeController{
+		scheduleRepo: scheduleRepo,
+		accountRepo:  accountRepo,
+		tradeRepo:    tradeRepo,
+		defaultRates: defaultRates,
+		volumeTiers:  volumeTiers,
+	}
+}
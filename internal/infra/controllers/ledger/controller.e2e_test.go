@@ -0,0 +1,148 @@
+package ledger_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	httpServer "github.com/juninhoitabh/clob-go/internal/infra/http-server"
+)
+
+type (
+	ledgerAccountDtoTest struct {
+		Owner  string `json:"owner"`
+		Bucket string `json:"bucket"`
+	}
+	postingOutputDtoTest struct {
+		Debit     ledgerAccountDtoTest `json:"debit"`
+		Credit    ledgerAccountDtoTest `json:"credit"`
+		Asset     string               `json:"asset"`
+		Operation string               `json:"operation"`
+		RefType   string               `json:"ref_type"`
+		RefID     string               `json:"ref_id"`
+		Amount    int64                `json:"amount"`
+	}
+	listOutputDtoTest struct {
+		Postings []postingOutputDtoTest `json:"postings"`
+		Total    int                    `json:"total"`
+		Limit    int                    `json:"limit"`
+		Offset   int                    `json:"offset"`
+	}
+	LedgerControllerTestSuite struct {
+		suite.Suite
+		e2eTestHandle *httpServer.E2eTestHandle
+		basePath      string
+	}
+)
+
+func (suite *LedgerControllerTestSuite) SetupTest() {
+	suite.e2eTestHandle = httpServer.NewE2eTestHandle()
+	suite.basePath = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1"
+}
+
+func (suite *LedgerControllerTestSuite) TearDownSuite() {
+	suite.e2eTestHandle.HttpServerTest.Close()
+}
+
+func (suite *LedgerControllerTestSuite) post(path string, body any) *http.Response {
+	t := suite.Suite.T()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	res, err := http.Post(suite.basePath+path, "application/json", bytes.NewReader(payload))
+	require.NoError(t, err)
+
+	return res
+}
+
+func (suite *LedgerControllerTestSuite) list(path string) (int, listOutputDtoTest) {
+	t := suite.Suite.T()
+
+	res, err := http.Get(suite.basePath + path)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	var out listOutputDtoTest
+	if res.StatusCode == http.StatusOK {
+		err = json.NewDecoder(res.Body).Decode(&out)
+		require.NoError(t, err)
+	}
+
+	return res.StatusCode, out
+}
+
+func (suite *LedgerControllerTestSuite) TestList_RecordsOrderPostings() {
+	t := suite.Suite.T()
+
+	res := suite.post("/accounts", map[string]string{"account_name": "ledger-user"})
+	defer res.Body.Close()
+
+	var created map[string]string
+	err := json.NewDecoder(res.Body).Decode(&created)
+	require.NoError(t, err)
+
+	accountID := created["account_id"]
+
+	creditRes := suite.post("/admin/accounts/"+accountID+"/credit", map[string]any{"asset": "XLM", "amount": 500})
+	defer creditRes.Body.Close()
+	require.Equal(t, http.StatusOK, creditRes.StatusCode)
+
+	orderRes := suite.post("/orders", map[string]any{
+		"account_id": accountID,
+		"instrument": "XLM/EUR",
+		"side":       "sell",
+		"price":      3,
+		"qty":        200,
+	})
+	defer orderRes.Body.Close()
+	require.Equal(t, http.StatusCreated, orderRes.StatusCode)
+
+	status, out := suite.list("/accounts/" + accountID + "/ledger")
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, 2, out.Total)
+	assert.Equal(t, 100, out.Limit)
+
+	deposit := out.Postings[0]
+	assert.Equal(t, "credit", deposit.Operation)
+	assert.Equal(t, "external", deposit.Debit.Bucket)
+	assert.Equal(t, ledgerAccountDtoTest{Owner: accountID, Bucket: "available"}, deposit.Credit)
+	assert.Equal(t, int64(500), deposit.Amount)
+
+	reserve := out.Postings[1]
+	assert.Equal(t, "reserve", reserve.Operation)
+	assert.Equal(t, "XLM", reserve.Asset)
+	assert.Equal(t, int64(200), reserve.Amount)
+	assert.Equal(t, "order", reserve.RefType)
+	assert.NotEmpty(t, reserve.RefID)
+	assert.Equal(t, ledgerAccountDtoTest{Owner: accountID, Bucket: "reserved"}, reserve.Credit)
+
+	status, page := suite.list("/accounts/" + accountID + "/ledger?limit=1&offset=1")
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, 2, page.Total)
+	require.Len(t, page.Postings, 1)
+	assert.Equal(t, "reserve", page.Postings[0].Operation)
+}
+
+func (suite *LedgerControllerTestSuite) TestList_InvalidLimit() {
+	t := suite.Suite.T()
+
+	status, _ := suite.list("/accounts/any/ledger?limit=abc")
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func (suite *LedgerControllerTestSuite) TestList_AccountNotFound() {
+	t := suite.Suite.T()
+
+	status, _ := suite.list("/accounts/unknown-account/ledger")
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(LedgerControllerTestSuite))
+}
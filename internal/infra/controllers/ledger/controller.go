@@ -0,0 +1,130 @@
+package ledger
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	ledgerUsecases "github.com/juninhoitabh/clob-go/internal/application/ledger/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainLedger "github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	ledgerAccountDto struct {
+		Owner  string `json:"owner,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+		Bucket string `json:"bucket" example:"available"`
+	}
+	postingOutputDto struct {
+		Debit     ledgerAccountDto `json:"debit"`
+		Credit    ledgerAccountDto `json:"credit"`
+		PostingID string           `json:"posting_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		Asset     string           `json:"asset" example:"USDT"`
+		Operation string           `json:"operation" example:"reserve"`
+		RefType   string           `json:"ref_type,omitempty" example:"order"`
+		RefID     string           `json:"ref_id,omitempty" example:"7"`
+		CreatedAt string           `json:"created_at" example:"2025-01-01T00:00:00Z"`
+		Amount    int64            `json:"amount" example:"1000"`
+		Seq       int64            `json:"seq" example:"1"`
+	}
+	listOutputDto struct {
+		Postings []postingOutputDto `json:"postings"`
+		Total    int                `json:"total" example:"250"`
+		Limit    int                `json:"limit" example:"100"`
+		Offset   int                `json:"offset" example:"0"`
+	}
+	LedgerController struct {
+		accountRepo domainAccount.IAccountRepository
+		ledgerRepo  domainLedger.ILedgerRepository
+	}
+)
+
+// List godoc
+// @Summary      Account Ledger
+// @Description  Immutable double-entry postings behind the account balances, oldest first
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string  true   "account_id" Format(uuid)
+// @Param        asset     query     string  false  "asset" example:"USDT"
+// @Param        limit     query     int     false  "limit" example:"100"
+// @Param        offset    query     int     false  "offset" example:"0"
+// @Success      200       {object}  listOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/ledger [get]
+func (l *LedgerController) List(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+
+	limit, err := intQuery(query.Get("limit"))
+	if err != nil {
+		shared.BadRequestError(w, "invalid limit", err.Error())
+
+		return
+	}
+
+	offset, err := intQuery(query.Get("offset"))
+	if err != nil {
+		shared.BadRequestError(w, "invalid offset", err.Error())
+
+		return
+	}
+
+	listLedgerUseCase := ledgerUsecases.NewListLedgerUseCase(l.accountRepo, l.ledgerRepo)
+
+	output, err := listLedgerUseCase.Execute(ledgerUsecases.ListLedgerInput{
+		AccountID: req.PathValue("id"),
+		Asset:     query.Get("asset"),
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	listOutputDtoResponse := listOutputDto{
+		Postings: make([]postingOutputDto, 0, len(output.Postings)),
+		Total:    output.Total,
+		Limit:    output.Limit,
+		Offset:   output.Offset,
+	}
+
+	for _, p := range output.Postings {
+		listOutputDtoResponse.Postings = append(listOutputDtoResponse.Postings, postingOutputDto{
+			Debit:     ledgerAccountDto{Owner: p.Debit.Owner, Bucket: string(p.Debit.Bucket)},
+			Credit:    ledgerAccountDto{Owner: p.Credit.Owner, Bucket: string(p.Credit.Bucket)},
+			PostingID: p.ID,
+			Asset:     p.Asset,
+			Operation: string(p.Operation),
+			RefType:   string(p.Ref.Type),
+			RefID:     p.Ref.ID,
+			CreatedAt: p.CreatedAt.UTC().Format(time.RFC3339Nano),
+			Amount:    p.Amount,
+			Seq:       p.Seq,
+		})
+	}
+
+	shared.WriteJSON(w, http.StatusOK, listOutputDtoResponse)
+}
+
+func intQuery(raw string) (int, error) {
+	if raw == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(raw)
+}
+
+func NewLedgerController(
+	accountRepo domainAccount.IAccountRepository,
+	ledgerRepo domainLedger.ILedgerRepository,
+) *LedgerController {
+	return &LedgerController{
+		accountRepo: accountRepo,
+		ledgerRepo:  ledgerRepo,
+	}
+}
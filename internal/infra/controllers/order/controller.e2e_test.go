@@ -16,11 +16,12 @@ import (
 
 type (
 	placeInputDtoTest struct {
-		AccountID  string `json:"account_id"`
-		Instrument string `json:"instrument"`
-		Side       string `json:"side"`
-		Price      int64  `json:"price"`
-		Qty        int64  `json:"qty"`
+		AccountID     string `json:"account_id"`
+		ClientOrderID string `json:"client_order_id,omitempty"`
+		Instrument    string `json:"instrument"`
+		Side          string `json:"side"`
+		Price         int64  `json:"price"`
+		Qty           int64  `json:"qty"`
 	}
 	placeTradeOutputDtoTest struct {
 		TakerOrderID string `json:"taker_order_id"`
@@ -34,13 +35,31 @@ type (
 		Trades []placeTradeOutputDtoTest `json:"trades"`
 	}
 	placeOutputDtoTest struct {
-		Order  map[string]any                `json:"order"`
-		Report placeTradeReportOutputDtoTest `json:"report"`
+		Order       map[string]any                `json:"order"`
+		Report      placeTradeReportOutputDtoTest `json:"report"`
+		CanceledQty int64                         `json:"canceled_qty"`
+		Halted      bool                          `json:"halted"`
 	}
 	cancelOutputDtoTest struct {
 		Order  map[string]any `json:"order"`
 		Status string         `json:"status"`
 	}
+	batchItemErrorDtoTest struct {
+		Message string `json:"message"`
+		Code    string `json:"code"`
+	}
+	batchPlaceItemOutputDtoTest struct {
+		Result *placeOutputDtoTest    `json:"result"`
+		Error  *batchItemErrorDtoTest `json:"error"`
+		Index  int                    `json:"index"`
+		Status int                    `json:"status"`
+	}
+	batchCancelItemOutputDtoTest struct {
+		Result *cancelOutputDtoTest   `json:"result"`
+		Error  *batchItemErrorDtoTest `json:"error"`
+		Index  int                    `json:"index"`
+		Status int                    `json:"status"`
+	}
 	OrderControllerTestSuite struct {
 		suite.Suite
 		e2eTestHandle *httpServer.E2eTestHandle
@@ -82,7 +101,7 @@ func (suite *OrderControllerTestSuite) setupAccount(name string, asset string, a
 	creditBody, err := json.Marshal(creditInput)
 	require.NoError(t, err)
 
-	creditURL := suite.accountsPath + "/" + accountID + "/credit"
+	creditURL := suite.e2eTestHandle.HttpServerTest.URL + "/api/v1/admin/accounts/" + accountID + "/credit"
 	creditRes, err := http.Post(creditURL, "application/json", bytes.NewReader(creditBody))
 	require.NoError(t, err)
 
@@ -299,6 +318,353 @@ func (suite *OrderControllerTestSuite) TestCancel_MissingOrderID() {
 		"Status deve ser 301 ou 400, recebeu %d", cancelRes.StatusCode)
 }
 
+func (suite *OrderControllerTestSuite) TestPlace_HaltCancelsRemaining() {
+	t := suite.Suite.T()
+
+	makerID := suite.setupAccount("halt-maker", "NEAR", 2)
+	takerID := suite.setupAccount("halt-taker", "USDT", 1_000)
+
+	status, _ := suite.place(placeInputDtoTest{AccountID: makerID, Instrument: "NEAR/USDT", Side: "sell", Price: 100, Qty: 1})
+	require.Equal(t, http.StatusCreated, status)
+
+	status, _ = suite.place(placeInputDtoTest{AccountID: makerID, Instrument: "NEAR/USDT", Side: "sell", Price: 200, Qty: 1})
+	require.Equal(t, http.StatusCreated, status)
+
+	configureBody, err := json.Marshal(map[string]int64{"move_bps": 1000, "window_seconds": 60, "halt_seconds": 60})
+	require.NoError(t, err)
+
+	configureReq, err := http.NewRequest(http.MethodPut, suite.e2eTestHandle.HttpServerTest.URL+"/api/v1/admin/books/circuit-breaker?instrument=NEAR/USDT", bytes.NewReader(configureBody))
+	require.NoError(t, err)
+
+	configureRes, err := http.DefaultClient.Do(configureReq)
+	require.NoError(t, err)
+	configureRes.Body.Close()
+	require.Equal(t, http.StatusOK, configureRes.StatusCode)
+
+	status, out := suite.place(placeInputDtoTest{AccountID: takerID, Instrument: "NEAR/USDT", Side: "buy", Price: 200, Qty: 2})
+	require.Equal(t, http.StatusCreated, status)
+
+	assert.True(t, out.Halted)
+	assert.Equal(t, int64(1), out.CanceledQty)
+	assert.Len(t, out.Report.Trades, 1)
+	assert.Equal(t, float64(0), out.Order["remaining"])
+	assert.Equal(t, float64(900), suite.availableBalance(takerID, "USDT"))
+
+	status, out = suite.place(placeInputDtoTest{AccountID: takerID, Instrument: "BTC/USDT", Side: "buy", Price: 1, Qty: 1})
+	require.Equal(t, http.StatusCreated, status)
+	assert.False(t, out.Halted)
+	assert.Zero(t, out.CanceledQty)
+}
+
+func (suite *OrderControllerTestSuite) place(input placeInputDtoTest) (int, placeOutputDtoTest) {
+	t := suite.Suite.T()
+
+	body, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	res, err := http.Post(suite.basePath, "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	var out placeOutputDtoTest
+	_ = json.NewDecoder(res.Body).Decode(&out)
+
+	return res.StatusCode, out
+}
+
+func (suite *OrderControllerTestSuite) availableBalance(accountID, asset string) float64 {
+	t := suite.Suite.T()
+
+	res, err := http.Get(suite.accountsPath + "/" + accountID)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	var out struct {
+		Balances map[string]map[string]float64 `json:"balances"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+
+	return out.Balances[asset]["available"]
+}
+
+func (suite *OrderControllerTestSuite) TestPlace_ClientOrderIDIsIdempotent() {
+	t := suite.Suite.T()
+
+	makerID := suite.setupAccount("client-order-maker", "ETH", 10)
+	takerID := suite.setupAccount("client-order-taker", "USDT", 100000)
+
+	status, _ := suite.place(placeInputDtoTest{AccountID: makerID, Instrument: "ETH/USDT", Side: "sell", Price: 2000, Qty: 1})
+	require.Equal(t, http.StatusCreated, status)
+
+	input := placeInputDtoTest{
+		AccountID:     takerID,
+		ClientOrderID: "retry-me",
+		Instrument:    "ETH/USDT",
+		Side:          "buy",
+		Price:         2000,
+		Qty:           2,
+	}
+
+	status, first := suite.place(input)
+	require.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "retry-me", first.Order["client_order_id"])
+	require.Len(t, first.Report.Trades, 1)
+
+	availableAfterFirst := suite.availableBalance(takerID, "USDT")
+
+	status, replay := suite.place(input)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, first.Order["id"], replay.Order["id"])
+	assert.Equal(t, first.Report.Trades, replay.Report.Trades)
+	assert.Equal(t, availableAfterFirst, suite.availableBalance(takerID, "USDT"))
+
+	input.Price = 2001
+
+	status, _ = suite.place(input)
+	assert.Equal(t, http.StatusConflict, status)
+}
+
+func (suite *OrderControllerTestSuite) TestPlace_ClientOrderIDTooLong() {
+	t := suite.Suite.T()
+
+	accountID := suite.setupAccount("client-order-long", "USDT", 100000)
+
+	status, _ := suite.place(placeInputDtoTest{
+		AccountID:     accountID,
+		ClientOrderID: string(bytes.Repeat([]byte("x"), 65)),
+		Instrument:    "BTC/USDT",
+		Side:          "buy",
+		Price:         100,
+		Qty:           1,
+	})
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func (suite *OrderControllerTestSuite) TestGetAndCancel_ByClientOrderID() {
+	t := suite.Suite.T()
+
+	accountID := suite.setupAccount("client-order-cancel", "USDT", 100000)
+
+	status, placed := suite.place(placeInputDtoTest{
+		AccountID:     accountID,
+		ClientOrderID: "cancel-me",
+		Instrument:    "SOL/USDT",
+		Side:          "buy",
+		Price:         100,
+		Qty:           3,
+	})
+	require.Equal(t, http.StatusCreated, status)
+
+	clientOrderURL := suite.accountsPath + "/" + accountID + "/client-orders/cancel-me"
+
+	getRes, err := http.Get(clientOrderURL)
+	require.NoError(t, err)
+	defer getRes.Body.Close()
+	require.Equal(t, http.StatusOK, getRes.StatusCode)
+
+	var got struct {
+		Order map[string]any `json:"order"`
+	}
+	err = json.NewDecoder(getRes.Body).Decode(&got)
+	require.NoError(t, err)
+	assert.Equal(t, placed.Order["id"], got.Order["id"])
+
+	byIDRes, err := http.Get(suite.basePath + "/" + placed.Order["id"].(string))
+	require.NoError(t, err)
+	defer byIDRes.Body.Close()
+	assert.Equal(t, http.StatusOK, byIDRes.StatusCode)
+
+	cancelRes, err := http.Post(clientOrderURL+"/cancel", "application/json", nil)
+	require.NoError(t, err)
+	defer cancelRes.Body.Close()
+	require.Equal(t, http.StatusOK, cancelRes.StatusCode)
+
+	var cancelOut cancelOutputDtoTest
+	err = json.NewDecoder(cancelRes.Body).Decode(&cancelOut)
+	require.NoError(t, err)
+	assert.Equal(t, "canceled", cancelOut.Status)
+	assert.Equal(t, placed.Order["id"], cancelOut.Order["id"])
+	assert.Equal(t, float64(100000), suite.availableBalance(accountID, "USDT"))
+
+	missingRes, err := http.Get(suite.accountsPath + "/" + accountID + "/client-orders/unknown")
+	require.NoError(t, err)
+	defer missingRes.Body.Close()
+	assert.Equal(t, http.StatusNotFound, missingRes.StatusCode)
+}
+
+func (suite *OrderControllerTestSuite) postBatch(path string, orders any, out any) int {
+	t := suite.Suite.T()
+
+	body, err := json.Marshal(map[string]any{"orders": orders})
+	require.NoError(t, err)
+
+	res, err := http.Post(suite.basePath+path, "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	_ = json.NewDecoder(res.Body).Decode(out)
+
+	return res.StatusCode
+}
+
+func (suite *OrderControllerTestSuite) TestPlaceBatch_PerItemResults() {
+	t := suite.Suite.T()
+
+	makerID := suite.setupAccount("batch-maker", "ADA", 10)
+	takerID := suite.setupAccount("batch-taker", "USDT", 1000)
+
+	var out struct {
+		Results []batchPlaceItemOutputDtoTest `json:"results"`
+	}
+
+	status := suite.postBatch("/batch", []placeInputDtoTest{
+		{AccountID: makerID, Instrument: "ADA/USDT", Side: "sell", Price: 50, Qty: 4},
+		{AccountID: takerID, Instrument: "ADA/USDT", Side: "buy", Price: 50, Qty: 3},
+		{AccountID: takerID, Instrument: "ADA/USDT", Side: "hold", Price: 50, Qty: 1},
+		{AccountID: takerID, Instrument: "ADA/USDT", Side: "buy", Price: 1000, Qty: 1000},
+	}, &out)
+	require.Equal(t, http.StatusOK, status)
+	require.Len(t, out.Results, 4)
+
+	assert.Equal(t, http.StatusCreated, out.Results[0].Status)
+	require.NotNil(t, out.Results[0].Result)
+
+	assert.Equal(t, http.StatusCreated, out.Results[1].Status)
+	require.NotNil(t, out.Results[1].Result)
+	require.Len(t, out.Results[1].Result.Report.Trades, 1)
+	assert.Equal(t, out.Results[0].Result.Order["id"], out.Results[1].Result.Report.Trades[0].MakerOrderID)
+
+	assert.Equal(t, 2, out.Results[2].Index)
+	assert.Equal(t, http.StatusBadRequest, out.Results[2].Status)
+	require.NotNil(t, out.Results[2].Error)
+	assert.Nil(t, out.Results[2].Result)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, out.Results[3].Status)
+	require.NotNil(t, out.Results[3].Error)
+	assert.NotEmpty(t, out.Results[3].Error.Code)
+
+	assert.Equal(t, float64(850), suite.availableBalance(takerID, "USDT"))
+}
+
+func (suite *OrderControllerTestSuite) TestPlaceBatch_InvalidSize() {
+	t := suite.Suite.T()
+
+	var out map[string]any
+
+	status := suite.postBatch("/batch", []placeInputDtoTest{}, &out)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	orders := make([]placeInputDtoTest, 51)
+	for i := range orders {
+		orders[i] = placeInputDtoTest{AccountID: "acc", Instrument: "BTC/USDT", Side: "buy", Price: 1, Qty: 1}
+	}
+
+	status = suite.postBatch("/batch", orders, &out)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func (suite *OrderControllerTestSuite) TestCancelBatch_PerItemResults() {
+	t := suite.Suite.T()
+
+	accountID := suite.setupAccount("batch-cancel", "USDT", 1000)
+
+	status, first := suite.place(placeInputDtoTest{AccountID: accountID, Instrument: "DOT/USDT", Side: "buy", Price: 10, Qty: 5})
+	require.Equal(t, http.StatusCreated, status)
+
+	status, _ = suite.place(placeInputDtoTest{
+		AccountID:     accountID,
+		ClientOrderID: "batch-cancel-2",
+		Instrument:    "DOT/USDT",
+		Side:          "buy",
+		Price:         9,
+		Qty:           5,
+	})
+	require.Equal(t, http.StatusCreated, status)
+
+	var out struct {
+		Results []batchCancelItemOutputDtoTest `json:"results"`
+	}
+
+	status = suite.postBatch("/cancel-batch", []map[string]string{
+		{"order_id": first.Order["id"].(string)},
+		{"account_id": accountID, "client_order_id": "batch-cancel-2"},
+		{"order_id": "unknown-order"},
+		{"order_id": first.Order["id"].(string)},
+		{},
+	}, &out)
+	require.Equal(t, http.StatusOK, status)
+	require.Len(t, out.Results, 5)
+
+	assert.Equal(t, http.StatusOK, out.Results[0].Status)
+	require.NotNil(t, out.Results[0].Result)
+	assert.Equal(t, "canceled", out.Results[0].Result.Status)
+
+	assert.Equal(t, http.StatusOK, out.Results[1].Status)
+	require.NotNil(t, out.Results[1].Result)
+	assert.Equal(t, "batch-cancel-2", out.Results[1].Result.Order["client_order_id"])
+
+	assert.Equal(t, http.StatusNotFound, out.Results[2].Status)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, out.Results[3].Status)
+	require.NotNil(t, out.Results[3].Error)
+	assert.Equal(t, "ORDER_NOT_OPEN", out.Results[3].Error.Code)
+
+	assert.Equal(t, http.StatusBadRequest, out.Results[4].Status)
+
+	assert.Equal(t, float64(1000), suite.availableBalance(accountID, "USDT"))
+}
+
+func (suite *OrderControllerTestSuite) massCancel(accountID, query string) (int, map[string]any) {
+	t := suite.Suite.T()
+
+	res, err := http.Post(suite.accountsPath+"/"+accountID+"/orders/cancel-all"+query, "application/json", nil)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	var out map[string]any
+	_ = json.NewDecoder(res.Body).Decode(&out)
+
+	return res.StatusCode, out
+}
+
+func (suite *OrderControllerTestSuite) TestMassCancel_FiltersAndReleasesReservations() {
+	t := suite.Suite.T()
+
+	accountID := suite.setupAccount("mass-cancel", "USDT", 1000)
+
+	for _, input := range []placeInputDtoTest{
+		{AccountID: accountID, Instrument: "LINK/USDT", Side: "buy", Price: 10, Qty: 5},
+		{AccountID: accountID, Instrument: "LINK/USDT", Side: "buy", Price: 9, Qty: 5},
+		{AccountID: accountID, Instrument: "AVAX/USDT", Side: "buy", Price: 20, Qty: 5},
+	} {
+		status, _ := suite.place(input)
+		require.Equal(t, http.StatusCreated, status)
+	}
+
+	require.Equal(t, float64(1000-50-45-100), suite.availableBalance(accountID, "USDT"))
+
+	status, out := suite.massCancel(accountID, "?instrument=link/usdt&side=buy")
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, float64(2), out["canceled"])
+	assert.Equal(t, float64(1000-100), suite.availableBalance(accountID, "USDT"))
+
+	status, out = suite.massCancel(accountID, "?side=sell")
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, float64(0), out["canceled"])
+
+	status, out = suite.massCancel(accountID, "")
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, float64(1), out["canceled"])
+	assert.Equal(t, float64(1000), suite.availableBalance(accountID, "USDT"))
+
+	status, _ = suite.massCancel(accountID, "?side=hold")
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	status, _ = suite.massCancel("unknown-account", "")
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, new(OrderControllerTestSuite))
 }
@@ -2,6 +2,7 @@ package order
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -14,48 +15,102 @@ import (
 
 type (
 	placeInputDto struct {
-		AccountID  string `json:"account_id" example:"123e4567-e89b-12d3-a456-426614174000" validate:"required"`
-		Instrument string `json:"instrument" example:"BTC-USD" validate:"required"`
-		Side       string `json:"side" example:"buy" validate:"required,oneof=buy sell"`
-		Price      int64  `json:"price" example:"50000" validate:"required,gte=1"`
-		Qty        int64  `json:"qty" example:"1" validate:"required,gte=1"`
+		AccountID     string `json:"account_id" example:"123e4567-e89b-12d3-a456-426614174000" validate:"required"`
+		ClientOrderID string `json:"client_order_id,omitempty" example:"my-order-1" validate:"max=64"`
+		Instrument    string `json:"instrument" example:"BTC-USD" validate:"required"`
+		Side          string `json:"side" example:"buy" validate:"required,oneof=buy sell"`
+		Price         int64  `json:"price" example:"50000" validate:"required,gte=1"`
+		Qty           int64  `json:"qty" example:"1" validate:"required,gte=1"`
 	}
 	placeTradeOutputDto struct {
-		TakerOrderID string `json:"taker_order_id" example:"123e4567-e89b-12d3-a456-426614174000"`
-		MakerOrderID string `json:"maker_order_id" example:"123e4567-e89b-12d3-a456-426614174000"`
-		BuyerID      string `json:"buyer_id" example:"123e4567-e89b-12d3-a456-426614174000"`
-		SellerID     string `json:"seller_id" example:"123e4567-e89b-12d3-a456-426614174000"`
-		Price        int64  `json:"price" example:"50000" validate:"required,gte=1"`
-		Qty          int64  `json:"qty" example:"1" validate:"required,gte=1"`
+		TradeID       string `json:"trade_id" example:"BTC/USDT-42"`
+		TakerOrderID  string `json:"taker_order_id" example:"7"`
+		MakerOrderID  string `json:"maker_order_id" example:"7"`
+		BuyerID       string `json:"buyer_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		SellerID      string `json:"seller_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		MakerFeeAsset string `json:"maker_fee_asset,omitempty" example:"USDT"`
+		TakerFeeAsset string `json:"taker_fee_asset,omitempty" example:"BTC"`
+		Price         int64  `json:"price" example:"50000" validate:"required,gte=1"`
+		Qty           int64  `json:"qty" example:"1" validate:"required,gte=1"`
+		MakerFee      int64  `json:"maker_fee" example:"-5"`
+		TakerFee      int64  `json:"taker_fee" example:"10"`
 	}
 	placeTradeReportOutputDto struct {
 		Trades []placeTradeOutputDto `json:"trades"`
 	}
+	// placeOutputDto is halted when the instrument halted during the match,
+	// which canceled the canceled_qty the order had left.
 	placeOutputDto struct {
-		Order  map[string]any            `json:"order"`
-		Report placeTradeReportOutputDto `json:"report"`
+		Order       map[string]any            `json:"order"`
+		Report      placeTradeReportOutputDto `json:"report"`
+		CanceledQty int64                     `json:"canceled_qty,omitempty" example:"1"`
+		Halted      bool                      `json:"halted,omitempty" example:"true"`
+	}
+	getOutputDto struct {
+		Order map[string]any `json:"order"`
 	}
 	cancelOutputDto struct {
 		Order  map[string]any `json:"order"`
 		Status string         `json:"status" example:"canceled"`
 	}
+	batchPlaceInputDto struct {
+		Orders []placeInputDto `json:"orders" validate:"required,min=1"`
+	}
+	batchPlaceItemOutputDto struct {
+		Result *placeOutputDto       `json:"result,omitempty"`
+		Error  *shared.ErrorResponse `json:"error,omitempty"`
+		Index  int                   `json:"index" example:"0"`
+		Status int                   `json:"status" example:"201"`
+	}
+	batchPlaceOutputDto struct {
+		Results []batchPlaceItemOutputDto `json:"results"`
+	}
+	batchCancelItemInputDto struct {
+		OrderID       string `json:"order_id,omitempty" example:"7"`
+		AccountID     string `json:"account_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+		ClientOrderID string `json:"client_order_id,omitempty" example:"my-order-1"`
+	}
+	batchCancelInputDto struct {
+		Orders []batchCancelItemInputDto `json:"orders" validate:"required,min=1"`
+	}
+	batchCancelItemOutputDto struct {
+		Result *cancelOutputDto      `json:"result,omitempty"`
+		Error  *shared.ErrorResponse `json:"error,omitempty"`
+		Index  int                   `json:"index" example:"0"`
+		Status int                   `json:"status" example:"200"`
+	}
+	batchCancelOutputDto struct {
+		Results []batchCancelItemOutputDto `json:"results"`
+	}
+	massCancelOutputDto struct {
+		Orders   []map[string]any `json:"orders"`
+		Canceled int              `json:"canceled" example:"3"`
+	}
 	OrderController struct {
-		bookRepo    domainBook.IBookRepository
-		orderRepo   domainOrder.IOrderRepository
-		accountRepo account.IAccountRepository
+		placeOrderUseCase *orderUsecases.PlaceOrderUseCase
+		bookRepo          domainBook.IBookRepository
+		orderRepo         domainOrder.IOrderRepository
+		accountRepo       account.IAccountRepository
+		batchMaxOrders    int
 	}
 )
 
 // Orders godoc
 // @Summary      Orders
-// @Description  Orders
+// @Description  Place an order. An optional client_order_id, unique per account, makes submission idempotent: resubmitting it returns the original order and its entry trades with 200
 // @Tags         Orders
 // @Accept       json
 // @Produce      json
 // @Param        request   body      placeInputDto  true  "placeInputDto request"
 // @Success      201       {object}  placeOutputDto
+// @Success      200       {object}  placeOutputDto "Replayed submission"
 // @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      401       {object}  shared.Errors "Unauthorized"
+// @Failure      403       {object}  shared.Errors "Account not owned by the caller"
 // @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      409       {object}  shared.Errors "Client order ID reused with different parameters"
+// @Failure      422       {object}  shared.Errors "Unprocessable Entity"
+// @Failure      429       {object}  shared.Errors "Too Many Requests"
 // @Failure      500       {object}  shared.Errors "Internal Server Error"
 // @Router       /orders   [post]
 func (o *OrderController) Place(w http.ResponseWriter, req *http.Request) {
@@ -66,46 +121,202 @@ func (o *OrderController) Place(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	if body.AccountID == "" || body.Instrument == "" || (body.Side != "buy" && body.Side != "sell") || body.Price <= 0 || body.Qty <= 0 {
-		shared.BadRequestError(w, "invalid fields")
+	if message := body.invalid(); message != "" {
+		shared.BadRequestError(w, message)
 
 		return
 	}
 
-	placeOrderInput := orderUsecases.PlaceOrderInput{
-		AccountID:  body.AccountID,
-		Instrument: strings.ToUpper(body.Instrument),
-		Side:       strings.ToLower(body.Side),
-		Price:      body.Price,
-		Qty:        body.Qty,
+	placeOrderOutput, err := o.placeOrderUseCase.Execute(body.toInput(shared.CallerFromContext(req.Context())))
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
 	}
 
-	placeOrderUseCase := orderUsecases.NewPlaceOrderUseCase(o.bookRepo, o.orderRepo, o.accountRepo)
+	shared.WriteJSON(w, placeStatus(placeOrderOutput), newPlaceOutputDto(placeOrderOutput))
+}
 
-	placeOrderOutput, err := placeOrderUseCase.Execute(placeOrderInput)
-	if err != nil {
-		shared.HandleError(w, err)
+// Orders Batch godoc
+// @Summary      Orders Batch
+// @Description  Place up to BATCH_MAX_ORDERS orders in one request. The orders run back to back on their books without other clients' orders in between; each one succeeds or fails on its own and is reported at its index with the status a single placement would return
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        request   body      batchPlaceInputDto  true  "batchPlaceInputDto request"
+// @Success      200       {object}  batchPlaceOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      401       {object}  shared.Errors "Unauthorized"
+// @Failure      429       {object}  shared.Errors "Too Many Requests"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /orders/batch [post]
+func (o *OrderController) PlaceBatch(w http.ResponseWriter, req *http.Request) {
+	var body batchPlaceInputDto
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		shared.BadRequestError(w, "Invalid JSON", err.Error())
 
 		return
 	}
 
+	if len(body.Orders) == 0 || len(body.Orders) > o.batchMaxOrders {
+		shared.BadRequestError(w, fmt.Sprintf("orders must contain between 1 and %d items", o.batchMaxOrders))
+
+		return
+	}
+
+	callerAccountID := shared.CallerFromContext(req.Context())
+	output := batchPlaceOutputDto{Results: make([]batchPlaceItemOutputDto, len(body.Orders))}
+	input := orderUsecases.BatchPlaceOrdersInput{}
+	indexes := make([]int, 0, len(body.Orders))
+
+	for i, item := range body.Orders {
+		if message := item.invalid(); message != "" {
+			errResp := shared.ErrorResponse{Status: http.StatusBadRequest, Message: message}
+			output.Results[i] = batchPlaceItemOutputDto{Index: i, Status: errResp.Status, Error: &errResp}
+
+			continue
+		}
+
+		input.Orders = append(input.Orders, item.toInput(callerAccountID))
+		indexes = append(indexes, i)
+	}
+
+	if len(input.Orders) > 0 {
+		batchOutput, err := orderUsecases.NewBatchPlaceOrdersUseCase(o.placeOrderUseCase, o.batchMaxOrders).Execute(input)
+		if err != nil {
+			shared.HandleError(w, err)
+
+			return
+		}
+
+		for j, result := range batchOutput.Results {
+			i := indexes[j]
+
+			if result.Err != nil {
+				errResp := shared.ErrorResponseFor(result.Err)
+				output.Results[i] = batchPlaceItemOutputDto{Index: i, Status: errResp.Status, Error: &errResp}
+
+				continue
+			}
+
+			placeOutput := newPlaceOutputDto(result.Output)
+			output.Results[i] = batchPlaceItemOutputDto{Index: i, Status: placeStatus(result.Output), Result: &placeOutput}
+		}
+	}
+
+	shared.WriteJSON(w, http.StatusOK, output)
+}
+
+func (body placeInputDto) invalid() string {
+	if body.AccountID == "" || body.Instrument == "" || (body.Side != "buy" && body.Side != "sell") || body.Price <= 0 || body.Qty <= 0 {
+		return "invalid fields"
+	}
+
+	if len(body.ClientOrderID) > domainOrder.MaxClientOrderIDLength {
+		return "client_order_id too long"
+	}
+
+	return ""
+}
+
+func (body placeInputDto) toInput(callerAccountID string) orderUsecases.PlaceOrderInput {
+	return orderUsecases.PlaceOrderInput{
+		AccountID:       body.AccountID,
+		ClientOrderID:   body.ClientOrderID,
+		CallerAccountID: callerAccountID,
+		Instrument:      strings.ToUpper(body.Instrument),
+		Side:            strings.ToLower(body.Side),
+		Price:           body.Price,
+		Qty:             body.Qty,
+	}
+}
+
+func newPlaceOutputDto(placeOrderOutput *orderUsecases.PlaceOrderOutput) placeOutputDto {
 	placeOutputDtoResponse := placeOutputDto{
-		Order:  placeOrderOutput.Order.Public(),
-		Report: placeTradeReportOutputDto{},
+		Order:       placeOrderOutput.Order.Public(),
+		Report:      placeTradeReportOutputDto{},
+		CanceledQty: placeOrderOutput.CanceledQty,
+		Halted:      placeOrderOutput.Halted,
 	}
 
 	for _, trade := range placeOrderOutput.TradeReport.Trades {
 		placeOutputDtoResponse.Report.Trades = append(placeOutputDtoResponse.Report.Trades, placeTradeOutputDto{
-			TakerOrderID: trade.TakerOrderID,
-			MakerOrderID: trade.MakerOrderID,
-			Price:        trade.Price,
-			Qty:          trade.Qty,
-			BuyerID:      trade.BuyerID,
-			SellerID:     trade.SellerID,
+			TradeID:       trade.ID(),
+			TakerOrderID:  trade.TakerOrderID,
+			MakerOrderID:  trade.MakerOrderID,
+			Price:         trade.Price,
+			Qty:           trade.Qty,
+			BuyerID:       trade.BuyerID,
+			SellerID:      trade.SellerID,
+			MakerFee:      trade.MakerFee,
+			MakerFeeAsset: trade.MakerFeeAsset,
+			TakerFee:      trade.TakerFee,
+			TakerFeeAsset: trade.TakerFeeAsset,
 		})
 	}
 
-	shared.WriteJSON(w, http.StatusCreated, placeOutputDtoResponse)
+	return placeOutputDtoResponse
+}
+
+func placeStatus(placeOrderOutput *orderUsecases.PlaceOrderOutput) int {
+	if placeOrderOutput.Replayed {
+		return http.StatusOK
+	}
+
+	return http.StatusCreated
+}
+
+// Orders Get godoc
+// @Summary      Orders Get
+// @Description  Look up an order by its exchange ID
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string        true  "order_id"
+// @Success      200       {object}  getOutputDto
+// @Failure      401       {object}  shared.Errors "Unauthorized"
+// @Failure      403       {object}  shared.Errors "Account not owned by the caller"
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /orders/{id} [get]
+func (o *OrderController) Get(w http.ResponseWriter, req *http.Request) {
+	o.get(w, req, orderUsecases.GetOrderInput{OrderID: req.PathValue("id")})
+}
+
+// Orders Get By Client Order ID godoc
+// @Summary      Orders Get By Client Order ID
+// @Description  Look up an account's order by the client_order_id it was submitted with
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        id               path      string        true  "account_id" Format(uuid)
+// @Param        client_order_id  path      string        true  "client_order_id"
+// @Success      200              {object}  getOutputDto
+// @Failure      401              {object}  shared.Errors "Unauthorized"
+// @Failure      403              {object}  shared.Errors "Account not owned by the caller"
+// @Failure      404              {object}  shared.Errors "Not Found"
+// @Failure      500              {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/client-orders/{client_order_id} [get]
+func (o *OrderController) GetByClientOrderID(w http.ResponseWriter, req *http.Request) {
+	o.get(w, req, orderUsecases.GetOrderInput{
+		AccountID:     req.PathValue("id"),
+		ClientOrderID: req.PathValue("client_order_id"),
+	})
+}
+
+func (o *OrderController) get(w http.ResponseWriter, req *http.Request, input orderUsecases.GetOrderInput) {
+	getOrderUseCase := orderUsecases.NewGetOrderUseCase(o.orderRepo, o.accountRepo)
+
+	input.CallerAccountID = shared.CallerFromContext(req.Context())
+
+	getOrderOutput, err := getOrderUseCase.Execute(input)
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, getOutputDto{Order: getOrderOutput.Order.Public()})
 }
 
 // Orders Cancel godoc
@@ -114,10 +325,13 @@ func (o *OrderController) Place(w http.ResponseWriter, req *http.Request) {
 // @Tags         Orders
 // @Accept       json
 // @Produce      json
-// @Param        id        path      string          true  "order_id" Format(uuid)
+// @Param        id        path      string          true  "order_id"
 // @Success      200       {object}  cancelOutputDto
 // @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      401       {object}  shared.Errors "Unauthorized"
+// @Failure      403       {object}  shared.Errors "Account not owned by the caller"
 // @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      429       {object}  shared.Errors "Too Many Requests"
 // @Failure      500       {object}  shared.Errors "Internal Server Error"
 // @Router       /orders/{id}/cancel [post]
 func (o *OrderController) Cancel(w http.ResponseWriter, req *http.Request) {
@@ -128,35 +342,181 @@ func (o *OrderController) Cancel(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	o.cancel(w, req, orderUsecases.CancelOrderInput{OrderID: oid})
+}
+
+// Orders Cancel By Client Order ID godoc
+// @Summary      Orders Cancel By Client Order ID
+// @Description  Cancel an account's order by the client_order_id it was submitted with
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        id               path      string          true  "account_id" Format(uuid)
+// @Param        client_order_id  path      string          true  "client_order_id"
+// @Success      200              {object}  cancelOutputDto
+// @Failure      401              {object}  shared.Errors "Unauthorized"
+// @Failure      403              {object}  shared.Errors "Account not owned by the caller"
+// @Failure      404              {object}  shared.Errors "Not Found"
+// @Failure      429              {object}  shared.Errors "Too Many Requests"
+// @Failure      500              {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/client-orders/{client_order_id}/cancel [post]
+func (o *OrderController) CancelByClientOrderID(w http.ResponseWriter, req *http.Request) {
+	o.cancel(w, req, orderUsecases.CancelOrderInput{
+		AccountID:     req.PathValue("id"),
+		ClientOrderID: req.PathValue("client_order_id"),
+	})
+}
+
+func (o *OrderController) cancel(w http.ResponseWriter, req *http.Request, input orderUsecases.CancelOrderInput) {
+	cancelOrderUseCase := orderUsecases.NewCancelOrderUseCase(o.bookRepo, o.orderRepo, o.accountRepo)
+
+	input.CallerAccountID = shared.CallerFromContext(req.Context())
+
+	cancelOrderOutput, err := cancelOrderUseCase.Execute(input)
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newCancelOutputDto(cancelOrderOutput))
+}
+
+// Orders Cancel Batch godoc
+// @Summary      Orders Cancel Batch
+// @Description  Cancel up to BATCH_MAX_ORDERS orders in one request, each identified by order_id or by account_id and client_order_id. The cancels run back to back on their books; each one is reported at its index with the status a single cancel would return
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        request   body      batchCancelInputDto  true  "batchCancelInputDto request"
+// @Success      200       {object}  batchCancelOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      401       {object}  shared.Errors "Unauthorized"
+// @Failure      429       {object}  shared.Errors "Too Many Requests"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /orders/cancel-batch [post]
+func (o *OrderController) CancelBatch(w http.ResponseWriter, req *http.Request) {
+	var body batchCancelInputDto
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		shared.BadRequestError(w, "Invalid JSON", err.Error())
+
+		return
+	}
+
+	if len(body.Orders) == 0 || len(body.Orders) > o.batchMaxOrders {
+		shared.BadRequestError(w, fmt.Sprintf("orders must contain between 1 and %d items", o.batchMaxOrders))
+
+		return
+	}
+
+	callerAccountID := shared.CallerFromContext(req.Context())
+	input := orderUsecases.BatchCancelOrdersInput{Orders: make([]orderUsecases.CancelOrderInput, len(body.Orders))}
+
+	for i, item := range body.Orders {
+		input.Orders[i] = orderUsecases.CancelOrderInput{
+			OrderID:         item.OrderID,
+			AccountID:       item.AccountID,
+			ClientOrderID:   item.ClientOrderID,
+			CallerAccountID: callerAccountID,
+		}
+	}
+
 	cancelOrderUseCase := orderUsecases.NewCancelOrderUseCase(o.bookRepo, o.orderRepo, o.accountRepo)
 
-	cancelOrderInput := orderUsecases.CancelOrderInput{
-		OrderID: oid,
+	batchOutput, err := orderUsecases.NewBatchCancelOrdersUseCase(cancelOrderUseCase, o.batchMaxOrders).Execute(input)
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	output := batchCancelOutputDto{Results: make([]batchCancelItemOutputDto, len(batchOutput.Results))}
+
+	for i, result := range batchOutput.Results {
+		if result.Err != nil {
+			errResp := shared.ErrorResponseFor(result.Err)
+			output.Results[i] = batchCancelItemOutputDto{Index: i, Status: errResp.Status, Error: &errResp}
+
+			continue
+		}
+
+		cancelOutput := newCancelOutputDto(result.Output)
+		output.Results[i] = batchCancelItemOutputDto{Index: i, Status: http.StatusOK, Result: &cancelOutput}
 	}
 
-	cancelOrderOutput, err := cancelOrderUseCase.Execute(cancelOrderInput)
+	shared.WriteJSON(w, http.StatusOK, output)
+}
+
+// Orders Mass Cancel godoc
+// @Summary      Orders Mass Cancel
+// @Description  Cancel every resting order of an account, optionally only those of one instrument and/or side, and release their reservations. Either all matching orders are cancelled or none are
+// @Tags         Orders
+// @Accept       json
+// @Produce      json
+// @Param        id          path      string  true   "account_id" Format(uuid)
+// @Param        instrument  query     string  false  "instrument"
+// @Param        side        query     string  false  "side" Enums(buy, sell)
+// @Success      200         {object}  massCancelOutputDto
+// @Failure      400         {object}  shared.Errors "Bad Request"
+// @Failure      401         {object}  shared.Errors "Unauthorized"
+// @Failure      403         {object}  shared.Errors "Account not owned by the caller"
+// @Failure      404         {object}  shared.Errors "Not Found"
+// @Failure      429         {object}  shared.Errors "Too Many Requests"
+// @Failure      500         {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/orders/cancel-all [post]
+func (o *OrderController) MassCancel(w http.ResponseWriter, req *http.Request) {
+	side := strings.ToLower(req.URL.Query().Get("side"))
+	if side != "" && side != "buy" && side != "sell" {
+		shared.BadRequestError(w, "invalid side")
+
+		return
+	}
+
+	massCancelOrdersUseCase := orderUsecases.NewMassCancelOrdersUseCase(o.bookRepo, o.orderRepo, o.accountRepo)
+
+	massCancelOutput, err := massCancelOrdersUseCase.Execute(orderUsecases.MassCancelOrdersInput{
+		AccountID:       req.PathValue("id"),
+		Instrument:      strings.ToUpper(req.URL.Query().Get("instrument")),
+		Side:            side,
+		CallerAccountID: shared.CallerFromContext(req.Context()),
+	})
 	if err != nil {
 		shared.HandleError(w, err)
 
 		return
 	}
 
-	cancelOutputDtoResponse := cancelOutputDto{
+	output := massCancelOutputDto{
+		Orders:   make([]map[string]any, 0, len(massCancelOutput.Orders)),
+		Canceled: len(massCancelOutput.Orders),
+	}
+
+	for _, order := range massCancelOutput.Orders {
+		output.Orders = append(output.Orders, order.Public())
+	}
+
+	shared.WriteJSON(w, http.StatusOK, output)
+}
+
+func newCancelOutputDto(cancelOrderOutput *orderUsecases.CancelOrderOutput) cancelOutputDto {
+	return cancelOutputDto{
 		Order:  cancelOrderOutput.Order.Public(),
 		Status: "canceled",
 	}
-
-	shared.WriteJSON(w, http.StatusOK, cancelOutputDtoResponse)
 }
 
 func NewOrderController(
+	placeOrderUseCase *orderUsecases.PlaceOrderUseCase,
 	bookRepo domainBook.IBookRepository,
 	orderRepo domainOrder.IOrderRepository,
 	accountRepo account.IAccountRepository,
+	batchMaxOrders int,
 ) *OrderController {
 	return &OrderController{
-		bookRepo:    bookRepo,
-		orderRepo:   orderRepo,
-		accountRepo: accountRepo,
+		placeOrderUseCase: placeOrderUseCase,
+		bookRepo:          bookRepo,
+		orderRepo:         orderRepo,
+		accountRepo:       accountRepo,
+		batchMaxOrders:    batchMaxOrders,
 	}
 }
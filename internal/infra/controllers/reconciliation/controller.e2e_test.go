@@ -0,0 +1,166 @@
+package reconciliation_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	httpServer "github.com/juninhoitabh/clob-go/internal/infra/http-server"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+)
+
+type (
+	assetOutputDtoTest struct {
+		Asset       string `json:"asset"`
+		Holdings    int64  `json:"holdings"`
+		Deposits    int64  `json:"deposits"`
+		Withdrawals int64  `json:"withdrawals"`
+		Expected    int64  `json:"expected"`
+	}
+	discrepancyOutputDtoTest struct {
+		Kind      string `json:"kind"`
+		AccountID string `json:"account_id"`
+		Asset     string `json:"asset"`
+		Expected  int64  `json:"expected"`
+		Actual    int64  `json:"actual"`
+	}
+	reconcileOutputDtoTest struct {
+		Assets        []assetOutputDtoTest       `json:"assets"`
+		Discrepancies []discrepancyOutputDtoTest `json:"discrepancies"`
+		Balanced      bool                       `json:"balanced"`
+	}
+	ReconciliationControllerTestSuite struct {
+		suite.Suite
+		e2eTestHandle *httpServer.E2eTestHandle
+		basePath      string
+	}
+)
+
+func (suite *ReconciliationControllerTestSuite) SetupTest() {
+	suite.e2eTestHandle = httpServer.NewE2eTestHandle()
+	suite.basePath = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1"
+}
+
+func (suite *ReconciliationControllerTestSuite) TearDownSuite() {
+	suite.e2eTestHandle.HttpServerTest.Close()
+}
+
+func (suite *ReconciliationControllerTestSuite) post(path string, body any) *http.Response {
+	t := suite.Suite.T()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	res, err := http.Post(suite.basePath+path, "application/json", bytes.NewReader(payload))
+	require.NoError(t, err)
+
+	return res
+}
+
+func (suite *ReconciliationControllerTestSuite) createAccount(name string, asset string, amount int64) string {
+	t := suite.Suite.T()
+
+	res := suite.post("/accounts", map[string]string{"account_name": name})
+	defer res.Body.Close()
+
+	var out map[string]string
+	err := json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+
+	creditRes := suite.post("/admin/accounts/"+out["account_id"]+"/credit", map[string]any{"asset": asset, "amount": amount})
+	defer creditRes.Body.Close()
+	require.Equal(t, http.StatusOK, creditRes.StatusCode)
+
+	return out["account_id"]
+}
+
+func (suite *ReconciliationControllerTestSuite) placeOrder(accountID, side string, price, qty int64) {
+	t := suite.Suite.T()
+
+	res := suite.post("/orders", map[string]any{
+		"account_id": accountID,
+		"instrument": "ATOM/USDT",
+		"side":       side,
+		"price":      price,
+		"qty":        qty,
+	})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+}
+
+func (suite *ReconciliationControllerTestSuite) reconcile() reconcileOutputDtoTest {
+	t := suite.Suite.T()
+
+	res, err := http.Get(suite.basePath + "/admin/reconciliation")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var out reconcileOutputDtoTest
+	err = json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+
+	return out
+}
+
+func (suite *ReconciliationControllerTestSuite) TestReconcile_BalancedAfterTrading() {
+	t := suite.Suite.T()
+
+	sellerID := suite.createAccount("reconcile-seller", "ATOM", 50)
+	buyerID := suite.createAccount("reconcile-buyer", "USDT", 1000)
+
+	suite.placeOrder(sellerID, "sell", 8, 20)
+	suite.placeOrder(buyerID, "buy", 10, 30)
+
+	withdrawRes := suite.post("/accounts/"+buyerID+"/withdraw", map[string]any{"asset": "USDT", "amount": 100})
+	defer withdrawRes.Body.Close()
+	require.Equal(t, http.StatusCreated, withdrawRes.StatusCode)
+
+	out := suite.reconcile()
+	assert.True(t, out.Balanced, "%+v", out.Discrepancies)
+	assert.Empty(t, out.Discrepancies)
+	assert.Contains(t, out.Assets, assetOutputDtoTest{
+		Asset:       "USDT",
+		Holdings:    900,
+		Deposits:    1000,
+		Withdrawals: 100,
+		Expected:    900,
+	})
+}
+
+func (suite *ReconciliationControllerTestSuite) TestReconcile_ReportsTamperedBalance() {
+	t := suite.Suite.T()
+
+	accountID := suite.createAccount("reconcile-tampered", "OSMO", 100)
+
+	acct, err := repositoriesAccount.NewInMemoryAccountRepository().Get(accountID)
+	require.NoError(t, err)
+
+	acct.Balances["OSMO"].Reserved += 5
+	defer func() { acct.Balances["OSMO"].Reserved -= 5 }()
+
+	out := suite.reconcile()
+	assert.False(t, out.Balanced)
+	assert.Contains(t, out.Discrepancies, discrepancyOutputDtoTest{
+		Kind:      "reserved_obligations",
+		AccountID: accountID,
+		Asset:     "OSMO",
+		Expected:  0,
+		Actual:    5,
+	})
+	assert.Contains(t, out.Discrepancies, discrepancyOutputDtoTest{
+		Kind:     "asset_supply",
+		Asset:    "OSMO",
+		Expected: 100,
+		Actual:   105,
+	})
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(ReconciliationControllerTestSuite))
+}
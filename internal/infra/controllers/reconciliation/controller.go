@@ -0,0 +1,106 @@
+package reconciliation
+
+import (
+	"net/http"
+	"time"
+
+	reconciliationUsecases "github.com/juninhoitabh/clob-go/internal/application/reconciliation/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	domainLedger "github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	assetOutputDto struct {
+		Asset       string `json:"asset" example:"USDT"`
+		Holdings    int64  `json:"holdings" example:"1000"`
+		Deposits    int64  `json:"deposits" example:"1500"`
+		Withdrawals int64  `json:"withdrawals" example:"500"`
+		Fees        int64  `json:"fees" example:"12"`
+		Expected    int64  `json:"expected" example:"1000"`
+	}
+	discrepancyOutputDto struct {
+		Kind      string `json:"kind" example:"reserved_obligations"`
+		AccountID string `json:"account_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+		Asset     string `json:"asset" example:"USDT"`
+		Expected  int64  `json:"expected" example:"300"`
+		Actual    int64  `json:"actual" example:"320"`
+	}
+	reconcileOutputDto struct {
+		CheckedAt     string                 `json:"checked_at" example:"2025-01-01T00:00:00Z"`
+		Assets        []assetOutputDto       `json:"assets"`
+		Discrepancies []discrepancyOutputDto `json:"discrepancies"`
+		Balanced      bool                   `json:"balanced" example:"true"`
+	}
+	ReconciliationController struct {
+		accountRepo  domainAccount.IAccountRepository
+		bookRepo     domainBook.IBookRepository
+		ledgerRepo   domainLedger.ILedgerRepository
+		feeAccountID string
+	}
+)
+
+// Reconcile godoc
+// @Summary      Reconcile
+// @Description  Check that every asset's holdings equal deposits minus withdrawals, that each account's Reserved matches its open orders and that balances agree with the ledger
+// @Tags         Reconciliation
+// @Accept       json
+// @Produce      json
+// @Success      200       {object}  reconcileOutputDto
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /admin/reconciliation [get]
+func (c *ReconciliationController) Reconcile(w http.ResponseWriter, req *http.Request) {
+	reconcileUseCase := reconciliationUsecases.NewReconcileUseCase(c.accountRepo, c.bookRepo, c.ledgerRepo, c.feeAccountID)
+
+	output, err := reconcileUseCase.Execute()
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	dto := reconcileOutputDto{
+		CheckedAt:     output.CheckedAt.UTC().Format(time.RFC3339Nano),
+		Assets:        make([]assetOutputDto, 0, len(output.Assets)),
+		Discrepancies: make([]discrepancyOutputDto, 0, len(output.Discrepancies)),
+		Balanced:      output.Balanced,
+	}
+
+	for _, a := range output.Assets {
+		dto.Assets = append(dto.Assets, assetOutputDto{
+			Asset:       a.Asset,
+			Holdings:    a.Holdings,
+			Deposits:    a.Deposits,
+			Withdrawals: a.Withdrawals,
+			Fees:        a.Fees,
+			Expected:    a.Expected(),
+		})
+	}
+
+	for _, d := range output.Discrepancies {
+		dto.Discrepancies = append(dto.Discrepancies, discrepancyOutputDto{
+			Kind:      string(d.Kind),
+			AccountID: d.AccountID,
+			Asset:     d.Asset,
+			Expected:  d.Expected,
+			Actual:    d.Actual,
+		})
+	}
+
+	shared.WriteJSON(w, http.StatusOK, dto)
+}
+
+func NewReconciliationController(
+	accountRepo domainAccount.IAccountRepository,
+	bookRepo domainBook.IBookRepository,
+	ledgerRepo domainLedger.ILedgerRepository,
+	feeAccountID string,
+) *ReconciliationController {
+	return &ReconciliationController{
+		accountRepo:  accountRepo,
+		bookRepo:     bookRepo,
+		ledgerRepo:   ledgerRepo,
+		feeAccountID: feeAccountID,
+	}
+}
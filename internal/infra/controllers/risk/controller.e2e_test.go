@@ -0,0 +1,213 @@
+package risk_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	httpServer "github.com/juninhoitabh/clob-go/internal/infra/http-server"
+)
+
+type (
+	limitsOutputDtoTest struct {
+		MaxPositions       map[string]int64 `json:"max_positions"`
+		AccountID          string           `json:"account_id"`
+		MaxOrderNotional   int64            `json:"max_order_notional"`
+		MaxOpenOrders      int64            `json:"max_open_orders"`
+		MaxOrdersPerWindow int64            `json:"max_orders_per_window"`
+		RateWindowSeconds  int64            `json:"rate_window_seconds"`
+	}
+	errorOutputDtoTest struct {
+		Message string `json:"message"`
+		Code    string `json:"code"`
+		Status  int    `json:"status"`
+	}
+	RiskControllerTestSuite struct {
+		suite.Suite
+		e2eTestHandle *httpServer.E2eTestHandle
+		basePath      string
+	}
+)
+
+func (suite *RiskControllerTestSuite) SetupTest() {
+	suite.e2eTestHandle = httpServer.NewE2eTestHandle()
+	suite.basePath = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1"
+}
+
+func (suite *RiskControllerTestSuite) TearDownSuite() {
+	suite.e2eTestHandle.HttpServerTest.Close()
+}
+
+func (suite *RiskControllerTestSuite) createAccount(name string, asset string, amount int64) string {
+	t := suite.Suite.T()
+
+	createAccountBody, err := json.Marshal(map[string]string{"account_name": name})
+	require.NoError(t, err)
+
+	createAccountRes, err := http.Post(suite.basePath+"/accounts", "application/json", bytes.NewReader(createAccountBody))
+	require.NoError(t, err)
+	defer createAccountRes.Body.Close()
+
+	var createAccountOut map[string]string
+	err = json.NewDecoder(createAccountRes.Body).Decode(&createAccountOut)
+	require.NoError(t, err)
+
+	accountID := createAccountOut["account_id"]
+
+	creditBody, err := json.Marshal(map[string]any{"asset": asset, "amount": amount})
+	require.NoError(t, err)
+
+	creditRes, err := http.Post(suite.basePath+"/admin/accounts/"+accountID+"/credit", "application/json", bytes.NewReader(creditBody))
+	require.NoError(t, err)
+	defer creditRes.Body.Close()
+
+	return accountID
+}
+
+func (suite *RiskControllerTestSuite) putLimits(accountID string, body any) *http.Response {
+	t := suite.Suite.T()
+
+	limitsBody, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPut, suite.basePath+"/admin/accounts/"+accountID+"/risk-limits", bytes.NewReader(limitsBody))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	return res
+}
+
+func (suite *RiskControllerTestSuite) TestSetAndGetLimits_Success() {
+	t := suite.Suite.T()
+
+	accountID := suite.createAccount("risk-limits-account", "USDT", 1000)
+
+	res := suite.putLimits(accountID, map[string]any{
+		"max_order_notional":    500,
+		"max_open_orders":       3,
+		"max_positions":         map[string]int64{"btc": 10},
+		"max_orders_per_window": 5,
+		"rate_window_seconds":   1,
+	})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	getRes, err := http.Get(suite.basePath + "/accounts/" + accountID + "/risk-limits")
+	require.NoError(t, err)
+	defer getRes.Body.Close()
+	assert.Equal(t, http.StatusOK, getRes.StatusCode)
+
+	var out limitsOutputDtoTest
+	err = json.NewDecoder(getRes.Body).Decode(&out)
+	require.NoError(t, err)
+	assert.Equal(t, accountID, out.AccountID)
+	assert.Equal(t, int64(500), out.MaxOrderNotional)
+	assert.Equal(t, int64(3), out.MaxOpenOrders)
+	assert.Equal(t, int64(10), out.MaxPositions["BTC"])
+	assert.Equal(t, int64(5), out.MaxOrdersPerWindow)
+	assert.Equal(t, int64(1), out.RateWindowSeconds)
+}
+
+func (suite *RiskControllerTestSuite) TestPlaceOrder_RejectedByRule() {
+	t := suite.Suite.T()
+
+	accountID := suite.createAccount("risk-reject-account", "USDT", 10000)
+
+	res := suite.putLimits(accountID, map[string]any{"max_order_notional": 500})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	orderBody, err := json.Marshal(map[string]any{
+		"account_id": accountID,
+		"instrument": "ADA/USDT",
+		"side":       "buy",
+		"price":      100,
+		"qty":        10,
+	})
+	require.NoError(t, err)
+
+	orderRes, err := http.Post(suite.basePath+"/orders", "application/json", bytes.NewReader(orderBody))
+	require.NoError(t, err)
+	defer orderRes.Body.Close()
+	assert.Equal(t, http.StatusUnprocessableEntity, orderRes.StatusCode)
+
+	var errOut errorOutputDtoTest
+	err = json.NewDecoder(orderRes.Body).Decode(&errOut)
+	require.NoError(t, err)
+	assert.Equal(t, "RISK_MAX_ORDER_NOTIONAL", errOut.Code)
+}
+
+func (suite *RiskControllerTestSuite) placeOrder(accountID, side string, price, qty int64) int {
+	t := suite.Suite.T()
+
+	orderBody, err := json.Marshal(map[string]any{
+		"account_id": accountID,
+		"instrument": "DOT/USDT",
+		"side":       side,
+		"price":      price,
+		"qty":        qty,
+	})
+	require.NoError(t, err)
+
+	orderRes, err := http.Post(suite.basePath+"/orders", "application/json", bytes.NewReader(orderBody))
+	require.NoError(t, err)
+	defer orderRes.Body.Close()
+
+	return orderRes.StatusCode
+}
+
+func (suite *RiskControllerTestSuite) TestPlaceOrder_FilledMakerNoLongerCountsAsOpen() {
+	t := suite.Suite.T()
+
+	sellerID := suite.createAccount("risk-open-seller", "DOT", 10)
+	buyerID := suite.createAccount("risk-open-buyer", "USDT", 10000)
+
+	res := suite.putLimits(sellerID, map[string]any{"max_open_orders": 1})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	require.Equal(t, http.StatusCreated, suite.placeOrder(sellerID, "sell", 10, 1))
+	assert.Equal(t, http.StatusUnprocessableEntity, suite.placeOrder(sellerID, "sell", 10, 1))
+
+	require.Equal(t, http.StatusCreated, suite.placeOrder(buyerID, "buy", 10, 1))
+	assert.Equal(t, http.StatusCreated, suite.placeOrder(sellerID, "sell", 10, 1))
+}
+
+func (suite *RiskControllerTestSuite) TestSetLimits_InvalidLimits() {
+	t := suite.Suite.T()
+
+	accountID := suite.createAccount("risk-invalid-account", "USDT", 1000)
+
+	res := suite.putLimits(accountID, map[string]any{"max_open_orders": -1})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func (suite *RiskControllerTestSuite) TestSetLimits_AccountNotFound() {
+	t := suite.Suite.T()
+
+	res := suite.putLimits("unknown-account", map[string]any{"max_open_orders": 1})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func (suite *RiskControllerTestSuite) TestGetLimits_AccountNotFound() {
+	t := suite.Suite.T()
+
+	res, err := http.Get(suite.basePath + "/accounts/unknown-account/risk-limits")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(RiskControllerTestSuite))
+}
@@ -0,0 +1,143 @@
+package risk
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	riskUsecases "github.com/juninhoitabh/clob-go/internal/application/risk/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainRisk "github.com/juninhoitabh/clob-go/internal/domain/risk"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	setLimitsInputDto struct {
+		MaxPositions       map[string]int64 `json:"max_positions"`
+		MaxOrderNotional   int64            `json:"max_order_notional" example:"1000000" validate:"gte=0"`
+		MaxOpenOrders      int64            `json:"max_open_orders" example:"50" validate:"gte=0"`
+		MaxOrdersPerWindow int64            `json:"max_orders_per_window" example:"10" validate:"gte=0"`
+		RateWindowSeconds  int64            `json:"rate_window_seconds" example:"1" validate:"gte=0"`
+	}
+	limitsOutputDto struct {
+		MaxPositions       map[string]int64 `json:"max_positions"`
+		AccountID          string           `json:"account_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		UpdatedAt          string           `json:"updated_at,omitempty" example:"2025-01-01T00:00:00Z"`
+		MaxOrderNotional   int64            `json:"max_order_notional" example:"1000000"`
+		MaxOpenOrders      int64            `json:"max_open_orders" example:"50"`
+		MaxOrdersPerWindow int64            `json:"max_orders_per_window" example:"10"`
+		RateWindowSeconds  int64            `json:"rate_window_seconds" example:"1"`
+	}
+	RiskController struct {
+		accountRepo domainAccount.IAccountRepository
+		limitsRepo  domainRisk.ILimitsRepository
+	}
+)
+
+// SetLimits godoc
+// @Summary      Set Risk Limits
+// @Description  Set the pre-trade risk limits of an account, zero disables a limit
+// @Tags         Risk
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string             true  "account_id" Format(uuid)
+// @Param        request   body      setLimitsInputDto  true  "setLimitsInputDto request"
+// @Success      200       {object}  limitsOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /admin/accounts/{id}/risk-limits [put]
+func (r *RiskController) SetLimits(w http.ResponseWriter, req *http.Request) {
+	id := req.PathValue("id")
+	if id == "" {
+		shared.BadRequestError(w, "missing account_id")
+
+		return
+	}
+
+	var body setLimitsInputDto
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		shared.BadRequestError(w, "Invalid JSON", err.Error())
+
+		return
+	}
+
+	setRiskLimitsUseCase := riskUsecases.NewSetRiskLimitsUseCase(r.accountRepo, r.limitsRepo)
+
+	output, err := setRiskLimitsUseCase.Execute(riskUsecases.SetRiskLimitsInput{
+		AccountID:          id,
+		MaxOrderNotional:   body.MaxOrderNotional,
+		MaxOpenOrders:      body.MaxOpenOrders,
+		MaxPositions:       body.MaxPositions,
+		MaxOrdersPerWindow: body.MaxOrdersPerWindow,
+		RateWindow:         time.Duration(body.RateWindowSeconds) * time.Second,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newLimitsOutputDto(output))
+}
+
+// GetLimits godoc
+// @Summary      Get Risk Limits
+// @Description  Get the pre-trade risk limits of an account
+// @Tags         Risk
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string  true  "account_id" Format(uuid)
+// @Success      200       {object}  limitsOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/risk-limits [get]
+func (r *RiskController) GetLimits(w http.ResponseWriter, req *http.Request) {
+	id := req.PathValue("id")
+	if id == "" {
+		shared.BadRequestError(w, "missing account_id")
+
+		return
+	}
+
+	getRiskLimitsUseCase := riskUsecases.NewGetRiskLimitsUseCase(r.accountRepo, r.limitsRepo)
+
+	output, err := getRiskLimitsUseCase.Execute(riskUsecases.GetRiskLimitsInput{
+		AccountID: id,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newLimitsOutputDto(output))
+}
+
+func newLimitsOutputDto(output *riskUsecases.RiskLimitsOutput) limitsOutputDto {
+	dto := limitsOutputDto{
+		MaxPositions:       output.MaxPositions,
+		AccountID:          output.AccountID,
+		MaxOrderNotional:   output.MaxOrderNotional,
+		MaxOpenOrders:      output.MaxOpenOrders,
+		MaxOrdersPerWindow: output.MaxOrdersPerWindow,
+		RateWindowSeconds:  int64(output.RateWindow / time.Second),
+	}
+
+	if !output.UpdatedAt.IsZero() {
+		dto.UpdatedAt = output.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	return dto
+}
+
+func NewRiskController(
+	accountRepo domainAccount.IAccountRepository,
+	limitsRepo domainRisk.ILimitsRepository,
+) *RiskController {
+	return &RiskController{
+		accountRepo: accountRepo,
+		limitsRepo:  limitsRepo,
+	}
+}
@@ -0,0 +1,136 @@
+package trade_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	httpServer "github.com/juninhoitabh/clob-go/internal/infra/http-server"
+)
+
+type TradeControllerTestSuite struct {
+	suite.Suite
+	e2eTestHandle *httpServer.E2eTestHandle
+	basePath      string
+}
+
+func (suite *TradeControllerTestSuite) SetupTest() {
+	suite.e2eTestHandle = httpServer.NewE2eTestHandle()
+	suite.basePath = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1/trades"
+}
+
+func (suite *TradeControllerTestSuite) TearDownSuite() {
+	suite.e2eTestHandle.HttpServerTest.Close()
+}
+
+func (suite *TradeControllerTestSuite) TestList_Success() {
+	t := suite.Suite.T()
+
+	res, err := http.Get(suite.basePath + "?account_id=no-trades-account")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func (suite *TradeControllerTestSuite) TestList_InvalidLimit() {
+	t := suite.Suite.T()
+
+	res, err := http.Get(suite.basePath + "?limit=abc")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	res, err = http.Get(suite.basePath + "?limit=5000")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func (suite *TradeControllerTestSuite) TestList_PublicTapeHidesAccounts() {
+	t := suite.Suite.T()
+
+	buyerID := suite.createAccount("public-tape-buyer", "USDT", 50000)
+	sellerID := suite.createAccount("public-tape-seller", "BTC", 1)
+
+	res := suite.post("/orders", map[string]interface{}{
+		"account_id": sellerID,
+		"instrument": "BTC/USDT",
+		"side":       "sell",
+		"qty":        1,
+		"price":      50000,
+	})
+	res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	res = suite.post("/orders", map[string]interface{}{
+		"account_id": buyerID,
+		"instrument": "BTC/USDT",
+		"side":       "buy",
+		"qty":        1,
+		"price":      50000,
+	})
+	res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	res, err := http.Get(suite.basePath + "?instrument=BTC/USDT")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var out struct {
+		Trades []map[string]interface{} `json:"trades"`
+	}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+	require.NotEmpty(t, out.Trades)
+
+	for _, trade := range out.Trades {
+		assert.Contains(t, trade, "trade_id")
+		assert.Contains(t, trade, "price")
+		assert.Contains(t, trade, "qty")
+
+		for _, key := range []string{"buyer_id", "seller_id", "taker_order_id", "maker_order_id", "maker_fee", "taker_fee", "maker_fee_asset", "taker_fee_asset"} {
+			assert.NotContains(t, trade, key)
+		}
+	}
+}
+
+func (suite *TradeControllerTestSuite) createAccount(name string, asset string, amount int64) string {
+	t := suite.Suite.T()
+
+	res := suite.post("/accounts", map[string]string{"account_name": name})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	var out map[string]string
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+	credit := suite.post("/admin/accounts/"+out["account_id"]+"/credit", map[string]interface{}{
+		"asset":  asset,
+		"amount": amount,
+	})
+	credit.Body.Close()
+	require.Equal(t, http.StatusOK, credit.StatusCode)
+
+	return out["account_id"]
+}
+
+func (suite *TradeControllerTestSuite) post(path string, body interface{}) *http.Response {
+	t := suite.Suite.T()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	res, err := http.Post(suite.e2eTestHandle.HttpServerTest.URL+"/api/v1"+path, "application/json", bytes.NewReader(payload))
+	require.NoError(t, err)
+
+	return res
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(TradeControllerTestSuite))
+}
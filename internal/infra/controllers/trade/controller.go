@@ -0,0 +1,156 @@
+package trade
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	tradeUsecases "github.com/juninhoitabh/clob-go/internal/application/trade/usecases"
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainTrade "github.com/juninhoitabh/clob-go/internal/domain/trade"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	tradeOutputDto struct {
+		TradeID       string `json:"trade_id" example:"BTC/USDT-42"`
+		Instrument    string `json:"instrument" example:"BTC/USDT"`
+		TakerOrderID  string `json:"taker_order_id" example:"7"`
+		MakerOrderID  string `json:"maker_order_id" example:"7"`
+		BuyerID       string `json:"buyer_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		SellerID      string `json:"seller_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		MakerFeeAsset string `json:"maker_fee_asset,omitempty" example:"USDT"`
+		TakerFeeAsset string `json:"taker_fee_asset,omitempty" example:"BTC"`
+		ExecutedAt    string `json:"executed_at" example:"2025-01-01T00:00:00Z"`
+		Price         int64  `json:"price" example:"50000"`
+		Qty           int64  `json:"qty" example:"1"`
+		MakerFee      int64  `json:"maker_fee" example:"-5"`
+		TakerFee      int64  `json:"taker_fee" example:"10"`
+	}
+	listOutputDto struct {
+		Trades []tradeOutputDto `json:"trades"`
+	}
+	// publicTradeOutputDto is a trade on the public tape, without the
+	// accounts, orders and fees behind it.
+	publicTradeOutputDto struct {
+		TradeID    string `json:"trade_id" example:"BTC/USDT-42"`
+		Instrument string `json:"instrument" example:"BTC/USDT"`
+		ExecutedAt string `json:"executed_at" example:"2025-01-01T00:00:00Z"`
+		Price      int64  `json:"price" example:"50000"`
+		Qty        int64  `json:"qty" example:"1"`
+	}
+	listPublicOutputDto struct {
+		Trades []publicTradeOutputDto `json:"trades"`
+	}
+	TradeController struct {
+		tradeRepo   domainTrade.ITradeRepository
+		accountRepo account.IAccountRepository
+	}
+)
+
+// List godoc
+// @Summary      List Trades
+// @Description  Trade history with fees, most recent first. Without account_id, the public tape: every trade, but only its ID, instrument, price, qty and time
+// @Tags         Trades
+// @Accept       json
+// @Produce      json
+// @Param        account_id query     string false "account_id" Format(uuid)
+// @Param        instrument query     string false "instrument" example:"BTC/USDT"
+// @Param        limit      query     int    false "limit" example:"100"
+// @Success      200       {object}  listOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      403       {object}  shared.Errors "Account not owned by the caller"
+// @Failure      429       {object}  shared.Errors "Too Many Requests"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /trades [get]
+func (t *TradeController) List(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+
+	var limit int
+
+	if raw := query.Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			shared.BadRequestError(w, "invalid limit", err.Error())
+
+			return
+		}
+
+		limit = parsed
+	}
+
+	listTradesUseCase := tradeUsecases.NewListTradesUseCase(t.tradeRepo, t.accountRepo)
+
+	accountID := query.Get("account_id")
+
+	output, err := listTradesUseCase.Execute(tradeUsecases.ListTradesInput{
+		AccountID:       accountID,
+		Instrument:      strings.ToUpper(query.Get("instrument")),
+		CallerAccountID: shared.CallerFromContext(req.Context()),
+		Limit:           limit,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	if accountID == "" {
+		shared.WriteJSON(w, http.StatusOK, newListPublicOutputDto(output))
+
+		return
+	}
+
+	listOutputDtoResponse := listOutputDto{
+		Trades: make([]tradeOutputDto, 0, len(output.Trades)),
+	}
+
+	for _, trade := range output.Trades {
+		listOutputDtoResponse.Trades = append(listOutputDtoResponse.Trades, tradeOutputDto{
+			TradeID:       trade.ID(),
+			Instrument:    trade.Instrument,
+			TakerOrderID:  trade.TakerOrderID,
+			MakerOrderID:  trade.MakerOrderID,
+			BuyerID:       trade.BuyerID,
+			SellerID:      trade.SellerID,
+			MakerFeeAsset: trade.MakerFeeAsset,
+			TakerFeeAsset: trade.TakerFeeAsset,
+			ExecutedAt:    trade.ExecutedAt.UTC().Format(time.RFC3339Nano),
+			Price:         trade.Price,
+			Qty:           trade.Qty,
+			MakerFee:      trade.MakerFee,
+			TakerFee:      trade.TakerFee,
+		})
+	}
+
+	shared.WriteJSON(w, http.StatusOK, listOutputDtoResponse)
+}
+
+func newListPublicOutputDto(output *tradeUsecases.ListTradesOutput) listPublicOutputDto {
+	out := listPublicOutputDto{
+		Trades: make([]publicTradeOutputDto, 0, len(output.Trades)),
+	}
+
+	for _, trade := range output.Trades {
+		out.Trades = append(out.Trades, publicTradeOutputDto{
+			TradeID:    trade.ID(),
+			Instrument: trade.Instrument,
+			ExecutedAt: trade.ExecutedAt.UTC().Format(time.RFC3339Nano),
+			Price:      trade.Price,
+			Qty:        trade.Qty,
+		})
+	}
+
+	return out
+}
+
+func NewTradeController(
+	tradeRepo domainTrade.ITradeRepository,
+	accountRepo account.IAccountRepository,
+) *TradeController {
+	return &TradeController{
+		tradeRepo:   tradeRepo,
+		accountRepo: accountRepo,
+	}
+}
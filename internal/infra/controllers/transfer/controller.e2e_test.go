@@ -0,0 +1,302 @@
+package transfer_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	httpServer "github.com/juninhoitabh/clob-go/internal/infra/http-server"
+)
+
+type (
+	transferOutputDtoTest struct {
+		TransferID     string `json:"transfer_id"`
+		IdempotencyKey string `json:"idempotency_key"`
+		FromAccountID  string `json:"from_account_id"`
+		ToAccountID    string `json:"to_account_id"`
+		Asset          string `json:"asset"`
+		Amount         int64  `json:"amount"`
+	}
+	errorOutputDtoTest struct {
+		Code string `json:"code"`
+	}
+	balanceOutputDtoTest struct {
+		Available int64 `json:"available"`
+		Reserved  int64 `json:"reserved"`
+	}
+	accountOutputDtoTest struct {
+		Balances map[string]balanceOutputDtoTest `json:"balances"`
+	}
+	postingOutputDtoTest struct {
+		Operation string `json:"operation"`
+		RefType   string `json:"ref_type"`
+		RefID     string `json:"ref_id"`
+		Amount    int64  `json:"amount"`
+	}
+	ledgerOutputDtoTest struct {
+		Postings []postingOutputDtoTest `json:"postings"`
+	}
+	TransferControllerTestSuite struct {
+		suite.Suite
+		e2eTestHandle *httpServer.E2eTestHandle
+		basePath      string
+	}
+)
+
+func (suite *TransferControllerTestSuite) SetupTest() {
+	suite.e2eTestHandle = httpServer.NewE2eTestHandle()
+	suite.basePath = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1"
+}
+
+func (suite *TransferControllerTestSuite) TearDownSuite() {
+	suite.e2eTestHandle.HttpServerTest.Close()
+}
+
+func (suite *TransferControllerTestSuite) post(path string, body any) *http.Response {
+	t := suite.Suite.T()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	res, err := http.Post(suite.basePath+path, "application/json", bytes.NewReader(payload))
+	require.NoError(t, err)
+
+	return res
+}
+
+func (suite *TransferControllerTestSuite) createAccount(name string, asset string, amount int64) string {
+	t := suite.Suite.T()
+
+	res := suite.post("/accounts", map[string]string{"account_name": name})
+	defer res.Body.Close()
+
+	var out map[string]string
+	err := json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+
+	if amount > 0 {
+		creditRes := suite.post("/admin/accounts/"+out["account_id"]+"/credit", map[string]any{"asset": asset, "amount": amount})
+		defer creditRes.Body.Close()
+		require.Equal(t, http.StatusOK, creditRes.StatusCode)
+	}
+
+	return out["account_id"]
+}
+
+func (suite *TransferControllerTestSuite) balance(accountID, asset string) balanceOutputDtoTest {
+	t := suite.Suite.T()
+
+	res, err := http.Get(suite.basePath + "/accounts/" + accountID)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	var out accountOutputDtoTest
+	err = json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+
+	return out.Balances[asset]
+}
+
+func (suite *TransferControllerTestSuite) transfer(key string, body any) *http.Response {
+	t := suite.Suite.T()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, suite.basePath+"/transfers", bytes.NewReader(payload))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	if key != "" {
+		req.Header.Set("Idempotency-Key", key)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	return res
+}
+
+func (suite *TransferControllerTestSuite) TestTransfer_MovesFundsAndReplays() {
+	t := suite.Suite.T()
+
+	fromID := suite.createAccount("transfer-from", "DOT", 1000)
+	toID := suite.createAccount("transfer-to", "DOT", 0)
+	body := map[string]any{"from_account_id": fromID, "to_account_id": toID, "asset": "dot", "amount": 400}
+
+	res := suite.transfer("transfer-key-1", body)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	var out transferOutputDtoTest
+	err := json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+	assert.NotEmpty(t, out.TransferID)
+	assert.Equal(t, "DOT", out.Asset)
+	assert.Equal(t, "transfer-key-1", out.IdempotencyKey)
+	assert.Equal(t, int64(600), suite.balance(fromID, "DOT").Available)
+	assert.Equal(t, int64(400), suite.balance(toID, "DOT").Available)
+
+	replayRes := suite.transfer("transfer-key-1", body)
+	defer replayRes.Body.Close()
+	require.Equal(t, http.StatusOK, replayRes.StatusCode)
+
+	var replayed transferOutputDtoTest
+	err = json.NewDecoder(replayRes.Body).Decode(&replayed)
+	require.NoError(t, err)
+	assert.Equal(t, out.TransferID, replayed.TransferID)
+	assert.Equal(t, int64(600), suite.balance(fromID, "DOT").Available)
+	assert.Equal(t, int64(400), suite.balance(toID, "DOT").Available)
+
+	ledgerRes, err := http.Get(suite.basePath + "/accounts/" + toID + "/ledger")
+	require.NoError(t, err)
+	defer ledgerRes.Body.Close()
+
+	var ledger ledgerOutputDtoTest
+	err = json.NewDecoder(ledgerRes.Body).Decode(&ledger)
+	require.NoError(t, err)
+	require.Len(t, ledger.Postings, 1)
+	assert.Equal(t, "transfer", ledger.Postings[0].RefType)
+	assert.Equal(t, out.TransferID, ledger.Postings[0].RefID)
+	assert.Equal(t, int64(400), ledger.Postings[0].Amount)
+}
+
+func (suite *TransferControllerTestSuite) TestTransfer_KeyReusedWithDifferentParams() {
+	t := suite.Suite.T()
+
+	fromID := suite.createAccount("transfer-reuse-from", "DOT", 1000)
+	toID := suite.createAccount("transfer-reuse-to", "DOT", 0)
+
+	res := suite.transfer("transfer-key-2", map[string]any{"from_account_id": fromID, "to_account_id": toID, "asset": "DOT", "amount": 100})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	conflictRes := suite.transfer("transfer-key-2", map[string]any{"from_account_id": fromID, "to_account_id": toID, "asset": "DOT", "amount": 200})
+	defer conflictRes.Body.Close()
+	assert.Equal(t, http.StatusConflict, conflictRes.StatusCode)
+	assert.Equal(t, int64(900), suite.balance(fromID, "DOT").Available)
+}
+
+func (suite *TransferControllerTestSuite) TestTransfer_NeverTouchesReserved() {
+	t := suite.Suite.T()
+
+	fromID := suite.createAccount("transfer-reserved-from", "USDT", 1000)
+	toID := suite.createAccount("transfer-reserved-to", "USDT", 0)
+
+	orderRes := suite.post("/orders", map[string]any{
+		"account_id": fromID,
+		"instrument": "DOT/USDT",
+		"side":       "buy",
+		"price":      1,
+		"qty":        800,
+	})
+	defer orderRes.Body.Close()
+	require.Equal(t, http.StatusCreated, orderRes.StatusCode)
+
+	res := suite.transfer("transfer-key-3", map[string]any{"from_account_id": fromID, "to_account_id": toID, "asset": "USDT", "amount": 300})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+
+	var out errorOutputDtoTest
+	err := json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+	assert.Equal(t, "INSUFFICIENT_AVAILABLE_BALANCE", out.Code)
+
+	balance := suite.balance(fromID, "USDT")
+	assert.Equal(t, int64(200), balance.Available)
+	assert.Equal(t, int64(800), balance.Reserved)
+}
+
+func (suite *TransferControllerTestSuite) TestTransfer_ConcurrentWithOrders() {
+	t := suite.Suite.T()
+
+	fromID := suite.createAccount("transfer-concurrent-from", "USDT", 10000)
+	toID := suite.createAccount("transfer-concurrent-to", "USDT", 0)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		statuses []int
+	)
+
+	record := func(res *http.Response) {
+		defer res.Body.Close()
+
+		mu.Lock()
+		statuses = append(statuses, res.StatusCode)
+		mu.Unlock()
+	}
+
+	for i := range 10 {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			record(suite.transfer(fmt.Sprintf("transfer-concurrent-%d", i), map[string]any{
+				"from_account_id": fromID,
+				"to_account_id":   toID,
+				"asset":           "USDT",
+				"amount":          100,
+			}))
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			record(suite.post("/orders", map[string]any{
+				"account_id": fromID,
+				"instrument": "KSM/USDT",
+				"side":       "buy",
+				"price":      1,
+				"qty":        100,
+			}))
+		}()
+	}
+
+	wg.Wait()
+
+	for _, status := range statuses {
+		assert.Equal(t, http.StatusCreated, status)
+	}
+
+	from := suite.balance(fromID, "USDT")
+	assert.Equal(t, int64(8000), from.Available)
+	assert.Equal(t, int64(1000), from.Reserved)
+	assert.Equal(t, int64(1000), suite.balance(toID, "USDT").Available)
+}
+
+func (suite *TransferControllerTestSuite) TestTransfer_MissingIdempotencyKey() {
+	t := suite.Suite.T()
+
+	res := suite.transfer("", map[string]any{"from_account_id": "a", "to_account_id": "b", "asset": "USDT", "amount": 10})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func (suite *TransferControllerTestSuite) TestTransfer_SameAccount() {
+	t := suite.Suite.T()
+
+	res := suite.transfer("transfer-key-4", map[string]any{"from_account_id": "a", "to_account_id": "a", "asset": "USDT", "amount": 10})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func (suite *TransferControllerTestSuite) TestTransfer_AccountNotFound() {
+	t := suite.Suite.T()
+
+	res := suite.transfer("transfer-key-5", map[string]any{"from_account_id": "unknown-a", "to_account_id": "unknown-b", "asset": "USDT", "amount": 10})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(TransferControllerTestSuite))
+}
@@ -0,0 +1,117 @@
+package transfer
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	transferUsecases "github.com/juninhoitabh/clob-go/internal/application/transfer/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainTransfer "github.com/juninhoitabh/clob-go/internal/domain/transfer"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+const idempotencyKeyHeader = "Idempotency-Key"
+
+type (
+	transferInputDto struct {
+		FromAccountID string `json:"from_account_id" example:"123e4567-e89b-12d3-a456-426614174000" validate:"required"`
+		ToAccountID   string `json:"to_account_id" example:"123e4567-e89b-12d3-a456-426614174001" validate:"required"`
+		Asset         string `json:"asset" example:"USDT" validate:"required"`
+		Amount        int64  `json:"amount" example:"1000" validate:"required,gt=0"`
+	}
+	transferOutputDto struct {
+		TransferID     string `json:"transfer_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		IdempotencyKey string `json:"idempotency_key" example:"8e0f5a52-5f2c-4f1e-9d4b-1c1f0c2d3e4f"`
+		FromAccountID  string `json:"from_account_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		ToAccountID    string `json:"to_account_id" example:"123e4567-e89b-12d3-a456-426614174001"`
+		Asset          string `json:"asset" example:"USDT"`
+		CreatedAt      string `json:"created_at" example:"2025-01-01T00:00:00Z"`
+		Amount         int64  `json:"amount" example:"1000"`
+	}
+	TransferController struct {
+		accountRepo  domainAccount.IAccountRepository
+		transferRepo domainTransfer.ITransferRepository
+	}
+)
+
+// Create godoc
+// @Summary      Transfer
+// @Description  Move an asset between two accounts atomically, debiting the source's Available balance. Sub-accounts can only transfer to or from their parent. Retrying with the same Idempotency-Key returns the original transfer
+// @Tags         Transfers
+// @Accept       json
+// @Produce      json
+// @Param        Idempotency-Key  header    string            true  "Unique key per source account"
+// @Param        request          body      transferInputDto  true  "transferInputDto request"
+// @Success      201              {object}  transferOutputDto
+// @Success      200              {object}  transferOutputDto "Replayed transfer"
+// @Failure      400              {object}  shared.Errors "Bad Request"
+// @Failure      401              {object}  shared.Errors "Unauthorized"
+// @Failure      403              {object}  shared.Errors "Account not owned by the caller"
+// @Failure      404              {object}  shared.Errors "Not Found"
+// @Failure      409              {object}  shared.Errors "Idempotency key reused with different parameters"
+// @Failure      422              {object}  shared.Errors "Insufficient available balance or sub-account transfer outside its parent"
+// @Failure      500              {object}  shared.Errors "Internal Server Error"
+// @Router       /transfers [post]
+func (c *TransferController) Create(w http.ResponseWriter, req *http.Request) {
+	key := req.Header.Get(idempotencyKeyHeader)
+	if key == "" {
+		shared.BadRequestError(w, "missing Idempotency-Key header")
+
+		return
+	}
+
+	var body transferInputDto
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		shared.BadRequestError(w, "Invalid JSON", err.Error())
+
+		return
+	}
+
+	if body.FromAccountID == "" || body.ToAccountID == "" || body.Asset == "" || body.Amount <= 0 {
+		shared.BadRequestError(w, "from_account_id, to_account_id, asset and positive amount required")
+
+		return
+	}
+
+	transferUseCase := transferUsecases.NewTransferUseCase(c.accountRepo, c.transferRepo)
+
+	output, err := transferUseCase.Execute(transferUsecases.TransferInput{
+		IdempotencyKey:  key,
+		FromAccountID:   body.FromAccountID,
+		ToAccountID:     body.ToAccountID,
+		CallerAccountID: shared.CallerFromContext(req.Context()),
+		Asset:           body.Asset,
+		Amount:          body.Amount,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	status := http.StatusCreated
+	if output.Replayed {
+		status = http.StatusOK
+	}
+
+	shared.WriteJSON(w, status, transferOutputDto{
+		TransferID:     output.ID,
+		IdempotencyKey: output.IdempotencyKey,
+		FromAccountID:  output.FromAccountID,
+		ToAccountID:    output.ToAccountID,
+		Asset:          output.Asset,
+		CreatedAt:      output.CreatedAt.UTC().Format(time.RFC3339Nano),
+		Amount:         output.Amount,
+	})
+}
+
+func NewTransferController(
+	accountRepo domainAccount.IAccountRepository,
+	transferRepo domainTransfer.ITransferRepository,
+) *TransferController {
+	return &TransferController{
+		accountRepo:  accountRepo,
+		transferRepo: transferRepo,
+	}
+}
@@ -0,0 +1,214 @@
+package withdrawal_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	httpServer "github.com/juninhoitabh/clob-go/internal/infra/http-server"
+)
+
+type (
+	withdrawalOutputDtoTest struct {
+		WithdrawalID string `json:"withdrawal_id"`
+		AccountID    string `json:"account_id"`
+		Asset        string `json:"asset"`
+		Status       string `json:"status"`
+		Reason       string `json:"reason"`
+		Amount       int64  `json:"amount"`
+	}
+	errorOutputDtoTest struct {
+		Code string `json:"code"`
+	}
+	balanceOutputDtoTest struct {
+		Available int64 `json:"available"`
+		Reserved  int64 `json:"reserved"`
+	}
+	accountOutputDtoTest struct {
+		Balances map[string]balanceOutputDtoTest `json:"balances"`
+	}
+	WithdrawalControllerTestSuite struct {
+		suite.Suite
+		e2eTestHandle *httpServer.E2eTestHandle
+		basePath      string
+	}
+)
+
+func (suite *WithdrawalControllerTestSuite) SetupTest() {
+	suite.e2eTestHandle = httpServer.NewE2eTestHandle()
+	suite.basePath = suite.e2eTestHandle.HttpServerTest.URL + "/api/v1"
+}
+
+func (suite *WithdrawalControllerTestSuite) TearDownSuite() {
+	suite.e2eTestHandle.HttpServerTest.Close()
+}
+
+func (suite *WithdrawalControllerTestSuite) post(path string, body any) *http.Response {
+	t := suite.Suite.T()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	res, err := http.Post(suite.basePath+path, "application/json", bytes.NewReader(payload))
+	require.NoError(t, err)
+
+	return res
+}
+
+func (suite *WithdrawalControllerTestSuite) createAccount(name string, asset string, amount int64) string {
+	t := suite.Suite.T()
+
+	res := suite.post("/accounts", map[string]string{"account_name": name})
+	defer res.Body.Close()
+
+	var out map[string]string
+	err := json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+
+	creditRes := suite.post("/admin/accounts/"+out["account_id"]+"/credit", map[string]any{"asset": asset, "amount": amount})
+	defer creditRes.Body.Close()
+	require.Equal(t, http.StatusOK, creditRes.StatusCode)
+
+	return out["account_id"]
+}
+
+func (suite *WithdrawalControllerTestSuite) balance(accountID, asset string) balanceOutputDtoTest {
+	t := suite.Suite.T()
+
+	res, err := http.Get(suite.basePath + "/accounts/" + accountID)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	var out accountOutputDtoTest
+	err = json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+
+	return out.Balances[asset]
+}
+
+func (suite *WithdrawalControllerTestSuite) withdraw(accountID string, body any) (int, withdrawalOutputDtoTest) {
+	t := suite.Suite.T()
+
+	res := suite.post("/accounts/"+accountID+"/withdraw", body)
+	defer res.Body.Close()
+
+	var out withdrawalOutputDtoTest
+	err := json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+
+	return res.StatusCode, out
+}
+
+func (suite *WithdrawalControllerTestSuite) TestWithdraw_CompleteLifecycle() {
+	t := suite.Suite.T()
+
+	accountID := suite.createAccount("withdraw-complete", "ADA", 1000)
+
+	status, out := suite.withdraw(accountID, map[string]any{"asset": "ada", "amount": 400})
+	require.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "pending", out.Status)
+	assert.Equal(t, "ADA", out.Asset)
+	assert.Equal(t, int64(600), suite.balance(accountID, "ADA").Available)
+
+	completeRes := suite.post("/admin/withdrawals/"+out.WithdrawalID+"/complete", nil)
+	defer completeRes.Body.Close()
+	require.Equal(t, http.StatusOK, completeRes.StatusCode)
+
+	getRes, err := http.Get(suite.basePath + "/withdrawals/" + out.WithdrawalID)
+	require.NoError(t, err)
+	defer getRes.Body.Close()
+
+	var got withdrawalOutputDtoTest
+	err = json.NewDecoder(getRes.Body).Decode(&got)
+	require.NoError(t, err)
+	assert.Equal(t, "completed", got.Status)
+	assert.Equal(t, int64(600), suite.balance(accountID, "ADA").Available)
+
+	failRes := suite.post("/admin/withdrawals/"+out.WithdrawalID+"/fail", map[string]string{"reason": "late"})
+	defer failRes.Body.Close()
+	assert.Equal(t, http.StatusUnprocessableEntity, failRes.StatusCode)
+}
+
+func (suite *WithdrawalControllerTestSuite) TestWithdraw_FailReturnsFunds() {
+	t := suite.Suite.T()
+
+	accountID := suite.createAccount("withdraw-fail", "ADA", 1000)
+
+	status, out := suite.withdraw(accountID, map[string]any{"asset": "ADA", "amount": 1000})
+	require.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, int64(0), suite.balance(accountID, "ADA").Available)
+
+	failRes := suite.post("/admin/withdrawals/"+out.WithdrawalID+"/fail", map[string]string{"reason": "rejected by custodian"})
+	defer failRes.Body.Close()
+	require.Equal(t, http.StatusOK, failRes.StatusCode)
+
+	var failed withdrawalOutputDtoTest
+	err := json.NewDecoder(failRes.Body).Decode(&failed)
+	require.NoError(t, err)
+	assert.Equal(t, "failed", failed.Status)
+	assert.Equal(t, "rejected by custodian", failed.Reason)
+	assert.Equal(t, int64(1000), suite.balance(accountID, "ADA").Available)
+}
+
+func (suite *WithdrawalControllerTestSuite) TestWithdraw_NeverTouchesReserved() {
+	t := suite.Suite.T()
+
+	accountID := suite.createAccount("withdraw-reserved", "USDT", 1000)
+
+	orderRes := suite.post("/orders", map[string]any{
+		"account_id": accountID,
+		"instrument": "ADA/USDT",
+		"side":       "buy",
+		"price":      1,
+		"qty":        800,
+	})
+	defer orderRes.Body.Close()
+	require.Equal(t, http.StatusCreated, orderRes.StatusCode)
+
+	res := suite.post("/accounts/"+accountID+"/withdraw", map[string]any{"asset": "USDT", "amount": 300})
+	defer res.Body.Close()
+	require.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+
+	var out errorOutputDtoTest
+	err := json.NewDecoder(res.Body).Decode(&out)
+	require.NoError(t, err)
+	assert.Equal(t, "INSUFFICIENT_AVAILABLE_BALANCE", out.Code)
+
+	balance := suite.balance(accountID, "USDT")
+	assert.Equal(t, int64(200), balance.Available)
+	assert.Equal(t, int64(800), balance.Reserved)
+}
+
+func (suite *WithdrawalControllerTestSuite) TestWithdraw_InvalidAmount() {
+	t := suite.Suite.T()
+
+	res := suite.post("/accounts/some-account/withdraw", map[string]any{"asset": "USDT", "amount": 0})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func (suite *WithdrawalControllerTestSuite) TestWithdraw_AccountNotFound() {
+	t := suite.Suite.T()
+
+	res := suite.post("/accounts/unknown-account/withdraw", map[string]any{"asset": "USDT", "amount": 10})
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func (suite *WithdrawalControllerTestSuite) TestGet_NotFound() {
+	t := suite.Suite.T()
+
+	res, err := http.Get(suite.basePath + "/withdrawals/unknown")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(WithdrawalControllerTestSuite))
+}
@@ -0,0 +1,205 @@
+package withdrawal
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	withdrawalUsecases "github.com/juninhoitabh/clob-go/internal/application/withdrawal/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainWithdrawal "github.com/juninhoitabh/clob-go/internal/domain/withdrawal"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type (
+	withdrawInputDto struct {
+		Asset  string `json:"asset" example:"USDT" validate:"required"`
+		Amount int64  `json:"amount" example:"1000" validate:"required,gt=0"`
+	}
+	failInputDto struct {
+		Reason string `json:"reason" example:"rejected by custodian"`
+	}
+	withdrawalOutputDto struct {
+		WithdrawalID string `json:"withdrawal_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		AccountID    string `json:"account_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+		Asset        string `json:"asset" example:"USDT"`
+		Status       string `json:"status" example:"pending"`
+		Reason       string `json:"reason,omitempty" example:"rejected by custodian"`
+		CreatedAt    string `json:"created_at" example:"2025-01-01T00:00:00Z"`
+		UpdatedAt    string `json:"updated_at" example:"2025-01-01T00:00:00Z"`
+		Amount       int64  `json:"amount" example:"1000"`
+	}
+	WithdrawalController struct {
+		accountRepo    domainAccount.IAccountRepository
+		withdrawalRepo domainWithdrawal.IWithdrawalRepository
+	}
+)
+
+// Withdraw godoc
+// @Summary      Withdraw
+// @Description  Request a withdrawal, debiting Available (never Reserved) and holding the funds while it is pending
+// @Tags         Withdrawals
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string            true  "account_id" Format(uuid)
+// @Param        request   body      withdrawInputDto  true  "withdrawInputDto request"
+// @Success      201       {object}  withdrawalOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      403       {object}  shared.Errors "Account not owned by the caller"
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      422       {object}  shared.Errors "Insufficient available balance"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /accounts/{id}/withdraw [post]
+func (c *WithdrawalController) Withdraw(w http.ResponseWriter, req *http.Request) {
+	id := req.PathValue("id")
+	if id == "" {
+		shared.BadRequestError(w, "missing account_id")
+
+		return
+	}
+
+	var body withdrawInputDto
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		shared.BadRequestError(w, "Invalid JSON", err.Error())
+
+		return
+	}
+
+	if body.Asset == "" || body.Amount <= 0 {
+		shared.BadRequestError(w, "asset and positive amount required")
+
+		return
+	}
+
+	requestWithdrawalUseCase := withdrawalUsecases.NewRequestWithdrawalUseCase(c.accountRepo, c.withdrawalRepo)
+
+	output, err := requestWithdrawalUseCase.Execute(withdrawalUsecases.RequestWithdrawalInput{
+		CallerAccountID: shared.CallerFromContext(req.Context()),
+		AccountID:       id,
+		Asset:           body.Asset,
+		Amount:          body.Amount,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusCreated, newWithdrawalOutputDto(output))
+}
+
+// Get godoc
+// @Summary      Get Withdrawal
+// @Description  Get a withdrawal and its current state
+// @Tags         Withdrawals
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string  true  "withdrawal_id" Format(uuid)
+// @Success      200       {object}  withdrawalOutputDto
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /withdrawals/{id} [get]
+func (c *WithdrawalController) Get(w http.ResponseWriter, req *http.Request) {
+	getWithdrawalUseCase := withdrawalUsecases.NewGetWithdrawalUseCase(c.accountRepo, c.withdrawalRepo)
+
+	output, err := getWithdrawalUseCase.Execute(withdrawalUsecases.GetWithdrawalInput{
+		CallerAccountID: shared.CallerFromContext(req.Context()),
+		WithdrawalID:    req.PathValue("id"),
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newWithdrawalOutputDto(output))
+}
+
+// Complete godoc
+// @Summary      Complete Withdrawal
+// @Description  Mark a pending withdrawal as completed, the held funds leave the exchange
+// @Tags         Withdrawals
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string  true  "withdrawal_id" Format(uuid)
+// @Success      200       {object}  withdrawalOutputDto
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      422       {object}  shared.Errors "Withdrawal is not pending"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /admin/withdrawals/{id}/complete [post]
+func (c *WithdrawalController) Complete(w http.ResponseWriter, req *http.Request) {
+	completeWithdrawalUseCase := withdrawalUsecases.NewCompleteWithdrawalUseCase(c.withdrawalRepo)
+
+	output, err := completeWithdrawalUseCase.Execute(withdrawalUsecases.CompleteWithdrawalInput{
+		WithdrawalID: req.PathValue("id"),
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newWithdrawalOutputDto(output))
+}
+
+// Fail godoc
+// @Summary      Fail Withdrawal
+// @Description  Mark a pending withdrawal as failed, the held funds return to Available
+// @Tags         Withdrawals
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string        true   "withdrawal_id" Format(uuid)
+// @Param        request   body      failInputDto  false  "failInputDto request"
+// @Success      200       {object}  withdrawalOutputDto
+// @Failure      400       {object}  shared.Errors "Bad Request"
+// @Failure      404       {object}  shared.Errors "Not Found"
+// @Failure      422       {object}  shared.Errors "Withdrawal is not pending"
+// @Failure      500       {object}  shared.Errors "Internal Server Error"
+// @Router       /admin/withdrawals/{id}/fail [post]
+func (c *WithdrawalController) Fail(w http.ResponseWriter, req *http.Request) {
+	var body failInputDto
+	if req.ContentLength != 0 {
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			shared.BadRequestError(w, "Invalid JSON", err.Error())
+
+			return
+		}
+	}
+
+	failWithdrawalUseCase := withdrawalUsecases.NewFailWithdrawalUseCase(c.accountRepo, c.withdrawalRepo)
+
+	output, err := failWithdrawalUseCase.Execute(withdrawalUsecases.FailWithdrawalInput{
+		WithdrawalID: req.PathValue("id"),
+		Reason:       body.Reason,
+	})
+	if err != nil {
+		shared.HandleError(w, err)
+
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, newWithdrawalOutputDto(output))
+}
+
+func newWithdrawalOutputDto(output *withdrawalUsecases.WithdrawalOutput) withdrawalOutputDto {
+	return withdrawalOutputDto{
+		WithdrawalID: output.ID,
+		AccountID:    output.AccountID,
+		Asset:        output.Asset,
+		Status:       output.Status,
+		Reason:       output.Reason,
+		CreatedAt:    output.CreatedAt.UTC().Format(time.RFC3339Nano),
+		UpdatedAt:    output.UpdatedAt.UTC().Format(time.RFC3339Nano),
+		Amount:       output.Amount,
+	}
+}
+
+func NewWithdrawalController(
+	accountRepo domainAccount.IAccountRepository,
+	withdrawalRepo domainWithdrawal.IWithdrawalRepository,
+) *WithdrawalController {
+	return &WithdrawalController{
+		accountRepo:    accountRepo,
+		withdrawalRepo: withdrawalRepo,
+	}
+}
@@ -28,6 +28,24 @@ func (suite *InMemoryAccountDAOE2ETestSuite) SetupTest() {
 				"USDT": {Available: 1000, Reserved: 0},
 			},
 		},
+		"sub1": {
+			ParentID: "acc1",
+			Balances: map[string]*account.Balance{
+				"BTC": {Available: 1, Reserved: 1},
+			},
+		},
+		"sub2": {
+			ParentID: "acc1",
+			Balances: map[string]*account.Balance{
+				"ETH": {Available: 5, Reserved: 0},
+			},
+		},
+		"sub1a": {
+			ParentID: "sub1",
+			Balances: map[string]*account.Balance{
+				"USDT": {Available: 50, Reserved: 25},
+			},
+		},
 	}
 	suite.dao = daosAccount.NewInMemoryAccountDAO(mu, accounts)
 }
@@ -41,6 +59,46 @@ func (suite *InMemoryAccountDAOE2ETestSuite) TestSnapshot_Success() {
 	assert.Equal(suite.T(), int64(2), snap.Balances["BTC"].Reserved)
 	assert.Equal(suite.T(), int64(1000), snap.Balances["USDT"].Available)
 	assert.Equal(suite.T(), int64(0), snap.Balances["USDT"].Reserved)
+	assert.Empty(suite.T(), snap.ParentID)
+	assert.Equal(suite.T(), []string{"sub1", "sub2"}, snap.SubAccountIDs)
+}
+
+func (suite *InMemoryAccountDAOE2ETestSuite) TestSnapshot_SubAccount() {
+	snap, err := suite.dao.Snapshot("sub1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "acc1", snap.ParentID)
+	assert.Equal(suite.T(), []string{"sub1a"}, snap.SubAccountIDs)
+}
+
+func (suite *InMemoryAccountDAOE2ETestSuite) TestAggregatedSnapshot_SumsHierarchy() {
+	snap, err := suite.dao.AggregatedSnapshot("acc1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "acc1", snap.AccountID)
+	assert.Equal(suite.T(), map[string]account.Balance{
+		"BTC":  {Available: 11, Reserved: 3},
+		"USDT": {Available: 1050, Reserved: 25},
+		"ETH":  {Available: 5, Reserved: 0},
+	}, snap.Balances)
+
+	ids := make([]string, 0, len(snap.SubAccounts))
+	for _, sub := range snap.SubAccounts {
+		ids = append(ids, sub.AccountID)
+	}
+
+	assert.Equal(suite.T(), []string{"sub1", "sub2", "sub1a"}, ids)
+}
+
+func (suite *InMemoryAccountDAOE2ETestSuite) TestAggregatedSnapshot_Leaf() {
+	snap, err := suite.dao.AggregatedSnapshot("sub2")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]account.Balance{"ETH": {Available: 5}}, snap.Balances)
+	assert.Empty(suite.T(), snap.SubAccounts)
+}
+
+func (suite *InMemoryAccountDAOE2ETestSuite) TestAggregatedSnapshot_NotFound() {
+	snap, err := suite.dao.AggregatedSnapshot("unknown")
+	assert.ErrorIs(suite.T(), err, shared.ErrNotFound)
+	assert.Nil(suite.T(), snap)
 }
 
 func (suite *InMemoryAccountDAOE2ETestSuite) TestSnapshot_NotFound() {
@@ -1,6 +1,7 @@
 package daos
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/juninhoitabh/clob-go/internal/domain/account"
@@ -12,20 +13,97 @@ type InMemoryAccountDAO struct {
 	accounts map[string]*account.Account
 }
 
+// Snapshot copies an account's balances. The repository's lock only
+// guards finding the account; its balances are read under the account's own
+// lock, taken after the repository's is released, as the use cases that
+// change them hold the account's lock while saving to the repository.
 func (dao *InMemoryAccountDAO) Snapshot(id string) (*account.AccountSnapshot, error) {
 	dao.mu.Lock()
-	defer dao.mu.Unlock()
 
 	acct, ok := dao.accounts[id]
 	if !ok {
+		dao.mu.Unlock()
+
+		return nil, shared.ErrNotFound
+	}
+
+	children := dao.children()
+	dao.mu.Unlock()
+
+	snap := dao.snapshot(id, acct, children)
+
+	return &snap, nil
+}
+
+// AggregatedSnapshot sums the balances of an account and every account below
+// it in the hierarchy.
+func (dao *InMemoryAccountDAO) AggregatedSnapshot(id string) (*account.AggregatedAccountSnapshot, error) {
+	dao.mu.Lock()
+
+	acct, ok := dao.accounts[id]
+	if !ok {
+		dao.mu.Unlock()
+
 		return nil, shared.ErrNotFound
 	}
 
-	out := &account.AccountSnapshot{
-		AccountID: id,
-		Balances:  make(map[string]account.Balance, len(acct.Balances)),
+	children := dao.children()
+
+	// Every account below id, found while the repository is held.
+	var (
+		subIDs      []string
+		subAccounts []*account.Account
+	)
+
+	queue := append([]string(nil), children[id]...)
+	for len(queue) > 0 {
+		subID := queue[0]
+		queue = queue[1:]
+
+		subIDs = append(subIDs, subID)
+		subAccounts = append(subAccounts, dao.accounts[subID])
+		queue = append(queue, children[subID]...)
+	}
+
+	dao.mu.Unlock()
+
+	out := &account.AggregatedAccountSnapshot{
+		AccountID:   id,
+		Balances:    make(map[string]account.Balance),
+		SubAccounts: make([]account.AccountSnapshot, 0),
+	}
+
+	add := func(snap account.AccountSnapshot) {
+		for asset, b := range snap.Balances {
+			total := out.Balances[asset]
+			total.Available += b.Available
+			total.Reserved += b.Reserved
+			out.Balances[asset] = total
+		}
+	}
+
+	add(dao.snapshot(id, acct, children))
+
+	for i, subID := range subIDs {
+		snap := dao.snapshot(subID, subAccounts[i], children)
+		out.SubAccounts = append(out.SubAccounts, snap)
+		add(snap)
+	}
+
+	return out, nil
+}
+
+func (dao *InMemoryAccountDAO) snapshot(id string, acct *account.Account, children map[string][]string) account.AccountSnapshot {
+	out := account.AccountSnapshot{
+		AccountID:     id,
+		ParentID:      acct.ParentID,
+		SubAccountIDs: append([]string{}, children[id]...),
+		Balances:      make(map[string]account.Balance, len(acct.Balances)),
 	}
 
+	acct.Lock()
+	defer acct.Unlock()
+
 	for asset, b := range acct.Balances {
 		out.Balances[asset] = account.Balance{
 			Available: b.Available,
@@ -33,7 +111,23 @@ func (dao *InMemoryAccountDAO) Snapshot(id string) (*account.AccountSnapshot, er
 		}
 	}
 
-	return out, nil
+	return out
+}
+
+func (dao *InMemoryAccountDAO) children() map[string][]string {
+	children := make(map[string][]string)
+
+	for id, acct := range dao.accounts {
+		if acct.ParentID != "" {
+			children[acct.ParentID] = append(children[acct.ParentID], id)
+		}
+	}
+
+	for _, ids := range children {
+		sort.Strings(ids)
+	}
+
+	return children
 }
 
 func NewInMemoryAccountDAO(mu *sync.Mutex, accounts map[string]*account.Account) *InMemoryAccountDAO {
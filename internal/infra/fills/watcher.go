@@ -0,0 +1,137 @@
+package fills
+
+import (
+	"sync"
+	"time"
+
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	domainTrade "github.com/juninhoitabh/clob-go/internal/domain/trade"
+)
+
+// lookback re-reads trades this far behind the newest one claimed, since
+// trades from other books may be saved slightly out of order.
+const lookback = time.Second
+
+type (
+	// Fill is one side of a trade, with the order it filled as it is now.
+	Fill struct {
+		Order *domainOrder.Order
+		Trade services.Trade
+	}
+	// Watcher finds the fills of a set of accounts' orders by polling their
+	// trades, reporting each order's side of a trade once.
+	Watcher struct {
+		since     time.Time
+		tradeRepo domainTrade.ITradeRepository
+		getOrder  orderUsecases.IGetOrderUseCase
+		claimed   map[string]time.Time
+		accounts  map[string]bool
+		mu        sync.Mutex
+	}
+)
+
+func (w *Watcher) Watch(accountID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.accounts[accountID] = true
+}
+
+// Claim records that orderID's side of trade is being reported and reports
+// whether it had not been already.
+func (w *Watcher) Claim(trade services.Trade, orderID string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	key := trade.ID() + "/" + orderID
+	if _, ok := w.claimed[key]; ok {
+		return false
+	}
+
+	w.claimed[key] = trade.ExecutedAt
+
+	if trade.ExecutedAt.After(w.since) {
+		w.since = trade.ExecutedAt
+	}
+
+	return true
+}
+
+// Poll claims and returns, oldest first, the fills of watched accounts'
+// orders not claimed yet. callerAccountID scopes the order lookups like any
+// other caller.
+func (w *Watcher) Poll(callerAccountID string) ([]Fill, error) {
+	accounts, since := w.scope()
+
+	var fills []Fill
+
+	for _, accountID := range accounts {
+		trades, err := w.tradeRepo.ListTrades(domainTrade.Filter{AccountID: accountID, Since: since})
+		if err != nil {
+			return fills, err
+		}
+
+		// Trades are listed newest first.
+		for i := len(trades) - 1; i >= 0; i-- {
+			trade := trades[i]
+
+			for _, orderID := range []string{trade.MakerOrderID, trade.TakerOrderID} {
+				getOrderOutput, err := w.getOrder.Execute(orderUsecases.GetOrderInput{
+					OrderID:         orderID,
+					CallerAccountID: callerAccountID,
+				})
+				if err != nil || getOrderOutput.Order.AccountID != accountID || !w.Claim(*trade, orderID) {
+					continue
+				}
+
+				fills = append(fills, Fill{Order: getOrderOutput.Order, Trade: *trade})
+			}
+		}
+	}
+
+	w.prune()
+
+	return fills, nil
+}
+
+func (w *Watcher) scope() ([]string, time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	accounts := make([]string, 0, len(w.accounts))
+	for accountID := range w.accounts {
+		accounts = append(accounts, accountID)
+	}
+
+	return accounts, w.since.Add(-lookback)
+}
+
+func (w *Watcher) prune() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	cutoff := w.since.Add(-2 * lookback)
+
+	for key, executedAt := range w.claimed {
+		if executedAt.Before(cutoff) {
+			delete(w.claimed, key)
+		}
+	}
+}
+
+// NewWatcher starts watching from now, so fills that happened before are
+// never reported.
+func NewWatcher(
+	tradeRepo domainTrade.ITradeRepository,
+	getOrder orderUsecases.IGetOrderUseCase,
+) *Watcher {
+	return &Watcher{
+		since:     time.Now(),
+		tradeRepo: tradeRepo,
+		getOrder:  getOrder,
+		claimed:   make(map[string]time.Time),
+		accounts:  make(map[string]bool),
+	}
+}
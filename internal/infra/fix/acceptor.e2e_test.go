@@ -0,0 +1,605 @@
+//go:build all || e2e || infra
+
+package fix_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	domainRisk "github.com/juninhoitabh/clob-go/internal/domain/risk"
+	"github.com/juninhoitabh/clob-go/internal/infra/fix"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesAPIKey "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey"
+	repositoriesBook "github.com/juninhoitabh/clob-go/internal/infra/repositories/book"
+	repositoriesFee "github.com/juninhoitabh/clob-go/internal/infra/repositories/fee"
+	repositoriesOrder "github.com/juninhoitabh/clob-go/internal/infra/repositories/order"
+	repositoriesRisk "github.com/juninhoitabh/clob-go/internal/infra/repositories/risk"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+const acceptorCompID = "CLOB"
+
+// initiator is a minimal FIX initiator driving the acceptor over TCP.
+type initiator struct {
+	t      *testing.T
+	conn   net.Conn
+	reader *bufio.Reader
+	compID string
+	seq    int64
+}
+
+func dialInitiator(t *testing.T, addr, compID string) *initiator {
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return &initiator{t: t, conn: conn, reader: bufio.NewReader(conn), compID: compID, seq: 1}
+}
+
+func (i *initiator) send(msg *fix.Message) {
+	i.sendSeq(msg, i.seq)
+	i.seq++
+}
+
+func (i *initiator) sendSeq(msg *fix.Message, seq int64) {
+	out := fix.NewMessage(msg.MsgType()).
+		Set(fix.TagSenderCompID, i.compID).
+		Set(fix.TagTargetCompID, acceptorCompID).
+		SetInt(fix.TagMsgSeqNum, seq).
+		Set(fix.TagSendingTime, time.Now().UTC().Format(fix.TimestampLayout))
+
+	out.Fields = append(out.Fields, msg.Fields[1:]...)
+
+	_, err := i.conn.Write(out.Encode())
+	require.NoError(i.t, err)
+}
+
+func (i *initiator) read() (*fix.Message, error) {
+	_ = i.conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+
+	return fix.ReadMessage(i.reader)
+}
+
+// expect returns the next message of msgType, answering test requests and
+// skipping heartbeats on the way.
+func (i *initiator) expect(msgType string) *fix.Message {
+	deadline := time.Now().Add(3 * time.Second)
+
+	for time.Now().Before(deadline) {
+		msg, err := i.read()
+		require.NoError(i.t, err, "waiting for MsgType %s", msgType)
+
+		if msg.MsgType() == msgType {
+			return msg
+		}
+
+		switch msg.MsgType() {
+		case fix.MsgTypeHeartbeat:
+		case fix.MsgTypeTestRequest:
+			testReqID, _ := msg.Get(fix.TagTestReqID)
+
+			i.send(fix.NewMessage(fix.MsgTypeHeartbeat).Set(fix.TagTestReqID, testReqID))
+		default:
+			require.Failf(i.t, "unexpected message", "want MsgType %s, got %s", msgType, msg)
+		}
+	}
+
+	require.Failf(i.t, "timed out", "waiting for MsgType %s", msgType)
+
+	return nil
+}
+
+func (i *initiator) logon(fields ...fix.Field) *fix.Message {
+	logon := fix.NewMessage(fix.MsgTypeLogon).Set(fix.TagEncryptMethod, "0").SetInt(fix.TagHeartBtInt, 1)
+	logon.Fields = append(logon.Fields, fields...)
+
+	i.send(logon)
+
+	return i.expect(fix.MsgTypeLogon)
+}
+
+func (i *initiator) newOrderSingle(clOrdID, instrument, side string, price, qty int64) {
+	i.send(fix.NewMessage(fix.MsgTypeNewOrderSingle).
+		Set(fix.TagClOrdID, clOrdID).
+		Set(fix.TagSymbol, instrument).
+		Set(fix.TagSide, side).
+		Set(fix.TagOrdType, fix.OrdTypeLimit).
+		SetInt(fix.TagPrice, price).
+		SetInt(fix.TagOrderQty, qty).
+		Set(fix.TagTransactTime, time.Now().UTC().Format(fix.TimestampLayout)))
+}
+
+func field(t *testing.T, msg *fix.Message, tag int) string {
+	value, ok := msg.Get(tag)
+	require.True(t, ok, "tag %d missing from %s", tag, msg)
+
+	return value
+}
+
+type FixAcceptorE2ETestSuite struct {
+	suite.Suite
+	accountRepo *repositoriesAccount.InMemoryAccountRepository
+	apiKeyRepo  *repositoriesAPIKey.InMemoryAPIKeyRepository
+	gateway     *fix.Gateway
+	cancel      context.CancelFunc
+	addr        string
+	accounts    int
+}
+
+func (suite *FixAcceptorE2ETestSuite) SetupTest() {
+	repositoriesAccount.ResetInMemoryAccountRepository()
+	repositoriesAPIKey.ResetInMemoryAPIKeyRepository()
+	repositoriesBook.ResetInMemoryBookRepository()
+	repositoriesOrder.ResetInMemoryOrderRepository()
+	repositoriesTrade.ResetInMemoryTradeRepository()
+	repositoriesFee.ResetInMemoryScheduleRepository()
+	repositoriesRisk.ResetInMemoryLimitsRepository()
+
+	suite.accountRepo = repositoriesAccount.NewInMemoryAccountRepository()
+	suite.apiKeyRepo = repositoriesAPIKey.NewInMemoryAPIKeyRepository()
+	bookRepo := repositoriesBook.NewInMemoryBookRepository()
+	orderRepo := repositoriesOrder.NewInMemoryOrderRepository()
+	tradeRepo := repositoriesTrade.NewInMemoryTradeRepository()
+
+	suite.gateway = fix.NewGateway(
+		orderUsecases.NewPlaceOrderUseCase(
+			bookRepo,
+			orderRepo,
+			suite.accountRepo,
+			repositoriesFee.NewInMemoryScheduleRepository(),
+			tradeRepo,
+			domainBook.CircuitBreakerProps{},
+			domainFee.FeeProps{AccountID: "fees"},
+			domainRisk.NewDefaultChain(repositoriesRisk.NewInMemoryLimitsRepository()),
+		),
+		orderUsecases.NewCancelOrderUseCase(bookRepo, orderRepo, suite.accountRepo),
+		orderUsecases.NewGetOrderUseCase(orderRepo, suite.accountRepo),
+		tradeRepo,
+		20*time.Millisecond,
+		log.New(io.Discard, "", 0),
+	)
+
+	suite.serve(nil)
+}
+
+func (suite *FixAcceptorE2ETestSuite) TearDownTest() {
+	suite.cancel()
+}
+
+func (suite *FixAcceptorE2ETestSuite) serve(authenticate apikeyUsecases.IAuthenticateUseCase) {
+	if suite.cancel != nil {
+		suite.cancel()
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(suite.T(), err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	suite.cancel = cancel
+	suite.addr = listener.Addr().String()
+
+	acceptor := fix.NewAcceptor(acceptorCompID, suite.gateway, authenticate, log.New(io.Discard, "", 0))
+
+	go func() { _ = acceptor.Serve(ctx, listener) }()
+}
+
+func (suite *FixAcceptorE2ETestSuite) newAccount(asset string, amount int64) *domainAccount.Account {
+	suite.accounts++
+
+	acct, err := domainAccount.NewAccount(domainAccount.AccountProps{Name: fmt.Sprintf("fix-%d", suite.accounts)}, idObjValue.Uuid)
+	require.NoError(suite.T(), err)
+	require.NoError(suite.T(), acct.Credit(asset, amount))
+	require.NoError(suite.T(), suite.accountRepo.Create(acct))
+
+	return acct
+}
+
+func (suite *FixAcceptorE2ETestSuite) TestLogon_HeartbeatsAndTestRequest() {
+	t := suite.T()
+
+	client := dialInitiator(t, suite.addr, "OMS-HB")
+
+	logon := client.logon()
+	assert.Equal(t, "1", field(t, logon, fix.TagMsgSeqNum))
+	assert.Equal(t, "1", field(t, logon, fix.TagHeartBtInt))
+	assert.Equal(t, acceptorCompID, field(t, logon, fix.TagSenderCompID))
+	assert.Equal(t, "OMS-HB", field(t, logon, fix.TagTargetCompID))
+
+	client.send(fix.NewMessage(fix.MsgTypeTestRequest).Set(fix.TagTestReqID, "ping"))
+
+	heartbeat := client.expect(fix.MsgTypeHeartbeat)
+	assert.Equal(t, "ping", field(t, heartbeat, fix.TagTestReqID))
+
+	idle, err := client.read()
+	require.NoError(t, err)
+	assert.Contains(t, []string{fix.MsgTypeHeartbeat, fix.MsgTypeTestRequest}, idle.MsgType())
+
+	client.send(fix.NewMessage(fix.MsgTypeLogout))
+	client.expect(fix.MsgTypeLogout)
+}
+
+func (suite *FixAcceptorE2ETestSuite) TestNewOrderSingle_AcksAndFillsBothSides() {
+	t := suite.T()
+
+	seller := suite.newAccount("XRP", 100)
+	buyer := suite.newAccount("USDT", 10_000)
+
+	sellerClient := dialInitiator(t, suite.addr, seller.GetID())
+	sellerClient.logon()
+
+	sellerClient.newOrderSingle("sell-1", "XRP/USDT", "2", 100, 10)
+
+	sellerAck := sellerClient.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeNew, field(t, sellerAck, fix.TagExecType))
+	assert.Equal(t, fix.OrdStatusNew, field(t, sellerAck, fix.TagOrdStatus))
+	assert.Equal(t, "sell-1", field(t, sellerAck, fix.TagClOrdID))
+	assert.Equal(t, "10", field(t, sellerAck, fix.TagLeavesQty))
+
+	buyerClient := dialInitiator(t, suite.addr, buyer.GetID())
+	buyerClient.logon()
+
+	buyerClient.newOrderSingle("buy-1", "XRP/USDT", "1", 100, 4)
+
+	buyerAck := buyerClient.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeNew, field(t, buyerAck, fix.TagExecType))
+
+	buyerFill := buyerClient.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeTrade, field(t, buyerFill, fix.TagExecType))
+	assert.Equal(t, fix.OrdStatusFilled, field(t, buyerFill, fix.TagOrdStatus))
+	assert.Equal(t, "4", field(t, buyerFill, fix.TagLastQty))
+	assert.Equal(t, "100", field(t, buyerFill, fix.TagLastPx))
+	assert.Equal(t, "4", field(t, buyerFill, fix.TagCumQty))
+	assert.Equal(t, "0", field(t, buyerFill, fix.TagLeavesQty))
+
+	sellerFill := sellerClient.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeTrade, field(t, sellerFill, fix.TagExecType))
+	assert.Equal(t, fix.OrdStatusPartiallyFilled, field(t, sellerFill, fix.TagOrdStatus))
+	assert.Equal(t, "sell-1", field(t, sellerFill, fix.TagClOrdID))
+	assert.Equal(t, "4", field(t, sellerFill, fix.TagLastQty))
+	assert.Equal(t, "4", field(t, sellerFill, fix.TagCumQty))
+	assert.Equal(t, "6", field(t, sellerFill, fix.TagLeavesQty))
+	assert.Equal(t, field(t, buyerFill, fix.TagTradeID), field(t, sellerFill, fix.TagTradeID))
+}
+
+func (suite *FixAcceptorE2ETestSuite) TestNewOrderSingle_HaltCancelsRemaining() {
+	t := suite.T()
+
+	seller := suite.newAccount("NEAR", 2)
+	buyer := suite.newAccount("USDT", 1_000)
+
+	sellerClient := dialInitiator(t, suite.addr, seller.GetID())
+	sellerClient.logon()
+
+	sellerClient.newOrderSingle("sell-1", "NEAR/USDT", "2", 100, 1)
+	sellerClient.expect(fix.MsgTypeExecutionReport)
+	sellerClient.newOrderSingle("sell-2", "NEAR/USDT", "2", 200, 1)
+	sellerClient.expect(fix.MsgTypeExecutionReport)
+
+	b, err := repositoriesBook.NewInMemoryBookRepository().GetBook("NEAR/USDT")
+	require.NoError(t, err)
+	b.Lock()
+	b.CircuitBreaker.Configure(domainBook.CircuitBreakerProps{MoveBps: 1000, Window: time.Minute, HaltDuration: time.Minute})
+	b.Unlock()
+
+	buyerClient := dialInitiator(t, suite.addr, buyer.GetID())
+	buyerClient.logon()
+
+	buyerClient.newOrderSingle("buy-1", "NEAR/USDT", "1", 200, 2)
+
+	buyerAck := buyerClient.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeNew, field(t, buyerAck, fix.TagExecType))
+
+	buyerFill := buyerClient.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeTrade, field(t, buyerFill, fix.TagExecType))
+	assert.Equal(t, "1", field(t, buyerFill, fix.TagCumQty))
+
+	halted := buyerClient.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeCanceled, field(t, halted, fix.TagExecType))
+	assert.Equal(t, fix.OrdStatusCanceled, field(t, halted, fix.TagOrdStatus))
+	assert.Equal(t, "buy-1", field(t, halted, fix.TagClOrdID))
+	assert.Equal(t, "1", field(t, halted, fix.TagCumQty))
+	assert.Equal(t, "0", field(t, halted, fix.TagLeavesQty))
+	assert.Contains(t, field(t, halted, fix.TagText), "INSTRUMENT_HALTED")
+	assert.Equal(t, int64(900), buyer.Balances["USDT"].Available)
+}
+
+func (suite *FixAcceptorE2ETestSuite) TestNewOrderSingle_Rejected() {
+	t := suite.T()
+
+	buyer := suite.newAccount("USDT", 100)
+
+	client := dialInitiator(t, suite.addr, buyer.GetID())
+	client.logon()
+
+	client.send(fix.NewMessage(fix.MsgTypeNewOrderSingle).
+		Set(fix.TagClOrdID, "market-1").
+		Set(fix.TagSymbol, "ADA/USDT").
+		Set(fix.TagSide, "1").
+		Set(fix.TagOrdType, "1").
+		SetInt(fix.TagOrderQty, 1))
+
+	unsupported := client.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeRejected, field(t, unsupported, fix.TagExecType))
+	assert.Equal(t, fix.OrdStatusRejected, field(t, unsupported, fix.TagOrdStatus))
+	assert.Equal(t, "market-1", field(t, unsupported, fix.TagClOrdID))
+
+	client.newOrderSingle("too-big", "ADA/USDT", "1", 100, 10)
+
+	insufficient := client.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeRejected, field(t, insufficient, fix.TagExecType))
+	assert.Equal(t, "NONE", field(t, insufficient, fix.TagOrderID))
+	assert.NotEmpty(t, field(t, insufficient, fix.TagText))
+}
+
+func (suite *FixAcceptorE2ETestSuite) TestOrderCancelRequest() {
+	t := suite.T()
+
+	buyer := suite.newAccount("USDT", 1_000)
+
+	client := dialInitiator(t, suite.addr, buyer.GetID())
+	client.logon()
+
+	client.newOrderSingle("rest-1", "DOT/USDT", "1", 10, 10)
+	ack := client.expect(fix.MsgTypeExecutionReport)
+
+	client.send(fix.NewMessage(fix.MsgTypeOrderCancelRequest).
+		Set(fix.TagClOrdID, "cxl-1").
+		Set(fix.TagOrigClOrdID, "rest-1").
+		Set(fix.TagSymbol, "DOT/USDT").
+		Set(fix.TagSide, "1"))
+
+	canceled := client.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeCanceled, field(t, canceled, fix.TagExecType))
+	assert.Equal(t, fix.OrdStatusCanceled, field(t, canceled, fix.TagOrdStatus))
+	assert.Equal(t, "cxl-1", field(t, canceled, fix.TagClOrdID))
+	assert.Equal(t, "rest-1", field(t, canceled, fix.TagOrigClOrdID))
+	assert.Equal(t, field(t, ack, fix.TagOrderID), field(t, canceled, fix.TagOrderID))
+	assert.Equal(t, int64(1_000), buyer.Balances["USDT"].Available)
+
+	client.send(fix.NewMessage(fix.MsgTypeOrderCancelRequest).
+		Set(fix.TagClOrdID, "cxl-2").
+		Set(fix.TagOrigClOrdID, "rest-1"))
+
+	tooLate := client.expect(fix.MsgTypeOrderCancelReject)
+	assert.Equal(t, "0", field(t, tooLate, fix.TagCxlRejReason))
+	assert.Equal(t, fix.CxlRejResponseToCancel, field(t, tooLate, fix.TagCxlRejResponseTo))
+	assert.Equal(t, fix.OrdStatusCanceled, field(t, tooLate, fix.TagOrdStatus))
+
+	client.newOrderSingle("rest-1", "DOT/USDT", "1", 10, 10)
+
+	status := client.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeOrderStatus, field(t, status, fix.TagExecType))
+	assert.Equal(t, fix.OrdStatusCanceled, field(t, status, fix.TagOrdStatus))
+	assert.Equal(t, "0", field(t, status, fix.TagCumQty))
+	assert.Equal(t, "0", field(t, status, fix.TagLeavesQty))
+
+	client.send(fix.NewMessage(fix.MsgTypeOrderCancelRequest).
+		Set(fix.TagClOrdID, "cxl-3").
+		Set(fix.TagOrigClOrdID, "unknown"))
+
+	unknown := client.expect(fix.MsgTypeOrderCancelReject)
+	assert.Equal(t, "1", field(t, unknown, fix.TagCxlRejReason))
+	assert.Equal(t, "NONE", field(t, unknown, fix.TagOrderID))
+}
+
+func (suite *FixAcceptorE2ETestSuite) TestOrderCancelReplaceRequest() {
+	t := suite.T()
+
+	buyer := suite.newAccount("USDT", 1_000)
+
+	client := dialInitiator(t, suite.addr, buyer.GetID())
+	client.logon()
+
+	client.newOrderSingle("orig-1", "SOL/USDT", "1", 90, 10)
+	ack := client.expect(fix.MsgTypeExecutionReport)
+
+	client.send(fix.NewMessage(fix.MsgTypeOrderCancelReplaceRequest).
+		Set(fix.TagClOrdID, "repl-1").
+		Set(fix.TagOrigClOrdID, "orig-1").
+		Set(fix.TagSymbol, "SOL/USDT").
+		Set(fix.TagSide, "1").
+		Set(fix.TagOrdType, fix.OrdTypeLimit).
+		SetInt(fix.TagPrice, 95).
+		SetInt(fix.TagOrderQty, 5))
+
+	replaced := client.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeReplaced, field(t, replaced, fix.TagExecType))
+	assert.Equal(t, "repl-1", field(t, replaced, fix.TagClOrdID))
+	assert.Equal(t, "orig-1", field(t, replaced, fix.TagOrigClOrdID))
+	assert.Equal(t, "95", field(t, replaced, fix.TagPrice))
+	assert.Equal(t, "5", field(t, replaced, fix.TagOrderQty))
+	assert.NotEqual(t, field(t, ack, fix.TagOrderID), field(t, replaced, fix.TagOrderID))
+	assert.Equal(t, int64(5*95), buyer.Balances["USDT"].Reserved)
+
+	client.send(fix.NewMessage(fix.MsgTypeOrderCancelReplaceRequest).
+		Set(fix.TagClOrdID, "repl-2").
+		Set(fix.TagOrigClOrdID, "repl-1").
+		Set(fix.TagSymbol, "SOL/USDT").
+		Set(fix.TagSide, "2").
+		Set(fix.TagOrdType, fix.OrdTypeLimit).
+		SetInt(fix.TagPrice, 95).
+		SetInt(fix.TagOrderQty, 5))
+
+	rejected := client.expect(fix.MsgTypeOrderCancelReject)
+	assert.Equal(t, fix.CxlRejResponseToReplace, field(t, rejected, fix.TagCxlRejResponseTo))
+	assert.Equal(t, int64(5*95), buyer.Balances["USDT"].Reserved)
+}
+
+func (suite *FixAcceptorE2ETestSuite) TestUnsupportedMessage_BusinessReject() {
+	t := suite.T()
+
+	client := dialInitiator(t, suite.addr, "OMS-BR")
+	client.logon()
+
+	client.send(fix.NewMessage("AE"))
+
+	reject := client.expect(fix.MsgTypeBusinessMessageReject)
+	assert.Equal(t, "AE", field(t, reject, fix.TagRefMsgType))
+	assert.Equal(t, "2", field(t, reject, fix.TagRefSeqNum))
+}
+
+func (suite *FixAcceptorE2ETestSuite) TestSequenceGap_RequestsResend() {
+	t := suite.T()
+
+	client := dialInitiator(t, suite.addr, "OMS-GAP")
+	client.logon()
+
+	client.sendSeq(fix.NewMessage(fix.MsgTypeTestRequest).Set(fix.TagTestReqID, "lost"), 5)
+
+	resendRequest := client.expect(fix.MsgTypeResendRequest)
+	assert.Equal(t, "2", field(t, resendRequest, fix.TagBeginSeqNo))
+	assert.Equal(t, "0", field(t, resendRequest, fix.TagEndSeqNo))
+
+	client.sendSeq(fix.NewMessage(fix.MsgTypeSequenceReset).
+		Set(fix.TagPossDupFlag, "Y").
+		Set(fix.TagGapFillFlag, "Y").
+		SetInt(fix.TagNewSeqNo, 5), 2)
+	client.sendSeq(fix.NewMessage(fix.MsgTypeTestRequest).
+		Set(fix.TagPossDupFlag, "Y").
+		Set(fix.TagTestReqID, "recovered"), 5)
+	client.seq = 6
+
+	heartbeat := client.expect(fix.MsgTypeHeartbeat)
+	for field(t, heartbeat, fix.TagTestReqID) != "recovered" {
+		heartbeat = client.expect(fix.MsgTypeHeartbeat)
+	}
+
+	client.sendSeq(fix.NewMessage(fix.MsgTypeHeartbeat), 3)
+
+	logout := client.expect(fix.MsgTypeLogout)
+	assert.Contains(t, field(t, logout, fix.TagText), "MsgSeqNum too low")
+}
+
+func (suite *FixAcceptorE2ETestSuite) TestResendRequest_ReplaysApplicationMessages() {
+	t := suite.T()
+
+	buyer := suite.newAccount("USDT", 1_000)
+
+	client := dialInitiator(t, suite.addr, buyer.GetID())
+	client.logon()
+
+	client.newOrderSingle("resend-1", "LTC/USDT", "1", 10, 1)
+	ack := client.expect(fix.MsgTypeExecutionReport)
+
+	client.send(fix.NewMessage(fix.MsgTypeResendRequest).SetInt(fix.TagBeginSeqNo, 1).SetInt(fix.TagEndSeqNo, 0))
+
+	gapFill := client.expect(fix.MsgTypeSequenceReset)
+	assert.Equal(t, "1", field(t, gapFill, fix.TagMsgSeqNum))
+	assert.Equal(t, "Y", field(t, gapFill, fix.TagGapFillFlag))
+	assert.Equal(t, "2", field(t, gapFill, fix.TagNewSeqNo))
+
+	replayed := client.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, "2", field(t, replayed, fix.TagMsgSeqNum))
+	assert.Equal(t, "Y", field(t, replayed, fix.TagPossDupFlag))
+	assert.Equal(t, field(t, ack, fix.TagSendingTime), field(t, replayed, fix.TagOrigSendingTime))
+	assert.Equal(t, field(t, ack, fix.TagExecID), field(t, replayed, fix.TagExecID))
+}
+
+func (suite *FixAcceptorE2ETestSuite) TestReconnect_KeepsSequenceNumbers() {
+	t := suite.T()
+
+	client := dialInitiator(t, suite.addr, "OMS-RECONNECT")
+	client.logon()
+
+	client.send(fix.NewMessage(fix.MsgTypeLogout))
+	logout := client.expect(fix.MsgTypeLogout)
+	assert.Equal(t, "2", field(t, logout, fix.TagMsgSeqNum))
+
+	_, err := client.read()
+	assert.ErrorIs(t, err, io.EOF)
+
+	reconnected := dialInitiator(t, suite.addr, "OMS-RECONNECT")
+	reconnected.seq = client.seq
+
+	logon := reconnected.logon()
+	assert.Equal(t, "3", field(t, logon, fix.TagMsgSeqNum))
+
+	duplicate := dialInitiator(t, suite.addr, "OMS-RECONNECT")
+	duplicate.send(fix.NewMessage(fix.MsgTypeLogon).Set(fix.TagEncryptMethod, "0").SetInt(fix.TagHeartBtInt, 1))
+
+	_, err = duplicate.read()
+	assert.ErrorIs(t, err, io.EOF)
+
+	reconnected.send(fix.NewMessage(fix.MsgTypeLogout))
+	reconnected.expect(fix.MsgTypeLogout)
+
+	reset := dialInitiator(t, suite.addr, "OMS-RECONNECT")
+
+	logon = reset.logon(fix.Field{Tag: fix.TagResetSeqNumFlag, Value: "Y"})
+	assert.Equal(t, "1", field(t, logon, fix.TagMsgSeqNum))
+	assert.Equal(t, "Y", field(t, logon, fix.TagResetSeqNumFlag))
+}
+
+func (suite *FixAcceptorE2ETestSuite) TestLogon_Authenticated() {
+	t := suite.T()
+
+	suite.serve(apikeyUsecases.NewAuthenticateUseCase(suite.apiKeyRepo, time.Minute))
+
+	trader := suite.newAccount("USDT", 1_000)
+
+	key, err := domainAPIKey.NewAPIKey(domainAPIKey.APIKeyProps{AccountID: trader.GetID()}, idObjValue.Uuid)
+	require.NoError(t, err)
+	require.NoError(t, suite.apiKeyRepo.SaveAPIKey(key))
+
+	signedLogon := func(compID, secret string) *fix.Message {
+		now := time.Now().UTC()
+
+		return fix.NewMessage(fix.MsgTypeLogon).
+			Set(fix.TagEncryptMethod, "0").
+			SetInt(fix.TagHeartBtInt, 1).
+			Set(fix.TagUsername, key.GetID()).
+			Set(fix.TagPassword, domainAPIKey.Sign(secret, now.Unix(), fix.LogonSignatureMethod, fix.LogonSignaturePath, []byte(compID)))
+	}
+
+	rejected := dialInitiator(t, suite.addr, "OMS-AUTH")
+	rejected.send(signedLogon("OMS-AUTH", "wrong-secret"))
+
+	_, err = rejected.read()
+	assert.ErrorIs(t, err, io.EOF)
+
+	client := dialInitiator(t, suite.addr, "OMS-AUTH")
+	client.send(signedLogon("OMS-AUTH", key.Secret))
+	client.expect(fix.MsgTypeLogon)
+
+	client.newOrderSingle("auth-1", "BNB/USDT", "1", 10, 1)
+
+	ack := client.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeNew, field(t, ack, fix.TagExecType))
+	assert.Equal(t, trader.GetID(), field(t, ack, fix.TagAccount))
+
+	other := suite.newAccount("USDT", 1_000)
+
+	client.send(fix.NewMessage(fix.MsgTypeNewOrderSingle).
+		Set(fix.TagClOrdID, "auth-2").
+		Set(fix.TagAccount, other.GetID()).
+		Set(fix.TagSymbol, "BNB/USDT").
+		Set(fix.TagSide, "1").
+		Set(fix.TagOrdType, fix.OrdTypeLimit).
+		SetInt(fix.TagPrice, 10).
+		SetInt(fix.TagOrderQty, 1))
+
+	forbidden := client.expect(fix.MsgTypeExecutionReport)
+	assert.Equal(t, fix.ExecTypeRejected, field(t, forbidden, fix.TagExecType))
+}
+
+func TestFixAcceptor(t *testing.T) {
+	suite.Run(t, new(FixAcceptorE2ETestSuite))
+}
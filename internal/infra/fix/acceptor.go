@@ -0,0 +1,145 @@
+package fix
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	"github.com/juninhoitabh/clob-go/internal/infra/fills"
+)
+
+// A logon authenticates like an HTTP request signed with an API key: Username
+// (553) carries the key, Password (554) the signature of the initiator's
+// SenderCompID as body, SendingTime as timestamp and this method and path.
+const (
+	LogonSignatureMethod = "FIX"
+	LogonSignaturePath   = "LOGON"
+	DefaultLogonTimeout  = 10 * time.Second
+	maxStoredMessages    = 10000
+)
+
+var ErrAlreadyLoggedOn = errors.New("fix: session already logged on")
+
+type (
+	storedMessage struct {
+		msg         *Message
+		sendingTime string
+	}
+	// sessionState outlives connections, so sequence numbers, the resend
+	// store and the fill cursor survive a reconnect as FIX requires.
+	sessionState struct {
+		sent   map[int64]storedMessage
+		fills  *fills.Watcher
+		inSeq  int64
+		outSeq int64
+		mu     sync.Mutex
+		active bool
+	}
+	Acceptor struct {
+		gateway      *Gateway
+		authenticate apikeyUsecases.IAuthenticateUseCase
+		logger       *log.Logger
+		states       map[string]*sessionState
+		compID       string
+		logonTimeout time.Duration
+		mu           sync.Mutex
+	}
+)
+
+// Serve runs a session per connection accepted on listener until ctx is done.
+func (a *Acceptor) Serve(ctx context.Context, listener net.Listener) error {
+	stop := context.AfterFunc(ctx, func() { _ = listener.Close() })
+	defer stop()
+
+	var sessions sync.WaitGroup
+	defer sessions.Wait()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		sessions.Add(1)
+
+		go func() {
+			defer sessions.Done()
+
+			newSession(a, conn).run(ctx)
+		}()
+	}
+}
+
+func (a *Acceptor) ListenAndServe(ctx context.Context, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return a.Serve(ctx, listener)
+}
+
+func (a *Acceptor) attach(targetCompID string) (*sessionState, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	state := a.states[targetCompID]
+	if state == nil {
+		state = &sessionState{fills: fills.NewWatcher(a.gateway.tradeRepo, a.gateway.getOrder)}
+		state.reset()
+
+		a.states[targetCompID] = state
+	}
+
+	if state.active {
+		return nil, ErrAlreadyLoggedOn
+	}
+
+	state.active = true
+
+	return state, nil
+}
+
+func (a *Acceptor) detach(state *sessionState) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	state.active = false
+}
+
+// reset restarts both sequence numbers at 1; callers hold mu or own state.
+func (s *sessionState) reset() {
+	s.inSeq = 1
+	s.outSeq = 1
+	s.sent = make(map[int64]storedMessage)
+}
+
+func (s *sessionState) store(seq int64, msg storedMessage) {
+	s.sent[seq] = msg
+
+	delete(s.sent, seq-maxStoredMessages)
+}
+
+func NewAcceptor(
+	compID string,
+	gateway *Gateway,
+	authenticate apikeyUsecases.IAuthenticateUseCase,
+	logger *log.Logger,
+) *Acceptor {
+	return &Acceptor{
+		gateway:      gateway,
+		authenticate: authenticate,
+		logger:       logger,
+		states:       make(map[string]*sessionState),
+		compID:       compID,
+		logonTimeout: DefaultLogonTimeout,
+	}
+}
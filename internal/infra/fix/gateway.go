@@ -0,0 +1,466 @@
+package fix
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	domainTrade "github.com/juninhoitabh/clob-go/internal/domain/trade"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+const (
+	ExecTypeNew         = "0"
+	ExecTypeCanceled    = "4"
+	ExecTypeReplaced    = "5"
+	ExecTypeRejected    = "8"
+	ExecTypeTrade       = "F"
+	ExecTypeOrderStatus = "I"
+
+	OrdStatusNew             = "0"
+	OrdStatusPartiallyFilled = "1"
+	OrdStatusFilled          = "2"
+	OrdStatusCanceled        = "4"
+	OrdStatusRejected        = "8"
+
+	OrdTypeLimit = "2"
+
+	CxlRejResponseToCancel  = "1"
+	CxlRejResponseToReplace = "2"
+)
+
+const (
+	cxlRejReasonTooLate      = 0
+	cxlRejReasonUnknownOrder = 1
+	cxlRejReasonOther        = 99
+	ordRejReasonOther        = 99
+
+	businessRejectUnsupportedMsgType = 3
+)
+
+var ErrUnsupportedOrder = errors.New("fix: only day or good-till-cancel limit orders are supported")
+
+// Gateway translates order-entry messages into the order use cases and
+// answers them with ExecutionReports and OrderCancelRejects.
+type Gateway struct {
+	placeOrder   orderUsecases.IPlaceOrderUseCase
+	cancelOrder  orderUsecases.ICancelOrderUseCase
+	getOrder     orderUsecases.IGetOrderUseCase
+	tradeRepo    domainTrade.ITradeRepository
+	logger       *log.Logger
+	fillInterval time.Duration
+	execIDs      atomic.Int64
+}
+
+func (g *Gateway) handle(s *session, msg *Message) {
+	switch msg.MsgType() {
+	case MsgTypeNewOrderSingle:
+		g.newOrderSingle(s, msg)
+	case MsgTypeOrderCancelRequest:
+		g.orderCancelRequest(s, msg)
+	case MsgTypeOrderCancelReplaceRequest:
+		g.orderCancelReplaceRequest(s, msg)
+	}
+}
+
+func (g *Gateway) newOrderSingle(s *session, msg *Message) {
+	input, err := g.placeInput(s, msg)
+	if err != nil {
+		g.rejectOrder(s, msg, err)
+
+		return
+	}
+
+	g.place(s, msg, input, ExecTypeNew, "")
+}
+
+// place runs input and reports the acknowledgement with execType, then one
+// Trade report per fill of the new order and, when the instrument halted
+// during the match, the cancel of what it had left.
+func (g *Gateway) place(s *session, msg *Message, input orderUsecases.PlaceOrderInput, execType, origClOrdID string) bool {
+	placeOrderOutput, err := g.placeOrder.Execute(input)
+	if err != nil {
+		g.rejectOrder(s, msg, err)
+
+		return false
+	}
+
+	order := placeOrderOutput.Order
+
+	s.state.fills.Watch(order.AccountID)
+
+	if placeOrderOutput.Replayed {
+		s.send(g.executionReport(order, ExecTypeOrderStatus, ordStatus(order), order.Filled()).
+			SetInt(TagLeavesQty, order.Remaining))
+
+		return true
+	}
+
+	ack := g.executionReport(order, execType, OrdStatusNew, 0)
+	if origClOrdID != "" {
+		ack.Set(TagOrigClOrdID, origClOrdID)
+	}
+
+	s.send(ack)
+
+	cumQty := int64(0)
+
+	for _, trade := range placeOrderOutput.TradeReport.Trades {
+		cumQty += trade.Qty
+
+		if s.state.fills.Claim(trade, order.GetID()) {
+			s.send(g.fillReport(order, trade, cumQty))
+		}
+	}
+
+	if placeOrderOutput.CanceledQty > 0 {
+		s.send(g.executionReport(order, ExecTypeCanceled, OrdStatusCanceled, cumQty).
+			SetInt(TagLeavesQty, 0).
+			Set(TagText, errorText(domainBook.ErrInstrumentHalted)))
+	}
+
+	return true
+}
+
+func (g *Gateway) orderCancelRequest(s *session, msg *Message) {
+	order, err := g.origOrder(s, msg)
+	if err != nil {
+		g.rejectCancel(s, msg, nil, CxlRejResponseToCancel, err)
+
+		return
+	}
+
+	cumQty := order.Filled()
+
+	err = g.cancel(s, order)
+	if err != nil {
+		g.rejectCancel(s, msg, order, CxlRejResponseToCancel, err)
+
+		return
+	}
+
+	s.send(g.canceledReport(msg, order, cumQty))
+}
+
+// orderCancelReplaceRequest cancels the original order and places its
+// replacement for the new total quantity less what already filled. The two
+// steps are not atomic and the replacement always loses time priority.
+func (g *Gateway) orderCancelReplaceRequest(s *session, msg *Message) {
+	order, err := g.origOrder(s, msg)
+	if err != nil {
+		g.rejectCancel(s, msg, nil, CxlRejResponseToReplace, err)
+
+		return
+	}
+
+	input, err := g.placeInput(s, msg)
+	if err != nil {
+		g.rejectCancel(s, msg, order, CxlRejResponseToReplace, err)
+
+		return
+	}
+
+	cumQty := order.Filled()
+	side, _ := msg.Get(TagSide)
+
+	switch {
+	case input.AccountID != order.AccountID || input.Instrument != order.Instrument || side != fixSide(order.Side):
+		err = fmt.Errorf("%w: account, symbol and side cannot be replaced", shared.ErrInvalidParam)
+	case input.Qty <= cumQty:
+		err = fmt.Errorf("%w: OrderQty must exceed the filled quantity", shared.ErrInvalidParam)
+	}
+
+	if err != nil {
+		g.rejectCancel(s, msg, order, CxlRejResponseToReplace, err)
+
+		return
+	}
+
+	err = g.cancel(s, order)
+	if err != nil {
+		g.rejectCancel(s, msg, order, CxlRejResponseToReplace, err)
+
+		return
+	}
+
+	input.Qty -= cumQty
+
+	origClOrdID, _ := msg.Get(TagOrigClOrdID)
+
+	if !g.place(s, msg, input, ExecTypeReplaced, origClOrdID) {
+		s.send(g.canceledReport(msg, order, cumQty))
+	}
+}
+
+func (g *Gateway) origOrder(s *session, msg *Message) (*domainOrder.Order, error) {
+	input := orderUsecases.GetOrderInput{CallerAccountID: s.callerAccountID}
+
+	if orderID, _ := msg.Get(TagOrderID); orderID != "" && orderID != "NONE" {
+		input.OrderID = orderID
+	} else {
+		origClOrdID, err := msg.GetString(TagOrigClOrdID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", shared.ErrInvalidParam, err)
+		}
+
+		input.AccountID = s.account(msg)
+		input.ClientOrderID = origClOrdID
+	}
+
+	getOrderOutput, err := g.getOrder.Execute(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return getOrderOutput.Order, nil
+}
+
+func (g *Gateway) cancel(s *session, order *domainOrder.Order) error {
+	_, err := g.cancelOrder.Execute(orderUsecases.CancelOrderInput{
+		OrderID:         order.GetID(),
+		CallerAccountID: s.callerAccountID,
+	})
+
+	return err
+}
+
+func (g *Gateway) placeInput(s *session, msg *Message) (orderUsecases.PlaceOrderInput, error) {
+	clOrdID, err := msg.GetString(TagClOrdID)
+	if err != nil {
+		return orderUsecases.PlaceOrderInput{}, fmt.Errorf("%w: %v", shared.ErrInvalidParam, err)
+	}
+
+	input := orderUsecases.PlaceOrderInput{
+		AccountID:       s.account(msg),
+		ClientOrderID:   clOrdID,
+		CallerAccountID: s.callerAccountID,
+	}
+
+	input.Instrument, err = msg.GetString(TagSymbol)
+	if err != nil {
+		return input, fmt.Errorf("%w: %v", shared.ErrInvalidParam, err)
+	}
+
+	side, _ := msg.Get(TagSide)
+
+	switch side {
+	case "1":
+		input.Side = "buy"
+	case "2":
+		input.Side = "sell"
+	default:
+		return input, fmt.Errorf("%w: Side must be 1 (buy) or 2 (sell)", shared.ErrInvalidParam)
+	}
+
+	ordType, _ := msg.Get(TagOrdType)
+	timeInForce, _ := msg.Get(TagTimeInForce)
+
+	if ordType != OrdTypeLimit || (timeInForce != "" && timeInForce != "0" && timeInForce != "1") {
+		return input, ErrUnsupportedOrder
+	}
+
+	input.Price, err = msg.GetInt(TagPrice)
+	if err != nil {
+		return input, fmt.Errorf("%w: %v", shared.ErrInvalidParam, err)
+	}
+
+	input.Qty, err = msg.GetInt(TagOrderQty)
+	if err != nil {
+		return input, fmt.Errorf("%w: %v", shared.ErrInvalidParam, err)
+	}
+
+	return input, nil
+}
+
+func (g *Gateway) rejectOrder(s *session, msg *Message, err error) {
+	report := NewMessage(MsgTypeExecutionReport).
+		Set(TagOrderID, "NONE").
+		Set(TagExecID, g.nextExecID()).
+		Set(TagExecType, ExecTypeRejected).
+		Set(TagOrdStatus, OrdStatusRejected).
+		SetInt(TagOrdRejReason, ordRejReasonOther).
+		SetInt(TagLeavesQty, 0).
+		SetInt(TagCumQty, 0).
+		SetInt(TagAvgPx, 0).
+		Set(TagTransactTime, formatTimestamp(time.Now())).
+		Set(TagText, errorText(err))
+
+	for _, tag := range []int{TagClOrdID, TagAccount, TagSymbol, TagSide, TagOrderQty, TagPrice} {
+		if value, ok := msg.Get(tag); ok {
+			report.Set(tag, value)
+		}
+	}
+
+	s.send(report)
+}
+
+func (g *Gateway) rejectCancel(s *session, msg *Message, order *domainOrder.Order, responseTo string, err error) {
+	clOrdID, _ := msg.Get(TagClOrdID)
+	origClOrdID, _ := msg.Get(TagOrigClOrdID)
+
+	reject := NewMessage(MsgTypeOrderCancelReject).
+		Set(TagOrderID, "NONE").
+		Set(TagClOrdID, clOrdID).
+		Set(TagOrigClOrdID, origClOrdID).
+		Set(TagOrdStatus, OrdStatusRejected).
+		Set(TagCxlRejResponseTo, responseTo).
+		SetInt(TagCxlRejReason, cxlRejReasonOther).
+		Set(TagText, errorText(err))
+
+	if order != nil {
+		reject.Set(TagOrderID, order.GetID()).Set(TagOrdStatus, ordStatus(order))
+	}
+
+	switch {
+	case errors.Is(err, shared.ErrNotFound):
+		reject.SetInt(TagCxlRejReason, cxlRejReasonUnknownOrder)
+	case errors.Is(err, domainOrder.ErrOrderNotOpen):
+		reject.SetInt(TagCxlRejReason, cxlRejReasonTooLate)
+	}
+
+	s.send(reject)
+}
+
+// executionReport describes order after cumQty of it filled. AvgPx is not
+// tracked by the order domain and is always reported as 0.
+func (g *Gateway) executionReport(order *domainOrder.Order, execType, status string, cumQty int64) *Message {
+	return NewMessage(MsgTypeExecutionReport).
+		Set(TagOrderID, order.GetID()).
+		Set(TagClOrdID, order.ClientOrderID).
+		Set(TagExecID, g.nextExecID()).
+		Set(TagExecType, execType).
+		Set(TagOrdStatus, status).
+		Set(TagAccount, order.AccountID).
+		Set(TagSymbol, order.Instrument).
+		Set(TagSide, fixSide(order.Side)).
+		SetInt(TagOrderQty, order.Qty).
+		Set(TagOrdType, OrdTypeLimit).
+		SetInt(TagPrice, order.Price).
+		SetInt(TagLeavesQty, order.Qty-cumQty).
+		SetInt(TagCumQty, cumQty).
+		SetInt(TagAvgPx, 0).
+		Set(TagTransactTime, formatTimestamp(time.Now()))
+}
+
+func (g *Gateway) fillReport(order *domainOrder.Order, trade services.Trade, cumQty int64) *Message {
+	status := OrdStatusPartiallyFilled
+	if cumQty >= order.Qty {
+		status = OrdStatusFilled
+	}
+
+	return g.executionReport(order, ExecTypeTrade, status, cumQty).
+		SetInt(TagLastQty, trade.Qty).
+		SetInt(TagLastPx, trade.Price).
+		Set(TagTradeID, trade.ID()).
+		Set(TagTransactTime, formatTimestamp(trade.ExecutedAt))
+}
+
+func (g *Gateway) canceledReport(msg *Message, order *domainOrder.Order, cumQty int64) *Message {
+	clOrdID, _ := msg.Get(TagClOrdID)
+	origClOrdID, _ := msg.Get(TagOrigClOrdID)
+
+	return g.executionReport(order, ExecTypeCanceled, OrdStatusCanceled, cumQty).
+		Set(TagClOrdID, clOrdID).
+		Set(TagOrigClOrdID, origClOrdID).
+		SetInt(TagLeavesQty, 0)
+}
+
+// watchFills reports fills that other participants' orders caused on the
+// session's accounts every fillInterval.
+func (g *Gateway) watchFills(s *session, done <-chan struct{}) {
+	if g.fillInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(g.fillInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			s.appMu.Lock()
+			g.reportFills(s)
+			s.appMu.Unlock()
+		}
+	}
+}
+
+func (g *Gateway) reportFills(s *session) {
+	fills, err := s.state.fills.Poll(s.callerAccountID)
+	if err != nil {
+		g.logger.Printf("fix: failed to poll fills for session %s: %v", s.targetCompID, err)
+	}
+
+	for _, fill := range fills {
+		s.send(g.fillReport(fill.Order, fill.Trade, fill.Order.Filled()))
+	}
+}
+
+func (g *Gateway) nextExecID() string {
+	return strconv.FormatInt(g.execIDs.Add(1), 10)
+}
+
+func (s *session) account(msg *Message) string {
+	if accountID, _ := msg.Get(TagAccount); accountID != "" {
+		return accountID
+	}
+
+	return s.accountID
+}
+
+func ordStatus(order *domainOrder.Order) string {
+	switch {
+	case order.Canceled():
+		return OrdStatusCanceled
+	case order.Remaining == 0:
+		return OrdStatusFilled
+	case order.Remaining < order.Qty:
+		return OrdStatusPartiallyFilled
+	default:
+		return OrdStatusNew
+	}
+}
+
+func fixSide(side domainOrder.Side) string {
+	if side == domainOrder.Sell {
+		return "2"
+	}
+
+	return "1"
+}
+
+func errorText(err error) string {
+	errResp := shared.ErrorResponseFor(err)
+	if errResp.Code != "" {
+		return errResp.Code + ": " + errResp.Message
+	}
+
+	return errResp.Message
+}
+
+func NewGateway(
+	placeOrder orderUsecases.IPlaceOrderUseCase,
+	cancelOrder orderUsecases.ICancelOrderUseCase,
+	getOrder orderUsecases.IGetOrderUseCase,
+	tradeRepo domainTrade.ITradeRepository,
+	fillInterval time.Duration,
+	logger *log.Logger,
+) *Gateway {
+	return &Gateway{
+		placeOrder:   placeOrder,
+		cancelOrder:  cancelOrder,
+		getOrder:     getOrder,
+		tradeRepo:    tradeRepo,
+		logger:       logger,
+		fillInterval: fillInterval,
+	}
+}
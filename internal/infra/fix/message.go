@@ -0,0 +1,311 @@
+package fix
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+const (
+	BeginString    = "FIX.4.4"
+	MaxBodyLength  = 64 * 1024
+	soh            = '\x01'
+	checkSumLength = len("10=000\x01")
+)
+
+const (
+	TagAccount              = 1
+	TagAvgPx                = 6
+	TagBeginSeqNo           = 7
+	TagBeginString          = 8
+	TagBodyLength           = 9
+	TagCheckSum             = 10
+	TagClOrdID              = 11
+	TagCumQty               = 14
+	TagEndSeqNo             = 16
+	TagExecID               = 17
+	TagLastPx               = 31
+	TagLastQty              = 32
+	TagMsgSeqNum            = 34
+	TagMsgType              = 35
+	TagNewSeqNo             = 36
+	TagOrderID              = 37
+	TagOrderQty             = 38
+	TagOrdStatus            = 39
+	TagOrdType              = 40
+	TagOrigClOrdID          = 41
+	TagPossDupFlag          = 43
+	TagPrice                = 44
+	TagRefSeqNum            = 45
+	TagSenderCompID         = 49
+	TagSendingTime          = 52
+	TagSide                 = 54
+	TagSymbol               = 55
+	TagTargetCompID         = 56
+	TagText                 = 58
+	TagTimeInForce          = 59
+	TagTransactTime         = 60
+	TagEncryptMethod        = 98
+	TagCxlRejReason         = 102
+	TagOrdRejReason         = 103
+	TagHeartBtInt           = 108
+	TagTestReqID            = 112
+	TagOrigSendingTime      = 122
+	TagGapFillFlag          = 123
+	TagResetSeqNumFlag      = 141
+	TagExecType             = 150
+	TagLeavesQty            = 151
+	TagRefMsgType           = 372
+	TagSessionRejectReason  = 373
+	TagBusinessRejectReason = 380
+	TagCxlRejResponseTo     = 434
+	TagUsername             = 553
+	TagPassword             = 554
+	TagTradeID              = 1003
+)
+
+const (
+	MsgTypeHeartbeat                 = "0"
+	MsgTypeTestRequest               = "1"
+	MsgTypeResendRequest             = "2"
+	MsgTypeReject                    = "3"
+	MsgTypeSequenceReset             = "4"
+	MsgTypeLogout                    = "5"
+	MsgTypeExecutionReport           = "8"
+	MsgTypeOrderCancelReject         = "9"
+	MsgTypeLogon                     = "A"
+	MsgTypeNewOrderSingle            = "D"
+	MsgTypeOrderCancelRequest        = "F"
+	MsgTypeOrderCancelReplaceRequest = "G"
+	MsgTypeBusinessMessageReject     = "j"
+)
+
+var (
+	ErrGarbled      = errors.New("fix: garbled message")
+	ErrBadChecksum  = errors.New("fix: checksum mismatch")
+	ErrMissingField = errors.New("fix: required field missing")
+	ErrInvalidField = errors.New("fix: incorrect field value")
+)
+
+type Field struct {
+	Value string
+	Tag   int
+}
+
+// Message is a FIX message as its ordered fields, without the BeginString,
+// BodyLength and CheckSum framing. MsgType is always the first field.
+type Message struct {
+	Fields []Field
+}
+
+func NewMessage(msgType string) *Message {
+	return &Message{Fields: []Field{{Tag: TagMsgType, Value: msgType}}}
+}
+
+func (m *Message) MsgType() string {
+	msgType, _ := m.Get(TagMsgType)
+
+	return msgType
+}
+
+func (m *Message) Get(tag int) (string, bool) {
+	for _, field := range m.Fields {
+		if field.Tag == tag {
+			return field.Value, true
+		}
+	}
+
+	return "", false
+}
+
+func (m *Message) GetString(tag int) (string, error) {
+	value, ok := m.Get(tag)
+	if !ok || value == "" {
+		return "", fmt.Errorf("%w: tag %d", ErrMissingField, tag)
+	}
+
+	return value, nil
+}
+
+func (m *Message) GetInt(tag int) (int64, error) {
+	value, err := m.GetString(tag)
+	if err != nil {
+		return 0, err
+	}
+
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: tag %d", ErrInvalidField, tag)
+	}
+
+	return parsed, nil
+}
+
+func (m *Message) GetBool(tag int) bool {
+	value, _ := m.Get(tag)
+
+	return value == "Y"
+}
+
+// Set replaces the first field with tag, or appends one.
+func (m *Message) Set(tag int, value string) *Message {
+	for i := range m.Fields {
+		if m.Fields[i].Tag == tag {
+			m.Fields[i].Value = value
+
+			return m
+		}
+	}
+
+	m.Fields = append(m.Fields, Field{Tag: tag, Value: value})
+
+	return m
+}
+
+func (m *Message) SetInt(tag int, value int64) *Message {
+	return m.Set(tag, strconv.FormatInt(value, 10))
+}
+
+func (m *Message) SetBool(tag int, value bool) *Message {
+	if value {
+		return m.Set(tag, "Y")
+	}
+
+	return m.Set(tag, "N")
+}
+
+// Encode frames the message with BeginString, BodyLength and CheckSum.
+func (m *Message) Encode() []byte {
+	var body bytes.Buffer
+
+	for _, field := range m.Fields {
+		body.WriteString(strconv.Itoa(field.Tag))
+		body.WriteByte('=')
+		body.WriteString(field.Value)
+		body.WriteByte(soh)
+	}
+
+	var out bytes.Buffer
+
+	out.WriteString("8=" + BeginString + string(soh))
+	out.WriteString("9=" + strconv.Itoa(body.Len()) + string(soh))
+	out.Write(body.Bytes())
+	fmt.Fprintf(&out, "10=%03d%c", checksum(out.Bytes()), soh)
+
+	return out.Bytes()
+}
+
+// String renders the message with '|' for SOH, for logs and test failures.
+func (m *Message) String() string {
+	return string(bytes.ReplaceAll(m.Encode(), []byte{soh}, []byte{'|'}))
+}
+
+// ReadMessage reads one framed message. ErrBadChecksum leaves the reader at
+// the next message, so callers may skip it; any other error means framing is
+// lost.
+func ReadMessage(reader *bufio.Reader) (*Message, error) {
+	var raw bytes.Buffer
+
+	beginString, err := readField(reader, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if beginString.Tag != TagBeginString || beginString.Value != BeginString {
+		return nil, fmt.Errorf("%w: unexpected begin string %q", ErrGarbled, beginString.Value)
+	}
+
+	bodyLength, err := readField(reader, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := strconv.Atoi(bodyLength.Value)
+	if bodyLength.Tag != TagBodyLength || err != nil || length <= 0 || length > MaxBodyLength {
+		return nil, fmt.Errorf("%w: invalid body length", ErrGarbled)
+	}
+
+	body := make([]byte, length)
+
+	_, err = io.ReadFull(reader, body)
+	if err != nil {
+		return nil, err
+	}
+
+	raw.Write(body)
+
+	trailer := make([]byte, checkSumLength)
+
+	_, err = io.ReadFull(reader, trailer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(trailer, []byte("10=")) || trailer[checkSumLength-1] != soh {
+		return nil, fmt.Errorf("%w: invalid trailer", ErrGarbled)
+	}
+
+	sum, err := strconv.Atoi(string(trailer[3 : checkSumLength-1]))
+	if err != nil || sum != checksum(raw.Bytes()) {
+		return nil, ErrBadChecksum
+	}
+
+	msg := &Message{}
+
+	for _, part := range bytes.Split(bytes.TrimSuffix(body, []byte{soh}), []byte{soh}) {
+		field, err := parseField(part)
+		if err != nil {
+			return nil, err
+		}
+
+		msg.Fields = append(msg.Fields, field)
+	}
+
+	if msg.Fields[0].Tag != TagMsgType {
+		return nil, fmt.Errorf("%w: MsgType must be the first body field", ErrGarbled)
+	}
+
+	return msg, nil
+}
+
+func readField(reader *bufio.Reader, raw *bytes.Buffer) (Field, error) {
+	part, err := reader.ReadSlice(soh)
+	if err != nil {
+		if errors.Is(err, bufio.ErrBufferFull) {
+			return Field{}, fmt.Errorf("%w: field too long", ErrGarbled)
+		}
+
+		return Field{}, err
+	}
+
+	raw.Write(part)
+
+	return parseField(part[:len(part)-1])
+}
+
+func parseField(part []byte) (Field, error) {
+	tag, value, found := bytes.Cut(part, []byte{'='})
+	if !found {
+		return Field{}, fmt.Errorf("%w: field without '='", ErrGarbled)
+	}
+
+	parsed, err := strconv.Atoi(string(tag))
+	if err != nil || parsed <= 0 {
+		return Field{}, fmt.Errorf("%w: invalid tag %q", ErrGarbled, tag)
+	}
+
+	return Field{Tag: parsed, Value: string(value)}, nil
+}
+
+func checksum(data []byte) int {
+	sum := 0
+
+	for _, b := range data {
+		sum += int(b)
+	}
+
+	return sum % 256
+}
@@ -0,0 +1,98 @@
+//go:build all || unit || infra
+
+package fix_test
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/juninhoitabh/clob-go/internal/infra/fix"
+)
+
+func readEncoded(raw string) (*fix.Message, error) {
+	return fix.ReadMessage(bufio.NewReader(strings.NewReader(strings.ReplaceAll(raw, "|", "\x01"))))
+}
+
+func TestEncode_FramesBodyLengthAndCheckSum(t *testing.T) {
+	msg := fix.NewMessage(fix.MsgTypeHeartbeat).
+		Set(fix.TagSenderCompID, "CLOB").
+		Set(fix.TagTargetCompID, "OMS").
+		SetInt(fix.TagMsgSeqNum, 1)
+
+	assert.Equal(t, "8=FIX.4.4|9=25|35=0|49=CLOB|56=OMS|34=1|10=014|", msg.String())
+}
+
+func TestReadMessage_RoundTrip(t *testing.T) {
+	msg := fix.NewMessage(fix.MsgTypeNewOrderSingle).
+		Set(fix.TagClOrdID, "c-1").
+		Set(fix.TagSymbol, "BTC/USDT").
+		SetInt(fix.TagPrice, 100).
+		SetBool(fix.TagPossDupFlag, true)
+
+	var buf bytes.Buffer
+
+	buf.Write(msg.Encode())
+	buf.Write(fix.NewMessage(fix.MsgTypeHeartbeat).Encode())
+
+	reader := bufio.NewReader(&buf)
+
+	first, err := fix.ReadMessage(reader)
+	require.NoError(t, err)
+	assert.Equal(t, msg.Fields, first.Fields)
+	assert.Equal(t, fix.MsgTypeNewOrderSingle, first.MsgType())
+
+	price, err := first.GetInt(fix.TagPrice)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), price)
+	assert.True(t, first.GetBool(fix.TagPossDupFlag))
+
+	second, err := fix.ReadMessage(reader)
+	require.NoError(t, err)
+	assert.Equal(t, fix.MsgTypeHeartbeat, second.MsgType())
+}
+
+func TestReadMessage_BadCheckSumKeepsFraming(t *testing.T) {
+	reader := bufio.NewReader(strings.NewReader(strings.ReplaceAll(
+		"8=FIX.4.4|9=5|35=0|10=000|"+fix.NewMessage(fix.MsgTypeHeartbeat).String(), "|", "\x01")))
+
+	_, err := fix.ReadMessage(reader)
+	assert.ErrorIs(t, err, fix.ErrBadChecksum)
+
+	msg, err := fix.ReadMessage(reader)
+	require.NoError(t, err)
+	assert.Equal(t, fix.MsgTypeHeartbeat, msg.MsgType())
+}
+
+func TestReadMessage_Garbled(t *testing.T) {
+	testCases := []struct {
+		name string
+		raw  string
+	}{
+		{name: "wrong begin string", raw: "8=FIX.4.2|9=5|35=0|10=000|"},
+		{name: "missing body length", raw: "8=FIX.4.4|35=0|10=000|"},
+		{name: "oversized body", raw: "8=FIX.4.4|9=999999999|35=0|10=000|"},
+		{name: "msg type not first", raw: "8=FIX.4.4|9=10|49=A|35=0|10=187|"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := readEncoded(tc.raw)
+			assert.ErrorIs(t, err, fix.ErrGarbled)
+		})
+	}
+}
+
+func TestGetInt_MissingAndInvalid(t *testing.T) {
+	msg := fix.NewMessage(fix.MsgTypeNewOrderSingle).Set(fix.TagPrice, "10.5")
+
+	_, err := msg.GetInt(fix.TagOrderQty)
+	assert.ErrorIs(t, err, fix.ErrMissingField)
+
+	_, err = msg.GetInt(fix.TagPrice)
+	assert.ErrorIs(t, err, fix.ErrInvalidField)
+}
@@ -0,0 +1,556 @@
+package fix
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+const (
+	TimestampLayout = "20060102-15:04:05.000"
+	writeTimeout    = 5 * time.Second
+)
+
+const (
+	sessionRejectValueIncorrect = 5
+	sessionRejectCompIDProblem  = 9
+	sessionRejectOther          = 99
+)
+
+type session struct {
+	lastSent        time.Time
+	lastRecv        time.Time
+	acceptor        *Acceptor
+	conn            net.Conn
+	reader          *bufio.Reader
+	state           *sessionState
+	targetCompID    string
+	accountID       string
+	callerAccountID string
+	heartBtInt      time.Duration
+	resendUpTo      int64
+	// appMu keeps fill polling out of the middle of an order message, so
+	// fills of a new order are never reported before its acknowledgement.
+	appMu     sync.Mutex
+	mu        sync.Mutex
+	loggedOn  atomic.Bool
+	loggedOut bool
+}
+
+func (s *session) run(ctx context.Context) {
+	defer s.conn.Close()
+
+	stop := context.AfterFunc(ctx, func() {
+		if s.loggedOn.Load() {
+			s.logout("server shutting down")
+		}
+
+		_ = s.conn.Close()
+	})
+	defer stop()
+
+	err := s.logon()
+	if s.state != nil {
+		defer s.acceptor.detach(s.state)
+	}
+
+	if err != nil {
+		s.acceptor.logger.Printf("fix: logon from %s rejected: %v", s.conn.RemoteAddr(), err)
+
+		return
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go s.heartbeat(done)
+	go s.acceptor.gateway.watchFills(s, done)
+
+	for {
+		_ = s.conn.SetReadDeadline(time.Now().Add(2 * s.heartBtInt))
+
+		msg, err := ReadMessage(s.reader)
+		if errors.Is(err, ErrBadChecksum) {
+			continue
+		}
+
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				s.acceptor.logger.Printf("fix: session %s closed: %v", s.targetCompID, err)
+			}
+
+			return
+		}
+
+		s.received()
+
+		if !s.handle(msg) {
+			return
+		}
+	}
+}
+
+func (s *session) logon() error {
+	_ = s.conn.SetReadDeadline(time.Now().Add(s.acceptor.logonTimeout))
+
+	msg, err := ReadMessage(s.reader)
+	if err != nil {
+		return err
+	}
+
+	if msg.MsgType() != MsgTypeLogon {
+		return fmt.Errorf("%w: first message is not a logon", ErrInvalidField)
+	}
+
+	s.targetCompID, err = msg.GetString(TagSenderCompID)
+	if err != nil {
+		return err
+	}
+
+	if targetCompID, _ := msg.Get(TagTargetCompID); targetCompID != s.acceptor.compID {
+		return fmt.Errorf("%w: unknown TargetCompID %q", ErrInvalidField, targetCompID)
+	}
+
+	heartBtInt, err := msg.GetInt(TagHeartBtInt)
+	if err != nil || heartBtInt <= 0 {
+		return fmt.Errorf("%w: HeartBtInt must be positive", ErrInvalidField)
+	}
+
+	if encryptMethod, _ := msg.Get(TagEncryptMethod); encryptMethod != "0" {
+		return fmt.Errorf("%w: EncryptMethod must be 0", ErrInvalidField)
+	}
+
+	seq, err := msg.GetInt(TagMsgSeqNum)
+	if err != nil {
+		return err
+	}
+
+	err = s.authenticate(msg)
+	if err != nil {
+		return err
+	}
+
+	s.state, err = s.acceptor.attach(s.targetCompID)
+	if err != nil {
+		return err
+	}
+
+	reset := msg.GetBool(TagResetSeqNumFlag)
+
+	if reset {
+		s.state.mu.Lock()
+		s.state.reset()
+		s.state.mu.Unlock()
+	}
+
+	s.state.fills.Watch(s.accountID)
+
+	if seq < s.state.inSeq {
+		s.logout(fmt.Sprintf("MsgSeqNum too low, expecting %d but received %d", s.state.inSeq, seq))
+
+		return fmt.Errorf("%w: MsgSeqNum too low", ErrInvalidField)
+	}
+
+	s.heartBtInt = time.Duration(heartBtInt) * time.Second
+
+	reply := NewMessage(MsgTypeLogon).Set(TagEncryptMethod, "0").SetInt(TagHeartBtInt, heartBtInt)
+	if reset {
+		reply.SetBool(TagResetSeqNumFlag, true)
+	}
+
+	s.send(reply)
+
+	if seq > s.state.inSeq {
+		s.requestResend(seq)
+	} else {
+		s.state.inSeq++
+	}
+
+	s.loggedOn.Store(true)
+
+	s.acceptor.logger.Printf("fix: session %s logged on for account %q", s.targetCompID, s.accountID)
+
+	return nil
+}
+
+// authenticate binds the session to an account. Without an authenticator
+// the initiator's SenderCompID names the account and any account may be
+// traded, like HTTP with auth disabled.
+func (s *session) authenticate(msg *Message) error {
+	if s.acceptor.authenticate == nil {
+		s.accountID = s.targetCompID
+
+		return nil
+	}
+
+	sendingTime, _ := msg.Get(TagSendingTime)
+
+	timestamp, err := parseTimestamp(sendingTime)
+	if err != nil {
+		return fmt.Errorf("%w: invalid SendingTime", shared.ErrUnauthorized)
+	}
+
+	apiKey, _ := msg.Get(TagUsername)
+	signature, _ := msg.Get(TagPassword)
+
+	authenticateOutput, err := s.acceptor.authenticate.Execute(apikeyUsecases.AuthenticateInput{
+		APIKey:    apiKey,
+		Signature: signature,
+		Method:    LogonSignatureMethod,
+		Path:      LogonSignaturePath,
+		Body:      []byte(s.targetCompID),
+		Timestamp: timestamp.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	role := domainAPIKey.Role(authenticateOutput.Role)
+	if !role.Allows(domainAPIKey.RoleTrader) {
+		return fmt.Errorf("%w: role %s not allowed", shared.ErrForbidden, role)
+	}
+
+	s.accountID = authenticateOutput.AccountID
+	s.callerAccountID = authenticateOutput.AccountID
+
+	return nil
+}
+
+// handle applies session-level sequencing to msg and dispatches it. It
+// returns false once the session must end.
+func (s *session) handle(msg *Message) bool {
+	sender, _ := msg.Get(TagSenderCompID)
+	target, _ := msg.Get(TagTargetCompID)
+
+	if sender != s.targetCompID || target != s.acceptor.compID {
+		s.reject(msg, sessionRejectCompIDProblem, "CompID problem")
+		s.logout("CompID problem")
+
+		return false
+	}
+
+	seq, err := msg.GetInt(TagMsgSeqNum)
+	if err != nil {
+		s.logout("MsgSeqNum missing")
+
+		return false
+	}
+
+	msgType := msg.MsgType()
+
+	// A SequenceReset in reset mode ignores its own MsgSeqNum.
+	if msgType == MsgTypeSequenceReset && !msg.GetBool(TagGapFillFlag) {
+		s.advanceInSeq(msg)
+
+		return true
+	}
+
+	switch {
+	case seq > s.state.inSeq:
+		s.requestResend(seq)
+
+		switch msgType {
+		case MsgTypeResendRequest:
+			s.resend(msg)
+		case MsgTypeLogout:
+			s.logout("")
+
+			return false
+		}
+
+		return true
+	case seq < s.state.inSeq:
+		if msg.GetBool(TagPossDupFlag) {
+			return true
+		}
+
+		s.logout(fmt.Sprintf("MsgSeqNum too low, expecting %d but received %d", s.state.inSeq, seq))
+
+		return false
+	}
+
+	s.state.inSeq++
+
+	if s.resendUpTo != 0 && s.state.inSeq > s.resendUpTo {
+		s.resendUpTo = 0
+	}
+
+	switch msgType {
+	case MsgTypeHeartbeat, MsgTypeReject:
+	case MsgTypeTestRequest:
+		testReqID, _ := msg.Get(TagTestReqID)
+
+		s.send(NewMessage(MsgTypeHeartbeat).Set(TagTestReqID, testReqID))
+	case MsgTypeResendRequest:
+		s.resend(msg)
+	case MsgTypeSequenceReset:
+		s.advanceInSeq(msg)
+	case MsgTypeLogout:
+		s.logout("")
+
+		return false
+	case MsgTypeLogon:
+		s.reject(msg, sessionRejectOther, "already logged on")
+	case MsgTypeNewOrderSingle, MsgTypeOrderCancelRequest, MsgTypeOrderCancelReplaceRequest:
+		s.appMu.Lock()
+		s.acceptor.gateway.handle(s, msg)
+		s.appMu.Unlock()
+	default:
+		s.businessReject(msg, businessRejectUnsupportedMsgType, "unsupported message type")
+	}
+
+	return true
+}
+
+func (s *session) advanceInSeq(msg *Message) {
+	newSeq, err := msg.GetInt(TagNewSeqNo)
+	if err != nil || newSeq < s.state.inSeq {
+		s.reject(msg, sessionRejectValueIncorrect, "NewSeqNo must not decrease")
+
+		return
+	}
+
+	s.state.inSeq = newSeq
+
+	if s.resendUpTo != 0 && s.state.inSeq > s.resendUpTo {
+		s.resendUpTo = 0
+	}
+}
+
+// requestResend asks for everything from the next expected sequence number
+// on, once per gap.
+func (s *session) requestResend(seq int64) {
+	if s.resendUpTo != 0 {
+		s.resendUpTo = max(s.resendUpTo, seq)
+
+		return
+	}
+
+	s.resendUpTo = seq
+
+	s.send(NewMessage(MsgTypeResendRequest).SetInt(TagBeginSeqNo, s.state.inSeq).SetInt(TagEndSeqNo, 0))
+}
+
+// resend replays stored application messages with PossDupFlag and replaces
+// session-level or forgotten ones with SequenceReset gap fills.
+func (s *session) resend(msg *Message) {
+	begin, err := msg.GetInt(TagBeginSeqNo)
+	if err != nil || begin <= 0 {
+		s.reject(msg, sessionRejectValueIncorrect, "invalid BeginSeqNo")
+
+		return
+	}
+
+	end, _ := msg.GetInt(TagEndSeqNo)
+
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+
+	last := s.state.outSeq - 1
+	if end == 0 || end > last {
+		end = last
+	}
+
+	now := time.Now()
+	sendingTime := formatTimestamp(now)
+	gapStart := int64(0)
+
+	for seq := begin; seq <= end; seq++ {
+		stored, ok := s.state.sent[seq]
+		if !ok || isSessionMessage(stored.msg.MsgType()) {
+			if gapStart == 0 {
+				gapStart = seq
+			}
+
+			continue
+		}
+
+		if gapStart != 0 {
+			s.write(s.gapFill(gapStart, seq, sendingTime), now)
+
+			gapStart = 0
+		}
+
+		s.write(s.stamp(stored.msg, seq, sendingTime, stored.sendingTime), now)
+	}
+
+	if gapStart != 0 {
+		s.write(s.gapFill(gapStart, end+1, sendingTime), now)
+	}
+}
+
+func (s *session) gapFill(seq, newSeq int64, sendingTime string) *Message {
+	gapFill := NewMessage(MsgTypeSequenceReset).SetBool(TagGapFillFlag, true).SetInt(TagNewSeqNo, newSeq)
+
+	return s.stamp(gapFill, seq, sendingTime, sendingTime)
+}
+
+func (s *session) heartbeat(done <-chan struct{}) {
+	ticker := time.NewTicker(max(s.heartBtInt/4, 10*time.Millisecond))
+	defer ticker.Stop()
+
+	var testRequestedAt time.Time
+
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			lastSent, lastRecv := s.activity()
+
+			if now.Sub(lastRecv) >= s.heartBtInt*6/5 && now.Sub(testRequestedAt) >= s.heartBtInt {
+				testRequestedAt = now
+
+				s.send(NewMessage(MsgTypeTestRequest).Set(TagTestReqID, formatTimestamp(now)))
+			} else if now.Sub(lastSent) >= s.heartBtInt {
+				s.send(NewMessage(MsgTypeHeartbeat))
+			}
+		}
+	}
+}
+
+// send assigns msg the next outbound sequence number and stores it for
+// resends before writing, so a message lost to a broken connection is
+// replayed after the initiator reconnects.
+func (s *session) send(msg *Message) {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+
+	now := time.Now()
+	seq := s.state.outSeq
+	sendingTime := formatTimestamp(now)
+
+	s.state.outSeq++
+	s.state.store(seq, storedMessage{msg: msg, sendingTime: sendingTime})
+
+	s.write(s.stamp(msg, seq, sendingTime, ""), now)
+}
+
+// write sends an already stamped message; callers hold state.mu.
+func (s *session) write(msg *Message, now time.Time) {
+	_ = s.conn.SetWriteDeadline(now.Add(writeTimeout))
+
+	_, err := s.conn.Write(msg.Encode())
+	if err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	s.lastSent = now
+	s.mu.Unlock()
+}
+
+// stamp prepends the standard header; a non-empty origSendingTime marks a
+// possible duplicate.
+func (s *session) stamp(msg *Message, seq int64, sendingTime, origSendingTime string) *Message {
+	out := NewMessage(msg.MsgType()).
+		Set(TagSenderCompID, s.acceptor.compID).
+		Set(TagTargetCompID, s.targetCompID).
+		SetInt(TagMsgSeqNum, seq).
+		Set(TagSendingTime, sendingTime)
+
+	if origSendingTime != "" {
+		out.SetBool(TagPossDupFlag, true).Set(TagOrigSendingTime, origSendingTime)
+	}
+
+	out.Fields = append(out.Fields, msg.Fields[1:]...)
+
+	return out
+}
+
+func (s *session) logout(text string) {
+	s.mu.Lock()
+	loggedOut := s.loggedOut
+	s.loggedOut = true
+	s.mu.Unlock()
+
+	if loggedOut {
+		return
+	}
+
+	logout := NewMessage(MsgTypeLogout)
+	if text != "" {
+		logout.Set(TagText, text)
+	}
+
+	s.send(logout)
+}
+
+func (s *session) reject(ref *Message, reason int64, text string) {
+	refSeq, _ := ref.Get(TagMsgSeqNum)
+
+	s.send(NewMessage(MsgTypeReject).
+		Set(TagRefSeqNum, refSeq).
+		Set(TagRefMsgType, ref.MsgType()).
+		SetInt(TagSessionRejectReason, reason).
+		Set(TagText, text))
+}
+
+func (s *session) businessReject(ref *Message, reason int64, text string) {
+	refSeq, _ := ref.Get(TagMsgSeqNum)
+
+	s.send(NewMessage(MsgTypeBusinessMessageReject).
+		Set(TagRefSeqNum, refSeq).
+		Set(TagRefMsgType, ref.MsgType()).
+		SetInt(TagBusinessRejectReason, reason).
+		Set(TagText, text))
+}
+
+func (s *session) received() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastRecv = time.Now()
+}
+
+func (s *session) activity() (time.Time, time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lastSent, s.lastRecv
+}
+
+func isSessionMessage(msgType string) bool {
+	switch msgType {
+	case MsgTypeHeartbeat, MsgTypeTestRequest, MsgTypeResendRequest,
+		MsgTypeReject, MsgTypeSequenceReset, MsgTypeLogout, MsgTypeLogon:
+		return true
+	}
+
+	return false
+}
+
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(TimestampLayout)
+}
+
+func parseTimestamp(value string) (time.Time, error) {
+	parsed, err := time.Parse(TimestampLayout, value)
+	if err != nil {
+		return time.Parse("20060102-15:04:05", value)
+	}
+
+	return parsed, nil
+}
+
+func newSession(acceptor *Acceptor, conn net.Conn) *session {
+	now := time.Now()
+
+	return &session{
+		lastSent: now,
+		lastRecv: now,
+		acceptor: acceptor,
+		conn:     conn,
+		reader:   bufio.NewReader(conn),
+	}
+}
@@ -0,0 +1,104 @@
+package grpcServer
+
+import (
+	"context"
+	"fmt"
+
+	accountUsecases "github.com/juninhoitabh/clob-go/internal/application/account/usecases"
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	"github.com/juninhoitabh/clob-go/internal/infra/grpc-server/pb"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type AccountService struct {
+	pb.UnimplementedAccountServiceServer
+	accountDAO  domainAccount.IAccountDAO
+	accountRepo domainAccount.IAccountRepository
+	apiKeyRepo  domainAPIKey.IAPIKeyRepository
+}
+
+func (a *AccountService) CreateAccount(_ context.Context, req *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	if req.GetAccountName() == "" {
+		return nil, fmt.Errorf("%w: account_name is required", shared.ErrInvalidParam)
+	}
+
+	createAccountUseCase := accountUsecases.NewCreateAccountUseCase(a.accountRepo)
+
+	createAccountOutput, err := createAccountUseCase.Execute(accountUsecases.CreateAccountInput{
+		AccountName:     req.GetAccountName(),
+		ParentAccountID: req.GetParentAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.CreateAccountResponse{
+		AccountId:       createAccountOutput.ID,
+		ParentAccountId: createAccountOutput.ParentID,
+	}
+
+	if createAccountOutput.ParentID != "" {
+		return resp, nil
+	}
+
+	issueAPIKeyUseCase := apikeyUsecases.NewIssueAPIKeyUseCase(a.accountRepo, a.apiKeyRepo)
+
+	issueAPIKeyOutput, err := issueAPIKeyUseCase.Execute(apikeyUsecases.IssueAPIKeyInput{
+		AccountID: createAccountOutput.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.ApiKey = issueAPIKeyOutput.ID
+	resp.ApiSecret = issueAPIKeyOutput.Secret
+
+	return resp, nil
+}
+
+func (a *AccountService) GetAccount(_ context.Context, req *pb.GetAccountRequest) (*pb.Account, error) {
+	acct, err := a.accountDAO.Snapshot(req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	return accountToPb(acct), nil
+}
+
+func (a *AccountService) CreditAccount(_ context.Context, req *pb.CreditAccountRequest) (*pb.Account, error) {
+	if req.GetAsset() == "" || req.GetAmount() <= 0 {
+		return nil, fmt.Errorf("%w: asset and positive amount required", shared.ErrInvalidParam)
+	}
+
+	creditAccountUseCase := accountUsecases.NewCreditAccountUseCase(a.accountRepo)
+
+	err := creditAccountUseCase.Execute(accountUsecases.CreditAccountInput{
+		AccountID: req.GetAccountId(),
+		Asset:     req.GetAsset(),
+		Amount:    req.GetAmount(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	acct, err := a.accountDAO.Snapshot(req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	return accountToPb(acct), nil
+}
+
+func NewAccountService(
+	accountDAO domainAccount.IAccountDAO,
+	accountRepo domainAccount.IAccountRepository,
+	apiKeyRepo domainAPIKey.IAPIKeyRepository,
+) *AccountService {
+	return &AccountService{
+		accountDAO:  accountDAO,
+		accountRepo: accountRepo,
+		apiKeyRepo:  apiKeyRepo,
+	}
+}
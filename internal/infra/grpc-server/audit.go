@@ -0,0 +1,99 @@
+package grpcServer
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc/metadata"
+
+	auditUsecases "github.com/juninhoitabh/clob-go/internal/application/audit/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainAudit "github.com/juninhoitabh/clob-go/internal/domain/audit"
+	"github.com/juninhoitabh/clob-go/internal/infra/grpc-server/pb"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+// auditor records admin calls in the audit log like the REST API's audited
+// routes, with the state of their target before and after, and the attempts
+// to make one that were denied.
+type auditor struct {
+	auditRepo  domainAudit.IAuditRepository
+	accountDAO domainAccount.IAccountDAO
+}
+
+// auditedAction names the admin action req performs and the resource it acts
+// on, or returns "" when the call is not audited.
+func auditedAction(req any) (action string, target string) {
+	switch req := req.(type) {
+	case *pb.CreditAccountRequest:
+		return "account.credit", "account:" + req.GetAccountId()
+	}
+
+	return "", ""
+}
+
+// state is the current state of the resource req acts on, or nil when it
+// does not exist.
+func (a *auditor) state(req any) any {
+	switch req := req.(type) {
+	case *pb.CreditAccountRequest:
+		snapshot, err := a.accountDAO.Snapshot(req.GetAccountId())
+		if err != nil {
+			return nil
+		}
+
+		return snapshot.Balances
+	}
+
+	return nil
+}
+
+func (a *auditor) record(ctx context.Context, action, target string, before, after any) {
+	caller := shared.CallerDetailsFromContext(ctx)
+
+	recordEntryUseCase := auditUsecases.NewRecordEntryUseCase(a.auditRepo)
+
+	_, err := recordEntryUseCase.Execute(auditUsecases.RecordEntryInput{
+		Before:    before,
+		After:     after,
+		Actor:     caller.APIKeyID,
+		AccountID: caller.AccountID,
+		Role:      caller.Role,
+		Action:    action,
+		Target:    target,
+	})
+	if err != nil {
+		log.Printf("ALERT audit: %s on %s by %q not recorded: %v", action, target, caller.APIKeyID, err)
+	}
+}
+
+// denied records an audited call that was refused before it ran as
+// "<action>.denied". When the key did not authenticate, the actor is the key
+// the call claimed.
+func (a *auditor) denied(ctx context.Context, req any, cause error) {
+	action, target := auditedAction(req)
+	if action == "" {
+		return
+	}
+
+	caller := shared.CallerDetailsFromContext(ctx)
+	if caller.APIKeyID == "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		caller.APIKeyID = firstValue(md, metadataKey)
+	}
+
+	log.Printf("audit: %s on %s by %q denied: %v", action, target, caller.APIKeyID, cause)
+
+	recordEntryUseCase := auditUsecases.NewRecordEntryUseCase(a.auditRepo)
+
+	_, err := recordEntryUseCase.Execute(auditUsecases.RecordEntryInput{
+		Actor:     caller.APIKeyID,
+		AccountID: caller.AccountID,
+		Role:      caller.Role,
+		Action:    action + ".denied",
+		Target:    target,
+	})
+	if err != nil {
+		log.Printf("ALERT audit: denied %s on %s by %q not recorded: %v", action, target, caller.APIKeyID, err)
+	}
+}
@@ -0,0 +1,91 @@
+package grpcServer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	bookUsecases "github.com/juninhoitabh/clob-go/internal/application/book/usecases"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	"github.com/juninhoitabh/clob-go/internal/infra/grpc-server/pb"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type BookService struct {
+	pb.UnimplementedBookServiceServer
+	bookRepo     domainBook.IBookRepository
+	pollInterval time.Duration
+}
+
+func (b *BookService) GetBook(_ context.Context, req *pb.GetBookRequest) (*pb.Book, error) {
+	instrument := strings.ToUpper(req.GetInstrument())
+	if instrument == "" {
+		return nil, fmt.Errorf("%w: instrument is required", shared.ErrInvalidParam)
+	}
+
+	return b.snapshot(instrument)
+}
+
+// StreamBook polls the book every pollInterval and sends it whenever it
+// changed. A book nobody traded yet streams as empty until it exists.
+func (b *BookService) StreamBook(req *pb.StreamBookRequest, stream pb.BookService_StreamBookServer) error {
+	instrument := strings.ToUpper(req.GetInstrument())
+	if instrument == "" {
+		return fmt.Errorf("%w: instrument is required", shared.ErrInvalidParam)
+	}
+
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+
+	var last *pb.Book
+
+	for {
+		book, err := b.snapshot(instrument)
+		if errors.Is(err, shared.ErrNotFound) {
+			book, err = &pb.Book{Instrument: instrument}, nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if last == nil || !proto.Equal(book, last) {
+			err = stream.Send(book)
+			if err != nil {
+				return err
+			}
+
+			last = book
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (b *BookService) snapshot(instrument string) (*pb.Book, error) {
+	snapshotBookUseCase := bookUsecases.NewSnapshotBookUseCase(b.bookRepo)
+
+	snapshot, err := snapshotBookUseCase.Execute(bookUsecases.SnapshotBookInput{
+		Instrument: instrument,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bookToPb(snapshot), nil
+}
+
+func NewBookService(bookRepo domainBook.IBookRepository, pollInterval time.Duration) *BookService {
+	return &BookService{
+		bookRepo:     bookRepo,
+		pollInterval: pollInterval,
+	}
+}
@@ -0,0 +1,104 @@
+package grpcServer
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bookUsecases "github.com/juninhoitabh/clob-go/internal/application/book/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	"github.com/juninhoitabh/clob-go/internal/infra/grpc-server/pb"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+func accountToPb(acct *domainAccount.AccountSnapshot) *pb.Account {
+	out := &pb.Account{
+		AccountId:       acct.AccountID,
+		ParentAccountId: acct.ParentID,
+		Balances:        make(map[string]*pb.Balance, len(acct.Balances)),
+		SubAccountIds:   acct.SubAccountIDs,
+	}
+
+	for asset, balance := range acct.Balances {
+		out.Balances[asset] = &pb.Balance{Available: balance.Available, Reserved: balance.Reserved}
+	}
+
+	return out
+}
+
+func orderToPb(order *domainOrder.Order) *pb.Order {
+	return &pb.Order{
+		OrderId:       order.GetID(),
+		AccountId:     order.AccountID,
+		ClientOrderId: order.ClientOrderID,
+		Instrument:    order.Instrument,
+		Side:          sideToPb(order.Side),
+		Price:         order.Price,
+		Qty:           order.Qty,
+		Remaining:     order.Remaining,
+		CreatedAt:     timestamppb.New(order.CreatedAt),
+	}
+}
+
+func tradeToPb(trade *services.Trade) *pb.Trade {
+	return &pb.Trade{
+		TradeId:       trade.ID(),
+		Instrument:    trade.Instrument,
+		TakerOrderId:  trade.TakerOrderID,
+		MakerOrderId:  trade.MakerOrderID,
+		BuyerId:       trade.BuyerID,
+		SellerId:      trade.SellerID,
+		TakerSide:     sideToPb(trade.TakerSide),
+		Price:         trade.Price,
+		Qty:           trade.Qty,
+		MakerFee:      trade.MakerFee,
+		MakerFeeAsset: trade.MakerFeeAsset,
+		TakerFee:      trade.TakerFee,
+		TakerFeeAsset: trade.TakerFeeAsset,
+		ExecutedAt:    timestamppb.New(trade.ExecutedAt),
+	}
+}
+
+func bookToPb(snapshot *bookUsecases.SnapshotBookOutput) *pb.Book {
+	out := &pb.Book{
+		Instrument: snapshot.Instrument,
+		Bids:       make([]*pb.Level, 0, len(snapshot.Bids)),
+		Asks:       make([]*pb.Level, 0, len(snapshot.Asks)),
+		LastPrice:  snapshot.LastPrice,
+		Halted:     snapshot.Halted,
+	}
+
+	for _, level := range snapshot.Bids {
+		out.Bids = append(out.Bids, &pb.Level{Price: level.Price, Qty: level.Qty})
+	}
+
+	for _, level := range snapshot.Asks {
+		out.Asks = append(out.Asks, &pb.Level{Price: level.Price, Qty: level.Qty})
+	}
+
+	return out
+}
+
+func sideToPb(side domainOrder.Side) pb.Side {
+	switch side {
+	case domainOrder.Buy:
+		return pb.Side_SIDE_BUY
+	case domainOrder.Sell:
+		return pb.Side_SIDE_SELL
+	default:
+		return pb.Side_SIDE_UNSPECIFIED
+	}
+}
+
+func sideFromPb(side pb.Side) (string, error) {
+	switch side {
+	case pb.Side_SIDE_BUY:
+		return "buy", nil
+	case pb.Side_SIDE_SELL:
+		return "sell", nil
+	default:
+		return "", fmt.Errorf("%w: side must be SIDE_BUY or SIDE_SELL", shared.ErrInvalidParam)
+	}
+}
@@ -0,0 +1,379 @@
+//go:build all || e2e || infra
+
+package grpcServer_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	domainAudit "github.com/juninhoitabh/clob-go/internal/domain/audit"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	domainRisk "github.com/juninhoitabh/clob-go/internal/domain/risk"
+	grpcServer "github.com/juninhoitabh/clob-go/internal/infra/grpc-server"
+	"github.com/juninhoitabh/clob-go/internal/infra/grpc-server/pb"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesAPIKey "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey"
+	repositoriesAudit "github.com/juninhoitabh/clob-go/internal/infra/repositories/audit"
+	repositoriesBook "github.com/juninhoitabh/clob-go/internal/infra/repositories/book"
+	repositoriesFee "github.com/juninhoitabh/clob-go/internal/infra/repositories/fee"
+	repositoriesOrder "github.com/juninhoitabh/clob-go/internal/infra/repositories/order"
+	repositoriesRisk "github.com/juninhoitabh/clob-go/internal/infra/repositories/risk"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
+)
+
+type GrpcServerE2ETestSuite struct {
+	suite.Suite
+	accounts pb.AccountServiceClient
+	orders   pb.OrderServiceClient
+	books    pb.BookServiceClient
+	trades   pb.TradeServiceClient
+}
+
+func (suite *GrpcServerE2ETestSuite) SetupTest() {
+	repositoriesAccount.ResetInMemoryAccountRepository()
+	repositoriesAPIKey.ResetInMemoryAPIKeyRepository()
+	repositoriesAudit.ResetInMemoryAuditRepository()
+	repositoriesBook.ResetInMemoryBookRepository()
+	repositoriesOrder.ResetInMemoryOrderRepository()
+	repositoriesTrade.ResetInMemoryTradeRepository()
+	repositoriesFee.ResetInMemoryScheduleRepository()
+	repositoriesRisk.ResetInMemoryLimitsRepository()
+
+	suite.serve(nil)
+}
+
+func (suite *GrpcServerE2ETestSuite) serve(authenticate apikeyUsecases.IAuthenticateUseCase) {
+	t := suite.T()
+
+	placeOrderUseCase := orderUsecases.NewPlaceOrderUseCase(
+		repositoriesBook.NewInMemoryBookRepository(),
+		repositoriesOrder.NewInMemoryOrderRepository(),
+		repositoriesAccount.NewInMemoryAccountRepository(),
+		repositoriesFee.NewInMemoryScheduleRepository(),
+		repositoriesTrade.NewInMemoryTradeRepository(),
+		domainBook.CircuitBreakerProps{},
+		domainFee.FeeProps{AccountID: "fees"},
+		domainRisk.NewDefaultChain(repositoriesRisk.NewInMemoryLimitsRepository()),
+	)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	server := grpcServer.NewServer(placeOrderUseCase, authenticate, 10*time.Millisecond)
+
+	go func() { _ = grpcServer.Serve(ctx, server, listener) }()
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	suite.accounts = pb.NewAccountServiceClient(conn)
+	suite.orders = pb.NewOrderServiceClient(conn)
+	suite.books = pb.NewBookServiceClient(conn)
+	suite.trades = pb.NewTradeServiceClient(conn)
+}
+
+func (suite *GrpcServerE2ETestSuite) newAccount(name, asset string, amount int64) string {
+	t := suite.T()
+
+	created, err := suite.accounts.CreateAccount(context.Background(), &pb.CreateAccountRequest{AccountName: name})
+	require.NoError(t, err)
+
+	_, err = suite.accounts.CreditAccount(context.Background(), &pb.CreditAccountRequest{
+		AccountId: created.GetAccountId(),
+		Asset:     asset,
+		Amount:    amount,
+	})
+	require.NoError(t, err)
+
+	return created.GetAccountId()
+}
+
+func assertCode(t *testing.T, want codes.Code, err error) {
+	require.Error(t, err)
+	assert.Equal(t, want, status.Code(err), err.Error())
+}
+
+func (suite *GrpcServerE2ETestSuite) TestAccount_CreateCreditGet() {
+	t := suite.T()
+	ctx := context.Background()
+
+	created, err := suite.accounts.CreateAccount(ctx, &pb.CreateAccountRequest{AccountName: "alice"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.GetApiKey())
+	assert.NotEmpty(t, created.GetApiSecret())
+
+	_, err = suite.accounts.CreateAccount(ctx, &pb.CreateAccountRequest{AccountName: "alice"})
+	assertCode(t, codes.AlreadyExists, err)
+
+	credited, err := suite.accounts.CreditAccount(ctx, &pb.CreditAccountRequest{AccountId: created.GetAccountId(), Asset: "USDT", Amount: 500})
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), credited.GetBalances()["USDT"].GetAvailable())
+
+	_, err = suite.accounts.CreditAccount(ctx, &pb.CreditAccountRequest{AccountId: created.GetAccountId(), Asset: "USDT"})
+	assertCode(t, codes.InvalidArgument, err)
+
+	entries, total, err := repositoriesAudit.NewInMemoryAuditRepository().ListEntries(domainAudit.Filter{Action: "account.credit"})
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	assert.Equal(t, "account:"+created.GetAccountId(), entries[0].Target)
+	assert.JSONEq(t, `{}`, string(entries[0].Before))
+	assert.JSONEq(t, `{"USDT":{"Available":500,"Reserved":0}}`, string(entries[0].After))
+
+	sub, err := suite.accounts.CreateAccount(ctx, &pb.CreateAccountRequest{AccountName: "alice-sub", ParentAccountId: created.GetAccountId()})
+	require.NoError(t, err)
+	assert.Empty(t, sub.GetApiKey())
+
+	acct, err := suite.accounts.GetAccount(ctx, &pb.GetAccountRequest{AccountId: created.GetAccountId()})
+	require.NoError(t, err)
+	assert.Equal(t, []string{sub.GetAccountId()}, acct.GetSubAccountIds())
+
+	_, err = suite.accounts.GetAccount(ctx, &pb.GetAccountRequest{AccountId: "missing"})
+	assertCode(t, codes.NotFound, err)
+}
+
+func (suite *GrpcServerE2ETestSuite) TestOrders_PlaceGetCancelAndTrades() {
+	t := suite.T()
+	ctx := context.Background()
+
+	seller := suite.newAccount("seller", "BTC", 10)
+	buyer := suite.newAccount("buyer", "USDT", 10_000)
+
+	sell, err := suite.orders.PlaceOrder(ctx, &pb.PlaceOrderRequest{
+		AccountId: seller, ClientOrderId: "s-1", Instrument: "BTC/USDT", Side: pb.Side_SIDE_SELL, Price: 100, Qty: 5,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, sell.GetTrades())
+	assert.Equal(t, int64(5), sell.GetOrder().GetRemaining())
+
+	buy, err := suite.orders.PlaceOrder(ctx, &pb.PlaceOrderRequest{
+		AccountId: buyer, Instrument: "BTC/USDT", Side: pb.Side_SIDE_BUY, Price: 100, Qty: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, buy.GetTrades(), 1)
+	assert.Equal(t, sell.GetOrder().GetOrderId(), buy.GetTrades()[0].GetMakerOrderId())
+	assert.Equal(t, pb.Side_SIDE_BUY, buy.GetTrades()[0].GetTakerSide())
+
+	_, err = suite.orders.PlaceOrder(ctx, &pb.PlaceOrderRequest{AccountId: buyer, Instrument: "BTC/USDT", Price: 100, Qty: 1})
+	assertCode(t, codes.InvalidArgument, err)
+
+	byClientID, err := suite.orders.GetOrder(ctx, &pb.GetOrderRequest{AccountId: seller, ClientOrderId: "s-1"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), byClientID.GetRemaining())
+
+	canceled, err := suite.orders.CancelOrder(ctx, &pb.CancelOrderRequest{OrderId: sell.GetOrder().GetOrderId()})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), canceled.GetRemaining())
+
+	_, err = suite.orders.CancelOrder(ctx, &pb.CancelOrderRequest{OrderId: sell.GetOrder().GetOrderId()})
+	assertCode(t, codes.FailedPrecondition, err)
+	assert.Contains(t, status.Convert(err).Message(), "ORDER_NOT_OPEN")
+
+	_, err = suite.orders.GetOrder(ctx, &pb.GetOrderRequest{OrderId: "missing"})
+	assertCode(t, codes.NotFound, err)
+
+	trades, err := suite.trades.ListTrades(ctx, &pb.ListTradesRequest{AccountId: seller, Instrument: "btc/usdt"})
+	require.NoError(t, err)
+	require.Len(t, trades.GetTrades(), 1)
+	assert.Equal(t, buy.GetTrades()[0].GetTradeId(), trades.GetTrades()[0].GetTradeId())
+}
+
+func (suite *GrpcServerE2ETestSuite) TestOrders_PlaceHaltCancelsRemaining() {
+	t := suite.T()
+	ctx := context.Background()
+
+	seller := suite.newAccount("seller", "NEAR", 2)
+	buyer := suite.newAccount("buyer", "USDT", 1_000)
+
+	for _, price := range []int64{100, 200} {
+		_, err := suite.orders.PlaceOrder(ctx, &pb.PlaceOrderRequest{
+			AccountId: seller, Instrument: "NEAR/USDT", Side: pb.Side_SIDE_SELL, Price: price, Qty: 1,
+		})
+		require.NoError(t, err)
+	}
+
+	b, err := repositoriesBook.NewInMemoryBookRepository().GetBook("NEAR/USDT")
+	require.NoError(t, err)
+	b.Lock()
+	b.CircuitBreaker.Configure(domainBook.CircuitBreakerProps{MoveBps: 1000, Window: time.Minute, HaltDuration: time.Minute})
+	b.Unlock()
+
+	buy, err := suite.orders.PlaceOrder(ctx, &pb.PlaceOrderRequest{
+		AccountId: buyer, Instrument: "NEAR/USDT", Side: pb.Side_SIDE_BUY, Price: 200, Qty: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, buy.GetTrades(), 1)
+	assert.True(t, buy.GetHalted())
+	assert.Equal(t, int64(1), buy.GetCanceledQty())
+	assert.Equal(t, int64(0), buy.GetOrder().GetRemaining())
+}
+
+func (suite *GrpcServerE2ETestSuite) TestStreamExecutionReports() {
+	t := suite.T()
+
+	seller := suite.newAccount("seller", "ETH", 10)
+	buyer := suite.newAccount("buyer", "USDT", 10_000)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	stream, err := suite.orders.StreamExecutionReports(ctx, &pb.StreamExecutionReportsRequest{AccountId: seller})
+	require.NoError(t, err)
+
+	// The stream only reports fills from when it opened.
+	time.Sleep(20 * time.Millisecond)
+
+	sell, err := suite.orders.PlaceOrder(ctx, &pb.PlaceOrderRequest{AccountId: seller, Instrument: "ETH/USDT", Side: pb.Side_SIDE_SELL, Price: 50, Qty: 4})
+	require.NoError(t, err)
+
+	_, err = suite.orders.PlaceOrder(ctx, &pb.PlaceOrderRequest{AccountId: buyer, Instrument: "ETH/USDT", Side: pb.Side_SIDE_BUY, Price: 50, Qty: 3})
+	require.NoError(t, err)
+
+	report, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, sell.GetOrder().GetOrderId(), report.GetOrder().GetOrderId())
+	assert.Equal(t, int64(1), report.GetOrder().GetRemaining())
+	assert.Equal(t, int64(3), report.GetTrade().GetQty())
+	assert.Equal(t, pb.Liquidity_LIQUIDITY_MAKER, report.GetLiquidity())
+
+	missing, err := suite.orders.StreamExecutionReports(ctx, &pb.StreamExecutionReportsRequest{AccountId: "missing"})
+	require.NoError(t, err)
+
+	_, err = missing.Recv()
+	assertCode(t, codes.NotFound, err)
+}
+
+func (suite *GrpcServerE2ETestSuite) TestStreamBook() {
+	t := suite.T()
+
+	buyer := suite.newAccount("buyer", "USDT", 10_000)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	stream, err := suite.books.StreamBook(ctx, &pb.StreamBookRequest{Instrument: "sol/usdt"})
+	require.NoError(t, err)
+
+	empty, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "SOL/USDT", empty.GetInstrument())
+	assert.Empty(t, empty.GetBids())
+
+	_, err = suite.orders.PlaceOrder(ctx, &pb.PlaceOrderRequest{AccountId: buyer, Instrument: "SOL/USDT", Side: pb.Side_SIDE_BUY, Price: 20, Qty: 7})
+	require.NoError(t, err)
+
+	updated, err := stream.Recv()
+	require.NoError(t, err)
+	require.Len(t, updated.GetBids(), 1)
+	assert.Equal(t, int64(20), updated.GetBids()[0].GetPrice())
+	assert.Equal(t, int64(7), updated.GetBids()[0].GetQty())
+
+	book, err := suite.books.GetBook(ctx, &pb.GetBookRequest{Instrument: "SOL/USDT"})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(updated, book))
+
+	_, err = suite.books.GetBook(ctx, &pb.GetBookRequest{Instrument: "NONE/USDT"})
+	assertCode(t, codes.NotFound, err)
+}
+
+func (suite *GrpcServerE2ETestSuite) TestAuthenticated() {
+	t := suite.T()
+	ctx := context.Background()
+
+	apiKeyRepo := repositoriesAPIKey.NewInMemoryAPIKeyRepository()
+	suite.serve(apikeyUsecases.NewAuthenticateUseCase(apiKeyRepo, time.Minute))
+
+	created, err := suite.accounts.CreateAccount(ctx, &pb.CreateAccountRequest{AccountName: "trader"})
+	require.NoError(t, err)
+
+	other, err := suite.accounts.CreateAccount(ctx, &pb.CreateAccountRequest{AccountName: "other"})
+	require.NoError(t, err)
+
+	signed := func(fullMethod string, req proto.Message) context.Context {
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+		require.NoError(t, err)
+
+		now := time.Now().Unix()
+
+		return metadata.AppendToOutgoingContext(ctx,
+			"x-api-key", created.GetApiKey(),
+			"x-api-timestamp", strconv.FormatInt(now, 10),
+			"x-api-signature", domainAPIKey.Sign(created.GetApiSecret(), now, grpcServer.SignatureMethod, fullMethod, body),
+		)
+	}
+
+	own := &pb.GetAccountRequest{AccountId: created.GetAccountId()}
+
+	_, err = suite.accounts.GetAccount(ctx, own)
+	assertCode(t, codes.Unauthenticated, err)
+
+	acct, err := suite.accounts.GetAccount(signed(pb.AccountService_GetAccount_FullMethodName, own), own)
+	require.NoError(t, err)
+	assert.Equal(t, created.GetAccountId(), acct.GetAccountId())
+
+	foreign := &pb.GetAccountRequest{AccountId: other.GetAccountId()}
+
+	_, err = suite.accounts.GetAccount(signed(pb.AccountService_GetAccount_FullMethodName, foreign), foreign)
+	assertCode(t, codes.PermissionDenied, err)
+
+	credit := &pb.CreditAccountRequest{AccountId: created.GetAccountId(), Asset: "USDT", Amount: 1}
+
+	_, err = suite.accounts.CreditAccount(signed(pb.AccountService_CreditAccount_FullMethodName, credit), credit)
+	assertCode(t, codes.PermissionDenied, err)
+
+	_, err = suite.accounts.CreditAccount(ctx, credit)
+	assertCode(t, codes.Unauthenticated, err)
+
+	denied, total, err := repositoriesAudit.NewInMemoryAuditRepository().ListEntries(domainAudit.Filter{Action: "account.credit.denied"})
+	require.NoError(t, err)
+	require.Equal(t, 2, total)
+	assert.Equal(t, created.GetApiKey(), denied[0].Actor)
+	assert.Equal(t, created.GetAccountId(), denied[0].AccountID)
+	assert.Equal(t, "account:"+created.GetAccountId(), denied[0].Target)
+	assert.Equal(t, domainAudit.AnonymousActor, denied[1].Actor)
+
+	credits, _, err := repositoriesAudit.NewInMemoryAuditRepository().ListEntries(domainAudit.Filter{Action: "account.credit"})
+	require.NoError(t, err)
+	assert.Empty(t, credits)
+
+	place := &pb.PlaceOrderRequest{AccountId: other.GetAccountId(), Instrument: "BTC/USDT", Side: pb.Side_SIDE_BUY, Price: 1, Qty: 1}
+
+	_, err = suite.orders.PlaceOrder(signed(pb.OrderService_PlaceOrder_FullMethodName, place), place)
+	assertCode(t, codes.PermissionDenied, err)
+
+	streamReq := &pb.StreamExecutionReportsRequest{AccountId: other.GetAccountId()}
+
+	stream, err := suite.orders.StreamExecutionReports(signed(pb.OrderService_StreamExecutionReports_FullMethodName, streamReq), streamReq)
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	assertCode(t, codes.PermissionDenied, err)
+
+	_, err = suite.books.GetBook(ctx, &pb.GetBookRequest{Instrument: "BTC/USDT"})
+	assertCode(t, codes.NotFound, err)
+}
+
+func TestGrpcServer(t *testing.T) {
+	suite.Run(t, new(GrpcServerE2ETestSuite))
+}
@@ -0,0 +1,87 @@
+package grpcServer
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	daosAccount "github.com/juninhoitabh/clob-go/internal/infra/daos/account"
+	"github.com/juninhoitabh/clob-go/internal/infra/grpc-server/pb"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesAPIKey "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey"
+	repositoriesAudit "github.com/juninhoitabh/clob-go/internal/infra/repositories/audit"
+	repositoriesBook "github.com/juninhoitabh/clob-go/internal/infra/repositories/book"
+	repositoriesOrder "github.com/juninhoitabh/clob-go/internal/infra/repositories/order"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
+)
+
+// NewServer registers the account, order, book and trade services on the
+// in-memory repositories. A nil authenticate disables authentication, and
+// streams poll for changes every pollInterval. Admin calls go to the same
+// audit log as the REST API's.
+func NewServer(
+	placeOrder orderUsecases.IPlaceOrderUseCase,
+	authenticate apikeyUsecases.IAuthenticateUseCase,
+	pollInterval time.Duration,
+) *grpc.Server {
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+	bookRepo := repositoriesBook.NewInMemoryBookRepository()
+	orderRepo := repositoriesOrder.NewInMemoryOrderRepository()
+	tradeRepo := repositoriesTrade.NewInMemoryTradeRepository()
+
+	accountDAO := daosAccount.NewInMemoryAccountDAO(accountRepo.Mutex(), accountRepo.AccountsMap())
+
+	auth := &authInterceptor{
+		authenticate: authenticate,
+		accountRepo:  accountRepo,
+		audit: &auditor{
+			auditRepo:  repositoriesAudit.NewInMemoryAuditRepository(),
+			accountDAO: accountDAO,
+		},
+	}
+
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(auth.unary),
+		grpc.StreamInterceptor(auth.stream),
+	)
+
+	pb.RegisterAccountServiceServer(server, NewAccountService(
+		accountDAO,
+		accountRepo,
+		repositoriesAPIKey.NewInMemoryAPIKeyRepository(),
+	))
+	pb.RegisterOrderServiceServer(server, NewOrderService(
+		placeOrder,
+		orderUsecases.NewCancelOrderUseCase(bookRepo, orderRepo, accountRepo),
+		orderUsecases.NewGetOrderUseCase(orderRepo, accountRepo),
+		accountRepo,
+		tradeRepo,
+		pollInterval,
+	))
+	pb.RegisterBookServiceServer(server, NewBookService(bookRepo, pollInterval))
+	pb.RegisterTradeServiceServer(server, NewTradeService(tradeRepo, accountRepo))
+
+	return server
+}
+
+// Serve runs server on listener until ctx is done. Streams never end on their
+// own, so shutdown closes every connection instead of draining them.
+func Serve(ctx context.Context, server *grpc.Server, listener net.Listener) error {
+	stop := context.AfterFunc(ctx, server.Stop)
+	defer stop()
+
+	return server.Serve(listener)
+}
+
+func ListenAndServe(ctx context.Context, server *grpc.Server, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return Serve(ctx, server, listener)
+}
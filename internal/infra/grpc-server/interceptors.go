@@ -0,0 +1,244 @@
+package grpcServer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	"github.com/juninhoitabh/clob-go/internal/infra/grpc-server/pb"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+// A call authenticates like an HTTP request signed with an API key: the key,
+// timestamp and signature travel as the lower-cased X-API-* metadata, the
+// method is SignatureMethod, the path the full gRPC method name and the body
+// the deterministic protobuf encoding of the request.
+const SignatureMethod = "GRPC"
+
+var (
+	metadataKey       = strings.ToLower(domainAPIKey.HeaderKey)
+	metadataTimestamp = strings.ToLower(domainAPIKey.HeaderTimestamp)
+	metadataSignature = strings.ToLower(domainAPIKey.HeaderSignature)
+)
+
+type (
+	authInterceptor struct {
+		authenticate apikeyUsecases.IAuthenticateUseCase
+		accountRepo  domainAccount.IAccountRepository
+		audit        *auditor
+	}
+	// authStream authenticates a server stream once its request arrives.
+	authStream struct {
+		grpc.ServerStream
+		auth       *authInterceptor
+		ctx        context.Context
+		fullMethod string
+	}
+)
+
+func (a *authInterceptor) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := a.authorize(ctx, info.FullMethod, req)
+	if err != nil {
+		a.audit.denied(ctx, req, err)
+
+		return nil, statusError(err)
+	}
+
+	action, target := auditedAction(req)
+	if action == "" {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, statusError(err)
+		}
+
+		return resp, nil
+	}
+
+	before := a.audit.state(req)
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	a.audit.record(ctx, action, target, before, a.audit.state(req))
+
+	return resp, nil
+}
+
+func (a *authInterceptor) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, &authStream{ServerStream: ss, auth: a, ctx: ss.Context(), fullMethod: info.FullMethod})
+	if err != nil {
+		return statusError(err)
+	}
+
+	return nil
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+
+	s.ctx, err = s.auth.authorize(s.ctx, s.fullMethod, m)
+
+	return err
+}
+
+// authorize checks the caller may make the call and records it in ctx.
+func (a *authInterceptor) authorize(ctx context.Context, fullMethod string, req any) (context.Context, error) {
+	if a.authenticate == nil || isPublicCall(req) {
+		return ctx, nil
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ctx, fmt.Errorf("%w: unexpected request %T", shared.ErrInvalidParam, req)
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return ctx, fmt.Errorf("%w: %v", shared.ErrInvalidParam, err)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	timestamp, _ := strconv.ParseInt(firstValue(md, metadataTimestamp), 10, 64)
+
+	authenticateOutput, err := a.authenticate.Execute(apikeyUsecases.AuthenticateInput{
+		APIKey:    firstValue(md, metadataKey),
+		Signature: firstValue(md, metadataSignature),
+		Method:    SignatureMethod,
+		Path:      fullMethod,
+		Body:      body,
+		Timestamp: timestamp,
+	})
+	if err != nil {
+		return ctx, err
+	}
+
+	// A denied call still carries its caller, for the audit log.
+	ctx = shared.WithCaller(ctx, shared.Caller{
+		AccountID: authenticateOutput.AccountID,
+		APIKeyID:  authenticateOutput.APIKeyID,
+		Role:      authenticateOutput.Role,
+	})
+
+	role := domainAPIKey.Role(authenticateOutput.Role)
+	if !role.Allows(requiredRole(req)) {
+		return ctx, fmt.Errorf("%w: role %s not allowed", shared.ErrForbidden, role)
+	}
+
+	if accountID := targetAccountID(req); accountID != "" {
+		err = accountServices.Authorize(a.accountRepo, authenticateOutput.AccountID, accountID)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	return ctx, nil
+}
+
+func isPublicCall(req any) bool {
+	switch req := req.(type) {
+	case *pb.CreateAccountRequest:
+		return req.GetParentAccountId() == ""
+	case *pb.GetBookRequest, *pb.StreamBookRequest:
+		return true
+	case *pb.ListTradesRequest:
+		return req.GetAccountId() == ""
+	}
+
+	return false
+}
+
+func requiredRole(req any) domainAPIKey.Role {
+	switch req.(type) {
+	case *pb.CreditAccountRequest:
+		return domainAPIKey.RoleAdmin
+	case *pb.GetAccountRequest, *pb.GetOrderRequest, *pb.ListTradesRequest, *pb.StreamExecutionReportsRequest:
+		return domainAPIKey.RoleReadOnly
+	}
+
+	return domainAPIKey.RoleTrader
+}
+
+// targetAccountID is the account a call acts on when its use case does not
+// check ownership itself.
+func targetAccountID(req any) string {
+	switch req := req.(type) {
+	case *pb.CreateAccountRequest:
+		return req.GetParentAccountId()
+	case *pb.GetAccountRequest:
+		return req.GetAccountId()
+	case *pb.ListTradesRequest:
+		return req.GetAccountId()
+	case *pb.StreamExecutionReportsRequest:
+		return req.GetAccountId()
+	}
+
+	return ""
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// statusError maps err to the gRPC code matching the HTTP status the REST API
+// answers it with. Business rejections carry their code in the message.
+func statusError(err error) error {
+	if _, ok := status.FromError(err); ok || errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	errResp := shared.ErrorResponseFor(err)
+
+	message := errResp.Message
+	if errResp.Code != "" {
+		message = errResp.Code + ": " + message
+	}
+
+	var code codes.Code
+
+	switch errResp.Status {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusConflict:
+		code = codes.AlreadyExists
+	case http.StatusUnauthorized:
+		code = codes.Unauthenticated
+	case http.StatusForbidden:
+		code = codes.PermissionDenied
+	case http.StatusUnprocessableEntity:
+		code = codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		code = codes.ResourceExhausted
+	default:
+		code = codes.Internal
+	}
+
+	return status.Error(code, message)
+}
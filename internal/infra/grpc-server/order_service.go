@@ -0,0 +1,155 @@
+package grpcServer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainTrade "github.com/juninhoitabh/clob-go/internal/domain/trade"
+	"github.com/juninhoitabh/clob-go/internal/infra/fills"
+	"github.com/juninhoitabh/clob-go/internal/infra/grpc-server/pb"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type OrderService struct {
+	pb.UnimplementedOrderServiceServer
+	placeOrder   orderUsecases.IPlaceOrderUseCase
+	cancelOrder  orderUsecases.ICancelOrderUseCase
+	getOrder     orderUsecases.IGetOrderUseCase
+	accountRepo  domainAccount.IAccountRepository
+	tradeRepo    domainTrade.ITradeRepository
+	pollInterval time.Duration
+}
+
+func (o *OrderService) PlaceOrder(ctx context.Context, req *pb.PlaceOrderRequest) (*pb.PlaceOrderResponse, error) {
+	side, err := sideFromPb(req.GetSide())
+	if err != nil {
+		return nil, err
+	}
+
+	placeOrderOutput, err := o.placeOrder.Execute(orderUsecases.PlaceOrderInput{
+		AccountID:       req.GetAccountId(),
+		ClientOrderID:   req.GetClientOrderId(),
+		CallerAccountID: shared.CallerFromContext(ctx),
+		Instrument:      req.GetInstrument(),
+		Side:            side,
+		Price:           req.GetPrice(),
+		Qty:             req.GetQty(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.PlaceOrderResponse{
+		Order:       orderToPb(placeOrderOutput.Order),
+		Replayed:    placeOrderOutput.Replayed,
+		Halted:      placeOrderOutput.Halted,
+		CanceledQty: placeOrderOutput.CanceledQty,
+	}
+
+	if placeOrderOutput.TradeReport != nil {
+		for i := range placeOrderOutput.TradeReport.Trades {
+			resp.Trades = append(resp.Trades, tradeToPb(&placeOrderOutput.TradeReport.Trades[i]))
+		}
+	}
+
+	return resp, nil
+}
+
+func (o *OrderService) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.Order, error) {
+	cancelOrderOutput, err := o.cancelOrder.Execute(orderUsecases.CancelOrderInput{
+		OrderID:         req.GetOrderId(),
+		AccountID:       req.GetAccountId(),
+		ClientOrderID:   req.GetClientOrderId(),
+		CallerAccountID: shared.CallerFromContext(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return orderToPb(cancelOrderOutput.Order), nil
+}
+
+func (o *OrderService) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.Order, error) {
+	getOrderOutput, err := o.getOrder.Execute(orderUsecases.GetOrderInput{
+		OrderID:         req.GetOrderId(),
+		AccountID:       req.GetAccountId(),
+		ClientOrderID:   req.GetClientOrderId(),
+		CallerAccountID: shared.CallerFromContext(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return orderToPb(getOrderOutput.Order), nil
+}
+
+// StreamExecutionReports polls the account's trades every pollInterval, as
+// matching publishes no events.
+func (o *OrderService) StreamExecutionReports(req *pb.StreamExecutionReportsRequest, stream pb.OrderService_StreamExecutionReportsServer) error {
+	if req.GetAccountId() == "" {
+		return fmt.Errorf("%w: account_id is required", shared.ErrInvalidParam)
+	}
+
+	_, err := o.accountRepo.Get(req.GetAccountId())
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+
+	watcher := fills.NewWatcher(o.tradeRepo, o.getOrder)
+	watcher.Watch(req.GetAccountId())
+
+	ticker := time.NewTicker(o.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		found, err := watcher.Poll(shared.CallerFromContext(ctx))
+		if err != nil {
+			return err
+		}
+
+		for _, fill := range found {
+			liquidity := pb.Liquidity_LIQUIDITY_MAKER
+			if fill.Trade.TakerOrderID == fill.Order.GetID() {
+				liquidity = pb.Liquidity_LIQUIDITY_TAKER
+			}
+
+			err = stream.Send(&pb.ExecutionReport{
+				Order:     orderToPb(fill.Order),
+				Trade:     tradeToPb(&fill.Trade),
+				Liquidity: liquidity,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func NewOrderService(
+	placeOrder orderUsecases.IPlaceOrderUseCase,
+	cancelOrder orderUsecases.ICancelOrderUseCase,
+	getOrder orderUsecases.IGetOrderUseCase,
+	accountRepo domainAccount.IAccountRepository,
+	tradeRepo domainTrade.ITradeRepository,
+	pollInterval time.Duration,
+) *OrderService {
+	return &OrderService{
+		placeOrder:   placeOrder,
+		cancelOrder:  cancelOrder,
+		getOrder:     getOrder,
+		accountRepo:  accountRepo,
+		tradeRepo:    tradeRepo,
+		pollInterval: pollInterval,
+	}
+}
@@ -0,0 +1,1691 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: clob/v1/clob.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Side int32
+
+const (
+	Side_SIDE_UNSPECIFIED Side = 0
+	Side_SIDE_BUY         Side = 1
+	Side_SIDE_SELL        Side = 2
+)
+
+// Enum value maps for Side.
+var (
+	Side_name = map[int32]string{
+		0: "SIDE_UNSPECIFIED",
+		1: "SIDE_BUY",
+		2: "SIDE_SELL",
+	}
+	Side_value = map[string]int32{
+		"SIDE_UNSPECIFIED": 0,
+		"SIDE_BUY":         1,
+		"SIDE_SELL":        2,
+	}
+)
+
+func (x Side) Enum() *Side {
+	p := new(Side)
+	*p = x
+	return p
+}
+
+func (x Side) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Side) Descriptor() protoreflect.EnumDescriptor {
+	return file_clob_v1_clob_proto_enumTypes[0].Descriptor()
+}
+
+func (Side) Type() protoreflect.EnumType {
+	return &file_clob_v1_clob_proto_enumTypes[0]
+}
+
+func (x Side) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Side.Descriptor instead.
+func (Side) EnumDescriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{0}
+}
+
+type Liquidity int32
+
+const (
+	Liquidity_LIQUIDITY_UNSPECIFIED Liquidity = 0
+	Liquidity_LIQUIDITY_MAKER       Liquidity = 1
+	Liquidity_LIQUIDITY_TAKER       Liquidity = 2
+)
+
+// Enum value maps for Liquidity.
+var (
+	Liquidity_name = map[int32]string{
+		0: "LIQUIDITY_UNSPECIFIED",
+		1: "LIQUIDITY_MAKER",
+		2: "LIQUIDITY_TAKER",
+	}
+	Liquidity_value = map[string]int32{
+		"LIQUIDITY_UNSPECIFIED": 0,
+		"LIQUIDITY_MAKER":       1,
+		"LIQUIDITY_TAKER":       2,
+	}
+)
+
+func (x Liquidity) Enum() *Liquidity {
+	p := new(Liquidity)
+	*p = x
+	return p
+}
+
+func (x Liquidity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Liquidity) Descriptor() protoreflect.EnumDescriptor {
+	return file_clob_v1_clob_proto_enumTypes[1].Descriptor()
+}
+
+func (Liquidity) Type() protoreflect.EnumType {
+	return &file_clob_v1_clob_proto_enumTypes[1]
+}
+
+func (x Liquidity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Liquidity.Descriptor instead.
+func (Liquidity) EnumDescriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{1}
+}
+
+type Balance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Available     int64                  `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	Reserved      int64                  `protobuf:"varint,2,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	mi := &file_clob_v1_clob_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Balance) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *Balance) GetReserved() int64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+type Account struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountId       string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ParentAccountId string                 `protobuf:"bytes,2,opt,name=parent_account_id,json=parentAccountId,proto3" json:"parent_account_id,omitempty"`
+	Balances        map[string]*Balance    `protobuf:"bytes,3,rep,name=balances,proto3" json:"balances,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SubAccountIds   []string               `protobuf:"bytes,4,rep,name=sub_account_ids,json=subAccountIds,proto3" json:"sub_account_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_clob_v1_clob_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Account) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Account) GetParentAccountId() string {
+	if x != nil {
+		return x.ParentAccountId
+	}
+	return ""
+}
+
+func (x *Account) GetBalances() map[string]*Balance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *Account) GetSubAccountIds() []string {
+	if x != nil {
+		return x.SubAccountIds
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountName     string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	ParentAccountId string                 `protobuf:"bytes,2,opt,name=parent_account_id,json=parentAccountId,proto3" json:"parent_account_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_clob_v1_clob_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAccountRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetParentAccountId() string {
+	if x != nil {
+		return x.ParentAccountId
+	}
+	return ""
+}
+
+type CreateAccountResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountId       string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ParentAccountId string                 `protobuf:"bytes,2,opt,name=parent_account_id,json=parentAccountId,proto3" json:"parent_account_id,omitempty"`
+	ApiKey          string                 `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	ApiSecret       string                 `protobuf:"bytes,4,opt,name=api_secret,json=apiSecret,proto3" json:"api_secret,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_clob_v1_clob_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateAccountResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CreateAccountResponse) GetParentAccountId() string {
+	if x != nil {
+		return x.ParentAccountId
+	}
+	return ""
+}
+
+func (x *CreateAccountResponse) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *CreateAccountResponse) GetApiSecret() string {
+	if x != nil {
+		return x.ApiSecret
+	}
+	return ""
+}
+
+type GetAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_clob_v1_clob_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type CreditAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditAccountRequest) Reset() {
+	*x = CreditAccountRequest{}
+	mi := &file_clob_v1_clob_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditAccountRequest) ProtoMessage() {}
+
+func (x *CreditAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreditAccountRequest) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreditAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CreditAccountRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *CreditAccountRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Order struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ClientOrderId string                 `protobuf:"bytes,3,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
+	Instrument    string                 `protobuf:"bytes,4,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Side          Side                   `protobuf:"varint,5,opt,name=side,proto3,enum=clob.v1.Side" json:"side,omitempty"`
+	Price         int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	Qty           int64                  `protobuf:"varint,7,opt,name=qty,proto3" json:"qty,omitempty"`
+	Remaining     int64                  `protobuf:"varint,8,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_clob_v1_clob_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Order) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Order) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Order) GetClientOrderId() string {
+	if x != nil {
+		return x.ClientOrderId
+	}
+	return ""
+}
+
+func (x *Order) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *Order) GetSide() Side {
+	if x != nil {
+		return x.Side
+	}
+	return Side_SIDE_UNSPECIFIED
+}
+
+func (x *Order) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Order) GetQty() int64 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *Order) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Trade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeId       string                 `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	Instrument    string                 `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	TakerOrderId  string                 `protobuf:"bytes,3,opt,name=taker_order_id,json=takerOrderId,proto3" json:"taker_order_id,omitempty"`
+	MakerOrderId  string                 `protobuf:"bytes,4,opt,name=maker_order_id,json=makerOrderId,proto3" json:"maker_order_id,omitempty"`
+	BuyerId       string                 `protobuf:"bytes,5,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	SellerId      string                 `protobuf:"bytes,6,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	TakerSide     Side                   `protobuf:"varint,7,opt,name=taker_side,json=takerSide,proto3,enum=clob.v1.Side" json:"taker_side,omitempty"`
+	Price         int64                  `protobuf:"varint,8,opt,name=price,proto3" json:"price,omitempty"`
+	Qty           int64                  `protobuf:"varint,9,opt,name=qty,proto3" json:"qty,omitempty"`
+	MakerFee      int64                  `protobuf:"varint,10,opt,name=maker_fee,json=makerFee,proto3" json:"maker_fee,omitempty"`
+	MakerFeeAsset string                 `protobuf:"bytes,11,opt,name=maker_fee_asset,json=makerFeeAsset,proto3" json:"maker_fee_asset,omitempty"`
+	TakerFee      int64                  `protobuf:"varint,12,opt,name=taker_fee,json=takerFee,proto3" json:"taker_fee,omitempty"`
+	TakerFeeAsset string                 `protobuf:"bytes,13,opt,name=taker_fee_asset,json=takerFeeAsset,proto3" json:"taker_fee_asset,omitempty"`
+	ExecutedAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trade) Reset() {
+	*x = Trade{}
+	mi := &file_clob_v1_clob_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trade) ProtoMessage() {}
+
+func (x *Trade) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trade.ProtoReflect.Descriptor instead.
+func (*Trade) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Trade) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *Trade) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *Trade) GetTakerOrderId() string {
+	if x != nil {
+		return x.TakerOrderId
+	}
+	return ""
+}
+
+func (x *Trade) GetMakerOrderId() string {
+	if x != nil {
+		return x.MakerOrderId
+	}
+	return ""
+}
+
+func (x *Trade) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *Trade) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *Trade) GetTakerSide() Side {
+	if x != nil {
+		return x.TakerSide
+	}
+	return Side_SIDE_UNSPECIFIED
+}
+
+func (x *Trade) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Trade) GetQty() int64 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *Trade) GetMakerFee() int64 {
+	if x != nil {
+		return x.MakerFee
+	}
+	return 0
+}
+
+func (x *Trade) GetMakerFeeAsset() string {
+	if x != nil {
+		return x.MakerFeeAsset
+	}
+	return ""
+}
+
+func (x *Trade) GetTakerFee() int64 {
+	if x != nil {
+		return x.TakerFee
+	}
+	return 0
+}
+
+func (x *Trade) GetTakerFeeAsset() string {
+	if x != nil {
+		return x.TakerFeeAsset
+	}
+	return ""
+}
+
+func (x *Trade) GetExecutedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecutedAt
+	}
+	return nil
+}
+
+type PlaceOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ClientOrderId string                 `protobuf:"bytes,2,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
+	Instrument    string                 `protobuf:"bytes,3,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Side          Side                   `protobuf:"varint,4,opt,name=side,proto3,enum=clob.v1.Side" json:"side,omitempty"`
+	Price         int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Qty           int64                  `protobuf:"varint,6,opt,name=qty,proto3" json:"qty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceOrderRequest) Reset() {
+	*x = PlaceOrderRequest{}
+	mi := &file_clob_v1_clob_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderRequest) ProtoMessage() {}
+
+func (x *PlaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlaceOrderRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetClientOrderId() string {
+	if x != nil {
+		return x.ClientOrderId
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetSide() Side {
+	if x != nil {
+		return x.Side
+	}
+	return Side_SIDE_UNSPECIFIED
+}
+
+func (x *PlaceOrderRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetQty() int64 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+type PlaceOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Trades        []*Trade               `protobuf:"bytes,2,rep,name=trades,proto3" json:"trades,omitempty"`
+	Replayed      bool                   `protobuf:"varint,3,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	Halted        bool                   `protobuf:"varint,4,opt,name=halted,proto3" json:"halted,omitempty"`
+	CanceledQty   int64                  `protobuf:"varint,5,opt,name=canceled_qty,json=canceledQty,proto3" json:"canceled_qty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceOrderResponse) Reset() {
+	*x = PlaceOrderResponse{}
+	mi := &file_clob_v1_clob_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderResponse) ProtoMessage() {}
+
+func (x *PlaceOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderResponse.ProtoReflect.Descriptor instead.
+func (*PlaceOrderResponse) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PlaceOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *PlaceOrderResponse) GetTrades() []*Trade {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+func (x *PlaceOrderResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+func (x *PlaceOrderResponse) GetHalted() bool {
+	if x != nil {
+		return x.Halted
+	}
+	return false
+}
+
+func (x *PlaceOrderResponse) GetCanceledQty() int64 {
+	if x != nil {
+		return x.CanceledQty
+	}
+	return 0
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ClientOrderId string                 `protobuf:"bytes,3,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_clob_v1_clob_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetClientOrderId() string {
+	if x != nil {
+		return x.ClientOrderId
+	}
+	return ""
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ClientOrderId string                 `protobuf:"bytes,3,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_clob_v1_clob_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetOrderRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetOrderRequest) GetClientOrderId() string {
+	if x != nil {
+		return x.ClientOrderId
+	}
+	return ""
+}
+
+type StreamExecutionReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamExecutionReportsRequest) Reset() {
+	*x = StreamExecutionReportsRequest{}
+	mi := &file_clob_v1_clob_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamExecutionReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamExecutionReportsRequest) ProtoMessage() {}
+
+func (x *StreamExecutionReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamExecutionReportsRequest.ProtoReflect.Descriptor instead.
+func (*StreamExecutionReportsRequest) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StreamExecutionReportsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type ExecutionReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Trade         *Trade                 `protobuf:"bytes,2,opt,name=trade,proto3" json:"trade,omitempty"`
+	Liquidity     Liquidity              `protobuf:"varint,3,opt,name=liquidity,proto3,enum=clob.v1.Liquidity" json:"liquidity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionReport) Reset() {
+	*x = ExecutionReport{}
+	mi := &file_clob_v1_clob_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionReport) ProtoMessage() {}
+
+func (x *ExecutionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionReport.ProtoReflect.Descriptor instead.
+func (*ExecutionReport) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExecutionReport) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ExecutionReport) GetTrade() *Trade {
+	if x != nil {
+		return x.Trade
+	}
+	return nil
+}
+
+func (x *ExecutionReport) GetLiquidity() Liquidity {
+	if x != nil {
+		return x.Liquidity
+	}
+	return Liquidity_LIQUIDITY_UNSPECIFIED
+}
+
+type Level struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         int64                  `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	Qty           int64                  `protobuf:"varint,2,opt,name=qty,proto3" json:"qty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Level) Reset() {
+	*x = Level{}
+	mi := &file_clob_v1_clob_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Level) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Level) ProtoMessage() {}
+
+func (x *Level) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Level.ProtoReflect.Descriptor instead.
+func (*Level) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Level) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Level) GetQty() int64 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+type Book struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instrument    string                 `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Bids          []*Level               `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids,omitempty"`
+	Asks          []*Level               `protobuf:"bytes,3,rep,name=asks,proto3" json:"asks,omitempty"`
+	LastPrice     int64                  `protobuf:"varint,4,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	Halted        bool                   `protobuf:"varint,5,opt,name=halted,proto3" json:"halted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Book) Reset() {
+	*x = Book{}
+	mi := &file_clob_v1_clob_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Book) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Book) ProtoMessage() {}
+
+func (x *Book) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Book.ProtoReflect.Descriptor instead.
+func (*Book) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Book) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *Book) GetBids() []*Level {
+	if x != nil {
+		return x.Bids
+	}
+	return nil
+}
+
+func (x *Book) GetAsks() []*Level {
+	if x != nil {
+		return x.Asks
+	}
+	return nil
+}
+
+func (x *Book) GetLastPrice() int64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+func (x *Book) GetHalted() bool {
+	if x != nil {
+		return x.Halted
+	}
+	return false
+}
+
+type GetBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instrument    string                 `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookRequest) Reset() {
+	*x = GetBookRequest{}
+	mi := &file_clob_v1_clob_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookRequest) ProtoMessage() {}
+
+func (x *GetBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookRequest.ProtoReflect.Descriptor instead.
+func (*GetBookRequest) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetBookRequest) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+type StreamBookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instrument    string                 `protobuf:"bytes,1,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamBookRequest) Reset() {
+	*x = StreamBookRequest{}
+	mi := &file_clob_v1_clob_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBookRequest) ProtoMessage() {}
+
+func (x *StreamBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBookRequest.ProtoReflect.Descriptor instead.
+func (*StreamBookRequest) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StreamBookRequest) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+type ListTradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Instrument    string                 `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTradesRequest) Reset() {
+	*x = ListTradesRequest{}
+	mi := &file_clob_v1_clob_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradesRequest) ProtoMessage() {}
+
+func (x *ListTradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradesRequest.ProtoReflect.Descriptor instead.
+func (*ListTradesRequest) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListTradesRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListTradesRequest) GetInstrument() string {
+	if x != nil {
+		return x.Instrument
+	}
+	return ""
+}
+
+func (x *ListTradesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTradesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trades        []*Trade               `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTradesResponse) Reset() {
+	*x = ListTradesResponse{}
+	mi := &file_clob_v1_clob_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradesResponse) ProtoMessage() {}
+
+func (x *ListTradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_clob_v1_clob_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradesResponse.ProtoReflect.Descriptor instead.
+func (*ListTradesResponse) Descriptor() ([]byte, []int) {
+	return file_clob_v1_clob_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListTradesResponse) GetTrades() []*Trade {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+var File_clob_v1_clob_proto protoreflect.FileDescriptor
+
+const file_clob_v1_clob_proto_rawDesc = "" +
+	"\n" +
+	"\x12clob/v1/clob.proto\x12\aclob.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"C\n" +
+	"\aBalance\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\x03R\tavailable\x12\x1a\n" +
+	"\breserved\x18\x02 \x01(\x03R\breserved\"\x87\x02\n" +
+	"\aAccount\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12*\n" +
+	"\x11parent_account_id\x18\x02 \x01(\tR\x0fparentAccountId\x12:\n" +
+	"\bbalances\x18\x03 \x03(\v2\x1e.clob.v1.Account.BalancesEntryR\bbalances\x12&\n" +
+	"\x0fsub_account_ids\x18\x04 \x03(\tR\rsubAccountIds\x1aM\n" +
+	"\rBalancesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12&\n" +
+	"\x05value\x18\x02 \x01(\v2\x10.clob.v1.BalanceR\x05value:\x028\x01\"e\n" +
+	"\x14CreateAccountRequest\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12*\n" +
+	"\x11parent_account_id\x18\x02 \x01(\tR\x0fparentAccountId\"\x9a\x01\n" +
+	"\x15CreateAccountResponse\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12*\n" +
+	"\x11parent_account_id\x18\x02 \x01(\tR\x0fparentAccountId\x12\x17\n" +
+	"\aapi_key\x18\x03 \x01(\tR\x06apiKey\x12\x1d\n" +
+	"\n" +
+	"api_secret\x18\x04 \x01(\tR\tapiSecret\"2\n" +
+	"\x11GetAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"c\n" +
+	"\x14CreditAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\"\xad\x02\n" +
+	"\x05Order\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12&\n" +
+	"\x0fclient_order_id\x18\x03 \x01(\tR\rclientOrderId\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x04 \x01(\tR\n" +
+	"instrument\x12!\n" +
+	"\x04side\x18\x05 \x01(\x0e2\r.clob.v1.SideR\x04side\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x03R\x05price\x12\x10\n" +
+	"\x03qty\x18\a \x01(\x03R\x03qty\x12\x1c\n" +
+	"\tremaining\x18\b \x01(\x03R\tremaining\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xe3\x03\n" +
+	"\x05Trade\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\tR\atradeId\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x02 \x01(\tR\n" +
+	"instrument\x12$\n" +
+	"\x0etaker_order_id\x18\x03 \x01(\tR\ftakerOrderId\x12$\n" +
+	"\x0emaker_order_id\x18\x04 \x01(\tR\fmakerOrderId\x12\x19\n" +
+	"\bbuyer_id\x18\x05 \x01(\tR\abuyerId\x12\x1b\n" +
+	"\tseller_id\x18\x06 \x01(\tR\bsellerId\x12,\n" +
+	"\n" +
+	"taker_side\x18\a \x01(\x0e2\r.clob.v1.SideR\ttakerSide\x12\x14\n" +
+	"\x05price\x18\b \x01(\x03R\x05price\x12\x10\n" +
+	"\x03qty\x18\t \x01(\x03R\x03qty\x12\x1b\n" +
+	"\tmaker_fee\x18\n" +
+	" \x01(\x03R\bmakerFee\x12&\n" +
+	"\x0fmaker_fee_asset\x18\v \x01(\tR\rmakerFeeAsset\x12\x1b\n" +
+	"\ttaker_fee\x18\f \x01(\x03R\btakerFee\x12&\n" +
+	"\x0ftaker_fee_asset\x18\r \x01(\tR\rtakerFeeAsset\x12;\n" +
+	"\vexecuted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"executedAt\"\xc5\x01\n" +
+	"\x11PlaceOrderRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12&\n" +
+	"\x0fclient_order_id\x18\x02 \x01(\tR\rclientOrderId\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x03 \x01(\tR\n" +
+	"instrument\x12!\n" +
+	"\x04side\x18\x04 \x01(\x0e2\r.clob.v1.SideR\x04side\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x03R\x05price\x12\x10\n" +
+	"\x03qty\x18\x06 \x01(\x03R\x03qty\"\xb9\x01\n" +
+	"\x12PlaceOrderResponse\x12$\n" +
+	"\x05order\x18\x01 \x01(\v2\x0e.clob.v1.OrderR\x05order\x12&\n" +
+	"\x06trades\x18\x02 \x03(\v2\x0e.clob.v1.TradeR\x06trades\x12\x1a\n" +
+	"\breplayed\x18\x03 \x01(\bR\breplayed\x12\x16\n" +
+	"\x06halted\x18\x04 \x01(\bR\x06halted\x12!\n" +
+	"\fcanceled_qty\x18\x05 \x01(\x03R\vcanceledQty\"v\n" +
+	"\x12CancelOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12&\n" +
+	"\x0fclient_order_id\x18\x03 \x01(\tR\rclientOrderId\"s\n" +
+	"\x0fGetOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12&\n" +
+	"\x0fclient_order_id\x18\x03 \x01(\tR\rclientOrderId\">\n" +
+	"\x1dStreamExecutionReportsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"\x8f\x01\n" +
+	"\x0fExecutionReport\x12$\n" +
+	"\x05order\x18\x01 \x01(\v2\x0e.clob.v1.OrderR\x05order\x12$\n" +
+	"\x05trade\x18\x02 \x01(\v2\x0e.clob.v1.TradeR\x05trade\x120\n" +
+	"\tliquidity\x18\x03 \x01(\x0e2\x12.clob.v1.LiquidityR\tliquidity\"/\n" +
+	"\x05Level\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x03R\x05price\x12\x10\n" +
+	"\x03qty\x18\x02 \x01(\x03R\x03qty\"\xa5\x01\n" +
+	"\x04Book\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x01 \x01(\tR\n" +
+	"instrument\x12\"\n" +
+	"\x04bids\x18\x02 \x03(\v2\x0e.clob.v1.LevelR\x04bids\x12\"\n" +
+	"\x04asks\x18\x03 \x03(\v2\x0e.clob.v1.LevelR\x04asks\x12\x1d\n" +
+	"\n" +
+	"last_price\x18\x04 \x01(\x03R\tlastPrice\x12\x16\n" +
+	"\x06halted\x18\x05 \x01(\bR\x06halted\"0\n" +
+	"\x0eGetBookRequest\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x01 \x01(\tR\n" +
+	"instrument\"3\n" +
+	"\x11StreamBookRequest\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x01 \x01(\tR\n" +
+	"instrument\"h\n" +
+	"\x11ListTradesRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1e\n" +
+	"\n" +
+	"instrument\x18\x02 \x01(\tR\n" +
+	"instrument\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"<\n" +
+	"\x12ListTradesResponse\x12&\n" +
+	"\x06trades\x18\x01 \x03(\v2\x0e.clob.v1.TradeR\x06trades*9\n" +
+	"\x04Side\x12\x14\n" +
+	"\x10SIDE_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bSIDE_BUY\x10\x01\x12\r\n" +
+	"\tSIDE_SELL\x10\x02*P\n" +
+	"\tLiquidity\x12\x19\n" +
+	"\x15LIQUIDITY_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fLIQUIDITY_MAKER\x10\x01\x12\x13\n" +
+	"\x0fLIQUIDITY_TAKER\x10\x022\xde\x01\n" +
+	"\x0eAccountService\x12N\n" +
+	"\rCreateAccount\x12\x1d.clob.v1.CreateAccountRequest\x1a\x1e.clob.v1.CreateAccountResponse\x12:\n" +
+	"\n" +
+	"GetAccount\x12\x1a.clob.v1.GetAccountRequest\x1a\x10.clob.v1.Account\x12@\n" +
+	"\rCreditAccount\x12\x1d.clob.v1.CreditAccountRequest\x1a\x10.clob.v1.Account2\xa5\x02\n" +
+	"\fOrderService\x12E\n" +
+	"\n" +
+	"PlaceOrder\x12\x1a.clob.v1.PlaceOrderRequest\x1a\x1b.clob.v1.PlaceOrderResponse\x12:\n" +
+	"\vCancelOrder\x12\x1b.clob.v1.CancelOrderRequest\x1a\x0e.clob.v1.Order\x124\n" +
+	"\bGetOrder\x12\x18.clob.v1.GetOrderRequest\x1a\x0e.clob.v1.Order\x12\\\n" +
+	"\x16StreamExecutionReports\x12&.clob.v1.StreamExecutionReportsRequest\x1a\x18.clob.v1.ExecutionReport0\x012{\n" +
+	"\vBookService\x121\n" +
+	"\aGetBook\x12\x17.clob.v1.GetBookRequest\x1a\r.clob.v1.Book\x129\n" +
+	"\n" +
+	"StreamBook\x12\x1a.clob.v1.StreamBookRequest\x1a\r.clob.v1.Book0\x012U\n" +
+	"\fTradeService\x12E\n" +
+	"\n" +
+	"ListTrades\x12\x1a.clob.v1.ListTradesRequest\x1a\x1b.clob.v1.ListTradesResponseBBZ@github.com/juninhoitabh/clob-go/internal/infra/grpc-server/pb;pbb\x06proto3"
+
+var (
+	file_clob_v1_clob_proto_rawDescOnce sync.Once
+	file_clob_v1_clob_proto_rawDescData []byte
+)
+
+func file_clob_v1_clob_proto_rawDescGZIP() []byte {
+	file_clob_v1_clob_proto_rawDescOnce.Do(func() {
+		file_clob_v1_clob_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_clob_v1_clob_proto_rawDesc), len(file_clob_v1_clob_proto_rawDesc)))
+	})
+	return file_clob_v1_clob_proto_rawDescData
+}
+
+var file_clob_v1_clob_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_clob_v1_clob_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_clob_v1_clob_proto_goTypes = []any{
+	(Side)(0),                             // 0: clob.v1.Side
+	(Liquidity)(0),                        // 1: clob.v1.Liquidity
+	(*Balance)(nil),                       // 2: clob.v1.Balance
+	(*Account)(nil),                       // 3: clob.v1.Account
+	(*CreateAccountRequest)(nil),          // 4: clob.v1.CreateAccountRequest
+	(*CreateAccountResponse)(nil),         // 5: clob.v1.CreateAccountResponse
+	(*GetAccountRequest)(nil),             // 6: clob.v1.GetAccountRequest
+	(*CreditAccountRequest)(nil),          // 7: clob.v1.CreditAccountRequest
+	(*Order)(nil),                         // 8: clob.v1.Order
+	(*Trade)(nil),                         // 9: clob.v1.Trade
+	(*PlaceOrderRequest)(nil),             // 10: clob.v1.PlaceOrderRequest
+	(*PlaceOrderResponse)(nil),            // 11: clob.v1.PlaceOrderResponse
+	(*CancelOrderRequest)(nil),            // 12: clob.v1.CancelOrderRequest
+	(*GetOrderRequest)(nil),               // 13: clob.v1.GetOrderRequest
+	(*StreamExecutionReportsRequest)(nil), // 14: clob.v1.StreamExecutionReportsRequest
+	(*ExecutionReport)(nil),               // 15: clob.v1.ExecutionReport
+	(*Level)(nil),                         // 16: clob.v1.Level
+	(*Book)(nil),                          // 17: clob.v1.Book
+	(*GetBookRequest)(nil),                // 18: clob.v1.GetBookRequest
+	(*StreamBookRequest)(nil),             // 19: clob.v1.StreamBookRequest
+	(*ListTradesRequest)(nil),             // 20: clob.v1.ListTradesRequest
+	(*ListTradesResponse)(nil),            // 21: clob.v1.ListTradesResponse
+	nil,                                   // 22: clob.v1.Account.BalancesEntry
+	(*timestamppb.Timestamp)(nil),         // 23: google.protobuf.Timestamp
+}
+var file_clob_v1_clob_proto_depIdxs = []int32{
+	22, // 0: clob.v1.Account.balances:type_name -> clob.v1.Account.BalancesEntry
+	0,  // 1: clob.v1.Order.side:type_name -> clob.v1.Side
+	23, // 2: clob.v1.Order.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: clob.v1.Trade.taker_side:type_name -> clob.v1.Side
+	23, // 4: clob.v1.Trade.executed_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: clob.v1.PlaceOrderRequest.side:type_name -> clob.v1.Side
+	8,  // 6: clob.v1.PlaceOrderResponse.order:type_name -> clob.v1.Order
+	9,  // 7: clob.v1.PlaceOrderResponse.trades:type_name -> clob.v1.Trade
+	8,  // 8: clob.v1.ExecutionReport.order:type_name -> clob.v1.Order
+	9,  // 9: clob.v1.ExecutionReport.trade:type_name -> clob.v1.Trade
+	1,  // 10: clob.v1.ExecutionReport.liquidity:type_name -> clob.v1.Liquidity
+	16, // 11: clob.v1.Book.bids:type_name -> clob.v1.Level
+	16, // 12: clob.v1.Book.asks:type_name -> clob.v1.Level
+	9,  // 13: clob.v1.ListTradesResponse.trades:type_name -> clob.v1.Trade
+	2,  // 14: clob.v1.Account.BalancesEntry.value:type_name -> clob.v1.Balance
+	4,  // 15: clob.v1.AccountService.CreateAccount:input_type -> clob.v1.CreateAccountRequest
+	6,  // 16: clob.v1.AccountService.GetAccount:input_type -> clob.v1.GetAccountRequest
+	7,  // 17: clob.v1.AccountService.CreditAccount:input_type -> clob.v1.CreditAccountRequest
+	10, // 18: clob.v1.OrderService.PlaceOrder:input_type -> clob.v1.PlaceOrderRequest
+	12, // 19: clob.v1.OrderService.CancelOrder:input_type -> clob.v1.CancelOrderRequest
+	13, // 20: clob.v1.OrderService.GetOrder:input_type -> clob.v1.GetOrderRequest
+	14, // 21: clob.v1.OrderService.StreamExecutionReports:input_type -> clob.v1.StreamExecutionReportsRequest
+	18, // 22: clob.v1.BookService.GetBook:input_type -> clob.v1.GetBookRequest
+	19, // 23: clob.v1.BookService.StreamBook:input_type -> clob.v1.StreamBookRequest
+	20, // 24: clob.v1.TradeService.ListTrades:input_type -> clob.v1.ListTradesRequest
+	5,  // 25: clob.v1.AccountService.CreateAccount:output_type -> clob.v1.CreateAccountResponse
+	3,  // 26: clob.v1.AccountService.GetAccount:output_type -> clob.v1.Account
+	3,  // 27: clob.v1.AccountService.CreditAccount:output_type -> clob.v1.Account
+	11, // 28: clob.v1.OrderService.PlaceOrder:output_type -> clob.v1.PlaceOrderResponse
+	8,  // 29: clob.v1.OrderService.CancelOrder:output_type -> clob.v1.Order
+	8,  // 30: clob.v1.OrderService.GetOrder:output_type -> clob.v1.Order
+	15, // 31: clob.v1.OrderService.StreamExecutionReports:output_type -> clob.v1.ExecutionReport
+	17, // 32: clob.v1.BookService.GetBook:output_type -> clob.v1.Book
+	17, // 33: clob.v1.BookService.StreamBook:output_type -> clob.v1.Book
+	21, // 34: clob.v1.TradeService.ListTrades:output_type -> clob.v1.ListTradesResponse
+	25, // [25:35] is the sub-list for method output_type
+	15, // [15:25] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_clob_v1_clob_proto_init() }
+func file_clob_v1_clob_proto_init() {
+	if File_clob_v1_clob_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_clob_v1_clob_proto_rawDesc), len(file_clob_v1_clob_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   4,
+		},
+		GoTypes:           file_clob_v1_clob_proto_goTypes,
+		DependencyIndexes: file_clob_v1_clob_proto_depIdxs,
+		EnumInfos:         file_clob_v1_clob_proto_enumTypes,
+		MessageInfos:      file_clob_v1_clob_proto_msgTypes,
+	}.Build()
+	File_clob_v1_clob_proto = out.File
+	file_clob_v1_clob_proto_goTypes = nil
+	file_clob_v1_clob_proto_depIdxs = nil
+}
@@ -0,0 +1,49 @@
+package grpcServer
+
+import (
+	"context"
+	"strings"
+
+	tradeUsecases "github.com/juninhoitabh/clob-go/internal/application/trade/usecases"
+	"github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainTrade "github.com/juninhoitabh/clob-go/internal/domain/trade"
+	"github.com/juninhoitabh/clob-go/internal/infra/grpc-server/pb"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type TradeService struct {
+	pb.UnimplementedTradeServiceServer
+	tradeRepo   domainTrade.ITradeRepository
+	accountRepo account.IAccountRepository
+}
+
+func (t *TradeService) ListTrades(ctx context.Context, req *pb.ListTradesRequest) (*pb.ListTradesResponse, error) {
+	listTradesUseCase := tradeUsecases.NewListTradesUseCase(t.tradeRepo, t.accountRepo)
+
+	output, err := listTradesUseCase.Execute(tradeUsecases.ListTradesInput{
+		AccountID:       req.GetAccountId(),
+		Instrument:      strings.ToUpper(req.GetInstrument()),
+		CallerAccountID: shared.CallerFromContext(ctx),
+		Limit:           int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListTradesResponse{
+		Trades: make([]*pb.Trade, 0, len(output.Trades)),
+	}
+
+	for _, trade := range output.Trades {
+		resp.Trades = append(resp.Trades, tradeToPb(trade))
+	}
+
+	return resp, nil
+}
+
+func NewTradeService(tradeRepo domainTrade.ITradeRepository, accountRepo account.IAccountRepository) *TradeService {
+	return &TradeService{
+		tradeRepo:   tradeRepo,
+		accountRepo: accountRepo,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/juninhoitabh/clob-go/internal/infra/config"
+	"github.com/juninhoitabh/clob-go/internal/infra/http-server/router/routes"
 )
 
 type E2eTestHandle struct {
@@ -31,7 +32,7 @@ func httpServerInitTest() *httptest.Server {
 
 		httpServer := &HttpServer{}
 
-		httpHandler := httpServer.generateRoutes(config.EnvConfigInstance.ApiPort)
+		httpHandler := httpServer.generateRoutes(config.EnvConfigInstance.ApiPort, routes.NewPlaceOrderUseCase())
 
 		instance = httptest.NewServer(httpHandler)
 	})
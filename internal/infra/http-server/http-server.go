@@ -4,20 +4,41 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"google.golang.org/grpc"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	deadmanUsecases "github.com/juninhoitabh/clob-go/internal/application/deadman/usecases"
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	reconciliationUsecases "github.com/juninhoitabh/clob-go/internal/application/reconciliation/usecases"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
 	"github.com/juninhoitabh/clob-go/internal/infra/config"
+	"github.com/juninhoitabh/clob-go/internal/infra/fix"
+	grpcServer "github.com/juninhoitabh/clob-go/internal/infra/grpc-server"
 	"github.com/juninhoitabh/clob-go/internal/infra/http-server/router"
+	"github.com/juninhoitabh/clob-go/internal/infra/http-server/router/routes"
+	"github.com/juninhoitabh/clob-go/internal/infra/jobs"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesAPIKey "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey"
+	repositoriesBook "github.com/juninhoitabh/clob-go/internal/infra/repositories/book"
+	repositoriesDeadman "github.com/juninhoitabh/clob-go/internal/infra/repositories/deadman"
+	repositoriesLedger "github.com/juninhoitabh/clob-go/internal/infra/repositories/ledger"
+	repositoriesOrder "github.com/juninhoitabh/clob-go/internal/infra/repositories/order"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
+	"github.com/juninhoitabh/clob-go/internal/infra/wire"
 )
 
 type HttpServer struct{}
 
-func (s *HttpServer) generateRoutes(apiPort string) http.Handler {
-	return router.Generate(apiPort)
+func (s *HttpServer) generateRoutes(apiPort string, placeOrderUseCase *orderUsecases.PlaceOrderUseCase) http.Handler {
+	return router.Generate(apiPort, placeOrderUseCase)
 }
 
 func Start() {
@@ -25,7 +46,35 @@ func Start() {
 
 	apiPort := config.EnvConfigInstance.ApiPort
 
-	server := &http.Server{Addr: fmt.Sprintf(":%s", apiPort), Handler: httpServer.generateRoutes(apiPort)}
+	// The fee account exists before the first order arrives, so no user can
+	// hold its ID by the time a trade collects into it.
+	_, err := accountServices.EnsureFeeAccount(repositoriesAccount.NewInMemoryAccountRepository(), config.EnvConfigInstance.FeeAccountID)
+	if err != nil {
+		log.Fatalf("fee account %q: %v", config.EnvConfigInstance.FeeAccountID, err)
+	}
+
+	// Order IDs keep counting from the orders already stored.
+	lastSeq, err := repositoriesOrder.NewInMemoryOrderRepository().LastSeq()
+	if err != nil {
+		log.Fatalf("last order seq: %v", err)
+	}
+
+	domainOrder.SeedSeq(lastSeq)
+
+	// Every gateway places orders through one use case, so they share its
+	// risk chain.
+	placeOrderUseCase := routes.NewPlaceOrderUseCase()
+
+	// Streaming sessions only end when their request context does, so cancel
+	// every request context once shutdown starts.
+	requestCtx, cancelRequests := context.WithCancel(context.Background())
+
+	server := &http.Server{
+		Addr:        fmt.Sprintf(":%s", apiPort),
+		Handler:     httpServer.generateRoutes(apiPort, placeOrderUseCase),
+		BaseContext: func(net.Listener) context.Context { return requestCtx },
+	}
+	server.RegisterOnShutdown(cancelRequests)
 
 	serverCtx, serverStopCtx := context.WithCancel(context.Background())
 	sig := make(chan os.Signal, 1)
@@ -33,6 +82,42 @@ func Start() {
 
 	go gracefulShutdown(sig, serverCtx, server, serverStopCtx)
 
+	go newReconciliationJob().Run(serverCtx)
+	go newDeadManSwitchJob().Run(serverCtx)
+
+	if fixPort := config.EnvConfigInstance.FixPort; fixPort != "" {
+		go func() {
+			fmt.Printf("FIX acceptor is starting on port %s\n", fixPort)
+
+			err := newFixAcceptor(placeOrderUseCase).ListenAndServe(serverCtx, fmt.Sprintf(":%s", fixPort))
+			if err != nil {
+				log.Printf("FIX acceptor stopped: %v", err)
+			}
+		}()
+	}
+
+	if binaryPort := config.EnvConfigInstance.BinaryPort; binaryPort != "" {
+		go func() {
+			fmt.Printf("Binary order-entry server is starting on port %s\n", binaryPort)
+
+			err := newWireServer(placeOrderUseCase).ListenAndServe(serverCtx, fmt.Sprintf(":%s", binaryPort))
+			if err != nil {
+				log.Printf("Binary order-entry server stopped: %v", err)
+			}
+		}()
+	}
+
+	if grpcPort := config.EnvConfigInstance.GrpcPort; grpcPort != "" {
+		go func() {
+			fmt.Printf("gRPC Server is starting on port %s\n", grpcPort)
+
+			err := grpcServer.ListenAndServe(serverCtx, newGrpcServer(placeOrderUseCase), fmt.Sprintf(":%s", grpcPort))
+			if err != nil {
+				log.Printf("gRPC Server stopped: %v", err)
+			}
+		}()
+	}
+
 	fmt.Printf("Http Server is starting on port %s\n", apiPort)
 
 	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -42,11 +127,86 @@ func Start() {
 	<-serverCtx.Done()
 }
 
+func newReconciliationJob() *jobs.ReconciliationJob {
+	reconcileUseCase := reconciliationUsecases.NewReconcileUseCase(
+		repositoriesAccount.NewInMemoryAccountRepository(),
+		repositoriesBook.NewInMemoryBookRepository(),
+		repositoriesLedger.NewInMemoryLedgerRepository(),
+		config.EnvConfigInstance.FeeAccountID,
+	)
+
+	return jobs.NewReconciliationJob(reconcileUseCase, config.EnvConfigInstance.ReconciliationInterval, log.Default())
+}
+
+func newDeadManSwitchJob() *jobs.DeadManSwitchJob {
+	fireExpiredSwitchesUseCase := deadmanUsecases.NewFireExpiredSwitchesUseCase(
+		repositoriesDeadman.NewInMemorySwitchRepository(),
+		orderUsecases.NewMassCancelOrdersUseCase(
+			repositoriesBook.NewInMemoryBookRepository(),
+			repositoriesOrder.NewInMemoryOrderRepository(),
+			repositoriesAccount.NewInMemoryAccountRepository(),
+		),
+	)
+
+	return jobs.NewDeadManSwitchJob(fireExpiredSwitchesUseCase, config.EnvConfigInstance.DeadManSwitchInterval, log.Default(), nil)
+}
+
+func newFixAcceptor(placeOrderUseCase orderUsecases.IPlaceOrderUseCase) *fix.Acceptor {
+	orderRepo := repositoriesOrder.NewInMemoryOrderRepository()
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+
+	gateway := fix.NewGateway(
+		placeOrderUseCase,
+		orderUsecases.NewCancelOrderUseCase(repositoriesBook.NewInMemoryBookRepository(), orderRepo, accountRepo),
+		orderUsecases.NewGetOrderUseCase(orderRepo, accountRepo),
+		repositoriesTrade.NewInMemoryTradeRepository(),
+		config.EnvConfigInstance.FixFillPollInterval,
+		log.Default(),
+	)
+
+	return fix.NewAcceptor(config.EnvConfigInstance.FixCompID, gateway, newAuthenticateUseCase(), log.Default())
+}
+
+func newWireServer(placeOrderUseCase orderUsecases.IPlaceOrderUseCase) *wire.Server {
+	orderRepo := repositoriesOrder.NewInMemoryOrderRepository()
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+
+	return wire.NewServer(
+		placeOrderUseCase,
+		orderUsecases.NewCancelOrderUseCase(repositoriesBook.NewInMemoryBookRepository(), orderRepo, accountRepo),
+		orderUsecases.NewGetOrderUseCase(orderRepo, accountRepo),
+		repositoriesTrade.NewInMemoryTradeRepository(),
+		newAuthenticateUseCase(),
+		config.EnvConfigInstance.BinaryFillPollInterval,
+		log.Default(),
+	)
+}
+
+func newGrpcServer(placeOrderUseCase orderUsecases.IPlaceOrderUseCase) *grpc.Server {
+	return grpcServer.NewServer(
+		placeOrderUseCase,
+		newAuthenticateUseCase(),
+		config.EnvConfigInstance.GrpcStreamPollInterval,
+	)
+}
+
+// newAuthenticateUseCase returns nil when authentication is disabled.
+func newAuthenticateUseCase() apikeyUsecases.IAuthenticateUseCase {
+	if !config.EnvConfigInstance.AuthEnabled {
+		return nil
+	}
+
+	return apikeyUsecases.NewAuthenticateUseCase(
+		repositoriesAPIKey.NewInMemoryAPIKeyRepository(),
+		config.EnvConfigInstance.AuthMaxSkew,
+	)
+}
+
 func gracefulShutdown(sig chan os.Signal, serverCtx context.Context, server *http.Server, serverStopCtx context.CancelFunc) {
 	<-sig
 
-	//nolint:govet
-	shutdownCtx, _ := context.WithTimeout(serverCtx, 30*time.Second)
+	shutdownCtx, cancel := context.WithTimeout(serverCtx, 30*time.Second)
+	defer cancel()
 
 	go func() {
 		<-shutdownCtx.Done()
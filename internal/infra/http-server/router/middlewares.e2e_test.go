@@ -0,0 +1,170 @@
+//go:build all || e2e || infra
+
+package router
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+const apiV1PrefixTest = "/api/v1"
+
+func newRateLimitedHandler(t *testing.T, cfg *config.Config) http.Handler {
+	t.Helper()
+
+	previous := config.EnvConfigInstance
+	config.EnvConfigInstance = cfg
+
+	t.Cleanup(func() { config.EnvConfigInstance = previous })
+
+	return withRateLimit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), apiV1PrefixTest)
+}
+
+func serve(handler http.Handler, method, path, remoteAddr string, caller *shared.Caller) *httptest.ResponseRecorder {
+	return serveBody(handler, method, path, remoteAddr, caller, "")
+}
+
+func serveBody(handler http.Handler, method, path, remoteAddr string, caller *shared.Caller, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.RemoteAddr = remoteAddr
+
+	if caller != nil {
+		req = req.WithContext(shared.WithCaller(req.Context(), *caller))
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestWithRateLimit_OrdersBudget(t *testing.T) {
+	handler := newRateLimitedHandler(t, &config.Config{
+		RateLimitEnabled:     true,
+		RateLimitOrders:      1,
+		RateLimitOrdersBurst: 2,
+	})
+
+	for remaining := 1; remaining >= 0; remaining-- {
+		rec := serve(handler, http.MethodPost, "/api/v1/orders", "10.0.0.1:5000", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "2", rec.Header().Get("X-RateLimit-Limit"))
+		assert.Equal(t, strconv.Itoa(remaining), rec.Header().Get("X-RateLimit-Remaining"))
+	}
+
+	rec := serve(handler, http.MethodPost, "/api/v1/orders", "10.0.0.1:5001", nil)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("Retry-After"))
+	assert.Equal(t, "0", rec.Header().Get("X-RateLimit-Remaining"))
+	assert.Equal(t, "2", rec.Header().Get("X-RateLimit-Reset"))
+	assert.Contains(t, rec.Body.String(), "orders budget exhausted")
+
+	rec = serve(handler, http.MethodPost, "/api/v1/orders", "10.0.0.2:5000", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestWithRateLimit_SeparateBudgets(t *testing.T) {
+	handler := newRateLimitedHandler(t, &config.Config{
+		RateLimitEnabled:         true,
+		RateLimitOrders:          1,
+		RateLimitOrdersBurst:     1,
+		RateLimitCancels:         1,
+		RateLimitCancelsBurst:    1,
+		RateLimitMarketData:      1,
+		RateLimitMarketDataBurst: 1,
+	})
+
+	addr := "10.0.0.3:5000"
+
+	assert.Equal(t, http.StatusOK, serve(handler, http.MethodPost, "/api/v1/orders", addr, nil).Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(handler, http.MethodPost, "/api/v1/orders", addr, nil).Code)
+
+	assert.Equal(t, http.StatusOK, serve(handler, http.MethodPost, "/api/v1/orders/123/cancel", addr, nil).Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(handler, http.MethodPost, "/api/v1/orders/456/cancel", addr, nil).Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(handler, http.MethodPost, "/api/v1/accounts/123/client-orders/c-1/cancel", addr, nil).Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(handler, http.MethodPost, "/api/v1/accounts/123/orders/cancel-all", addr, nil).Code)
+
+	assert.Equal(t, http.StatusOK, serve(handler, http.MethodGet, "/api/v1/books?instrument=BTC/USDT", addr, nil).Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(handler, http.MethodGet, "/api/v1/trades", addr, nil).Code)
+
+	rec := serve(handler, http.MethodGet, "/api/v1/accounts/123", addr, nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("X-RateLimit-Limit"))
+}
+
+func TestWithRateLimit_BatchCostsOneTokenPerOrder(t *testing.T) {
+	var received string
+
+	previous := config.EnvConfigInstance
+	config.EnvConfigInstance = &config.Config{
+		RateLimitEnabled:      true,
+		RateLimitOrders:       1,
+		RateLimitOrdersBurst:  3,
+		RateLimitCancels:      1,
+		RateLimitCancelsBurst: 1,
+	}
+
+	t.Cleanup(func() { config.EnvConfigInstance = previous })
+
+	handler := withRateLimit(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+
+		w.WriteHeader(http.StatusOK)
+	}), apiV1PrefixTest)
+
+	addr := "10.0.0.6:5000"
+	batch := `{"orders":[{},{}]}`
+
+	rec := serveBody(handler, http.MethodPost, "/api/v1/orders/batch", addr, nil, batch)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("X-RateLimit-Remaining"))
+	assert.Equal(t, batch, received)
+
+	rec = serveBody(handler, http.MethodPost, "/api/v1/orders/batch", addr, nil, batch)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	assert.Equal(t, http.StatusOK, serveBody(handler, http.MethodPost, "/api/v1/orders/cancel-batch", addr, nil, `{"orders":[{}]}`).Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(handler, http.MethodPost, "/api/v1/orders/1/cancel", addr, nil).Code)
+}
+
+func TestWithRateLimit_KeyedByAPIKey(t *testing.T) {
+	handler := newRateLimitedHandler(t, &config.Config{
+		RateLimitEnabled:     true,
+		RateLimitOrders:      1,
+		RateLimitOrdersBurst: 1,
+	})
+
+	addr := "10.0.0.4:5000"
+	alice := &shared.Caller{AccountID: "alice", APIKeyID: "alice-key"}
+	bob := &shared.Caller{AccountID: "bob", APIKeyID: "bob-key"}
+
+	assert.Equal(t, http.StatusOK, serve(handler, http.MethodPost, "/api/v1/orders", addr, alice).Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(handler, http.MethodPost, "/api/v1/orders", addr, alice).Code)
+	assert.Equal(t, http.StatusOK, serve(handler, http.MethodPost, "/api/v1/orders", addr, bob).Code)
+	assert.Equal(t, http.StatusOK, serve(handler, http.MethodPost, "/api/v1/orders", addr, nil).Code)
+}
+
+func TestWithRateLimit_Disabled(t *testing.T) {
+	handler := newRateLimitedHandler(t, &config.Config{
+		RateLimitOrders:      1,
+		RateLimitOrdersBurst: 1,
+	})
+
+	for range 3 {
+		rec := serve(handler, http.MethodPost, "/api/v1/orders", "10.0.0.5:5000", nil)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+}
@@ -1,16 +1,34 @@
 package router
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
 	"log"
+	"math"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	accountServices "github.com/juninhoitabh/clob-go/internal/domain/account/services"
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
+	"github.com/juninhoitabh/clob-go/internal/infra/ratelimit"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesAPIKey "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey"
+	"github.com/juninhoitabh/clob-go/internal/shared"
 )
 
 func withCORS(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Authorization, Content-Type, X-CSRF-Token")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Authorization, Content-Type, X-CSRF-Token, Idempotency-Key, X-API-Key, X-API-Timestamp, X-API-Signature")
+		w.Header().Set("Access-Control-Expose-Headers", "Retry-After, X-RateLimit-Limit, X-RateLimit-Remaining, X-RateLimit-Reset")
 		w.Header().Set("Access-Control-Max-Age", "300")
 
 		if r.Method == "OPTIONS" {
@@ -52,6 +70,12 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer, which
+// streaming handlers need to flush.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 func withLogging(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		wrapped := &responseWriter{
@@ -76,3 +100,235 @@ func withLogging(next http.Handler) http.Handler {
 		)
 	})
 }
+
+func withAuth(next http.Handler, apiV1Prefix string) http.Handler {
+	if !config.EnvConfigInstance.AuthEnabled {
+		return next
+	}
+
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+	authenticateUseCase := apikeyUsecases.NewAuthenticateUseCase(
+		repositoriesAPIKey.NewInMemoryAPIKeyRepository(),
+		config.EnvConfigInstance.AuthMaxSkew,
+	)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isPublicRoute(r, apiV1Prefix) {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			shared.BadRequestError(w, "Invalid body", err.Error())
+
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		timestamp, _ := strconv.ParseInt(r.Header.Get(domainAPIKey.HeaderTimestamp), 10, 64)
+
+		authenticateOutput, err := authenticateUseCase.Execute(apikeyUsecases.AuthenticateInput{
+			APIKey:    r.Header.Get(domainAPIKey.HeaderKey),
+			Signature: r.Header.Get(domainAPIKey.HeaderSignature),
+			Method:    r.Method,
+			Path:      r.URL.RequestURI(),
+			Body:      body,
+			Timestamp: timestamp,
+		})
+		if err != nil {
+			shared.HandleError(w, err)
+
+			return
+		}
+
+		role := domainAPIKey.Role(authenticateOutput.Role)
+
+		if !role.Allows(requiredRole(r, apiV1Prefix)) {
+			shared.HandleError(w, fmt.Errorf("%w: role %s not allowed", shared.ErrForbidden, role))
+
+			return
+		}
+
+		if accountID := targetAccountID(r, apiV1Prefix); accountID != "" {
+			err = accountServices.Authorize(accountRepo, authenticateOutput.AccountID, accountID)
+			if err != nil {
+				shared.HandleError(w, err)
+
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r.WithContext(shared.WithCaller(r.Context(), shared.Caller{
+			AccountID: authenticateOutput.AccountID,
+			APIKeyID:  authenticateOutput.APIKeyID,
+			Role:      authenticateOutput.Role,
+		})))
+	})
+}
+
+func isPublicRoute(r *http.Request, apiV1Prefix string) bool {
+	path := r.URL.Path
+
+	switch {
+	case strings.HasPrefix(path, apiV1Prefix+"/docs/"):
+		return true
+	case r.Method == http.MethodPost && path == apiV1Prefix+"/accounts":
+		return true
+	case r.Method == http.MethodGet && path == apiV1Prefix+"/books":
+		return true
+	case r.Method == http.MethodGet && path == apiV1Prefix+"/fees/schedules":
+		return true
+	case r.Method == http.MethodGet && path == apiV1Prefix+"/trades":
+		return r.URL.Query().Get("account_id") == ""
+	}
+
+	return false
+}
+
+func requiredRole(r *http.Request, apiV1Prefix string) domainAPIKey.Role {
+	path, isAdmin := strings.CutPrefix(r.URL.Path, apiV1Prefix+"/admin/")
+
+	switch {
+	case !isAdmin && r.Method == http.MethodGet:
+		return domainAPIKey.RoleReadOnly
+	case !isAdmin:
+		return domainAPIKey.RoleTrader
+	case strings.HasSuffix(path, "/credit"),
+		strings.HasSuffix(path, "/fee-tier"),
+		strings.HasSuffix(path, "/api-keys"),
+		path == "fees/schedules",
+		path == "audit-log":
+		return domainAPIKey.RoleAdmin
+	}
+
+	return domainAPIKey.RoleOperator
+}
+
+func targetAccountID(r *http.Request, apiV1Prefix string) string {
+	if rest, found := strings.CutPrefix(r.URL.Path, apiV1Prefix+"/accounts/"); found {
+		accountID, _, _ := strings.Cut(rest, "/")
+
+		return accountID
+	}
+
+	if r.URL.Path == apiV1Prefix+"/trades" {
+		return r.URL.Query().Get("account_id")
+	}
+
+	return ""
+}
+
+func withRateLimit(next http.Handler, apiV1Prefix string) http.Handler {
+	if !config.EnvConfigInstance.RateLimitEnabled {
+		return next
+	}
+
+	limiters := map[string]*ratelimit.Limiter{
+		"orders": ratelimit.NewLimiter(ratelimit.Budget{
+			Rate:  config.EnvConfigInstance.RateLimitOrders,
+			Burst: config.EnvConfigInstance.RateLimitOrdersBurst,
+		}, nil),
+		"cancels": ratelimit.NewLimiter(ratelimit.Budget{
+			Rate:  config.EnvConfigInstance.RateLimitCancels,
+			Burst: config.EnvConfigInstance.RateLimitCancelsBurst,
+		}, nil),
+		"market-data": ratelimit.NewLimiter(ratelimit.Budget{
+			Rate:  config.EnvConfigInstance.RateLimitMarketData,
+			Burst: config.EnvConfigInstance.RateLimitMarketDataBurst,
+		}, nil),
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		budget := rateLimitBudget(r, apiV1Prefix)
+
+		limiter := limiters[budget]
+		if limiter == nil {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		decision := limiter.AllowN(budget+":"+rateLimitKey(r), rateLimitCost(r, apiV1Prefix))
+
+		w.Header().Set("X-RateLimit-Limit", strconv.FormatInt(decision.Limit, 10))
+		w.Header().Set("X-RateLimit-Remaining", strconv.FormatInt(decision.Remaining, 10))
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(ceilSeconds(decision.Reset), 10))
+
+		if !decision.Allowed {
+			w.Header().Set("Retry-After", strconv.FormatInt(ceilSeconds(decision.RetryAfter), 10))
+			shared.HandleError(w, fmt.Errorf("%w: %s budget exhausted", shared.ErrRateLimited, budget))
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func rateLimitBudget(r *http.Request, apiV1Prefix string) string {
+	path := r.URL.Path
+
+	switch {
+	case r.Method == http.MethodGet &&
+		(path == apiV1Prefix+"/books" || path == apiV1Prefix+"/trades"):
+		return "market-data"
+	case r.Method == http.MethodGet:
+		return ""
+	case path == apiV1Prefix+"/orders/cancel-batch" || strings.HasSuffix(path, "/orders/cancel-all"):
+		return "cancels"
+	case strings.HasSuffix(path, "/cancel") &&
+		(strings.HasPrefix(path, apiV1Prefix+"/orders/") || strings.Contains(path, "/client-orders/")):
+		return "cancels"
+	case path == apiV1Prefix+"/orders" || strings.HasPrefix(path, apiV1Prefix+"/orders/"):
+		return "orders"
+	}
+
+	return ""
+}
+
+// rateLimitKey prefers the authenticated API key so clients behind a shared
+// address get their own budget, falling back to the remote host.
+func rateLimitKey(r *http.Request) string {
+	if apiKeyID := shared.CallerDetailsFromContext(r.Context()).APIKeyID; apiKeyID != "" {
+		return "key:" + apiKeyID
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	return "addr:" + host
+}
+
+func ceilSeconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
+
+// rateLimitCost charges batch requests one token per order, so batching does
+// not widen the budget. The body is restored for the handler.
+func rateLimitCost(r *http.Request, apiV1Prefix string) int64 {
+	if r.URL.Path != apiV1Prefix+"/orders/batch" && r.URL.Path != apiV1Prefix+"/orders/cancel-batch" {
+		return 1
+	}
+
+	body, err := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return 1
+	}
+
+	var batch struct {
+		Orders []json.RawMessage `json:"orders"`
+	}
+
+	if json.Unmarshal(body, &batch) != nil || len(batch.Orders) == 0 {
+		return 1
+	}
+
+	return int64(len(batch.Orders))
+}
@@ -5,17 +5,22 @@ import (
 	"net/http"
 
 	"github.com/juninhoitabh/clob-go/docs"
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
 	"github.com/juninhoitabh/clob-go/internal/infra/config"
 	"github.com/juninhoitabh/clob-go/internal/infra/http-server/router/routes"
 	httpSwagger "github.com/swaggo/http-swagger"
 )
 
 // @title           Clob API
-// @description     Clob API without Authentication
+// @description     Clob API. Requests are signed with an account API key: send X-API-Key, X-API-Timestamp (unix seconds) and X-API-Signature, the hex HMAC-SHA256 of "timestamp\nMETHOD\npath\nhex(sha256(body))" keyed with the API secret
 // @termsOfService  http://swagger.io/terms/
 
+// @securityDefinitions.apikey  ApiKeyAuth
+// @in                          header
+// @name                        X-API-Key
+
 // @contact.name   Junior Paz
-func Generate(apiPort string) http.Handler {
+func Generate(apiPort string, placeOrderUseCase *orderUsecases.PlaceOrderUseCase) http.Handler {
 	mux := http.NewServeMux()
 
 	apiV1Prefix := "/api/v1"
@@ -27,12 +32,21 @@ func Generate(apiPort string) http.Handler {
 	mux.Handle(apiV1Prefix+"/docs/", httpSwagger.WrapHandler)
 
 	routes.AccountGenerate(mux, apiV1Prefix)
+	routes.APIKeyGenerate(mux, apiV1Prefix)
 	routes.BookGenerate(mux, apiV1Prefix)
-	routes.OrderGenerate(mux, apiV1Prefix)
+	routes.OrderGenerate(mux, apiV1Prefix, placeOrderUseCase)
+	routes.DeadManGenerate(mux, apiV1Prefix)
+	routes.RiskGenerate(mux, apiV1Prefix)
+	routes.FeeGenerate(mux, apiV1Prefix)
+	routes.TradeGenerate(mux, apiV1Prefix)
+	routes.WithdrawalGenerate(mux, apiV1Prefix)
+	routes.LedgerGenerate(mux, apiV1Prefix)
+	routes.TransferGenerate(mux, apiV1Prefix)
+	routes.AdminGenerate(mux, apiV1Prefix)
 
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		http.NotFound(w, r)
 	})
 
-	return withCORS(withJSON(withLogging(withRecover(mux))))
+	return withCORS(withJSON(withLogging(withRecover(withAuth(withRateLimit(mux, apiV1Prefix), apiV1Prefix)))))
 }
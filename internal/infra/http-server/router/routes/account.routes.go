@@ -6,6 +6,7 @@ import (
 	controllerAccount "github.com/juninhoitabh/clob-go/internal/infra/controllers/account"
 	daosAccount "github.com/juninhoitabh/clob-go/internal/infra/daos/account"
 	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesAPIKey "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey"
 )
 
 func AccountGenerate(router *http.ServeMux, apiV1Prefix string) {
@@ -15,9 +16,11 @@ func AccountGenerate(router *http.ServeMux, apiV1Prefix string) {
 	controller := controllerAccount.NewAccountController(
 		accountDAO,
 		accountRepo,
+		repositoriesAPIKey.NewInMemoryAPIKeyRepository(),
 	)
 
 	router.HandleFunc("POST "+apiV1Prefix+"/accounts", controller.Create)
-	router.HandleFunc("POST "+apiV1Prefix+"/accounts/{id}/credit", controller.Credit)
+	router.HandleFunc("POST "+apiV1Prefix+"/accounts/{id}/sub-accounts", controller.CreateSubAccount)
 	router.HandleFunc("GET "+apiV1Prefix+"/accounts/{id}", controller.GetAllById)
+	router.HandleFunc("GET "+apiV1Prefix+"/accounts/{id}/aggregated-balances", controller.GetAggregated)
 }
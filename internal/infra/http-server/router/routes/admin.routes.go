@@ -0,0 +1,206 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
+	controllerAccount "github.com/juninhoitabh/clob-go/internal/infra/controllers/account"
+	controllerAPIKey "github.com/juninhoitabh/clob-go/internal/infra/controllers/apikey"
+	controllerAudit "github.com/juninhoitabh/clob-go/internal/infra/controllers/audit"
+	controllerBook "github.com/juninhoitabh/clob-go/internal/infra/controllers/book"
+	controllerFee "github.com/juninhoitabh/clob-go/internal/infra/controllers/fee"
+	controllerReconciliation "github.com/juninhoitabh/clob-go/internal/infra/controllers/reconciliation"
+	controllerRisk "github.com/juninhoitabh/clob-go/internal/infra/controllers/risk"
+	controllerWithdrawal "github.com/juninhoitabh/clob-go/internal/infra/controllers/withdrawal"
+	daosAccount "github.com/juninhoitabh/clob-go/internal/infra/daos/account"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesAPIKey "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey"
+	repositoriesAudit "github.com/juninhoitabh/clob-go/internal/infra/repositories/audit"
+	repositoriesBook "github.com/juninhoitabh/clob-go/internal/infra/repositories/book"
+	repositoriesFee "github.com/juninhoitabh/clob-go/internal/infra/repositories/fee"
+	repositoriesLedger "github.com/juninhoitabh/clob-go/internal/infra/repositories/ledger"
+	repositoriesRisk "github.com/juninhoitabh/clob-go/internal/infra/repositories/risk"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
+	repositoriesWithdrawal "github.com/juninhoitabh/clob-go/internal/infra/repositories/withdrawal"
+)
+
+const adminAccountID = "admin"
+
+type (
+	feeTierState struct {
+		Tier   string `json:"tier"`
+		Pinned bool   `json:"pinned"`
+	}
+	circuitBreakerState struct {
+		HaltedUntil    string `json:"halted_until,omitempty"`
+		Halted         bool   `json:"halted"`
+		ReferencePrice int64  `json:"reference_price"`
+		BandBps        int64  `json:"band_bps"`
+		MoveBps        int64  `json:"move_bps"`
+		WindowMs       int64  `json:"window_ms"`
+		HaltDurationMs int64  `json:"halt_duration_ms"`
+	}
+	apiKeyState struct {
+		ID   string `json:"id"`
+		Role string `json:"role"`
+	}
+)
+
+func AdminGenerate(router *http.ServeMux, apiV1Prefix string) {
+	adminPrefix := apiV1Prefix + "/admin"
+
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+	accountDAO := daosAccount.NewInMemoryAccountDAO(accountRepo.Mutex(), accountRepo.AccountsMap())
+	apiKeyRepo := repositoriesAPIKey.NewInMemoryAPIKeyRepository()
+	bookRepo := repositoriesBook.NewInMemoryBookRepository()
+	scheduleRepo := repositoriesFee.NewInMemoryScheduleRepository()
+	limitsRepo := repositoriesRisk.NewInMemoryLimitsRepository()
+	withdrawalRepo := repositoriesWithdrawal.NewInMemoryWithdrawalRepository()
+
+	seedAdminAPIKey(apiKeyRepo)
+
+	audit := controllerAudit.NewAuditController(repositoriesAudit.NewInMemoryAuditRepository())
+	account := controllerAccount.NewAccountController(accountDAO, accountRepo, apiKeyRepo)
+	apiKey := controllerAPIKey.NewAPIKeyController(accountRepo, apiKeyRepo)
+	book := controllerBook.NewBookController(bookRepo)
+	fee := controllerFee.NewFeeController(
+		scheduleRepo,
+		accountRepo,
+		repositoriesTrade.NewInMemoryTradeRepository(),
+		domainFee.Rates{
+			MakerBps: config.EnvConfigInstance.MakerFeeBps,
+			TakerBps: config.EnvConfigInstance.TakerFeeBps,
+		},
+		feeVolumeTiers(),
+	)
+	risk := controllerRisk.NewRiskController(accountRepo, limitsRepo)
+	withdrawal := controllerWithdrawal.NewWithdrawalController(accountRepo, withdrawalRepo)
+	reconciliation := controllerReconciliation.NewReconciliationController(
+		accountRepo,
+		bookRepo,
+		repositoriesLedger.NewInMemoryLedgerRepository(),
+		config.EnvConfigInstance.FeeAccountID,
+	)
+
+	accountBalances := func(req *http.Request) (string, any) {
+		id := req.PathValue("id")
+
+		snapshot, err := accountDAO.Snapshot(id)
+		if err != nil {
+			return "account:" + id, nil
+		}
+
+		return "account:" + id, snapshot.Balances
+	}
+	accountFeeTier := func(req *http.Request) (string, any) {
+		id := req.PathValue("id")
+
+		acct, err := accountRepo.Get(id)
+		if err != nil {
+			return "account:" + id, nil
+		}
+
+		acct.Lock()
+		defer acct.Unlock()
+
+		return "account:" + id, feeTierState{Tier: acct.FeeTier, Pinned: acct.FeeTierPinned}
+	}
+	accountRiskLimits := func(req *http.Request) (string, any) {
+		id := req.PathValue("id")
+
+		limits, _ := limitsRepo.GetLimits(id)
+
+		return "account:" + id, limits
+	}
+	accountAPIKeys := func(req *http.Request) (string, any) {
+		id := req.PathValue("id")
+
+		keys, _ := apiKeyRepo.ListAPIKeysByAccount(id)
+		state := make([]apiKeyState, 0, len(keys))
+
+		for _, k := range keys {
+			state = append(state, apiKeyState{ID: k.GetID(), Role: string(k.Role)})
+		}
+
+		return "account:" + id, state
+	}
+	instrumentCircuitBreaker := func(req *http.Request) (string, any) {
+		inst := strings.ToUpper(req.URL.Query().Get("instrument"))
+
+		b, _ := bookRepo.GetBook(inst)
+		if b == nil || b.CircuitBreaker == nil {
+			return "instrument:" + inst, nil
+		}
+
+		b.Lock()
+		defer b.Unlock()
+
+		cb := b.CircuitBreaker
+		state := circuitBreakerState{
+			Halted:         cb.IsHalted(time.Now()),
+			ReferencePrice: cb.ReferencePrice,
+			BandBps:        cb.BandBps,
+			MoveBps:        cb.MoveBps,
+			WindowMs:       cb.Window.Milliseconds(),
+			HaltDurationMs: cb.HaltDuration.Milliseconds(),
+		}
+
+		if state.Halted {
+			state.HaltedUntil = cb.HaltedUntil.UTC().Format(time.RFC3339)
+		}
+
+		return "instrument:" + inst, state
+	}
+	instrumentFeeSchedule := func(req *http.Request) (string, any) {
+		inst := strings.ToUpper(req.URL.Query().Get("instrument"))
+
+		schedule, _ := scheduleRepo.GetSchedule(inst)
+
+		return "instrument:" + inst, schedule
+	}
+	withdrawalState := func(req *http.Request) (string, any) {
+		id := req.PathValue("id")
+
+		w, _ := withdrawalRepo.GetWithdrawal(id)
+
+		return "withdrawal:" + id, w
+	}
+
+	router.HandleFunc("POST "+adminPrefix+"/accounts/{id}/credit", audit.Audited("account.credit", accountBalances, account.Credit))
+	router.HandleFunc("PUT "+adminPrefix+"/accounts/{id}/fee-tier", audit.Audited("account.set_fee_tier", accountFeeTier, fee.SetTier))
+	router.HandleFunc("PUT "+adminPrefix+"/accounts/{id}/risk-limits", audit.Audited("account.set_risk_limits", accountRiskLimits, risk.SetLimits))
+	router.HandleFunc("POST "+adminPrefix+"/accounts/{id}/api-keys", audit.Audited("account.issue_api_key", accountAPIKeys, apiKey.Issue))
+	router.HandleFunc("PUT "+adminPrefix+"/books/circuit-breaker", audit.Audited("book.configure_circuit_breaker", instrumentCircuitBreaker, book.ConfigureCircuitBreaker))
+	router.HandleFunc("POST "+adminPrefix+"/books/halt", audit.Audited("book.halt", instrumentCircuitBreaker, book.Halt))
+	router.HandleFunc("POST "+adminPrefix+"/books/resume", audit.Audited("book.resume", instrumentCircuitBreaker, book.Resume))
+	router.HandleFunc("PUT "+adminPrefix+"/fees/schedules", audit.Audited("fee.set_schedule", instrumentFeeSchedule, fee.SetSchedule))
+	router.HandleFunc("POST "+adminPrefix+"/withdrawals/{id}/complete", audit.Audited("withdrawal.complete", withdrawalState, withdrawal.Complete))
+	router.HandleFunc("POST "+adminPrefix+"/withdrawals/{id}/fail", audit.Audited("withdrawal.fail", withdrawalState, withdrawal.Fail))
+	router.HandleFunc("GET "+adminPrefix+"/reconciliation", reconciliation.Reconcile)
+	router.HandleFunc("GET "+adminPrefix+"/audit-log", audit.List)
+}
+
+func seedAdminAPIKey(apiKeyRepo domainAPIKey.IAPIKeyRepository) {
+	if config.EnvConfigInstance.AdminAPIKey == "" {
+		return
+	}
+
+	key, err := domainAPIKey.RestoreAPIKey(
+		config.EnvConfigInstance.AdminAPIKey,
+		config.EnvConfigInstance.AdminAPISecret,
+		domainAPIKey.APIKeyProps{AccountID: adminAccountID, Role: domainAPIKey.RoleAdmin},
+	)
+	if err != nil {
+		log.Fatalf("invalid ADMIN_API_KEY/ADMIN_API_SECRET: %v", err)
+	}
+
+	err = apiKeyRepo.SaveAPIKey(key)
+	if err != nil {
+		log.Fatalf("failed to seed admin api key: %v", err)
+	}
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"net/http"
+
+	controllerAPIKey "github.com/juninhoitabh/clob-go/internal/infra/controllers/apikey"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesAPIKey "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey"
+)
+
+func APIKeyGenerate(router *http.ServeMux, apiV1Prefix string) {
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+	apiKeyRepo := repositoriesAPIKey.NewInMemoryAPIKeyRepository()
+
+	controller := controllerAPIKey.NewAPIKeyController(
+		accountRepo,
+		apiKeyRepo,
+	)
+
+	router.HandleFunc("POST "+apiV1Prefix+"/accounts/{id}/api-keys", controller.Issue)
+}
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
+	controllerDeadman "github.com/juninhoitabh/clob-go/internal/infra/controllers/deadman"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesBook "github.com/juninhoitabh/clob-go/internal/infra/repositories/book"
+	repositoriesDeadman "github.com/juninhoitabh/clob-go/internal/infra/repositories/deadman"
+	repositoriesOrder "github.com/juninhoitabh/clob-go/internal/infra/repositories/order"
+)
+
+func DeadManGenerate(router *http.ServeMux, apiV1Prefix string) {
+	controller := controllerDeadman.NewDeadManController(
+		repositoriesAccount.NewInMemoryAccountRepository(),
+		repositoriesBook.NewInMemoryBookRepository(),
+		repositoriesOrder.NewInMemoryOrderRepository(),
+		repositoriesDeadman.NewInMemorySwitchRepository(),
+		config.EnvConfigInstance.SessionHeartbeatInterval,
+	)
+
+	router.HandleFunc("PUT "+apiV1Prefix+"/accounts/{id}/dead-man-switch", controller.SetSwitch)
+	router.HandleFunc("DELETE "+apiV1Prefix+"/accounts/{id}/dead-man-switch", controller.DisarmSwitch)
+	router.HandleFunc("POST "+apiV1Prefix+"/accounts/{id}/dead-man-switch/heartbeat", controller.Heartbeat)
+	router.HandleFunc("POST "+apiV1Prefix+"/accounts/{id}/sessions", controller.Session)
+}
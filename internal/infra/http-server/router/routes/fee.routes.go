@@ -0,0 +1,42 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
+	controllerFee "github.com/juninhoitabh/clob-go/internal/infra/controllers/fee"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesFee "github.com/juninhoitabh/clob-go/internal/infra/repositories/fee"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
+)
+
+func FeeGenerate(router *http.ServeMux, apiV1Prefix string) {
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+	scheduleRepo := repositoriesFee.NewInMemoryScheduleRepository()
+	tradeRepo := repositoriesTrade.NewInMemoryTradeRepository()
+
+	controller := controllerFee.NewFeeController(
+		scheduleRepo,
+		accountRepo,
+		tradeRepo,
+		domainFee.Rates{
+			MakerBps: config.EnvConfigInstance.MakerFeeBps,
+			TakerBps: config.EnvConfigInstance.TakerFeeBps,
+		},
+		feeVolumeTiers(),
+	)
+
+	router.HandleFunc("GET "+apiV1Prefix+"/fees/schedules", controller.GetSchedule)
+	router.HandleFunc("GET "+apiV1Prefix+"/accounts/{id}/fee-tier", controller.GetTier)
+}
+
+func feeVolumeTiers() domainFee.VolumeTiers {
+	tiers, err := domainFee.NewVolumeTiers(config.EnvConfigInstance.FeeVolumeTiers)
+	if err != nil {
+		log.Fatalf("invalid FEE_VOLUME_TIERS: %v", err)
+	}
+
+	return tiers
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"net/http"
+
+	controllerLedger "github.com/juninhoitabh/clob-go/internal/infra/controllers/ledger"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesLedger "github.com/juninhoitabh/clob-go/internal/infra/repositories/ledger"
+)
+
+func LedgerGenerate(router *http.ServeMux, apiV1Prefix string) {
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+	ledgerRepo := repositoriesLedger.NewInMemoryLedgerRepository()
+
+	controller := controllerLedger.NewLedgerController(
+		accountRepo,
+		ledgerRepo,
+	)
+
+	router.HandleFunc("GET "+apiV1Prefix+"/accounts/{id}/ledger", controller.List)
+}
@@ -0,0 +1,24 @@
+//go:build all || e2e || infra
+
+package routes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
+	"github.com/juninhoitabh/clob-go/internal/infra/http-server/router/routes"
+)
+
+func TestNewPlaceOrderUseCase_BuildsOnePerCall(t *testing.T) {
+	config.Init()
+
+	first := routes.NewPlaceOrderUseCase()
+	second := routes.NewPlaceOrderUseCase()
+
+	require.NotNil(t, first.RiskChecker)
+	assert.NotSame(t, first, second)
+	assert.NotSame(t, first.RiskChecker, second.RiskChecker)
+}
@@ -3,23 +3,64 @@ package routes
 import (
 	"net/http"
 
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	domainRisk "github.com/juninhoitabh/clob-go/internal/domain/risk"
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
 	controllerOrder "github.com/juninhoitabh/clob-go/internal/infra/controllers/order"
 	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
 	repositoriesBook "github.com/juninhoitabh/clob-go/internal/infra/repositories/book"
+	repositoriesFee "github.com/juninhoitabh/clob-go/internal/infra/repositories/fee"
 	repositoriesOrder "github.com/juninhoitabh/clob-go/internal/infra/repositories/order"
+	repositoriesRisk "github.com/juninhoitabh/clob-go/internal/infra/repositories/risk"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
 )
 
-func OrderGenerate(router *http.ServeMux, apiV1Prefix string) {
-	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
-	bookRepo := repositoriesBook.NewInMemoryBookRepository()
-	orderRepo := repositoriesOrder.NewInMemoryOrderRepository()
+// NewPlaceOrderUseCase builds the place-order use case from the config. The
+// server builds one and hands it to every order entry gateway, so HTTP, FIX,
+// the binary protocol and gRPC run one risk chain over the same repositories
+// and settings.
+func NewPlaceOrderUseCase() *orderUsecases.PlaceOrderUseCase {
+	return orderUsecases.NewPlaceOrderUseCase(
+		repositoriesBook.NewInMemoryBookRepository(),
+		repositoriesOrder.NewInMemoryOrderRepository(),
+		repositoriesAccount.NewInMemoryAccountRepository(),
+		repositoriesFee.NewInMemoryScheduleRepository(),
+		repositoriesTrade.NewInMemoryTradeRepository(),
+		domainBook.CircuitBreakerProps{
+			BandBps:      config.EnvConfigInstance.PriceBandBps,
+			MoveBps:      config.EnvConfigInstance.CircuitBreakerMoveBps,
+			Window:       config.EnvConfigInstance.CircuitBreakerWindow,
+			HaltDuration: config.EnvConfigInstance.CircuitBreakerHalt,
+		},
+		domainFee.FeeProps{
+			AccountID:   config.EnvConfigInstance.FeeAccountID,
+			VolumeTiers: feeVolumeTiers(),
+			Rates: domainFee.Rates{
+				MakerBps: config.EnvConfigInstance.MakerFeeBps,
+				TakerBps: config.EnvConfigInstance.TakerFeeBps,
+			},
+		},
+		domainRisk.NewDefaultChain(repositoriesRisk.NewInMemoryLimitsRepository()),
+	)
+}
 
+func OrderGenerate(router *http.ServeMux, apiV1Prefix string, placeOrderUseCase *orderUsecases.PlaceOrderUseCase) {
 	controller := controllerOrder.NewOrderController(
-		bookRepo,
-		orderRepo,
-		accountRepo,
+		placeOrderUseCase,
+		repositoriesBook.NewInMemoryBookRepository(),
+		repositoriesOrder.NewInMemoryOrderRepository(),
+		repositoriesAccount.NewInMemoryAccountRepository(),
+		int(config.EnvConfigInstance.BatchMaxOrders),
 	)
 
 	router.HandleFunc("POST "+apiV1Prefix+"/orders", controller.Place)
+	router.HandleFunc("POST "+apiV1Prefix+"/orders/batch", controller.PlaceBatch)
+	router.HandleFunc("POST "+apiV1Prefix+"/orders/cancel-batch", controller.CancelBatch)
+	router.HandleFunc("GET "+apiV1Prefix+"/orders/{id}", controller.Get)
 	router.HandleFunc("POST "+apiV1Prefix+"/orders/{id}/cancel", controller.Cancel)
+	router.HandleFunc("POST "+apiV1Prefix+"/accounts/{id}/orders/cancel-all", controller.MassCancel)
+	router.HandleFunc("GET "+apiV1Prefix+"/accounts/{id}/client-orders/{client_order_id}", controller.GetByClientOrderID)
+	router.HandleFunc("POST "+apiV1Prefix+"/accounts/{id}/client-orders/{client_order_id}/cancel", controller.CancelByClientOrderID)
 }
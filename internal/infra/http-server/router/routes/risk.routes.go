@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"net/http"
+
+	controllerRisk "github.com/juninhoitabh/clob-go/internal/infra/controllers/risk"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesRisk "github.com/juninhoitabh/clob-go/internal/infra/repositories/risk"
+)
+
+func RiskGenerate(router *http.ServeMux, apiV1Prefix string) {
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+	limitsRepo := repositoriesRisk.NewInMemoryLimitsRepository()
+
+	controller := controllerRisk.NewRiskController(accountRepo, limitsRepo)
+
+	router.HandleFunc("GET "+apiV1Prefix+"/accounts/{id}/risk-limits", controller.GetLimits)
+}
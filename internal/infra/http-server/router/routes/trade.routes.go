@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"net/http"
+
+	controllerTrade "github.com/juninhoitabh/clob-go/internal/infra/controllers/trade"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
+)
+
+func TradeGenerate(router *http.ServeMux, apiV1Prefix string) {
+	tradeRepo := repositoriesTrade.NewInMemoryTradeRepository()
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+
+	controller := controllerTrade.NewTradeController(tradeRepo, accountRepo)
+
+	router.HandleFunc("GET "+apiV1Prefix+"/trades", controller.List)
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"net/http"
+
+	controllerTransfer "github.com/juninhoitabh/clob-go/internal/infra/controllers/transfer"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesTransfer "github.com/juninhoitabh/clob-go/internal/infra/repositories/transfer"
+)
+
+func TransferGenerate(router *http.ServeMux, apiV1Prefix string) {
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+	transferRepo := repositoriesTransfer.NewInMemoryTransferRepository()
+
+	controller := controllerTransfer.NewTransferController(
+		accountRepo,
+		transferRepo,
+	)
+
+	router.HandleFunc("POST "+apiV1Prefix+"/transfers", controller.Create)
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"net/http"
+
+	controllerWithdrawal "github.com/juninhoitabh/clob-go/internal/infra/controllers/withdrawal"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesWithdrawal "github.com/juninhoitabh/clob-go/internal/infra/repositories/withdrawal"
+)
+
+func WithdrawalGenerate(router *http.ServeMux, apiV1Prefix string) {
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+	withdrawalRepo := repositoriesWithdrawal.NewInMemoryWithdrawalRepository()
+
+	controller := controllerWithdrawal.NewWithdrawalController(
+		accountRepo,
+		withdrawalRepo,
+	)
+
+	router.HandleFunc("POST "+apiV1Prefix+"/accounts/{id}/withdraw", controller.Withdraw)
+	router.HandleFunc("GET "+apiV1Prefix+"/withdrawals/{id}", controller.Get)
+}
@@ -0,0 +1,108 @@
+//go:build all || e2e || infra
+
+package jobs_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	deadmanUsecases "github.com/juninhoitabh/clob-go/internal/application/deadman/usecases"
+	"github.com/juninhoitabh/clob-go/internal/infra/jobs"
+)
+
+type stubFireExpiredSwitchesUseCase struct {
+	output *deadmanUsecases.FireExpiredSwitchesOutput
+	err    error
+	now    atomic.Value
+	calls  atomic.Int32
+}
+
+func (s *stubFireExpiredSwitchesUseCase) Execute(
+	input deadmanUsecases.FireExpiredSwitchesInput,
+) (*deadmanUsecases.FireExpiredSwitchesOutput, error) {
+	s.calls.Add(1)
+	s.now.Store(input.Now)
+
+	return s.output, s.err
+}
+
+type DeadManSwitchJobE2ETestSuite struct {
+	suite.Suite
+	logs *bytes.Buffer
+}
+
+func (suite *DeadManSwitchJobE2ETestSuite) SetupTest() {
+	suite.logs = &bytes.Buffer{}
+}
+
+func (suite *DeadManSwitchJobE2ETestSuite) TestRunOnce_LogsFiredSwitches() {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	usecase := &stubFireExpiredSwitchesUseCase{output: &deadmanUsecases.FireExpiredSwitchesOutput{
+		Fired: []deadmanUsecases.FiredSwitch{
+			{AccountID: "acc-1", Canceled: 3},
+			{AccountID: "acc-2", Err: errors.New("boom")},
+		},
+	}}
+
+	job := jobs.NewDeadManSwitchJob(usecase, time.Second, log.New(suite.logs, "", 0), func() time.Time { return now })
+
+	assert.NotNil(suite.T(), job.RunOnce())
+	assert.Equal(suite.T(), now, usecase.now.Load())
+	assert.Equal(suite.T(),
+		"dead man's switch: account=\"acc-1\" fired canceled=3\n"+
+			"ALERT dead man's switch: account=\"acc-2\" cancel failed: boom\n",
+		suite.logs.String(),
+	)
+}
+
+func (suite *DeadManSwitchJobE2ETestSuite) TestRunOnce_LogsError() {
+	usecase := &stubFireExpiredSwitchesUseCase{err: errors.New("boom")}
+
+	job := jobs.NewDeadManSwitchJob(usecase, time.Second, log.New(suite.logs, "", 0), nil)
+
+	assert.Nil(suite.T(), job.RunOnce())
+	assert.Contains(suite.T(), suite.logs.String(), "boom")
+}
+
+func (suite *DeadManSwitchJobE2ETestSuite) TestRun_TicksUntilCancelled() {
+	usecase := &stubFireExpiredSwitchesUseCase{output: &deadmanUsecases.FireExpiredSwitchesOutput{}}
+	job := jobs.NewDeadManSwitchJob(usecase, 5*time.Millisecond, log.New(suite.logs, "", 0), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+
+	assert.Eventually(suite.T(), func() bool { return usecase.calls.Load() >= 2 }, time.Second, time.Millisecond)
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		suite.T().Fatal("job did not stop after cancel")
+	}
+}
+
+func (suite *DeadManSwitchJobE2ETestSuite) TestRun_DisabledWithZeroInterval() {
+	usecase := &stubFireExpiredSwitchesUseCase{}
+	job := jobs.NewDeadManSwitchJob(usecase, 0, nil, nil)
+
+	job.Run(context.Background())
+	assert.Equal(suite.T(), int32(0), usecase.calls.Load())
+}
+
+func TestDeadManSwitchJob(t *testing.T) {
+	suite.Run(t, new(DeadManSwitchJobE2ETestSuite))
+}
@@ -0,0 +1,79 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	deadmanUsecases "github.com/juninhoitabh/clob-go/internal/application/deadman/usecases"
+)
+
+type DeadManSwitchJob struct {
+	usecase  deadmanUsecases.IFireExpiredSwitchesUseCase
+	logger   *log.Logger
+	now      func() time.Time
+	interval time.Duration
+}
+
+// Run fires expired switches every interval until ctx is done. A
+// non-positive interval disables the job.
+func (j *DeadManSwitchJob) Run(ctx context.Context) {
+	if j.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.RunOnce()
+		}
+	}
+}
+
+func (j *DeadManSwitchJob) RunOnce() *deadmanUsecases.FireExpiredSwitchesOutput {
+	output, err := j.usecase.Execute(deadmanUsecases.FireExpiredSwitchesInput{Now: j.now()})
+	if err != nil {
+		j.logger.Printf("dead man's switch: failed to run: %v", err)
+
+		return nil
+	}
+
+	for _, fired := range output.Fired {
+		if fired.Err != nil {
+			j.logger.Printf("ALERT dead man's switch: account=%q cancel failed: %v", fired.AccountID, fired.Err)
+
+			continue
+		}
+
+		j.logger.Printf("dead man's switch: account=%q fired canceled=%d", fired.AccountID, fired.Canceled)
+	}
+
+	return output
+}
+
+func NewDeadManSwitchJob(
+	usecase deadmanUsecases.IFireExpiredSwitchesUseCase,
+	interval time.Duration,
+	logger *log.Logger,
+	now func() time.Time,
+) *DeadManSwitchJob {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	if now == nil {
+		now = time.Now
+	}
+
+	return &DeadManSwitchJob{
+		usecase:  usecase,
+		logger:   logger,
+		now:      now,
+		interval: interval,
+	}
+}
@@ -0,0 +1,115 @@
+//go:build all || e2e || infra
+
+package jobs_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	reconciliationUsecases "github.com/juninhoitabh/clob-go/internal/application/reconciliation/usecases"
+	domainReconciliation "github.com/juninhoitabh/clob-go/internal/domain/reconciliation"
+	"github.com/juninhoitabh/clob-go/internal/infra/jobs"
+)
+
+type stubReconcileUseCase struct {
+	output *reconciliationUsecases.ReconcileOutput
+	err    error
+	calls  atomic.Int32
+}
+
+func (s *stubReconcileUseCase) Execute() (*reconciliationUsecases.ReconcileOutput, error) {
+	s.calls.Add(1)
+
+	return s.output, s.err
+}
+
+type ReconciliationJobE2ETestSuite struct {
+	suite.Suite
+	logs *bytes.Buffer
+}
+
+func (suite *ReconciliationJobE2ETestSuite) SetupTest() {
+	suite.logs = &bytes.Buffer{}
+}
+
+func (suite *ReconciliationJobE2ETestSuite) TestRunOnce_LogsDiscrepancies() {
+	usecase := &stubReconcileUseCase{output: &reconciliationUsecases.ReconcileOutput{
+		Discrepancies: []domainReconciliation.Discrepancy{{
+			Kind:      domainReconciliation.ReservedObligations,
+			AccountID: "acc-1",
+			Asset:     "USDT",
+			Expected:  300,
+			Actual:    320,
+		}},
+	}}
+
+	job := jobs.NewReconciliationJob(usecase, time.Minute, log.New(suite.logs, "", 0))
+
+	out := job.RunOnce()
+	assert.NotNil(suite.T(), out)
+	assert.Equal(suite.T(),
+		"ALERT reconciliation: reserved_obligations account=\"acc-1\" asset=USDT expected=300 actual=320\n",
+		suite.logs.String(),
+	)
+}
+
+func (suite *ReconciliationJobE2ETestSuite) TestRunOnce_BalancedLogsNothing() {
+	usecase := &stubReconcileUseCase{output: &reconciliationUsecases.ReconcileOutput{Balanced: true}}
+
+	job := jobs.NewReconciliationJob(usecase, time.Minute, log.New(suite.logs, "", 0))
+
+	assert.NotNil(suite.T(), job.RunOnce())
+	assert.Empty(suite.T(), suite.logs.String())
+}
+
+func (suite *ReconciliationJobE2ETestSuite) TestRunOnce_LogsError() {
+	usecase := &stubReconcileUseCase{err: errors.New("boom")}
+
+	job := jobs.NewReconciliationJob(usecase, time.Minute, log.New(suite.logs, "", 0))
+
+	assert.Nil(suite.T(), job.RunOnce())
+	assert.Contains(suite.T(), suite.logs.String(), "boom")
+}
+
+func (suite *ReconciliationJobE2ETestSuite) TestRun_TicksUntilCancelled() {
+	usecase := &stubReconcileUseCase{output: &reconciliationUsecases.ReconcileOutput{Balanced: true}}
+	job := jobs.NewReconciliationJob(usecase, 5*time.Millisecond, log.New(suite.logs, "", 0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+
+	assert.Eventually(suite.T(), func() bool { return usecase.calls.Load() >= 2 }, time.Second, time.Millisecond)
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		suite.T().Fatal("job did not stop after cancel")
+	}
+}
+
+func (suite *ReconciliationJobE2ETestSuite) TestRun_DisabledWithZeroInterval() {
+	usecase := &stubReconcileUseCase{}
+	job := jobs.NewReconciliationJob(usecase, 0, nil)
+
+	job.Run(context.Background())
+	assert.Equal(suite.T(), int32(0), usecase.calls.Load())
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(ReconciliationJobE2ETestSuite))
+}
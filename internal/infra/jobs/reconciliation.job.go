@@ -0,0 +1,69 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	reconciliationUsecases "github.com/juninhoitabh/clob-go/internal/application/reconciliation/usecases"
+)
+
+type ReconciliationJob struct {
+	usecase  reconciliationUsecases.IReconcileUseCase
+	logger   *log.Logger
+	interval time.Duration
+}
+
+// Run reconciles every interval until ctx is done. A non-positive interval
+// disables the job.
+func (j *ReconciliationJob) Run(ctx context.Context) {
+	if j.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.RunOnce()
+		}
+	}
+}
+
+func (j *ReconciliationJob) RunOnce() *reconciliationUsecases.ReconcileOutput {
+	output, err := j.usecase.Execute()
+	if err != nil {
+		j.logger.Printf("reconciliation: failed to run: %v", err)
+
+		return nil
+	}
+
+	for _, d := range output.Discrepancies {
+		j.logger.Printf(
+			"ALERT reconciliation: %s account=%q asset=%s expected=%d actual=%d",
+			d.Kind, d.AccountID, d.Asset, d.Expected, d.Actual,
+		)
+	}
+
+	return output
+}
+
+func NewReconciliationJob(
+	usecase reconciliationUsecases.IReconcileUseCase,
+	interval time.Duration,
+	logger *log.Logger,
+) *ReconciliationJob {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return &ReconciliationJob{
+		usecase:  usecase,
+		logger:   logger,
+		interval: interval,
+	}
+}
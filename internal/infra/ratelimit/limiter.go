@@ -0,0 +1,125 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const sweepInterval = time.Minute
+
+type (
+	Budget struct {
+		Rate  int64
+		Burst int64
+	}
+	Decision struct {
+		RetryAfter time.Duration
+		Reset      time.Duration
+		Limit      int64
+		Remaining  int64
+		Allowed    bool
+	}
+	bucket struct {
+		updated time.Time
+		tokens  float64
+	}
+	Limiter struct {
+		lastSweep time.Time
+		buckets   map[string]*bucket
+		now       func() time.Time
+		budget    Budget
+		mu        sync.Mutex
+	}
+)
+
+// Allow takes one token from key's bucket. Buckets start full and refill at
+// budget.Rate tokens per second up to budget.Burst.
+func (l *Limiter) Allow(key string) Decision {
+	return l.AllowN(key, 1)
+}
+
+// AllowN takes n tokens from key's bucket, or none if fewer than n are left.
+func (l *Limiter) AllowN(key string, n int64) Decision {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.budget.Burst), updated: now}
+		l.buckets[key] = b
+	}
+
+	l.refill(b, now)
+
+	decision := Decision{Limit: l.budget.Burst}
+
+	if b.tokens >= float64(n) {
+		b.tokens -= float64(n)
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = l.durationFor(float64(n) - b.tokens)
+	}
+
+	decision.Remaining = int64(math.Floor(b.tokens))
+	decision.Reset = l.durationFor(float64(l.budget.Burst) - b.tokens)
+
+	return decision
+}
+
+func (l *Limiter) refill(b *bucket, now time.Time) {
+	elapsed := now.Sub(b.updated).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	b.tokens = math.Min(float64(l.budget.Burst), b.tokens+elapsed*float64(l.budget.Rate))
+	b.updated = now
+}
+
+func (l *Limiter) durationFor(tokens float64) time.Duration {
+	if tokens <= 0 {
+		return 0
+	}
+
+	return time.Duration(math.Ceil(tokens / float64(l.budget.Rate) * float64(time.Second)))
+}
+
+// sweep drops buckets that have refilled completely, since a fresh bucket is
+// indistinguishable from them.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		l.refill(b, now)
+
+		if b.tokens >= float64(l.budget.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// NewLimiter returns nil when the budget is not positive, meaning unlimited.
+func NewLimiter(budget Budget, now func() time.Time) *Limiter {
+	if budget.Rate <= 0 || budget.Burst <= 0 {
+		return nil
+	}
+
+	if now == nil {
+		now = time.Now
+	}
+
+	return &Limiter{
+		budget:    budget,
+		buckets:   make(map[string]*bucket),
+		now:       now,
+		lastSweep: now(),
+	}
+}
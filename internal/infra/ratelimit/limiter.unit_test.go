@@ -0,0 +1,116 @@
+//go:build all || unit || infra
+
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/juninhoitabh/clob-go/internal/infra/ratelimit"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func TestNewLimiter_NonPositiveBudgetIsUnlimited(t *testing.T) {
+	assert.Nil(t, ratelimit.NewLimiter(ratelimit.Budget{Rate: 0, Burst: 10}, nil))
+	assert.Nil(t, ratelimit.NewLimiter(ratelimit.Budget{Rate: 10, Burst: 0}, nil))
+	assert.NotNil(t, ratelimit.NewLimiter(ratelimit.Budget{Rate: 1, Burst: 1}, nil))
+}
+
+func TestAllow_BurstThenReject(t *testing.T) {
+	clock := newFakeClock()
+	limiter := ratelimit.NewLimiter(ratelimit.Budget{Rate: 2, Burst: 3}, clock.Now)
+	require.NotNil(t, limiter)
+
+	for i := int64(2); i >= 0; i-- {
+		decision := limiter.Allow("client")
+		assert.True(t, decision.Allowed)
+		assert.Equal(t, int64(3), decision.Limit)
+		assert.Equal(t, i, decision.Remaining)
+	}
+
+	decision := limiter.Allow("client")
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, int64(0), decision.Remaining)
+	assert.Equal(t, 500*time.Millisecond, decision.RetryAfter)
+	assert.Equal(t, 1500*time.Millisecond, decision.Reset)
+}
+
+func TestAllow_Refills(t *testing.T) {
+	clock := newFakeClock()
+	limiter := ratelimit.NewLimiter(ratelimit.Budget{Rate: 2, Burst: 2}, clock.Now)
+
+	assert.True(t, limiter.Allow("client").Allowed)
+	assert.True(t, limiter.Allow("client").Allowed)
+	assert.False(t, limiter.Allow("client").Allowed)
+
+	clock.Advance(500 * time.Millisecond)
+
+	assert.True(t, limiter.Allow("client").Allowed)
+	assert.False(t, limiter.Allow("client").Allowed)
+
+	clock.Advance(time.Hour)
+
+	decision := limiter.Allow("client")
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, int64(1), decision.Remaining)
+}
+
+func TestAllowN_TakesAllOrNothing(t *testing.T) {
+	clock := newFakeClock()
+	limiter := ratelimit.NewLimiter(ratelimit.Budget{Rate: 2, Burst: 5}, clock.Now)
+
+	decision := limiter.AllowN("client", 3)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, int64(2), decision.Remaining)
+
+	decision = limiter.AllowN("client", 3)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, int64(2), decision.Remaining)
+	assert.Equal(t, 500*time.Millisecond, decision.RetryAfter)
+
+	assert.True(t, limiter.AllowN("client", 2).Allowed)
+}
+
+func TestAllow_KeysAreIndependent(t *testing.T) {
+	clock := newFakeClock()
+	limiter := ratelimit.NewLimiter(ratelimit.Budget{Rate: 1, Burst: 1}, clock.Now)
+
+	assert.True(t, limiter.Allow("alice").Allowed)
+	assert.False(t, limiter.Allow("alice").Allowed)
+	assert.True(t, limiter.Allow("bob").Allowed)
+}
+
+func TestAllow_SweepKeepsPartialBuckets(t *testing.T) {
+	clock := newFakeClock()
+	limiter := ratelimit.NewLimiter(ratelimit.Budget{Rate: 1, Burst: 1000}, clock.Now)
+
+	for range 1000 {
+		limiter.Allow("busy")
+	}
+
+	limiter.Allow("idle")
+
+	clock.Advance(2 * time.Minute)
+
+	decision := limiter.Allow("busy")
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, int64(119), decision.Remaining)
+
+	decision = limiter.Allow("idle")
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, int64(999), decision.Remaining)
+}
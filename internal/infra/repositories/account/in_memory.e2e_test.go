@@ -10,8 +10,11 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainLedger "github.com/juninhoitabh/clob-go/internal/domain/ledger"
 	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesLedger "github.com/juninhoitabh/clob-go/internal/infra/repositories/ledger"
 	"github.com/juninhoitabh/clob-go/internal/shared"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
 )
 
 type InMemoryAccountRepositoryE2ETestSuite struct {
@@ -20,6 +23,7 @@ type InMemoryAccountRepositoryE2ETestSuite struct {
 }
 
 func (suite *InMemoryAccountRepositoryE2ETestSuite) SetupTest() {
+	repositoriesLedger.ResetInMemoryLedgerRepository()
 	repositoriesAccount.ResetInMemoryAccountRepository()
 	suite.repo = repositoriesAccount.NewInMemoryAccountRepository()
 }
@@ -75,6 +79,31 @@ func (suite *InMemoryAccountRepositoryE2ETestSuite) TestCreate_DuplicateName() {
 	assert.ErrorIs(suite.T(), err, shared.ErrAlreadyExists)
 }
 
+func (suite *InMemoryAccountRepositoryE2ETestSuite) TestCreate_SystemAccountNameNotReserved() {
+	squatter, _ := domainAccount.NewAccount(domainAccount.AccountProps{Name: "fees"}, "Uuid")
+	assert.NoError(suite.T(), suite.repo.Create(squatter))
+
+	feeAccount, _ := domainAccount.NewSystemAccount("fees")
+	assert.NoError(suite.T(), suite.repo.Create(feeAccount))
+
+	got, err := suite.repo.Get("fees")
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), got.System)
+}
+
+func (suite *InMemoryAccountRepositoryE2ETestSuite) TestList_SortedByID() {
+	second, _ := domainAccount.NewAccount(domainAccount.AccountProps{Name: "Second"}, idObjValue.Uuid)
+	second.ID.ID = "acc-b"
+	first, _ := domainAccount.NewAccount(domainAccount.AccountProps{Name: "First"}, idObjValue.Uuid)
+	first.ID.ID = "acc-a"
+	_ = suite.repo.Create(second)
+	_ = suite.repo.Create(first)
+
+	accounts, err := suite.repo.List()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domainAccount.Account{first, second}, accounts)
+}
+
 func (suite *InMemoryAccountRepositoryE2ETestSuite) TestAccountsMap() {
 	account, _ := domainAccount.NewAccount(domainAccount.AccountProps{Name: "Bob"}, "Uuid")
 	_ = suite.repo.Create(account)
@@ -88,6 +117,26 @@ func (suite *InMemoryAccountRepositoryE2ETestSuite) TestMutex() {
 	assert.IsType(suite.T(), &sync.Mutex{}, mutex)
 }
 
+func (suite *InMemoryAccountRepositoryE2ETestSuite) TestSave_AppendsPostingsToLedger() {
+	account, _ := domainAccount.NewAccount(domainAccount.AccountProps{Name: "Erin"}, "Uuid")
+	_ = suite.repo.Create(account)
+
+	_ = account.Credit("BTC", 10)
+	_ = account.Reserve("BTC", 4, domainLedger.OrderRef("order-1"))
+
+	err := suite.repo.Save(account)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), account.PendingPostings())
+
+	postings, total, err := repositoriesLedger.NewInMemoryLedgerRepository().ListPostings(domainLedger.Filter{
+		AccountID: account.GetID(),
+	})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, total)
+	assert.Equal(suite.T(), domainLedger.OpCredit, postings[0].Operation)
+	assert.Equal(suite.T(), domainLedger.OrderRef("order-1"), postings[1].Ref)
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, new(InMemoryAccountRepositoryE2ETestSuite))
 }
@@ -1,9 +1,12 @@
 package repositories
 
 import (
+	"sort"
 	"sync"
 
 	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainLedger "github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	repositoriesLedger "github.com/juninhoitabh/clob-go/internal/infra/repositories/ledger"
 	"github.com/juninhoitabh/clob-go/internal/shared"
 )
 
@@ -13,6 +16,7 @@ var (
 )
 
 type InMemoryAccountRepository struct {
+	ledger   domainLedger.ILedgerRepository
 	accounts map[string]*domainAccount.Account
 	mu       sync.Mutex
 }
@@ -20,6 +24,7 @@ type InMemoryAccountRepository struct {
 func NewInMemoryAccountRepository() *InMemoryAccountRepository {
 	once.Do(func() {
 		instance = &InMemoryAccountRepository{
+			ledger:   repositoriesLedger.NewInMemoryLedgerRepository(),
 			accounts: make(map[string]*domainAccount.Account),
 		}
 	})
@@ -37,15 +42,17 @@ func (i *InMemoryAccountRepository) Create(account *domainAccount.Account) error
 		return shared.ErrAlreadyExists
 	}
 
+	// Names are unique among user accounts only; a system account is known
+	// by its ID, so no user can block it by taking its name first.
 	for _, acct := range i.accounts {
-		if acct.Name == account.Name {
+		if !acct.System && !account.System && acct.Name == account.Name {
 			return shared.ErrAlreadyExists
 		}
 	}
 
 	i.accounts[id] = account
 
-	return nil
+	return i.ledger.Append(account.TakePostings()...)
 }
 
 func (i *InMemoryAccountRepository) Get(id string) (*domainAccount.Account, error) {
@@ -67,7 +74,21 @@ func (i *InMemoryAccountRepository) Save(account *domainAccount.Account) error {
 	id := account.GetID()
 	i.accounts[id] = account
 
-	return nil
+	return i.ledger.Append(account.TakePostings()...)
+}
+
+func (i *InMemoryAccountRepository) List() ([]*domainAccount.Account, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	accounts := make([]*domainAccount.Account, 0, len(i.accounts))
+	for _, acct := range i.accounts {
+		accounts = append(accounts, acct)
+	}
+
+	sort.Slice(accounts, func(a, b int) bool { return accounts[a].GetID() < accounts[b].GetID() })
+
+	return accounts, nil
 }
 
 func (i *InMemoryAccountRepository) AccountsMap() map[string]*domainAccount.Account {
@@ -63,6 +63,21 @@ func (mr *MockIAccountRepositoryMockRecorder) Get(id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIAccountRepository)(nil).Get), id)
 }
 
+// List mocks base method.
+func (m *MockIAccountRepository) List() ([]*account.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]*account.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIAccountRepositoryMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIAccountRepository)(nil).List))
+}
+
 // Save mocks base method.
 func (m *MockIAccountRepository) Save(account *account.Account) error {
 	m.ctrl.T.Helper()
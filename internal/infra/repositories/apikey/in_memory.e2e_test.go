@@ -0,0 +1,66 @@
+//go:build all || e2e || infra
+
+package repositories_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	repositoriesAPIKey "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type InMemoryAPIKeyRepositoryE2ETestSuite struct {
+	suite.Suite
+	repo *repositoriesAPIKey.InMemoryAPIKeyRepository
+}
+
+func (suite *InMemoryAPIKeyRepositoryE2ETestSuite) SetupTest() {
+	repositoriesAPIKey.ResetInMemoryAPIKeyRepository()
+	suite.repo = repositoriesAPIKey.NewInMemoryAPIKeyRepository()
+}
+
+func (suite *InMemoryAPIKeyRepositoryE2ETestSuite) TestSaveAndGet() {
+	key, err := domainAPIKey.NewAPIKey(domainAPIKey.APIKeyProps{AccountID: "acc1"}, idObjValue.Uuid)
+	assert.NoError(suite.T(), err)
+
+	err = suite.repo.SaveAPIKey(key)
+	assert.NoError(suite.T(), err)
+
+	got, err := suite.repo.GetAPIKey(key.GetID())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), key, got)
+}
+
+func (suite *InMemoryAPIKeyRepositoryE2ETestSuite) TestGet_NotFound() {
+	got, err := suite.repo.GetAPIKey("missing")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), got)
+}
+
+func (suite *InMemoryAPIKeyRepositoryE2ETestSuite) TestListAPIKeysByAccount() {
+	first, _ := domainAPIKey.NewAPIKey(domainAPIKey.APIKeyProps{AccountID: "acc1"}, idObjValue.Uuid)
+	second, _ := domainAPIKey.NewAPIKey(domainAPIKey.APIKeyProps{AccountID: "acc1", Role: domainAPIKey.RoleReadOnly}, idObjValue.Uuid)
+	other, _ := domainAPIKey.NewAPIKey(domainAPIKey.APIKeyProps{AccountID: "acc2"}, idObjValue.Uuid)
+	second.CreatedAt = first.CreatedAt.Add(time.Second)
+
+	_ = suite.repo.SaveAPIKey(second)
+	_ = suite.repo.SaveAPIKey(first)
+	_ = suite.repo.SaveAPIKey(other)
+
+	keys, err := suite.repo.ListAPIKeysByAccount("acc1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domainAPIKey.APIKey{first, second}, keys)
+
+	keys, err = suite.repo.ListAPIKeysByAccount("missing")
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), keys)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(InMemoryAPIKeyRepositoryE2ETestSuite))
+}
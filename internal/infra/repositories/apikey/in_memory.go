@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"sort"
+	"sync"
+
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+)
+
+var (
+	instance *InMemoryAPIKeyRepository
+	once     sync.Once
+)
+
+type InMemoryAPIKeyRepository struct {
+	keys map[string]*domainAPIKey.APIKey
+	mu   sync.Mutex
+}
+
+func NewInMemoryAPIKeyRepository() *InMemoryAPIKeyRepository {
+	once.Do(func() {
+		instance = &InMemoryAPIKeyRepository{
+			keys: make(map[string]*domainAPIKey.APIKey),
+		}
+	})
+
+	return instance
+}
+
+func (r *InMemoryAPIKeyRepository) SaveAPIKey(key *domainAPIKey.APIKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.keys[key.GetID()] = key
+
+	return nil
+}
+
+func (r *InMemoryAPIKeyRepository) GetAPIKey(id string) (*domainAPIKey.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.keys[id], nil
+}
+
+func (r *InMemoryAPIKeyRepository) ListAPIKeysByAccount(accountID string) ([]*domainAPIKey.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := make([]*domainAPIKey.APIKey, 0)
+
+	for _, k := range r.keys {
+		if k.AccountID == accountID {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+
+	return keys, nil
+}
+
+func ResetInMemoryAPIKeyRepository() {
+	once = sync.Once{}
+	instance = nil
+}
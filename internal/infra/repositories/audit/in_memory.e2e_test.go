@@ -0,0 +1,75 @@
+//go:build all || e2e || infra
+
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	domainAudit "github.com/juninhoitabh/clob-go/internal/domain/audit"
+	repositoriesAudit "github.com/juninhoitabh/clob-go/internal/infra/repositories/audit"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type InMemoryAuditRepositoryE2ETestSuite struct {
+	suite.Suite
+	repo *repositoriesAudit.InMemoryAuditRepository
+}
+
+func (suite *InMemoryAuditRepositoryE2ETestSuite) SetupTest() {
+	repositoriesAudit.ResetInMemoryAuditRepository()
+	suite.repo = repositoriesAudit.NewInMemoryAuditRepository()
+}
+
+func (suite *InMemoryAuditRepositoryE2ETestSuite) entry(actor, action, target string) *domainAudit.Entry {
+	entry, err := domainAudit.NewEntry(domainAudit.EntryProps{
+		Actor:  actor,
+		Action: action,
+		Target: target,
+	}, idObjValue.Uuid)
+	suite.Require().NoError(err)
+
+	return entry
+}
+
+func (suite *InMemoryAuditRepositoryE2ETestSuite) TestSaveEntry_KeepsOrder() {
+	first := suite.entry("key1", "book.halt", "instrument:BTC/USDT")
+	second := suite.entry("key1", "book.resume", "instrument:BTC/USDT")
+
+	assert.NoError(suite.T(), suite.repo.SaveEntry(first))
+	assert.NoError(suite.T(), suite.repo.SaveEntry(second))
+
+	entries, total, err := suite.repo.ListEntries(domainAudit.Filter{})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, total)
+	assert.Equal(suite.T(), []*domainAudit.Entry{first, second}, entries)
+}
+
+func (suite *InMemoryAuditRepositoryE2ETestSuite) TestListEntries_FiltersAndPaginates() {
+	_ = suite.repo.SaveEntry(suite.entry("key1", "account.credit", "account:acc1"))
+	_ = suite.repo.SaveEntry(suite.entry("key2", "account.credit", "account:acc2"))
+	_ = suite.repo.SaveEntry(suite.entry("key1", "book.halt", "instrument:BTC/USDT"))
+	_ = suite.repo.SaveEntry(suite.entry("key1", "account.credit", "account:acc3"))
+
+	entries, total, err := suite.repo.ListEntries(domainAudit.Filter{Actor: "key1", Action: "account.credit", Limit: 1, Offset: 1})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, total)
+	assert.Len(suite.T(), entries, 1)
+	assert.Equal(suite.T(), "account:acc3", entries[0].Target)
+
+	entries, total, err = suite.repo.ListEntries(domainAudit.Filter{Target: "instrument:BTC/USDT"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, total)
+	assert.Equal(suite.T(), "book.halt", entries[0].Action)
+
+	entries, total, err = suite.repo.ListEntries(domainAudit.Filter{Offset: 10})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 4, total)
+	assert.Empty(suite.T(), entries)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(InMemoryAuditRepositoryE2ETestSuite))
+}
@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"sync"
+
+	domainAudit "github.com/juninhoitabh/clob-go/internal/domain/audit"
+)
+
+var (
+	instance *InMemoryAuditRepository
+	once     sync.Once
+)
+
+type InMemoryAuditRepository struct {
+	entries []*domainAudit.Entry
+	mu      sync.Mutex
+}
+
+func NewInMemoryAuditRepository() *InMemoryAuditRepository {
+	once.Do(func() {
+		instance = &InMemoryAuditRepository{}
+	})
+
+	return instance
+}
+
+func (r *InMemoryAuditRepository) SaveEntry(entry *domainAudit.Entry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, entry)
+
+	return nil
+}
+
+func (r *InMemoryAuditRepository) ListEntries(filter domainAudit.Filter) ([]*domainAudit.Entry, int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	matched := make([]*domainAudit.Entry, 0)
+
+	for _, e := range r.entries {
+		if filter.Actor != "" && e.Actor != filter.Actor {
+			continue
+		}
+
+		if filter.Action != "" && e.Action != filter.Action {
+			continue
+		}
+
+		if filter.Target != "" && e.Target != filter.Target {
+			continue
+		}
+
+		matched = append(matched, e)
+	}
+
+	total := len(matched)
+
+	if filter.Offset >= total {
+		return []*domainAudit.Entry{}, total, nil
+	}
+
+	matched = matched[filter.Offset:]
+
+	if filter.Limit > 0 && len(matched) > filter.Limit {
+		matched = matched[:filter.Limit]
+	}
+
+	return matched, total, nil
+}
+
+func ResetInMemoryAuditRepository() {
+	once = sync.Once{}
+	instance = nil
+}
@@ -49,6 +49,40 @@ func (suite *InMemoryBookRepositoryE2ETestSuite) TestSaveBook_Overwrite() {
 	suite.Equal(book2, got)
 }
 
+func (suite *InMemoryBookRepositoryE2ETestSuite) TestGetOrCreateBook_KeepsFirst() {
+	first := &domainBook.Book{Instrument: "DOGE/USDT"}
+	second := &domainBook.Book{Instrument: "DOGE/USDT"}
+
+	got, err := suite.repo.GetOrCreateBook(first)
+	suite.NoError(err)
+	suite.Same(first, got)
+
+	got, err = suite.repo.GetOrCreateBook(second)
+	suite.NoError(err)
+	suite.Same(first, got)
+
+	stored, _ := suite.repo.GetBook("DOGE/USDT")
+	suite.Same(first, stored)
+}
+
+func (suite *InMemoryBookRepositoryE2ETestSuite) TestListBooks_SortedByInstrument() {
+	_ = suite.repo.SaveBook(&domainBook.Book{Instrument: "SOL/USDT"})
+	_ = suite.repo.SaveBook(&domainBook.Book{Instrument: "ADA/USDT"})
+
+	books, err := suite.repo.ListBooks()
+	suite.NoError(err)
+	suite.GreaterOrEqual(len(books), 2)
+
+	instruments := make([]string, 0, len(books))
+	for _, b := range books {
+		instruments = append(instruments, b.Instrument)
+	}
+
+	suite.Contains(instruments, "SOL/USDT")
+	suite.Contains(instruments, "ADA/USDT")
+	suite.IsIncreasing(instruments)
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, new(InMemoryBookRepositoryE2ETestSuite))
 }
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/juninhoitabh/clob-go/internal/domain/book"
@@ -33,6 +34,19 @@ func (r *InMemoryBookRepository) GetBook(instrument string) (*book.Book, error)
 	return r.books[instrument], nil
 }
 
+func (r *InMemoryBookRepository) GetOrCreateBook(b *book.Book) (*book.Book, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.books[b.Instrument]; ok {
+		return existing, nil
+	}
+
+	r.books[b.Instrument] = b
+
+	return b, nil
+}
+
 func (r *InMemoryBookRepository) SaveBook(b *book.Book) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -41,3 +55,22 @@ func (r *InMemoryBookRepository) SaveBook(b *book.Book) error {
 
 	return nil
 }
+
+func (r *InMemoryBookRepository) ListBooks() ([]*book.Book, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	books := make([]*book.Book, 0, len(r.books))
+	for _, b := range r.books {
+		books = append(books, b)
+	}
+
+	sort.Slice(books, func(i, j int) bool { return books[i].Instrument < books[j].Instrument })
+
+	return books, nil
+}
+
+func ResetInMemoryBookRepository() {
+	once = sync.Once{}
+	instance = nil
+}
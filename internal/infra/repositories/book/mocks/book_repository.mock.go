@@ -49,6 +49,36 @@ func (mr *MockIBookRepositoryMockRecorder) GetBook(instrument interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBook", reflect.TypeOf((*MockIBookRepository)(nil).GetBook), instrument)
 }
 
+// GetOrCreateBook mocks base method.
+func (m *MockIBookRepository) GetOrCreateBook(arg0 *book.Book) (*book.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrCreateBook", arg0)
+	ret0, _ := ret[0].(*book.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrCreateBook indicates an expected call of GetOrCreateBook.
+func (mr *MockIBookRepositoryMockRecorder) GetOrCreateBook(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreateBook", reflect.TypeOf((*MockIBookRepository)(nil).GetOrCreateBook), arg0)
+}
+
+// ListBooks mocks base method.
+func (m *MockIBookRepository) ListBooks() ([]*book.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBooks")
+	ret0, _ := ret[0].([]*book.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBooks indicates an expected call of ListBooks.
+func (mr *MockIBookRepositoryMockRecorder) ListBooks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBooks", reflect.TypeOf((*MockIBookRepository)(nil).ListBooks))
+}
+
 // SaveBook mocks base method.
 func (m *MockIBookRepository) SaveBook(book *book.Book) error {
 	m.ctrl.T.Helper()
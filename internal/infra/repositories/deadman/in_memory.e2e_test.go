@@ -0,0 +1,82 @@
+//go:build all || e2e || infra
+
+package repositories_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	domainDeadman "github.com/juninhoitabh/clob-go/internal/domain/deadman"
+	repositoriesDeadman "github.com/juninhoitabh/clob-go/internal/infra/repositories/deadman"
+)
+
+type InMemorySwitchRepositoryE2ETestSuite struct {
+	suite.Suite
+	repo *repositoriesDeadman.InMemorySwitchRepository
+	now  time.Time
+}
+
+func (suite *InMemorySwitchRepositoryE2ETestSuite) SetupTest() {
+	repositoriesDeadman.ResetInMemorySwitchRepository()
+	suite.repo = repositoriesDeadman.NewInMemorySwitchRepository()
+	suite.now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (suite *InMemorySwitchRepositoryE2ETestSuite) arm(accountID string, timeout time.Duration) *domainDeadman.Switch {
+	s, err := domainDeadman.NewSwitch(domainDeadman.SwitchProps{AccountID: accountID, Timeout: timeout}, suite.now)
+	require.NoError(suite.T(), err)
+	require.NoError(suite.T(), suite.repo.SaveSwitch(s))
+
+	return s
+}
+
+func (suite *InMemorySwitchRepositoryE2ETestSuite) TestSaveGetDelete() {
+	s := suite.arm("acc1", time.Minute)
+
+	got, err := suite.repo.GetSwitch("acc1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), s, got)
+
+	assert.NoError(suite.T(), suite.repo.DeleteSwitch("acc1"))
+
+	got, err = suite.repo.GetSwitch("acc1")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), got)
+}
+
+func (suite *InMemorySwitchRepositoryE2ETestSuite) TestListExpired_OnlyExpiredOldestFirst() {
+	late := suite.arm("late", 10*time.Second)
+	early := suite.arm("early", 5*time.Second)
+	suite.arm("alive", time.Minute)
+
+	expired, err := suite.repo.ListExpired(suite.now.Add(10 * time.Second))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domainDeadman.Switch{early, late}, expired)
+
+	expired, err = suite.repo.ListExpired(suite.now.Add(10 * time.Second))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domainDeadman.Switch{early, late}, expired)
+}
+
+func (suite *InMemorySwitchRepositoryE2ETestSuite) TestDeleteFired_KeepsRearmedSwitch() {
+	fired := suite.arm("fired", 5*time.Second)
+	stale := suite.arm("rearmed", 5*time.Second)
+	rearmed := suite.arm("rearmed", time.Minute)
+
+	assert.NoError(suite.T(), suite.repo.DeleteFired(fired))
+	assert.NoError(suite.T(), suite.repo.DeleteFired(stale))
+
+	got, _ := suite.repo.GetSwitch("fired")
+	assert.Nil(suite.T(), got)
+
+	got, _ = suite.repo.GetSwitch("rearmed")
+	assert.Same(suite.T(), rearmed, got)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(InMemorySwitchRepositoryE2ETestSuite))
+}
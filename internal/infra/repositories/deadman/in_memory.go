@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	domainDeadman "github.com/juninhoitabh/clob-go/internal/domain/deadman"
+)
+
+var (
+	instance *InMemorySwitchRepository
+	once     sync.Once
+)
+
+type InMemorySwitchRepository struct {
+	switches map[string]*domainDeadman.Switch
+	mu       sync.Mutex
+}
+
+func NewInMemorySwitchRepository() *InMemorySwitchRepository {
+	once.Do(func() {
+		instance = &InMemorySwitchRepository{
+			switches: make(map[string]*domainDeadman.Switch),
+		}
+	})
+
+	return instance
+}
+
+func (r *InMemorySwitchRepository) GetSwitch(accountID string) (*domainDeadman.Switch, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.switches[accountID], nil
+}
+
+func (r *InMemorySwitchRepository) SaveSwitch(s *domainDeadman.Switch) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.switches[s.AccountID] = s
+
+	return nil
+}
+
+func (r *InMemorySwitchRepository) DeleteSwitch(accountID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.switches, accountID)
+
+	return nil
+}
+
+func (r *InMemorySwitchRepository) ListExpired(now time.Time) ([]*domainDeadman.Switch, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	expired := make([]*domainDeadman.Switch, 0)
+
+	for _, s := range r.switches {
+		if s.Expired(now) {
+			expired = append(expired, s)
+		}
+	}
+
+	sort.Slice(expired, func(i, j int) bool {
+		return expired[i].ExpiresAt.Before(expired[j].ExpiresAt)
+	})
+
+	return expired, nil
+}
+
+func (r *InMemorySwitchRepository) DeleteFired(s *domainDeadman.Switch) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.switches[s.AccountID] == s {
+		delete(r.switches, s.AccountID)
+	}
+
+	return nil
+}
+
+func ResetInMemorySwitchRepository() {
+	once = sync.Once{}
+	instance = nil
+}
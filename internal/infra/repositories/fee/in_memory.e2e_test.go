@@ -0,0 +1,48 @@
+//go:build all || e2e || infra
+
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	repositoriesFee "github.com/juninhoitabh/clob-go/internal/infra/repositories/fee"
+)
+
+type InMemoryScheduleRepositoryE2ETestSuite struct {
+	suite.Suite
+	repo *repositoriesFee.InMemoryScheduleRepository
+}
+
+func (suite *InMemoryScheduleRepositoryE2ETestSuite) SetupTest() {
+	repositoriesFee.ResetInMemoryScheduleRepository()
+	suite.repo = repositoriesFee.NewInMemoryScheduleRepository()
+}
+
+func (suite *InMemoryScheduleRepositoryE2ETestSuite) TestSaveAndGetSchedule_Success() {
+	schedule, err := domainFee.NewSchedule(domainFee.ScheduleProps{
+		Instrument: "btc/usdt",
+		Rates:      domainFee.Rates{MakerBps: 1, TakerBps: 2},
+	})
+	assert.NoError(suite.T(), err)
+
+	err = suite.repo.SaveSchedule(schedule)
+	assert.NoError(suite.T(), err)
+
+	got, err := suite.repo.GetSchedule("btc/usdt")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), schedule, got)
+}
+
+func (suite *InMemoryScheduleRepositoryE2ETestSuite) TestGetSchedule_NotFound() {
+	got, err := suite.repo.GetSchedule("ETH/USDT")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), got)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(InMemoryScheduleRepositoryE2ETestSuite))
+}
@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"strings"
+	"sync"
+
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+)
+
+var (
+	instance *InMemoryScheduleRepository
+	once     sync.Once
+)
+
+type InMemoryScheduleRepository struct {
+	schedules map[string]*domainFee.Schedule
+	mu        sync.Mutex
+}
+
+func NewInMemoryScheduleRepository() *InMemoryScheduleRepository {
+	once.Do(func() {
+		instance = &InMemoryScheduleRepository{
+			schedules: make(map[string]*domainFee.Schedule),
+		}
+	})
+
+	return instance
+}
+
+func (r *InMemoryScheduleRepository) GetSchedule(instrument string) (*domainFee.Schedule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.schedules[strings.ToUpper(instrument)], nil
+}
+
+func (r *InMemoryScheduleRepository) SaveSchedule(schedule *domainFee.Schedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.schedules[schedule.Instrument] = schedule
+
+	return nil
+}
+
+func ResetInMemoryScheduleRepository() {
+	once = sync.Once{}
+	instance = nil
+}
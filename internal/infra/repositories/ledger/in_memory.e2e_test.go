@@ -0,0 +1,79 @@
+//go:build all || e2e || infra
+
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	domainLedger "github.com/juninhoitabh/clob-go/internal/domain/ledger"
+	repositoriesLedger "github.com/juninhoitabh/clob-go/internal/infra/repositories/ledger"
+)
+
+type InMemoryLedgerRepositoryE2ETestSuite struct {
+	suite.Suite
+	repo *repositoriesLedger.InMemoryLedgerRepository
+}
+
+func (suite *InMemoryLedgerRepositoryE2ETestSuite) SetupTest() {
+	repositoriesLedger.ResetInMemoryLedgerRepository()
+	suite.repo = repositoriesLedger.NewInMemoryLedgerRepository()
+}
+
+func (suite *InMemoryLedgerRepositoryE2ETestSuite) posting(owner, asset string, amount int64) domainLedger.Posting {
+	return domainLedger.NewPosting(
+		domainLedger.OpCredit,
+		asset,
+		amount,
+		domainLedger.Account{Bucket: domainLedger.External},
+		domainLedger.Account{Owner: owner, Bucket: domainLedger.Available},
+		domainLedger.Ref{},
+	)
+}
+
+func (suite *InMemoryLedgerRepositoryE2ETestSuite) TestAppend_AssignsSequence() {
+	err := suite.repo.Append(suite.posting("acc1", "BTC", 1), suite.posting("acc1", "BTC", 2))
+	assert.NoError(suite.T(), err)
+
+	postings, total, err := suite.repo.ListPostings(domainLedger.Filter{AccountID: "acc1"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, total)
+	assert.Equal(suite.T(), int64(1), postings[0].Seq)
+	assert.Equal(suite.T(), int64(2), postings[1].Seq)
+}
+
+func (suite *InMemoryLedgerRepositoryE2ETestSuite) TestListPostings_FiltersAndPaginates() {
+	_ = suite.repo.Append(
+		suite.posting("acc1", "BTC", 1),
+		suite.posting("acc2", "BTC", 2),
+		suite.posting("acc1", "USDT", 3),
+		suite.posting("acc1", "BTC", 4),
+	)
+
+	postings, total, err := suite.repo.ListPostings(domainLedger.Filter{AccountID: "acc1", Asset: "btc", Limit: 1, Offset: 1})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, total)
+	assert.Len(suite.T(), postings, 1)
+	assert.Equal(suite.T(), int64(4), postings[0].Amount)
+
+	postings, total, err = suite.repo.ListPostings(domainLedger.Filter{AccountID: "acc1", Offset: 10})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, total)
+	assert.Empty(suite.T(), postings)
+}
+
+func (suite *InMemoryLedgerRepositoryE2ETestSuite) TestListPostings_ReturnsCopies() {
+	_ = suite.repo.Append(suite.posting("acc1", "BTC", 1))
+
+	postings, _, _ := suite.repo.ListPostings(domainLedger.Filter{})
+	postings[0].Amount = 100
+
+	postings, _, _ = suite.repo.ListPostings(domainLedger.Filter{})
+	assert.Equal(suite.T(), int64(1), postings[0].Amount)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(InMemoryLedgerRepositoryE2ETestSuite))
+}
@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"strings"
+	"sync"
+
+	domainLedger "github.com/juninhoitabh/clob-go/internal/domain/ledger"
+)
+
+var (
+	instance *InMemoryLedgerRepository
+	once     sync.Once
+)
+
+type InMemoryLedgerRepository struct {
+	postings []domainLedger.Posting
+	mu       sync.Mutex
+}
+
+func NewInMemoryLedgerRepository() *InMemoryLedgerRepository {
+	once.Do(func() {
+		instance = &InMemoryLedgerRepository{}
+	})
+
+	return instance
+}
+
+func (r *InMemoryLedgerRepository) Append(postings ...domainLedger.Posting) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, p := range postings {
+		p.Seq = int64(len(r.postings)) + 1
+		r.postings = append(r.postings, p)
+	}
+
+	return nil
+}
+
+func (r *InMemoryLedgerRepository) ListPostings(filter domainLedger.Filter) ([]domainLedger.Posting, int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	asset := strings.ToUpper(filter.Asset)
+	matched := make([]domainLedger.Posting, 0)
+
+	for _, p := range r.postings {
+		if filter.AccountID != "" && !p.Involves(filter.AccountID) {
+			continue
+		}
+
+		if asset != "" && p.Asset != asset {
+			continue
+		}
+
+		matched = append(matched, p)
+	}
+
+	total := len(matched)
+
+	if filter.Offset >= total {
+		return []domainLedger.Posting{}, total, nil
+	}
+
+	matched = matched[filter.Offset:]
+
+	if filter.Limit > 0 && len(matched) > filter.Limit {
+		matched = matched[:filter.Limit]
+	}
+
+	return matched, total, nil
+}
+
+func ResetInMemoryLedgerRepository() {
+	once = sync.Once{}
+	instance = nil
+}
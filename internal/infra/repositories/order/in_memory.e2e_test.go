@@ -6,10 +6,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
 	repositoriesOrder "github.com/juninhoitabh/clob-go/internal/infra/repositories/order"
+	"github.com/juninhoitabh/clob-go/internal/shared"
 )
 
 type InMemoryOrderRepositoryE2ETestSuite struct {
@@ -50,6 +52,131 @@ func (suite *InMemoryOrderRepositoryE2ETestSuite) TestRemoveOrder() {
 	assert.Nil(suite.T(), got)
 }
 
+func (suite *InMemoryOrderRepositoryE2ETestSuite) TestListOpenOrdersByAccount() {
+	open := &domainOrder.Order{AccountID: "acc-open", Remaining: 5}
+	open.ID.ID = "order3"
+	filled := &domainOrder.Order{AccountID: "acc-open", Remaining: 0}
+	filled.ID.ID = "order4"
+	other := &domainOrder.Order{AccountID: "acc-other", Remaining: 5}
+	other.ID.ID = "order5"
+
+	_ = suite.repo.SaveOrder(open)
+	_ = suite.repo.SaveOrder(filled)
+	_ = suite.repo.SaveOrder(other)
+
+	got, err := suite.repo.ListOpenOrdersByAccount("acc-open")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domainOrder.Order{open}, got)
+}
+
+func (suite *InMemoryOrderRepositoryE2ETestSuite) TestListOpenOrdersByAccount_AsLastSaved() {
+	o := &domainOrder.Order{AccountID: "acc-saved", Remaining: 5}
+	o.ID.ID = "order9"
+
+	_ = suite.repo.SaveOrder(o)
+
+	// A fill the book has made but not yet saved is not seen.
+	o.Remaining = 2
+
+	got, _ := suite.repo.ListOpenOrdersByAccount("acc-saved")
+	assert.Len(suite.T(), got, 1)
+	assert.Equal(suite.T(), int64(5), got[0].Remaining)
+	assert.NotSame(suite.T(), o, got[0])
+
+	_ = suite.repo.SaveOrder(o)
+
+	got, _ = suite.repo.ListOpenOrdersByAccount("acc-saved")
+	assert.Equal(suite.T(), int64(2), got[0].Remaining)
+
+	o.Remaining = 0
+	_ = suite.repo.SaveOrder(o)
+
+	got, _ = suite.repo.ListOpenOrdersByAccount("acc-saved")
+	assert.Empty(suite.T(), got)
+
+	stored, _ := suite.repo.GetOrder("order9")
+	assert.Same(suite.T(), o, stored)
+}
+
+func (suite *InMemoryOrderRepositoryE2ETestSuite) TestRemoveOrder_LeavesOpenOrders() {
+	o := &domainOrder.Order{AccountID: "acc-removed", Remaining: 5}
+	o.ID.ID = "order10"
+
+	_ = suite.repo.SaveOrder(o)
+	_ = suite.repo.RemoveOrder("order10")
+
+	got, _ := suite.repo.ListOpenOrdersByAccount("acc-removed")
+	assert.Empty(suite.T(), got)
+}
+
+func (suite *InMemoryOrderRepositoryE2ETestSuite) TestClientOrderID_UniquePerAccount() {
+	first := &domainOrder.Order{AccountID: "acc-client", ClientOrderID: "client-1", Remaining: 5}
+	first.ID.ID = "order6"
+	duplicate := &domainOrder.Order{AccountID: "acc-client", ClientOrderID: "client-1", Remaining: 5}
+	duplicate.ID.ID = "order7"
+	otherAccount := &domainOrder.Order{AccountID: "acc-client-other", ClientOrderID: "client-1", Remaining: 5}
+	otherAccount.ID.ID = "order8"
+
+	require.NoError(suite.T(), suite.repo.SaveOrder(first))
+	require.NoError(suite.T(), suite.repo.SaveOrder(first))
+
+	err := suite.repo.SaveOrder(duplicate)
+	assert.ErrorIs(suite.T(), err, shared.ErrAlreadyExists)
+	assert.ErrorIs(suite.T(), err, domainOrder.ErrDuplicateClientOrderID)
+
+	require.NoError(suite.T(), suite.repo.SaveOrder(otherAccount))
+
+	got, err := suite.repo.GetOrderByClientOrderID("acc-client", "client-1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), first, got)
+
+	got, err = suite.repo.GetOrderByClientOrderID("acc-client-other", "client-1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), otherAccount, got)
+}
+
+func (suite *InMemoryOrderRepositoryE2ETestSuite) TestGetOrderByClientOrderID_NotFound() {
+	got, err := suite.repo.GetOrderByClientOrderID("acc-client", "unknown")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), got)
+}
+
+func (suite *InMemoryOrderRepositoryE2ETestSuite) TestRemoveOrder_ReleasesClientOrderID() {
+	order := &domainOrder.Order{AccountID: "acc-remove", ClientOrderID: "client-2"}
+	order.ID.ID = "order9"
+	require.NoError(suite.T(), suite.repo.SaveOrder(order))
+
+	require.NoError(suite.T(), suite.repo.RemoveOrder("order9"))
+
+	got, err := suite.repo.GetOrderByClientOrderID("acc-remove", "client-2")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), got)
+
+	retry := &domainOrder.Order{AccountID: "acc-remove", ClientOrderID: "client-2"}
+	retry.ID.ID = "order10"
+	assert.NoError(suite.T(), suite.repo.SaveOrder(retry))
+}
+
+func (suite *InMemoryOrderRepositoryE2ETestSuite) TestLastSeq() {
+	repositoriesOrder.ResetInMemoryOrderRepository()
+	suite.repo = repositoriesOrder.NewInMemoryOrderRepository()
+
+	last, err := suite.repo.LastSeq()
+	require.NoError(suite.T(), err)
+	assert.Zero(suite.T(), last)
+
+	later := &domainOrder.Order{Seq: 9}
+	later.ID.ID = "9"
+	earlier := &domainOrder.Order{Seq: 4}
+	earlier.ID.ID = "4"
+	require.NoError(suite.T(), suite.repo.SaveOrder(later))
+	require.NoError(suite.T(), suite.repo.SaveOrder(earlier))
+
+	last, err = suite.repo.LastSeq()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint64(9), last)
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, new(InMemoryOrderRepositoryE2ETestSuite))
 }
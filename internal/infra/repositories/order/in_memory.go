@@ -1,9 +1,12 @@
 package repositories
 
 import (
+	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/juninhoitabh/clob-go/internal/domain/order"
+	"github.com/juninhoitabh/clob-go/internal/shared"
 )
 
 var (
@@ -11,15 +14,25 @@ var (
 	once     sync.Once
 )
 
+// InMemoryOrderRepository keeps every order it is given and, by account, a
+// copy of each open one as of its last save. Orders are saved under their
+// book's lock whenever their Remaining changes, so listing the open ones
+// neither scans the whole history nor reads an order another book is
+// trading.
 type InMemoryOrderRepository struct {
-	orders map[string]*order.Order
-	mu     sync.Mutex
+	orders         map[string]*order.Order
+	open           map[string]map[string]order.Order
+	clientOrderIDs map[string]string
+	lastSeq        uint64
+	mu             sync.Mutex
 }
 
 func NewInMemoryOrderRepository() *InMemoryOrderRepository {
 	once.Do(func() {
 		instance = &InMemoryOrderRepository{
-			orders: make(map[string]*order.Order),
+			orders:         make(map[string]*order.Order),
+			open:           make(map[string]map[string]order.Order),
+			clientOrderIDs: make(map[string]string),
 		}
 	})
 
@@ -33,11 +46,35 @@ func (r *InMemoryOrderRepository) GetOrder(orderID string) (*order.Order, error)
 	return r.orders[orderID], nil
 }
 
+func (r *InMemoryOrderRepository) GetOrderByClientOrderID(accountID, clientOrderID string) (*order.Order, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	orderID, ok := r.clientOrderIDs[clientOrderIDKey(accountID, clientOrderID)]
+	if !ok {
+		return nil, nil
+	}
+
+	return r.orders[orderID], nil
+}
+
 func (r *InMemoryOrderRepository) SaveOrder(o *order.Order) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if o.ClientOrderID != "" {
+		key := clientOrderIDKey(o.AccountID, o.ClientOrderID)
+
+		if existingID, ok := r.clientOrderIDs[key]; ok && existingID != o.GetID() {
+			return fmt.Errorf("%w: %w", shared.ErrAlreadyExists, order.ErrDuplicateClientOrderID)
+		}
+
+		r.clientOrderIDs[key] = o.GetID()
+	}
+
 	r.orders[o.GetID()] = o
+	r.lastSeq = max(r.lastSeq, o.Seq)
+	r.index(o)
 
 	return nil
 }
@@ -46,7 +83,74 @@ func (r *InMemoryOrderRepository) RemoveOrder(orderID string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if o, ok := r.orders[orderID]; ok {
+		if o.ClientOrderID != "" {
+			delete(r.clientOrderIDs, clientOrderIDKey(o.AccountID, o.ClientOrderID))
+		}
+
+		r.unindex(o.AccountID, orderID)
+	}
+
 	delete(r.orders, orderID)
 
 	return nil
 }
+
+func (r *InMemoryOrderRepository) ListOpenOrdersByAccount(accountID string) ([]*order.Order, error) {
+	r.mu.Lock()
+
+	orders := make([]*order.Order, 0, len(r.open[accountID]))
+	for _, o := range r.open[accountID] {
+		orders = append(orders, &o)
+	}
+
+	r.mu.Unlock()
+
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].CreatedAt.Before(orders[j].CreatedAt)
+	})
+
+	return orders, nil
+}
+
+func (r *InMemoryOrderRepository) LastSeq() (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.lastSeq, nil
+}
+
+func (r *InMemoryOrderRepository) index(o *order.Order) {
+	if o.Remaining == 0 {
+		r.unindex(o.AccountID, o.GetID())
+
+		return
+	}
+
+	open := r.open[o.AccountID]
+	if open == nil {
+		open = make(map[string]order.Order)
+		r.open[o.AccountID] = open
+	}
+
+	open[o.GetID()] = *o
+}
+
+func (r *InMemoryOrderRepository) unindex(accountID, orderID string) {
+	open := r.open[accountID]
+
+	delete(open, orderID)
+
+	if len(open) == 0 {
+		delete(r.open, accountID)
+	}
+}
+
+func clientOrderIDKey(accountID, clientOrderID string) string {
+	return accountID + "\x00" + clientOrderID
+}
+
+func ResetInMemoryOrderRepository() {
+	once = sync.Once{}
+	instance = nil
+}
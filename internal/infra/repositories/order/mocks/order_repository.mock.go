@@ -49,6 +49,51 @@ func (mr *MockIOrderRepositoryMockRecorder) GetOrder(orderID interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockIOrderRepository)(nil).GetOrder), orderID)
 }
 
+// GetOrderByClientOrderID mocks base method.
+func (m *MockIOrderRepository) GetOrderByClientOrderID(accountID, clientOrderID string) (*order.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderByClientOrderID", accountID, clientOrderID)
+	ret0, _ := ret[0].(*order.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderByClientOrderID indicates an expected call of GetOrderByClientOrderID.
+func (mr *MockIOrderRepositoryMockRecorder) GetOrderByClientOrderID(accountID, clientOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByClientOrderID", reflect.TypeOf((*MockIOrderRepository)(nil).GetOrderByClientOrderID), accountID, clientOrderID)
+}
+
+// LastSeq mocks base method.
+func (m *MockIOrderRepository) LastSeq() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastSeq")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastSeq indicates an expected call of LastSeq.
+func (mr *MockIOrderRepositoryMockRecorder) LastSeq() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastSeq", reflect.TypeOf((*MockIOrderRepository)(nil).LastSeq))
+}
+
+// ListOpenOrdersByAccount mocks base method.
+func (m *MockIOrderRepository) ListOpenOrdersByAccount(accountID string) ([]*order.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenOrdersByAccount", accountID)
+	ret0, _ := ret[0].([]*order.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenOrdersByAccount indicates an expected call of ListOpenOrdersByAccount.
+func (mr *MockIOrderRepositoryMockRecorder) ListOpenOrdersByAccount(accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenOrdersByAccount", reflect.TypeOf((*MockIOrderRepository)(nil).ListOpenOrdersByAccount), accountID)
+}
+
 // RemoveOrder mocks base method.
 func (m *MockIOrderRepository) RemoveOrder(orderID string) error {
 	m.ctrl.T.Helper()
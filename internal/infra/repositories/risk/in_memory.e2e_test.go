@@ -0,0 +1,48 @@
+//go:build all || e2e || infra
+
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	domainRisk "github.com/juninhoitabh/clob-go/internal/domain/risk"
+	repositoriesRisk "github.com/juninhoitabh/clob-go/internal/infra/repositories/risk"
+)
+
+type InMemoryLimitsRepositoryE2ETestSuite struct {
+	suite.Suite
+	repo *repositoriesRisk.InMemoryLimitsRepository
+}
+
+func (suite *InMemoryLimitsRepositoryE2ETestSuite) SetupTest() {
+	repositoriesRisk.ResetInMemoryLimitsRepository()
+	suite.repo = repositoriesRisk.NewInMemoryLimitsRepository()
+}
+
+func (suite *InMemoryLimitsRepositoryE2ETestSuite) TestSaveAndGetLimits_Success() {
+	limits, err := domainRisk.NewLimits(domainRisk.LimitsProps{
+		AccountID:        "acc1",
+		MaxOrderNotional: 1000,
+	})
+	assert.NoError(suite.T(), err)
+
+	err = suite.repo.SaveLimits(limits)
+	assert.NoError(suite.T(), err)
+
+	got, err := suite.repo.GetLimits("acc1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), limits, got)
+}
+
+func (suite *InMemoryLimitsRepositoryE2ETestSuite) TestGetLimits_NotFound() {
+	got, err := suite.repo.GetLimits("unknown")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), got)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(InMemoryLimitsRepositoryE2ETestSuite))
+}
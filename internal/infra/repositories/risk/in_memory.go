@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"sync"
+
+	domainRisk "github.com/juninhoitabh/clob-go/internal/domain/risk"
+)
+
+var (
+	instance *InMemoryLimitsRepository
+	once     sync.Once
+)
+
+type InMemoryLimitsRepository struct {
+	limits map[string]*domainRisk.Limits
+	mu     sync.Mutex
+}
+
+func NewInMemoryLimitsRepository() *InMemoryLimitsRepository {
+	once.Do(func() {
+		instance = &InMemoryLimitsRepository{
+			limits: make(map[string]*domainRisk.Limits),
+		}
+	})
+
+	return instance
+}
+
+func (r *InMemoryLimitsRepository) GetLimits(accountID string) (*domainRisk.Limits, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.limits[accountID], nil
+}
+
+func (r *InMemoryLimitsRepository) SaveLimits(limits *domainRisk.Limits) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.limits[limits.AccountID] = limits
+
+	return nil
+}
+
+func ResetInMemoryLimitsRepository() {
+	once = sync.Once{}
+	instance = nil
+}
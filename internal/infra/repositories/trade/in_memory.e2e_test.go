@@ -0,0 +1,110 @@
+//go:build all || e2e || infra
+
+package repositories_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	domainTrade "github.com/juninhoitabh/clob-go/internal/domain/trade"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
+)
+
+type InMemoryTradeRepositoryE2ETestSuite struct {
+	suite.Suite
+	repo *repositoriesTrade.InMemoryTradeRepository
+}
+
+func (suite *InMemoryTradeRepositoryE2ETestSuite) SetupTest() {
+	repositoriesTrade.ResetInMemoryTradeRepository()
+	suite.repo = repositoriesTrade.NewInMemoryTradeRepository()
+}
+
+func (suite *InMemoryTradeRepositoryE2ETestSuite) TestListTrades_Filters() {
+	now := time.Now()
+	old := &services.Trade{Seq: 1, Instrument: "BTC/USDT", BuyerID: "a", SellerID: "b", ExecutedAt: now.Add(-time.Hour)}
+	other := &services.Trade{Seq: 2, Instrument: "ETH/USDT", BuyerID: "a", SellerID: "c", ExecutedAt: now}
+	recent := &services.Trade{Seq: 3, Instrument: "BTC/USDT", BuyerID: "c", SellerID: "a", ExecutedAt: now}
+
+	_ = suite.repo.SaveTrade(old)
+	_ = suite.repo.SaveTrade(other)
+	_ = suite.repo.SaveTrade(recent)
+
+	got, err := suite.repo.ListTrades(domainTrade.Filter{AccountID: "a"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*services.Trade{recent, other, old}, got)
+
+	got, err = suite.repo.ListTrades(domainTrade.Filter{AccountID: "a", Instrument: "BTC/USDT"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*services.Trade{recent, old}, got)
+
+	got, err = suite.repo.ListTrades(domainTrade.Filter{AccountID: "a", Since: now.Add(-time.Minute)})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*services.Trade{recent, other}, got)
+
+	got, err = suite.repo.ListTrades(domainTrade.Filter{Limit: 1})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*services.Trade{recent}, got)
+}
+
+func (suite *InMemoryTradeRepositoryE2ETestSuite) TestRollingVolume() {
+	now := time.Now()
+
+	_ = suite.repo.SaveTrade(&services.Trade{BuyerID: "a", SellerID: "b", Price: 100, Qty: 10, ExecutedAt: now.Add(-2 * time.Hour)})
+	_ = suite.repo.SaveTrade(&services.Trade{BuyerID: "b", SellerID: "a", Price: 50, Qty: 2, ExecutedAt: now.Add(-time.Hour)})
+	_ = suite.repo.SaveTrade(&services.Trade{BuyerID: "a", SellerID: "a", Price: 10, Qty: 1, ExecutedAt: now})
+
+	volume, err := suite.repo.RollingVolume("a", time.Time{})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1110), volume)
+
+	volume, _ = suite.repo.RollingVolume("a", now.Add(-90*time.Minute))
+	assert.Equal(suite.T(), int64(110), volume)
+
+	volume, _ = suite.repo.RollingVolume("a", now.Add(time.Minute))
+	assert.Equal(suite.T(), int64(0), volume)
+
+	volume, _ = suite.repo.RollingVolume("b", now.Add(-time.Hour))
+	assert.Equal(suite.T(), int64(100), volume)
+
+	volume, _ = suite.repo.RollingVolume("unknown", time.Time{})
+	assert.Equal(suite.T(), int64(0), volume)
+}
+
+func (suite *InMemoryTradeRepositoryE2ETestSuite) TestSaveTrade_OutOfOrder() {
+	now := time.Now()
+
+	// Three books settle at once: the middle trade is saved last.
+	first := &services.Trade{Seq: 1, Instrument: "BTC/USDT", BuyerID: "a", SellerID: "b", Price: 100, Qty: 1, ExecutedAt: now.Add(-2 * time.Minute)}
+	last := &services.Trade{Seq: 1, Instrument: "ETH/USDT", BuyerID: "a", SellerID: "c", Price: 10, Qty: 1, ExecutedAt: now}
+	middle := &services.Trade{Seq: 1, Instrument: "KSM/USDT", BuyerID: "a", SellerID: "d", Price: 1, Qty: 1, ExecutedAt: now.Add(-time.Minute)}
+
+	_ = suite.repo.SaveTrade(first)
+	_ = suite.repo.SaveTrade(last)
+	_ = suite.repo.SaveTrade(middle)
+
+	got, err := suite.repo.ListTrades(domainTrade.Filter{AccountID: "a"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*services.Trade{last, middle, first}, got)
+
+	got, err = suite.repo.ListTrades(domainTrade.Filter{AccountID: "a", Since: now.Add(-90 * time.Second)})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*services.Trade{last, middle}, got)
+
+	volume, _ := suite.repo.RollingVolume("a", now.Add(-90*time.Second))
+	assert.Equal(suite.T(), int64(11), volume)
+
+	volume, _ = suite.repo.RollingVolume("a", now.Add(-30*time.Second))
+	assert.Equal(suite.T(), int64(10), volume)
+
+	volume, _ = suite.repo.RollingVolume("a", time.Time{})
+	assert.Equal(suite.T(), int64(111), volume)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(InMemoryTradeRepositoryE2ETestSuite))
+}
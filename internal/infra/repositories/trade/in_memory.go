@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	domainTrade "github.com/juninhoitabh/clob-go/internal/domain/trade"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+var (
+	instance *InMemoryTradeRepository
+	once     sync.Once
+)
+
+type (
+	// volumeMark is an account's running notional as of one of its trades.
+	volumeMark struct {
+		At         time.Time
+		Cumulative int64
+	}
+	// InMemoryTradeRepository keeps trades in the order they executed in,
+	// and for every account the running notional after each of its trades,
+	// so a rolling volume is the running total less the total at the
+	// window's start. Books settle in parallel, so a trade can be saved
+	// after a later one; it is put back in its place rather than appended.
+	InMemoryTradeRepository struct {
+		volumes map[string][]volumeMark
+		trades  []*services.Trade
+		mu      sync.Mutex
+	}
+)
+
+func NewInMemoryTradeRepository() *InMemoryTradeRepository {
+	once.Do(func() {
+		instance = &InMemoryTradeRepository{
+			volumes: make(map[string][]volumeMark),
+			trades:  make([]*services.Trade, 0),
+		}
+	})
+
+	return instance
+}
+
+func (r *InMemoryTradeRepository) SaveTrade(t *services.Trade) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := len(r.trades)
+	for i > 0 && r.trades[i-1].ExecutedAt.After(t.ExecutedAt) {
+		i--
+	}
+
+	r.trades = slices.Insert(r.trades, i, t)
+
+	notional := shared.Mul(t.Price, t.Qty)
+
+	r.addVolume(t.BuyerID, t.ExecutedAt, notional)
+
+	if t.SellerID != t.BuyerID {
+		r.addVolume(t.SellerID, t.ExecutedAt, notional)
+	}
+
+	return nil
+}
+
+func (r *InMemoryTradeRepository) ListTrades(filter domainTrade.Filter) ([]*services.Trade, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	trades := make([]*services.Trade, 0)
+
+	for i := len(r.trades) - 1; i >= 0; i-- {
+		t := r.trades[i]
+
+		if filter.Limit > 0 && len(trades) >= filter.Limit {
+			break
+		}
+
+		// Trades are newest first from here on, so every one left is older.
+		if t.ExecutedAt.Before(filter.Since) {
+			break
+		}
+
+		if filter.AccountID != "" && t.BuyerID != filter.AccountID && t.SellerID != filter.AccountID {
+			continue
+		}
+
+		if filter.Instrument != "" && t.Instrument != filter.Instrument {
+			continue
+		}
+
+		trades = append(trades, t)
+	}
+
+	return trades, nil
+}
+
+func (r *InMemoryTradeRepository) RollingVolume(accountID string, since time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	marks := r.volumes[accountID]
+	if len(marks) == 0 {
+		return 0, nil
+	}
+
+	// The first mark at or after since; everything before it is outside
+	// the window.
+	i := sort.Search(len(marks), func(i int) bool { return !marks[i].At.Before(since) })
+
+	total := marks[len(marks)-1].Cumulative
+	if i == 0 {
+		return total, nil
+	}
+
+	return total - marks[i-1].Cumulative, nil
+}
+
+// addVolume puts a mark for the trade at its place in time, and adds its
+// notional to the running totals of any trades saved before it but
+// executed after.
+func (r *InMemoryTradeRepository) addVolume(accountID string, at time.Time, notional int64) {
+	marks := r.volumes[accountID]
+
+	i := len(marks)
+	for i > 0 && marks[i-1].At.After(at) {
+		i--
+		marks[i].Cumulative += notional
+	}
+
+	cumulative := notional
+	if i > 0 {
+		cumulative += marks[i-1].Cumulative
+	}
+
+	r.volumes[accountID] = slices.Insert(marks, i, volumeMark{At: at, Cumulative: cumulative})
+}
+
+func ResetInMemoryTradeRepository() {
+	once = sync.Once{}
+	instance = nil
+}
@@ -0,0 +1,50 @@
+//go:build all || e2e || infra
+
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	domainTransfer "github.com/juninhoitabh/clob-go/internal/domain/transfer"
+	repositoriesTransfer "github.com/juninhoitabh/clob-go/internal/infra/repositories/transfer"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type InMemoryTransferRepositoryE2ETestSuite struct {
+	suite.Suite
+	repo *repositoriesTransfer.InMemoryTransferRepository
+}
+
+func (suite *InMemoryTransferRepositoryE2ETestSuite) SetupTest() {
+	repositoriesTransfer.ResetInMemoryTransferRepository()
+	suite.repo = repositoriesTransfer.NewInMemoryTransferRepository()
+}
+
+func (suite *InMemoryTransferRepositoryE2ETestSuite) TestSaveAndGetByIdempotencyKey() {
+	tr, err := domainTransfer.NewTransfer(domainTransfer.TransferProps{
+		IdempotencyKey: "key-1",
+		FromAccountID:  "acc1",
+		ToAccountID:    "acc2",
+		Asset:          "BTC",
+		Amount:         10,
+	}, idObjValue.Uuid)
+	assert.NoError(suite.T(), err)
+
+	err = suite.repo.SaveTransfer(tr)
+	assert.NoError(suite.T(), err)
+
+	got, err := suite.repo.GetTransferByIdempotencyKey("acc1", "key-1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), tr, got)
+
+	got, err = suite.repo.GetTransferByIdempotencyKey("acc2", "key-1")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), got)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(InMemoryTransferRepositoryE2ETestSuite))
+}
@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"sync"
+
+	domainTransfer "github.com/juninhoitabh/clob-go/internal/domain/transfer"
+)
+
+var (
+	instance *InMemoryTransferRepository
+	once     sync.Once
+)
+
+type InMemoryTransferRepository struct {
+	transfers map[string]*domainTransfer.Transfer
+	mu        sync.Mutex
+}
+
+func NewInMemoryTransferRepository() *InMemoryTransferRepository {
+	once.Do(func() {
+		instance = &InMemoryTransferRepository{
+			transfers: make(map[string]*domainTransfer.Transfer),
+		}
+	})
+
+	return instance
+}
+
+func (r *InMemoryTransferRepository) SaveTransfer(transfer *domainTransfer.Transfer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.transfers[idempotencyKey(transfer.FromAccountID, transfer.IdempotencyKey)] = transfer
+
+	return nil
+}
+
+func (r *InMemoryTransferRepository) GetTransferByIdempotencyKey(fromAccountID, key string) (*domainTransfer.Transfer, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.transfers[idempotencyKey(fromAccountID, key)], nil
+}
+
+func idempotencyKey(fromAccountID, key string) string {
+	return fromAccountID + "|" + key
+}
+
+func ResetInMemoryTransferRepository() {
+	once = sync.Once{}
+	instance = nil
+}
@@ -0,0 +1,114 @@
+//go:build all || e2e || infra
+
+package repositories_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	domainWithdrawal "github.com/juninhoitabh/clob-go/internal/domain/withdrawal"
+	repositoriesWithdrawal "github.com/juninhoitabh/clob-go/internal/infra/repositories/withdrawal"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+type InMemoryWithdrawalRepositoryE2ETestSuite struct {
+	suite.Suite
+	repo *repositoriesWithdrawal.InMemoryWithdrawalRepository
+}
+
+func (suite *InMemoryWithdrawalRepositoryE2ETestSuite) SetupTest() {
+	repositoriesWithdrawal.ResetInMemoryWithdrawalRepository()
+	suite.repo = repositoriesWithdrawal.NewInMemoryWithdrawalRepository()
+}
+
+func (suite *InMemoryWithdrawalRepositoryE2ETestSuite) TestSaveAndGetWithdrawal_Success() {
+	w, err := domainWithdrawal.NewWithdrawal(domainWithdrawal.WithdrawalProps{
+		AccountID: "acc1",
+		Asset:     "BTC",
+		Amount:    10,
+	}, idObjValue.Uuid)
+	assert.NoError(suite.T(), err)
+
+	err = suite.repo.SaveWithdrawal(w)
+	assert.NoError(suite.T(), err)
+
+	got, err := suite.repo.GetWithdrawal(w.GetID())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), w, got)
+}
+
+func (suite *InMemoryWithdrawalRepositoryE2ETestSuite) TestGetWithdrawal_NotFound() {
+	got, err := suite.repo.GetWithdrawal("unknown")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), got)
+}
+
+func (suite *InMemoryWithdrawalRepositoryE2ETestSuite) TestListWithdrawalsByAccount_NewestFirst() {
+	now := time.Now()
+	older := &domainWithdrawal.Withdrawal{AccountID: "acc1", CreatedAt: now.Add(-time.Minute)}
+	older.ID.ID = "w1"
+	newer := &domainWithdrawal.Withdrawal{AccountID: "acc1", CreatedAt: now}
+	newer.ID.ID = "w2"
+	other := &domainWithdrawal.Withdrawal{AccountID: "acc2", CreatedAt: now}
+	other.ID.ID = "w3"
+
+	_ = suite.repo.SaveWithdrawal(older)
+	_ = suite.repo.SaveWithdrawal(newer)
+	_ = suite.repo.SaveWithdrawal(other)
+
+	got, err := suite.repo.ListWithdrawalsByAccount("acc1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domainWithdrawal.Withdrawal{newer, older}, got)
+}
+
+func (suite *InMemoryWithdrawalRepositoryE2ETestSuite) TestTransitionWithdrawal_OnlyOneWins() {
+	w, _ := domainWithdrawal.NewWithdrawal(domainWithdrawal.WithdrawalProps{
+		AccountID: "acc1",
+		Asset:     "BTC",
+		Amount:    10,
+	}, idObjValue.Uuid)
+	_ = suite.repo.SaveWithdrawal(w)
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		wins int
+	)
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			failed := *w
+			_ = failed.Fail("rejected")
+
+			if suite.repo.TransitionWithdrawal(&failed, domainWithdrawal.Pending) == nil {
+				mu.Lock()
+				wins++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(suite.T(), 1, wins)
+
+	got, _ := suite.repo.GetWithdrawal(w.GetID())
+	assert.Equal(suite.T(), domainWithdrawal.Failed, got.Status)
+	assert.Equal(suite.T(), domainWithdrawal.Pending, w.Status)
+
+	completed := *got
+	err := suite.repo.TransitionWithdrawal(&completed, domainWithdrawal.Pending)
+	assert.ErrorIs(suite.T(), err, domainWithdrawal.ErrInvalidTransition)
+}
+
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(InMemoryWithdrawalRepositoryE2ETestSuite))
+}
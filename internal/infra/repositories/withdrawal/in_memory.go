@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"sort"
+	"sync"
+
+	domainWithdrawal "github.com/juninhoitabh/clob-go/internal/domain/withdrawal"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+var (
+	instance *InMemoryWithdrawalRepository
+	once     sync.Once
+)
+
+// InMemoryWithdrawalRepository never changes a stored withdrawal in place:
+// a transition stores a new one, so a withdrawal handed out stays as it
+// was read.
+type InMemoryWithdrawalRepository struct {
+	withdrawals map[string]*domainWithdrawal.Withdrawal
+	mu          sync.Mutex
+}
+
+func NewInMemoryWithdrawalRepository() *InMemoryWithdrawalRepository {
+	once.Do(func() {
+		instance = &InMemoryWithdrawalRepository{
+			withdrawals: make(map[string]*domainWithdrawal.Withdrawal),
+		}
+	})
+
+	return instance
+}
+
+func (r *InMemoryWithdrawalRepository) SaveWithdrawal(withdrawal *domainWithdrawal.Withdrawal) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.withdrawals[withdrawal.GetID()] = withdrawal
+
+	return nil
+}
+
+func (r *InMemoryWithdrawalRepository) TransitionWithdrawal(withdrawal *domainWithdrawal.Withdrawal, from domainWithdrawal.Status) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.withdrawals[withdrawal.GetID()]
+	if !ok {
+		return shared.ErrNotFound
+	}
+
+	if current.Status != from {
+		return domainWithdrawal.ErrInvalidTransition
+	}
+
+	r.withdrawals[withdrawal.GetID()] = withdrawal
+
+	return nil
+}
+
+func (r *InMemoryWithdrawalRepository) GetWithdrawal(id string) (*domainWithdrawal.Withdrawal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.withdrawals[id], nil
+}
+
+func (r *InMemoryWithdrawalRepository) ListWithdrawalsByAccount(accountID string) ([]*domainWithdrawal.Withdrawal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	withdrawals := make([]*domainWithdrawal.Withdrawal, 0)
+
+	for _, w := range r.withdrawals {
+		if w.AccountID == accountID {
+			withdrawals = append(withdrawals, w)
+		}
+	}
+
+	sort.Slice(withdrawals, func(i, j int) bool {
+		return withdrawals[i].CreatedAt.After(withdrawals[j].CreatedAt)
+	})
+
+	return withdrawals, nil
+}
+
+func ResetInMemoryWithdrawalRepository() {
+	once = sync.Once{}
+	instance = nil
+}
@@ -0,0 +1,135 @@
+package wire
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+var ErrLoginRejected = errors.New("wire: login rejected")
+
+// Client is a session with a Server. Place and Cancel may be called while
+// another goroutine reads responses with Next.
+type Client struct {
+	conn      net.Conn
+	reader    *bufio.Reader
+	writer    *bufio.Writer
+	AccountID string
+	buf       []byte
+	mu        sync.Mutex
+}
+
+// Dial connects to addr and logs in, returning once the server accepted.
+func Dial(ctx context.Context, addr string, login Login) (*Client, error) {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if tcpConn, ok := conn.(*net.TCPConn); ok {
+		_ = tcpConn.SetNoDelay(true)
+	}
+
+	c := &Client{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+		writer: bufio.NewWriter(conn),
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	err = c.Send(&login)
+	if err == nil {
+		err = c.Flush()
+	}
+
+	var msg Message
+	if err == nil {
+		msg, err = c.Next()
+	}
+
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	_ = conn.SetDeadline(time.Time{})
+
+	switch msg := msg.(type) {
+	case *LoginAccepted:
+		c.AccountID = msg.AccountID
+
+		return c, nil
+	case *LoginRejected:
+		_ = conn.Close()
+
+		return nil, fmt.Errorf("%w: %s", ErrLoginRejected, msg.Text)
+	default:
+		_ = conn.Close()
+
+		return nil, fmt.Errorf("%w: unexpected message type %q", ErrLoginRejected, msg.Type())
+	}
+}
+
+// Place sends an order and flushes it.
+func (c *Client) Place(order PlaceOrder) error {
+	return c.sendNow(&order)
+}
+
+// Cancel sends a cancel and flushes it.
+func (c *Client) Cancel(cancel CancelOrder) error {
+	return c.sendNow(&cancel)
+}
+
+// Send buffers msg without flushing, so several requests can be pipelined
+// in one write.
+func (c *Client) Send(msg Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var err error
+
+	c.buf, err = AppendMessage(c.buf[:0], msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.writer.Write(c.buf)
+
+	return err
+}
+
+func (c *Client) Flush() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.writer.Flush()
+}
+
+// Next blocks until the server's next message. It must not be called
+// concurrently.
+func (c *Client) Next() (Message, error) {
+	return ReadMessage(c.reader)
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (c *Client) sendNow(msg Message) error {
+	err := c.Send(msg)
+	if err != nil {
+		return err
+	}
+
+	return c.Flush()
+}
@@ -0,0 +1,457 @@
+package wire
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Every frame is a big-endian uint16 length, then that many bytes: a type
+// byte and the type's fixed-layout payload. Strings are NUL padded.
+const (
+	TypeLogin         byte = 'L'
+	TypeLoginAccepted byte = 'A'
+	TypeLoginRejected byte = 'J'
+	TypePlaceOrder    byte = 'O'
+	TypeCancelOrder   byte = 'X'
+	TypeAccepted      byte = 'K'
+	TypeFill          byte = 'F'
+	TypeCanceled      byte = 'C'
+	TypeRejected      byte = 'R'
+)
+
+const (
+	SideBuy  byte = 1
+	SideSell byte = 2
+
+	LiquidityMaker byte = 'M'
+	LiquidityTaker byte = 'T'
+)
+
+const (
+	RejectInvalid byte = iota + 1
+	RejectNotFound
+	RejectForbidden
+	RejectUnauthorized
+	RejectBusiness
+	RejectConflict
+	RejectRateLimited
+	RejectInternal
+)
+
+const (
+	idLength         = 36
+	instrumentLength = 16
+	codeLength       = 32
+	textLength       = 64
+	signatureLength  = 64
+	headerLength     = 3
+)
+
+var (
+	ErrUnknownType  = errors.New("wire: unknown message type")
+	ErrShortPayload = errors.New("wire: payload does not match message type")
+	ErrFieldTooLong = errors.New("wire: field too long")
+)
+
+type (
+	Message interface {
+		Type() byte
+		size() int
+		encode(b []byte) error
+		decode(b []byte)
+	}
+	// Login opens a session. With authentication enabled APIKey, Timestamp
+	// and Signature are checked and AccountID is ignored.
+	Login struct {
+		APIKey    string
+		Signature string
+		AccountID string
+		Timestamp int64
+	}
+	LoginAccepted struct {
+		AccountID string
+	}
+	LoginRejected struct {
+		Text string
+	}
+	// PlaceOrder submits a limit order. A non-zero ClientOrderID makes the
+	// submission idempotent per account.
+	PlaceOrder struct {
+		Instrument    string
+		ClientOrderID uint64
+		Price         int64
+		Qty           int64
+		Side          byte
+	}
+	// CancelOrder cancels by OrderID or, when it is empty, by ClientOrderID.
+	CancelOrder struct {
+		OrderID       string
+		ClientOrderID uint64
+	}
+	Accepted struct {
+		OrderID       string
+		Instrument    string
+		ClientOrderID uint64
+		Price         int64
+		Qty           int64
+		Remaining     int64
+		Timestamp     int64
+		Side          byte
+	}
+	Fill struct {
+		OrderID       string
+		TradeID       string
+		ClientOrderID uint64
+		Price         int64
+		Qty           int64
+		Remaining     int64
+		Timestamp     int64
+		Liquidity     byte
+	}
+	Canceled struct {
+		OrderID       string
+		ClientOrderID uint64
+		CanceledQty   int64
+		Timestamp     int64
+	}
+	Rejected struct {
+		Code          string
+		Text          string
+		ClientOrderID uint64
+		Reason        byte
+	}
+)
+
+func (Login) Type() byte         { return TypeLogin }
+func (LoginAccepted) Type() byte { return TypeLoginAccepted }
+func (LoginRejected) Type() byte { return TypeLoginRejected }
+func (PlaceOrder) Type() byte    { return TypePlaceOrder }
+func (CancelOrder) Type() byte   { return TypeCancelOrder }
+func (Accepted) Type() byte      { return TypeAccepted }
+func (Fill) Type() byte          { return TypeFill }
+func (Canceled) Type() byte      { return TypeCanceled }
+func (Rejected) Type() byte      { return TypeRejected }
+
+func (Login) size() int         { return idLength + signatureLength + idLength + 8 }
+func (LoginAccepted) size() int { return idLength }
+func (LoginRejected) size() int { return textLength }
+func (PlaceOrder) size() int    { return 8 + instrumentLength + 1 + 8 + 8 }
+func (CancelOrder) size() int   { return 8 + idLength }
+func (Accepted) size() int      { return 8 + idLength + instrumentLength + 1 + 8*4 }
+func (Fill) size() int          { return 8 + idLength*2 + 8*4 + 1 }
+func (Canceled) size() int      { return 8 + idLength + 8*2 }
+func (Rejected) size() int      { return 8 + 1 + codeLength + textLength }
+
+func (m *Login) encode(b []byte) error {
+	w := writer{b: b}
+	w.string(m.APIKey, idLength)
+	w.string(m.Signature, signatureLength)
+	w.string(m.AccountID, idLength)
+	w.int64(m.Timestamp)
+
+	return w.err
+}
+
+func (m *Login) decode(b []byte) {
+	r := reader{b: b}
+	m.APIKey = r.string(idLength)
+	m.Signature = r.string(signatureLength)
+	m.AccountID = r.string(idLength)
+	m.Timestamp = r.int64()
+}
+
+func (m *LoginAccepted) encode(b []byte) error {
+	w := writer{b: b}
+	w.string(m.AccountID, idLength)
+
+	return w.err
+}
+
+func (m *LoginAccepted) decode(b []byte) {
+	r := reader{b: b}
+	m.AccountID = r.string(idLength)
+}
+
+func (m *LoginRejected) encode(b []byte) error {
+	w := writer{b: b}
+	w.text(m.Text, textLength)
+
+	return w.err
+}
+
+func (m *LoginRejected) decode(b []byte) {
+	r := reader{b: b}
+	m.Text = r.string(textLength)
+}
+
+func (m *PlaceOrder) encode(b []byte) error {
+	w := writer{b: b}
+	w.uint64(m.ClientOrderID)
+	w.string(m.Instrument, instrumentLength)
+	w.byte(m.Side)
+	w.int64(m.Price)
+	w.int64(m.Qty)
+
+	return w.err
+}
+
+func (m *PlaceOrder) decode(b []byte) {
+	r := reader{b: b}
+	m.ClientOrderID = r.uint64()
+	m.Instrument = r.string(instrumentLength)
+	m.Side = r.byte()
+	m.Price = r.int64()
+	m.Qty = r.int64()
+}
+
+func (m *CancelOrder) encode(b []byte) error {
+	w := writer{b: b}
+	w.uint64(m.ClientOrderID)
+	w.string(m.OrderID, idLength)
+
+	return w.err
+}
+
+func (m *CancelOrder) decode(b []byte) {
+	r := reader{b: b}
+	m.ClientOrderID = r.uint64()
+	m.OrderID = r.string(idLength)
+}
+
+func (m *Accepted) encode(b []byte) error {
+	w := writer{b: b}
+	w.uint64(m.ClientOrderID)
+	w.string(m.OrderID, idLength)
+	w.string(m.Instrument, instrumentLength)
+	w.byte(m.Side)
+	w.int64(m.Price)
+	w.int64(m.Qty)
+	w.int64(m.Remaining)
+	w.int64(m.Timestamp)
+
+	return w.err
+}
+
+func (m *Accepted) decode(b []byte) {
+	r := reader{b: b}
+	m.ClientOrderID = r.uint64()
+	m.OrderID = r.string(idLength)
+	m.Instrument = r.string(instrumentLength)
+	m.Side = r.byte()
+	m.Price = r.int64()
+	m.Qty = r.int64()
+	m.Remaining = r.int64()
+	m.Timestamp = r.int64()
+}
+
+func (m *Fill) encode(b []byte) error {
+	w := writer{b: b}
+	w.uint64(m.ClientOrderID)
+	w.string(m.OrderID, idLength)
+	w.string(m.TradeID, idLength)
+	w.int64(m.Price)
+	w.int64(m.Qty)
+	w.int64(m.Remaining)
+	w.int64(m.Timestamp)
+	w.byte(m.Liquidity)
+
+	return w.err
+}
+
+func (m *Fill) decode(b []byte) {
+	r := reader{b: b}
+	m.ClientOrderID = r.uint64()
+	m.OrderID = r.string(idLength)
+	m.TradeID = r.string(idLength)
+	m.Price = r.int64()
+	m.Qty = r.int64()
+	m.Remaining = r.int64()
+	m.Timestamp = r.int64()
+	m.Liquidity = r.byte()
+}
+
+func (m *Canceled) encode(b []byte) error {
+	w := writer{b: b}
+	w.uint64(m.ClientOrderID)
+	w.string(m.OrderID, idLength)
+	w.int64(m.CanceledQty)
+	w.int64(m.Timestamp)
+
+	return w.err
+}
+
+func (m *Canceled) decode(b []byte) {
+	r := reader{b: b}
+	m.ClientOrderID = r.uint64()
+	m.OrderID = r.string(idLength)
+	m.CanceledQty = r.int64()
+	m.Timestamp = r.int64()
+}
+
+func (m *Rejected) encode(b []byte) error {
+	w := writer{b: b}
+	w.uint64(m.ClientOrderID)
+	w.byte(m.Reason)
+	w.text(m.Code, codeLength)
+	w.text(m.Text, textLength)
+
+	return w.err
+}
+
+func (m *Rejected) decode(b []byte) {
+	r := reader{b: b}
+	m.ClientOrderID = r.uint64()
+	m.Reason = r.byte()
+	m.Code = r.string(codeLength)
+	m.Text = r.string(textLength)
+}
+
+// AppendMessage appends msg's frame to dst.
+func AppendMessage(dst []byte, msg Message) ([]byte, error) {
+	size := msg.size()
+
+	dst = binary.BigEndian.AppendUint16(dst, uint16(size+1))
+	dst = append(dst, msg.Type())
+
+	start := len(dst)
+	dst = append(dst, make([]byte, size)...)
+
+	err := msg.encode(dst[start:])
+	if err != nil {
+		return dst[:start-headerLength], err
+	}
+
+	return dst, nil
+}
+
+func WriteMessage(w io.Writer, msg Message) error {
+	frame, err := AppendMessage(make([]byte, 0, headerLength+msg.size()), msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(frame)
+
+	return err
+}
+
+func ReadMessage(r *bufio.Reader) (Message, error) {
+	var header [headerLength]byte
+
+	_, err := io.ReadFull(r, header[:])
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := newMessage(header[2])
+	if err != nil {
+		return nil, err
+	}
+
+	length := int(binary.BigEndian.Uint16(header[:2])) - 1
+	if length != msg.size() {
+		return nil, fmt.Errorf("%w: type %q with %d bytes", ErrShortPayload, header[2], length)
+	}
+
+	payload := make([]byte, length)
+
+	_, err = io.ReadFull(r, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.decode(payload)
+
+	return msg, nil
+}
+
+func newMessage(msgType byte) (Message, error) {
+	switch msgType {
+	case TypeLogin:
+		return &Login{}, nil
+	case TypeLoginAccepted:
+		return &LoginAccepted{}, nil
+	case TypeLoginRejected:
+		return &LoginRejected{}, nil
+	case TypePlaceOrder:
+		return &PlaceOrder{}, nil
+	case TypeCancelOrder:
+		return &CancelOrder{}, nil
+	case TypeAccepted:
+		return &Accepted{}, nil
+	case TypeFill:
+		return &Fill{}, nil
+	case TypeCanceled:
+		return &Canceled{}, nil
+	case TypeRejected:
+		return &Rejected{}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUnknownType, msgType)
+}
+
+type writer struct {
+	err error
+	b   []byte
+}
+
+func (w *writer) byte(v byte) {
+	w.b[0] = v
+	w.b = w.b[1:]
+}
+
+func (w *writer) uint64(v uint64) {
+	binary.BigEndian.PutUint64(w.b, v)
+	w.b = w.b[8:]
+}
+
+func (w *writer) int64(v int64) {
+	w.uint64(uint64(v))
+}
+
+func (w *writer) string(v string, length int) {
+	if len(v) > length && w.err == nil {
+		w.err = fmt.Errorf("%w: %q exceeds %d bytes", ErrFieldTooLong, v, length)
+	}
+
+	copy(w.b[:length], v)
+	w.b = w.b[length:]
+}
+
+// text truncates free text instead of failing.
+func (w *writer) text(v string, length int) {
+	w.string(v[:min(len(v), length)], length)
+}
+
+type reader struct {
+	b []byte
+}
+
+func (r *reader) byte() byte {
+	v := r.b[0]
+	r.b = r.b[1:]
+
+	return v
+}
+
+func (r *reader) uint64() uint64 {
+	v := binary.BigEndian.Uint64(r.b)
+	r.b = r.b[8:]
+
+	return v
+}
+
+func (r *reader) int64() int64 {
+	return int64(r.uint64())
+}
+
+func (r *reader) string(length int) string {
+	v := r.b[:length]
+	r.b = r.b[length:]
+
+	return string(bytes.TrimRight(v, "\x00"))
+}
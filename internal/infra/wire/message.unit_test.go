@@ -0,0 +1,80 @@
+//go:build all || unit || infra
+
+package wire_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/juninhoitabh/clob-go/internal/infra/wire"
+)
+
+func TestReadMessage_RoundTrip(t *testing.T) {
+	messages := []wire.Message{
+		&wire.Login{APIKey: "key", Signature: strings.Repeat("f", 64), AccountID: "acct", Timestamp: 1700000000},
+		&wire.LoginAccepted{AccountID: "acct"},
+		&wire.LoginRejected{Text: "unauthorized"},
+		&wire.PlaceOrder{ClientOrderID: 7, Instrument: "BTC/USDT", Side: wire.SideSell, Price: 100, Qty: 3},
+		&wire.CancelOrder{ClientOrderID: 7, OrderID: "123e4567-e89b-12d3-a456-426614174000"},
+		&wire.Accepted{ClientOrderID: 7, OrderID: "o-1", Instrument: "BTC/USDT", Side: wire.SideBuy, Price: 100, Qty: 3, Remaining: 1, Timestamp: -1},
+		&wire.Fill{ClientOrderID: 7, OrderID: "o-1", TradeID: "t-1", Price: 100, Qty: 2, Remaining: 1, Timestamp: 42, Liquidity: wire.LiquidityTaker},
+		&wire.Canceled{ClientOrderID: 7, OrderID: "o-1", CanceledQty: 1, Timestamp: 43},
+		&wire.Rejected{ClientOrderID: 7, Reason: wire.RejectBusiness, Code: "PRICE_BAND", Text: "price outside band"},
+	}
+
+	var buf bytes.Buffer
+
+	for _, msg := range messages {
+		require.NoError(t, wire.WriteMessage(&buf, msg))
+	}
+
+	reader := bufio.NewReader(&buf)
+
+	for _, want := range messages {
+		got, err := wire.ReadMessage(reader)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+}
+
+func TestAppendMessage_FixedFrameSize(t *testing.T) {
+	frame, err := wire.AppendMessage(nil, &wire.PlaceOrder{Instrument: "BTC/USDT", Side: wire.SideBuy, Price: 1, Qty: 1})
+	require.NoError(t, err)
+
+	assert.Len(t, frame, 3+41)
+	assert.Equal(t, uint16(42), binary.BigEndian.Uint16(frame))
+	assert.Equal(t, wire.TypePlaceOrder, frame[2])
+}
+
+func TestAppendMessage_FieldTooLong(t *testing.T) {
+	frame, err := wire.AppendMessage([]byte("x"), &wire.PlaceOrder{Instrument: strings.Repeat("A", 17)})
+	assert.ErrorIs(t, err, wire.ErrFieldTooLong)
+	assert.Equal(t, []byte("x"), frame)
+
+	_, err = wire.AppendMessage(nil, &wire.Rejected{Text: strings.Repeat("a", 100)})
+	assert.NoError(t, err)
+}
+
+func TestReadMessage_Invalid(t *testing.T) {
+	testCases := []struct {
+		want  error
+		name  string
+		frame []byte
+	}{
+		{name: "unknown type", frame: []byte{0, 1, 'Z'}, want: wire.ErrUnknownType},
+		{name: "wrong length", frame: []byte{0, 2, wire.TypeLoginAccepted, 0}, want: wire.ErrShortPayload},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := wire.ReadMessage(bufio.NewReader(bytes.NewReader(tc.frame)))
+			assert.ErrorIs(t, err, tc.want)
+		})
+	}
+}
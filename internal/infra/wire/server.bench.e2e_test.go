@@ -0,0 +1,120 @@
+//go:build all || e2e || infra
+
+package wire_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
+	"github.com/juninhoitabh/clob-go/internal/infra/http-server/router"
+	"github.com/juninhoitabh/clob-go/internal/infra/http-server/router/routes"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesBook "github.com/juninhoitabh/clob-go/internal/infra/repositories/book"
+	repositoriesOrder "github.com/juninhoitabh/clob-go/internal/infra/repositories/order"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
+	"github.com/juninhoitabh/clob-go/internal/infra/wire"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+// The benchmarks below measure one place plus one cancel round trip of a
+// resting order, over the binary protocol and over the HTTP API.
+
+func benchmarkAccount(b *testing.B) *domainAccount.Account {
+	repositoriesAccount.ResetInMemoryAccountRepository()
+	repositoriesBook.ResetInMemoryBookRepository()
+	repositoriesOrder.ResetInMemoryOrderRepository()
+	repositoriesTrade.ResetInMemoryTradeRepository()
+
+	acct, err := domainAccount.NewAccount(domainAccount.AccountProps{Name: "bench"}, idObjValue.Uuid)
+	require.NoError(b, err)
+	require.NoError(b, acct.Credit("USDT", 1<<40))
+	require.NoError(b, repositoriesAccount.NewInMemoryAccountRepository().Create(acct))
+
+	return acct
+}
+
+func BenchmarkPlaceCancel_Wire(b *testing.B) {
+	acct := benchmarkAccount(b)
+
+	client := dialWire(b, serveWire(b, newWireServer(nil)), wire.Login{AccountID: acct.GetID()})
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		require.NoError(b, client.Place(wire.PlaceOrder{Instrument: "BTC/USDT", Side: wire.SideBuy, Price: 100, Qty: 1}))
+
+		msg, err := client.Next()
+		require.NoError(b, err)
+
+		ack, ok := msg.(*wire.Accepted)
+		require.True(b, ok, "unexpected message %+v", msg)
+
+		require.NoError(b, client.Cancel(wire.CancelOrder{OrderID: ack.OrderID}))
+
+		msg, err = client.Next()
+		require.NoError(b, err)
+
+		_, ok = msg.(*wire.Canceled)
+		require.True(b, ok, "unexpected message %+v", msg)
+	}
+}
+
+func BenchmarkPlaceCancel_HTTP(b *testing.B) {
+	b.Setenv("AUTH_ENABLED", "false")
+	b.Setenv("RATE_LIMIT_ENABLED", "false")
+
+	log.SetOutput(io.Discard)
+	b.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	config.Init()
+
+	acct := benchmarkAccount(b)
+
+	server := httptest.NewServer(router.Generate(config.EnvConfigInstance.ApiPort, routes.NewPlaceOrderUseCase()))
+	b.Cleanup(server.Close)
+
+	body, err := json.Marshal(map[string]any{
+		"account_id": acct.GetID(),
+		"instrument": "BTC/USDT",
+		"side":       "buy",
+		"price":      100,
+		"qty":        1,
+	})
+	require.NoError(b, err)
+
+	post := func(path string, body []byte, status int) []byte {
+		resp, err := server.Client().Post(server.URL+path, "application/json", bytes.NewReader(body))
+		require.NoError(b, err)
+
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		require.NoError(b, err)
+		require.Equal(b, status, resp.StatusCode, string(respBody))
+
+		return respBody
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var placed struct {
+			Order map[string]any `json:"order"`
+		}
+
+		require.NoError(b, json.Unmarshal(post("/api/v1/orders", body, http.StatusCreated), &placed))
+
+		post(fmt.Sprintf("/api/v1/orders/%s/cancel", placed.Order["id"]), nil, http.StatusOK)
+	}
+}
@@ -0,0 +1,332 @@
+//go:build all || e2e || infra
+
+package wire_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainAccount "github.com/juninhoitabh/clob-go/internal/domain/account"
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	domainBook "github.com/juninhoitabh/clob-go/internal/domain/book"
+	domainFee "github.com/juninhoitabh/clob-go/internal/domain/fee"
+	domainRisk "github.com/juninhoitabh/clob-go/internal/domain/risk"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesAPIKey "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey"
+	repositoriesBook "github.com/juninhoitabh/clob-go/internal/infra/repositories/book"
+	repositoriesFee "github.com/juninhoitabh/clob-go/internal/infra/repositories/fee"
+	repositoriesOrder "github.com/juninhoitabh/clob-go/internal/infra/repositories/order"
+	repositoriesRisk "github.com/juninhoitabh/clob-go/internal/infra/repositories/risk"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
+	"github.com/juninhoitabh/clob-go/internal/infra/wire"
+	idObjValue "github.com/juninhoitabh/clob-go/internal/shared/domain/value-objects/id"
+)
+
+func newWireServer(authenticate apikeyUsecases.IAuthenticateUseCase) *wire.Server {
+	accountRepo := repositoriesAccount.NewInMemoryAccountRepository()
+	bookRepo := repositoriesBook.NewInMemoryBookRepository()
+	orderRepo := repositoriesOrder.NewInMemoryOrderRepository()
+	tradeRepo := repositoriesTrade.NewInMemoryTradeRepository()
+
+	return wire.NewServer(
+		orderUsecases.NewPlaceOrderUseCase(
+			bookRepo,
+			orderRepo,
+			accountRepo,
+			repositoriesFee.NewInMemoryScheduleRepository(),
+			tradeRepo,
+			domainBook.CircuitBreakerProps{},
+			domainFee.FeeProps{AccountID: "fees"},
+			domainRisk.NewDefaultChain(repositoriesRisk.NewInMemoryLimitsRepository()),
+		),
+		orderUsecases.NewCancelOrderUseCase(bookRepo, orderRepo, accountRepo),
+		orderUsecases.NewGetOrderUseCase(orderRepo, accountRepo),
+		tradeRepo,
+		authenticate,
+		10*time.Millisecond,
+		log.New(io.Discard, "", 0),
+	)
+}
+
+func serveWire(t testing.TB, server *wire.Server) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go func() { _ = server.Serve(ctx, listener) }()
+
+	return listener.Addr().String()
+}
+
+func dialWire(t testing.TB, addr string, login wire.Login) *wire.Client {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	client, err := wire.Dial(ctx, addr, login)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client
+}
+
+func next[T wire.Message](t *testing.T, client *wire.Client) T {
+	msg, err := client.Next()
+	require.NoError(t, err)
+
+	typed, ok := msg.(T)
+	require.True(t, ok, "unexpected message %T: %+v", msg, msg)
+
+	return typed
+}
+
+type WireServerE2ETestSuite struct {
+	suite.Suite
+	accountRepo *repositoriesAccount.InMemoryAccountRepository
+	apiKeyRepo  *repositoriesAPIKey.InMemoryAPIKeyRepository
+	addr        string
+	accounts    int
+}
+
+func (suite *WireServerE2ETestSuite) SetupTest() {
+	repositoriesAccount.ResetInMemoryAccountRepository()
+	repositoriesAPIKey.ResetInMemoryAPIKeyRepository()
+	repositoriesBook.ResetInMemoryBookRepository()
+	repositoriesOrder.ResetInMemoryOrderRepository()
+	repositoriesTrade.ResetInMemoryTradeRepository()
+	repositoriesFee.ResetInMemoryScheduleRepository()
+	repositoriesRisk.ResetInMemoryLimitsRepository()
+
+	suite.accountRepo = repositoriesAccount.NewInMemoryAccountRepository()
+	suite.apiKeyRepo = repositoriesAPIKey.NewInMemoryAPIKeyRepository()
+	suite.addr = serveWire(suite.T(), newWireServer(nil))
+}
+
+func (suite *WireServerE2ETestSuite) newAccount(asset string, amount int64) *domainAccount.Account {
+	suite.accounts++
+
+	acct, err := domainAccount.NewAccount(domainAccount.AccountProps{Name: fmt.Sprintf("wire-%d", suite.accounts)}, idObjValue.Uuid)
+	require.NoError(suite.T(), err)
+	require.NoError(suite.T(), acct.Credit(asset, amount))
+	require.NoError(suite.T(), suite.accountRepo.Create(acct))
+
+	return acct
+}
+
+func (suite *WireServerE2ETestSuite) TestPlace_AcksAndFillsBothSides() {
+	t := suite.T()
+
+	seller := suite.newAccount("XRP", 100)
+	buyer := suite.newAccount("USDT", 10_000)
+
+	sellerClient := dialWire(t, suite.addr, wire.Login{AccountID: seller.GetID()})
+	assert.Equal(t, seller.GetID(), sellerClient.AccountID)
+
+	require.NoError(t, sellerClient.Place(wire.PlaceOrder{ClientOrderID: 1, Instrument: "XRP/USDT", Side: wire.SideSell, Price: 100, Qty: 10}))
+
+	sellerAck := next[*wire.Accepted](t, sellerClient)
+	assert.Equal(t, uint64(1), sellerAck.ClientOrderID)
+	assert.Equal(t, wire.SideSell, sellerAck.Side)
+	assert.Equal(t, int64(10), sellerAck.Remaining)
+
+	buyerClient := dialWire(t, suite.addr, wire.Login{AccountID: buyer.GetID()})
+
+	require.NoError(t, buyerClient.Place(wire.PlaceOrder{ClientOrderID: 2, Instrument: "XRP/USDT", Side: wire.SideBuy, Price: 100, Qty: 4}))
+
+	buyerAck := next[*wire.Accepted](t, buyerClient)
+	assert.Equal(t, int64(0), buyerAck.Remaining)
+
+	buyerFill := next[*wire.Fill](t, buyerClient)
+	assert.Equal(t, buyerAck.OrderID, buyerFill.OrderID)
+	assert.Equal(t, wire.LiquidityTaker, buyerFill.Liquidity)
+	assert.Equal(t, int64(4), buyerFill.Qty)
+	assert.Equal(t, int64(100), buyerFill.Price)
+	assert.Equal(t, int64(0), buyerFill.Remaining)
+
+	sellerFill := next[*wire.Fill](t, sellerClient)
+	assert.Equal(t, uint64(1), sellerFill.ClientOrderID)
+	assert.Equal(t, sellerAck.OrderID, sellerFill.OrderID)
+	assert.Equal(t, wire.LiquidityMaker, sellerFill.Liquidity)
+	assert.Equal(t, int64(6), sellerFill.Remaining)
+	assert.Equal(t, buyerFill.TradeID, sellerFill.TradeID)
+}
+
+func (suite *WireServerE2ETestSuite) TestPlace_HaltCancelsRemaining() {
+	t := suite.T()
+
+	seller := suite.newAccount("NEAR", 2)
+	buyer := suite.newAccount("USDT", 1_000)
+
+	sellerClient := dialWire(t, suite.addr, wire.Login{AccountID: seller.GetID()})
+
+	require.NoError(t, sellerClient.Place(wire.PlaceOrder{ClientOrderID: 1, Instrument: "NEAR/USDT", Side: wire.SideSell, Price: 100, Qty: 1}))
+	require.NoError(t, sellerClient.Place(wire.PlaceOrder{ClientOrderID: 2, Instrument: "NEAR/USDT", Side: wire.SideSell, Price: 200, Qty: 1}))
+	next[*wire.Accepted](t, sellerClient)
+	next[*wire.Accepted](t, sellerClient)
+
+	b, err := repositoriesBook.NewInMemoryBookRepository().GetBook("NEAR/USDT")
+	require.NoError(t, err)
+	b.Lock()
+	b.CircuitBreaker.Configure(domainBook.CircuitBreakerProps{MoveBps: 1000, Window: time.Minute, HaltDuration: time.Minute})
+	b.Unlock()
+
+	buyerClient := dialWire(t, suite.addr, wire.Login{AccountID: buyer.GetID()})
+
+	require.NoError(t, buyerClient.Place(wire.PlaceOrder{ClientOrderID: 3, Instrument: "NEAR/USDT", Side: wire.SideBuy, Price: 200, Qty: 2}))
+
+	ack := next[*wire.Accepted](t, buyerClient)
+	assert.Equal(t, int64(0), ack.Remaining)
+
+	fill := next[*wire.Fill](t, buyerClient)
+	assert.Equal(t, int64(1), fill.Qty)
+
+	canceled := next[*wire.Canceled](t, buyerClient)
+	assert.Equal(t, ack.OrderID, canceled.OrderID)
+	assert.Equal(t, uint64(3), canceled.ClientOrderID)
+	assert.Equal(t, int64(1), canceled.CanceledQty)
+}
+
+func (suite *WireServerE2ETestSuite) TestPlace_Rejected() {
+	t := suite.T()
+
+	buyer := suite.newAccount("USDT", 100)
+
+	client := dialWire(t, suite.addr, wire.Login{AccountID: buyer.GetID()})
+
+	require.NoError(t, client.Place(wire.PlaceOrder{ClientOrderID: 1, Instrument: "ADA/USDT", Side: 9, Price: 1, Qty: 1}))
+
+	invalid := next[*wire.Rejected](t, client)
+	assert.Equal(t, uint64(1), invalid.ClientOrderID)
+	assert.Equal(t, wire.RejectInvalid, invalid.Reason)
+
+	require.NoError(t, client.Place(wire.PlaceOrder{ClientOrderID: 2, Instrument: "ADA/USDT", Side: wire.SideBuy, Price: 100, Qty: 10}))
+
+	insufficient := next[*wire.Rejected](t, client)
+	assert.Equal(t, uint64(2), insufficient.ClientOrderID)
+	assert.NotEmpty(t, insufficient.Text)
+}
+
+func (suite *WireServerE2ETestSuite) TestPlace_ClientOrderIDIsIdempotent() {
+	t := suite.T()
+
+	buyer := suite.newAccount("USDT", 1_000)
+
+	client := dialWire(t, suite.addr, wire.Login{AccountID: buyer.GetID()})
+
+	order := wire.PlaceOrder{ClientOrderID: 5, Instrument: "SOL/USDT", Side: wire.SideBuy, Price: 10, Qty: 10}
+
+	require.NoError(t, client.Send(&order))
+	require.NoError(t, client.Send(&order))
+	require.NoError(t, client.Flush())
+
+	first := next[*wire.Accepted](t, client)
+	second := next[*wire.Accepted](t, client)
+	assert.Equal(t, first.OrderID, second.OrderID)
+
+	acct, err := suite.accountRepo.Get(buyer.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, int64(900), acct.Balances["USDT"].Available)
+}
+
+func (suite *WireServerE2ETestSuite) TestCancel() {
+	t := suite.T()
+
+	buyer := suite.newAccount("USDT", 1_000)
+
+	client := dialWire(t, suite.addr, wire.Login{AccountID: buyer.GetID()})
+
+	require.NoError(t, client.Place(wire.PlaceOrder{ClientOrderID: 1, Instrument: "DOT/USDT", Side: wire.SideBuy, Price: 10, Qty: 10}))
+	require.NoError(t, client.Place(wire.PlaceOrder{ClientOrderID: 2, Instrument: "DOT/USDT", Side: wire.SideBuy, Price: 10, Qty: 5}))
+
+	ack := next[*wire.Accepted](t, client)
+	next[*wire.Accepted](t, client)
+
+	require.NoError(t, client.Cancel(wire.CancelOrder{OrderID: ack.OrderID}))
+
+	canceled := next[*wire.Canceled](t, client)
+	assert.Equal(t, ack.OrderID, canceled.OrderID)
+	assert.Equal(t, int64(10), canceled.CanceledQty)
+
+	require.NoError(t, client.Cancel(wire.CancelOrder{ClientOrderID: 2}))
+
+	byClientID := next[*wire.Canceled](t, client)
+	assert.Equal(t, uint64(2), byClientID.ClientOrderID)
+	assert.Equal(t, int64(5), byClientID.CanceledQty)
+
+	require.NoError(t, client.Place(wire.PlaceOrder{ClientOrderID: 2, Instrument: "DOT/USDT", Side: wire.SideBuy, Price: 10, Qty: 5}))
+
+	replayed := next[*wire.Accepted](t, client)
+	assert.Equal(t, byClientID.OrderID, replayed.OrderID)
+	assert.Equal(t, int64(0), replayed.Remaining)
+
+	stillCanceled := next[*wire.Canceled](t, client)
+	assert.Equal(t, byClientID.OrderID, stillCanceled.OrderID)
+	assert.Equal(t, int64(5), stillCanceled.CanceledQty)
+
+	require.NoError(t, client.Cancel(wire.CancelOrder{ClientOrderID: 2}))
+
+	tooLate := next[*wire.Rejected](t, client)
+	assert.Equal(t, uint64(2), tooLate.ClientOrderID)
+
+	require.NoError(t, client.Cancel(wire.CancelOrder{ClientOrderID: 99}))
+
+	unknown := next[*wire.Rejected](t, client)
+	assert.Equal(t, wire.RejectNotFound, unknown.Reason)
+
+	acct, err := suite.accountRepo.Get(buyer.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1_000), acct.Balances["USDT"].Available)
+}
+
+func (suite *WireServerE2ETestSuite) TestLogin_Authenticated() {
+	t := suite.T()
+
+	addr := serveWire(t, newWireServer(apikeyUsecases.NewAuthenticateUseCase(suite.apiKeyRepo, time.Minute)))
+
+	trader := suite.newAccount("USDT", 1_000)
+
+	key, err := domainAPIKey.NewAPIKey(domainAPIKey.APIKeyProps{AccountID: trader.GetID()}, idObjValue.Uuid)
+	require.NoError(t, err)
+	require.NoError(t, suite.apiKeyRepo.SaveAPIKey(key))
+
+	signedLogin := func(secret string) wire.Login {
+		now := time.Now().Unix()
+
+		return wire.Login{
+			APIKey:    key.GetID(),
+			Signature: domainAPIKey.Sign(secret, now, wire.LoginSignatureMethod, wire.LoginSignaturePath, nil),
+			Timestamp: now,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err = wire.Dial(ctx, addr, signedLogin("wrong-secret"))
+	assert.ErrorIs(t, err, wire.ErrLoginRejected)
+
+	client := dialWire(t, addr, signedLogin(key.Secret))
+	assert.Equal(t, trader.GetID(), client.AccountID)
+
+	require.NoError(t, client.Place(wire.PlaceOrder{Instrument: "BNB/USDT", Side: wire.SideBuy, Price: 10, Qty: 1}))
+
+	ack := next[*wire.Accepted](t, client)
+	assert.NotEmpty(t, ack.OrderID)
+}
+
+func TestWireServer(t *testing.T) {
+	suite.Run(t, new(WireServerE2ETestSuite))
+}
@@ -0,0 +1,488 @@
+package wire
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	apikeyUsecases "github.com/juninhoitabh/clob-go/internal/application/apikey/usecases"
+	orderUsecases "github.com/juninhoitabh/clob-go/internal/application/order/usecases"
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	"github.com/juninhoitabh/clob-go/internal/domain/book/services"
+	domainOrder "github.com/juninhoitabh/clob-go/internal/domain/order"
+	domainTrade "github.com/juninhoitabh/clob-go/internal/domain/trade"
+	"github.com/juninhoitabh/clob-go/internal/infra/fills"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+// A login authenticates like an HTTP request signed with an API key, with an
+// empty body, Login.Timestamp as timestamp and this method and path.
+const (
+	LoginSignatureMethod = "WIRE"
+	LoginSignaturePath   = "LOGIN"
+	DefaultLoginTimeout  = 10 * time.Second
+)
+
+type (
+	// Server runs the binary order-entry protocol on top of the order use
+	// cases. Each connection is a session for one account.
+	Server struct {
+		placeOrder   orderUsecases.IPlaceOrderUseCase
+		cancelOrder  orderUsecases.ICancelOrderUseCase
+		getOrder     orderUsecases.IGetOrderUseCase
+		tradeRepo    domainTrade.ITradeRepository
+		authenticate apikeyUsecases.IAuthenticateUseCase
+		logger       *log.Logger
+		fillInterval time.Duration
+		loginTimeout time.Duration
+	}
+	session struct {
+		conn            net.Conn
+		reader          *bufio.Reader
+		writer          *bufio.Writer
+		fills           *fills.Watcher
+		accountID       string
+		callerAccountID string
+		buf             []byte
+		mu              sync.Mutex
+	}
+)
+
+// Serve runs a session per connection accepted on listener until ctx is done.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	stop := context.AfterFunc(ctx, func() { _ = listener.Close() })
+	defer stop()
+
+	var sessions sync.WaitGroup
+	defer sessions.Wait()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		sessions.Add(1)
+
+		go func() {
+			defer sessions.Done()
+
+			s.run(ctx, conn)
+		}()
+	}
+}
+
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(ctx, listener)
+}
+
+func (s *Server) run(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	if tcpConn, ok := conn.(*net.TCPConn); ok {
+		_ = tcpConn.SetNoDelay(true)
+	}
+
+	sess := &session{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+		writer: bufio.NewWriter(conn),
+		fills:  fills.NewWatcher(s.tradeRepo, s.getOrder),
+	}
+
+	err := s.login(sess)
+	if err != nil {
+		s.logger.Printf("wire: login from %s rejected: %v", conn.RemoteAddr(), err)
+
+		sess.write(&LoginRejected{Text: err.Error()})
+		_ = sess.writer.Flush()
+
+		return
+	}
+
+	sess.fills.Watch(sess.accountID)
+
+	sess.write(&LoginAccepted{AccountID: sess.accountID})
+
+	err = sess.writer.Flush()
+	if err != nil {
+		return
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go s.watchFills(sess, done)
+
+	for {
+		msg, err := ReadMessage(sess.reader)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				s.logger.Printf("wire: session %s closed: %v", sess.accountID, err)
+			}
+
+			return
+		}
+
+		sess.mu.Lock()
+
+		s.handle(sess, msg)
+
+		// Responses to pipelined requests are flushed together.
+		if sess.reader.Buffered() == 0 {
+			err = sess.writer.Flush()
+		}
+
+		sess.mu.Unlock()
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) login(sess *session) error {
+	_ = sess.conn.SetReadDeadline(time.Now().Add(s.loginTimeout))
+	defer sess.conn.SetReadDeadline(time.Time{})
+
+	msg, err := ReadMessage(sess.reader)
+	if err != nil {
+		return err
+	}
+
+	login, ok := msg.(*Login)
+	if !ok {
+		return fmt.Errorf("%w: first message is not a login", shared.ErrInvalidParam)
+	}
+
+	if s.authenticate == nil {
+		if login.AccountID == "" {
+			return fmt.Errorf("%w: AccountID is required", shared.ErrInvalidParam)
+		}
+
+		sess.accountID = login.AccountID
+
+		return nil
+	}
+
+	authenticateOutput, err := s.authenticate.Execute(apikeyUsecases.AuthenticateInput{
+		APIKey:    login.APIKey,
+		Signature: login.Signature,
+		Method:    LoginSignatureMethod,
+		Path:      LoginSignaturePath,
+		Timestamp: login.Timestamp,
+	})
+	if err != nil {
+		return err
+	}
+
+	role := domainAPIKey.Role(authenticateOutput.Role)
+	if !role.Allows(domainAPIKey.RoleTrader) {
+		return fmt.Errorf("%w: role %s not allowed", shared.ErrForbidden, role)
+	}
+
+	sess.accountID = authenticateOutput.AccountID
+	sess.callerAccountID = authenticateOutput.AccountID
+
+	return nil
+}
+
+func (s *Server) handle(sess *session, msg Message) {
+	switch msg := msg.(type) {
+	case *PlaceOrder:
+		s.place(sess, msg)
+	case *CancelOrder:
+		s.cancel(sess, msg)
+	default:
+		sess.write(&Rejected{
+			Reason: RejectInvalid,
+			Text:   fmt.Sprintf("unexpected message type %q", msg.Type()),
+		})
+	}
+}
+
+func (s *Server) place(sess *session, msg *PlaceOrder) {
+	input := orderUsecases.PlaceOrderInput{
+		AccountID:       sess.accountID,
+		CallerAccountID: sess.callerAccountID,
+		Instrument:      msg.Instrument,
+		Price:           msg.Price,
+		Qty:             msg.Qty,
+	}
+
+	if msg.ClientOrderID != 0 {
+		input.ClientOrderID = strconv.FormatUint(msg.ClientOrderID, 10)
+	}
+
+	switch msg.Side {
+	case SideBuy:
+		input.Side = "buy"
+	case SideSell:
+		input.Side = "sell"
+	default:
+		sess.reject(msg.ClientOrderID, fmt.Errorf("%w: Side must be 1 (buy) or 2 (sell)", shared.ErrInvalidParam))
+
+		return
+	}
+
+	placeOrderOutput, err := s.placeOrder.Execute(input)
+	if err != nil {
+		sess.reject(msg.ClientOrderID, err)
+
+		return
+	}
+
+	order := placeOrderOutput.Order
+
+	// A replay reports the order as it stands now, canceled if it was.
+	if placeOrderOutput.Replayed {
+		sess.write(&Accepted{
+			ClientOrderID: msg.ClientOrderID,
+			OrderID:       order.GetID(),
+			Instrument:    order.Instrument,
+			Side:          wireSide(order.Side),
+			Price:         order.Price,
+			Qty:           order.Qty,
+			Remaining:     order.Remaining,
+			Timestamp:     time.Now().UnixNano(),
+		})
+
+		if order.Canceled() {
+			sess.write(&Canceled{
+				ClientOrderID: msg.ClientOrderID,
+				OrderID:       order.GetID(),
+				CanceledQty:   order.CanceledQty,
+				Timestamp:     time.Now().UnixNano(),
+			})
+		}
+
+		return
+	}
+
+	filled := int64(0)
+
+	for _, trade := range placeOrderOutput.TradeReport.Trades {
+		filled += trade.Qty
+	}
+
+	sess.write(&Accepted{
+		ClientOrderID: msg.ClientOrderID,
+		OrderID:       order.GetID(),
+		Instrument:    order.Instrument,
+		Side:          wireSide(order.Side),
+		Price:         order.Price,
+		Qty:           order.Qty,
+		Remaining:     order.Qty - filled - placeOrderOutput.CanceledQty,
+		Timestamp:     time.Now().UnixNano(),
+	})
+
+	remaining := order.Qty
+
+	for _, trade := range placeOrderOutput.TradeReport.Trades {
+		remaining -= trade.Qty
+
+		if sess.fills.Claim(trade, order.GetID()) {
+			sess.write(fillMessage(order, trade, remaining))
+		}
+	}
+
+	// A halt during the match cancels what the order had left.
+	if placeOrderOutput.CanceledQty > 0 {
+		sess.write(&Canceled{
+			ClientOrderID: msg.ClientOrderID,
+			OrderID:       order.GetID(),
+			CanceledQty:   placeOrderOutput.CanceledQty,
+			Timestamp:     time.Now().UnixNano(),
+		})
+	}
+}
+
+func (s *Server) cancel(sess *session, msg *CancelOrder) {
+	input := orderUsecases.GetOrderInput{
+		OrderID:         msg.OrderID,
+		CallerAccountID: sess.callerAccountID,
+	}
+
+	if msg.OrderID == "" && msg.ClientOrderID != 0 {
+		input.AccountID = sess.accountID
+		input.ClientOrderID = strconv.FormatUint(msg.ClientOrderID, 10)
+	}
+
+	getOrderOutput, err := s.getOrder.Execute(input)
+	if err != nil {
+		sess.reject(msg.ClientOrderID, err)
+
+		return
+	}
+
+	order := getOrderOutput.Order
+	remaining := order.Remaining
+
+	_, err = s.cancelOrder.Execute(orderUsecases.CancelOrderInput{
+		OrderID:         order.GetID(),
+		CallerAccountID: sess.callerAccountID,
+	})
+	if err != nil {
+		sess.reject(msg.ClientOrderID, err)
+
+		return
+	}
+
+	sess.write(&Canceled{
+		ClientOrderID: msg.ClientOrderID,
+		OrderID:       order.GetID(),
+		CanceledQty:   remaining,
+		Timestamp:     time.Now().UnixNano(),
+	})
+}
+
+// watchFills reports fills that other participants' orders caused on the
+// session's account every fillInterval.
+func (s *Server) watchFills(sess *session, done <-chan struct{}) {
+	if s.fillInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.fillInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			sess.mu.Lock()
+			s.reportFills(sess)
+			sess.mu.Unlock()
+		}
+	}
+}
+
+func (s *Server) reportFills(sess *session) {
+	found, err := sess.fills.Poll(sess.callerAccountID)
+	if err != nil {
+		s.logger.Printf("wire: failed to poll fills for session %s: %v", sess.accountID, err)
+	}
+
+	if len(found) == 0 {
+		return
+	}
+
+	for _, fill := range found {
+		sess.write(fillMessage(fill.Order, fill.Trade, fill.Order.Remaining))
+	}
+
+	_ = sess.writer.Flush()
+}
+
+func (sess *session) write(msg Message) {
+	var err error
+
+	sess.buf, err = AppendMessage(sess.buf[:0], msg)
+	if err != nil {
+		sess.buf, _ = AppendMessage(sess.buf[:0], &Rejected{Reason: RejectInternal, Text: err.Error()})
+	}
+
+	_, _ = sess.writer.Write(sess.buf)
+}
+
+func (sess *session) reject(clientOrderID uint64, err error) {
+	errResp := shared.ErrorResponseFor(err)
+
+	sess.write(&Rejected{
+		ClientOrderID: clientOrderID,
+		Reason:        rejectReason(errResp.Status),
+		Code:          errResp.Code,
+		Text:          errResp.Message,
+	})
+}
+
+func fillMessage(order *domainOrder.Order, trade services.Trade, remaining int64) *Fill {
+	liquidity := LiquidityMaker
+	if trade.TakerOrderID == order.GetID() {
+		liquidity = LiquidityTaker
+	}
+
+	clientOrderID, _ := strconv.ParseUint(order.ClientOrderID, 10, 64)
+
+	return &Fill{
+		ClientOrderID: clientOrderID,
+		OrderID:       order.GetID(),
+		TradeID:       trade.ID(),
+		Price:         trade.Price,
+		Qty:           trade.Qty,
+		Remaining:     remaining,
+		Timestamp:     trade.ExecutedAt.UnixNano(),
+		Liquidity:     liquidity,
+	}
+}
+
+func rejectReason(status int) byte {
+	switch status {
+	case http.StatusBadRequest:
+		return RejectInvalid
+	case http.StatusNotFound:
+		return RejectNotFound
+	case http.StatusForbidden:
+		return RejectForbidden
+	case http.StatusUnauthorized:
+		return RejectUnauthorized
+	case http.StatusUnprocessableEntity:
+		return RejectBusiness
+	case http.StatusConflict:
+		return RejectConflict
+	case http.StatusTooManyRequests:
+		return RejectRateLimited
+	default:
+		return RejectInternal
+	}
+}
+
+func wireSide(side domainOrder.Side) byte {
+	if side == domainOrder.Sell {
+		return SideSell
+	}
+
+	return SideBuy
+}
+
+func NewServer(
+	placeOrder orderUsecases.IPlaceOrderUseCase,
+	cancelOrder orderUsecases.ICancelOrderUseCase,
+	getOrder orderUsecases.IGetOrderUseCase,
+	tradeRepo domainTrade.ITradeRepository,
+	authenticate apikeyUsecases.IAuthenticateUseCase,
+	fillInterval time.Duration,
+	logger *log.Logger,
+) *Server {
+	return &Server{
+		placeOrder:   placeOrder,
+		cancelOrder:  cancelOrder,
+		getOrder:     getOrder,
+		tradeRepo:    tradeRepo,
+		authenticate: authenticate,
+		logger:       logger,
+		fillInterval: fillInterval,
+		loginTimeout: DefaultLoginTimeout,
+	}
+}
@@ -0,0 +1,31 @@
+package shared
+
+import "context"
+
+type (
+	callerKey struct{}
+	Caller    struct {
+		AccountID string
+		APIKeyID  string
+		Role      string
+	}
+)
+
+// WithCaller records the API key that signed the request.
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext returns the authenticated account, or "" when the request
+// was not authenticated.
+func CallerFromContext(ctx context.Context) string {
+	return CallerDetailsFromContext(ctx).AccountID
+}
+
+// CallerDetailsFromContext returns the authenticated key, account and role, or
+// the zero Caller when the request was not authenticated.
+func CallerDetailsFromContext(ctx context.Context) Caller {
+	caller, _ := ctx.Value(callerKey{}).(Caller)
+
+	return caller
+}
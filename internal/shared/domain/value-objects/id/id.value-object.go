@@ -11,6 +11,9 @@ const (
 	ObjectID TypeIdEnum = "ObjectID"
 	Uuid     TypeIdEnum = "Uuid"
 	Str      TypeIdEnum = "String"
+	// Seq IDs are numbers the entity gives itself, written out in decimal;
+	// NewID keeps them as given.
+	Seq TypeIdEnum = "Seq"
 )
 
 type ID struct {
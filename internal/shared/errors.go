@@ -7,4 +7,23 @@ var (
 	ErrInvalidParam  = errors.New("invalid parameter")
 	ErrAlreadyExists = errors.New("already exists")
 	ErrExternalApi   = errors.New("external API error")
+	ErrUnauthorized  = errors.New("unauthorized")
+	ErrForbidden     = errors.New("forbidden")
+	ErrRateLimited   = errors.New("rate limit exceeded")
 )
+
+type RejectError struct {
+	Code    string
+	Message string
+}
+
+func (e *RejectError) Error() string {
+	return e.Message
+}
+
+func NewRejectError(code, message string) *RejectError {
+	return &RejectError{
+		Code:    code,
+		Message: message,
+	}
+}
@@ -8,12 +8,14 @@ import (
 
 type Errors struct {
 	Message string   `json:"message" example:"Invalid parameter"`
+	Code    string   `json:"code,omitempty" example:"PRICE_OUT_OF_BAND"`
 	Details []string `json:"details,omitempty" example:"The 'name' field is required"`
 	Status  int      `json:"status" example:"400"`
 }
 
 type ErrorResponse struct {
 	Message string   `json:"message"`
+	Code    string   `json:"code,omitempty"`
 	Details []string `json:"details,omitempty"`
 	Status  int      `json:"status"`
 }
@@ -35,17 +37,50 @@ func WriteError(w http.ResponseWriter, err error, status int) {
 	WriteJSON(w, status, errResp)
 }
 
-func HandleError(w http.ResponseWriter, err error) {
+func WriteRejectError(w http.ResponseWriter, err error, rejectErr *RejectError) {
+	errResp := ErrorResponse{
+		Status:  http.StatusUnprocessableEntity,
+		Message: err.Error(),
+		Code:    rejectErr.Code,
+	}
+
+	WriteJSON(w, http.StatusUnprocessableEntity, errResp)
+}
+
+// ErrorResponseFor maps err to the status and body HandleError would write,
+// for callers that report errors inside a larger response.
+func ErrorResponseFor(err error) ErrorResponse {
+	var rejectErr *RejectError
+
+	errResp := ErrorResponse{Message: err.Error()}
+
 	switch {
+	case errors.As(err, &rejectErr):
+		errResp.Status = http.StatusUnprocessableEntity
+		errResp.Code = rejectErr.Code
 	case errors.Is(err, ErrNotFound):
-		WriteError(w, err, http.StatusNotFound)
+		errResp.Status = http.StatusNotFound
 	case errors.Is(err, ErrAlreadyExists):
-		WriteError(w, err, http.StatusConflict)
+		errResp.Status = http.StatusConflict
 	case errors.Is(err, ErrInvalidParam):
-		WriteError(w, err, http.StatusBadRequest)
+		errResp.Status = http.StatusBadRequest
+	case errors.Is(err, ErrUnauthorized):
+		errResp.Status = http.StatusUnauthorized
+	case errors.Is(err, ErrForbidden):
+		errResp.Status = http.StatusForbidden
+	case errors.Is(err, ErrRateLimited):
+		errResp.Status = http.StatusTooManyRequests
 	default:
-		WriteError(w, err, http.StatusInternalServerError)
+		errResp.Status = http.StatusInternalServerError
 	}
+
+	return errResp
+}
+
+func HandleError(w http.ResponseWriter, err error) {
+	errResp := ErrorResponseFor(err)
+
+	WriteJSON(w, errResp.Status, errResp)
 }
 
 func BadRequestError(w http.ResponseWriter, message string, details ...string) {
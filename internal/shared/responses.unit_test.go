@@ -1,8 +1,10 @@
 package shared_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -116,6 +118,26 @@ func TestHandleError(t *testing.T) {
 			err:            shared.ErrInvalidParam,
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name:           "ErrUnauthorized",
+			err:            shared.ErrUnauthorized,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "ErrForbidden",
+			err:            fmt.Errorf("%w: order belongs to another account", shared.ErrForbidden),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "ErrRateLimited",
+			err:            fmt.Errorf("%w: orders budget exhausted", shared.ErrRateLimited),
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			name:           "RejectError",
+			err:            fmt.Errorf("wrapped: %w", shared.NewRejectError("TEST_REJECT", "rejected")),
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
 		{
 			name:           "default error",
 			err:            errors.New("unknown error"),
@@ -138,6 +160,14 @@ func TestHandleError(t *testing.T) {
 	}
 }
 
+func TestErrorResponseFor_RejectError(t *testing.T) {
+	errResp := shared.ErrorResponseFor(fmt.Errorf("wrapped: %w", shared.NewRejectError("TEST_REJECT", "rejected")))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, errResp.Status)
+	assert.Equal(t, "TEST_REJECT", errResp.Code)
+	assert.Equal(t, "wrapped: rejected", errResp.Message)
+}
+
 func TestBadRequestError(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -183,3 +213,27 @@ func TestBadRequestError(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleError_RejectErrorCode(t *testing.T) {
+	w := httptest.NewRecorder()
+	shared.HandleError(w, shared.NewRejectError("TEST_REJECT", "rejected"))
+
+	var response shared.ErrorResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Status)
+	assert.Equal(t, "TEST_REJECT", response.Code)
+	assert.Equal(t, "rejected", response.Message)
+}
+
+func TestCallerContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, shared.CallerFromContext(ctx))
+
+	assert.Equal(t, shared.Caller{}, shared.CallerDetailsFromContext(ctx))
+
+	caller := shared.Caller{AccountID: "acc-1", APIKeyID: "key-1", Role: "trader"}
+	ctx = shared.WithCaller(ctx, caller)
+	assert.Equal(t, "acc-1", shared.CallerFromContext(ctx))
+	assert.Equal(t, caller, shared.CallerDetailsFromContext(ctx))
+}
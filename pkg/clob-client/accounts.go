@@ -0,0 +1,83 @@
+package clobClient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type createAccountInput struct {
+	AccountName string `json:"account_name"`
+}
+
+type creditInput struct {
+	Asset  string `json:"asset"`
+	Amount int64  `json:"amount"`
+}
+
+// CreateAccount is not retried: the API answers a repeated name without the
+// API key, so a lost response cannot be recovered by asking again.
+func (c *Client) CreateAccount(ctx context.Context, name string) (*CreatedAccount, error) {
+	var output struct {
+		CreatedAccount
+		Status string `json:"status"`
+	}
+
+	_, err := c.do(ctx, http.MethodPost, "/accounts", createAccountInput{AccountName: name}, false, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Status == "exists" {
+		return nil, fmt.Errorf("%w: account %q", ErrAlreadyExists, name)
+	}
+
+	return &output.CreatedAccount, nil
+}
+
+func (c *Client) CreateSubAccount(ctx context.Context, parentAccountID, name string) (*SubAccount, error) {
+	var output SubAccount
+
+	_, err := c.do(ctx, http.MethodPost, "/accounts/"+url.PathEscape(parentAccountID)+"/sub-accounts", createAccountInput{AccountName: name}, false, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func (c *Client) GetAccount(ctx context.Context, accountID string) (*Account, error) {
+	var output Account
+
+	_, err := c.do(ctx, http.MethodGet, "/accounts/"+url.PathEscape(accountID), nil, true, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func (c *Client) GetAggregatedBalances(ctx context.Context, accountID string) (*AggregatedAccount, error) {
+	var output AggregatedAccount
+
+	_, err := c.do(ctx, http.MethodGet, "/accounts/"+url.PathEscape(accountID)+"/aggregated-balances", nil, true, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// Credit needs an admin key. It is not retried, as a repeated credit adds
+// the amount twice.
+func (c *Client) Credit(ctx context.Context, accountID, asset string, amount int64) (*Account, error) {
+	var output Account
+
+	_, err := c.do(ctx, http.MethodPost, "/admin/accounts/"+url.PathEscape(accountID)+"/credit", creditInput{Asset: asset, Amount: amount}, false, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,55 @@
+package clobClient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type listTradesOutput struct {
+	Trades []Trade `json:"trades"`
+}
+
+func (c *Client) GetBook(ctx context.Context, instrument string) (*Book, error) {
+	var output Book
+
+	_, err := c.do(ctx, http.MethodGet, "/books?"+url.Values{"instrument": {instrument}}.Encode(), nil, true, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// ListTrades returns the most recent trades first. Without an AccountID it
+// is the public tape; with one it needs the account's key.
+func (c *Client) ListTrades(ctx context.Context, input ListTradesInput) ([]Trade, error) {
+	query := url.Values{}
+
+	if input.AccountID != "" {
+		query.Set("account_id", input.AccountID)
+	}
+
+	if input.Instrument != "" {
+		query.Set("instrument", input.Instrument)
+	}
+
+	if input.Limit > 0 {
+		query.Set("limit", strconv.Itoa(input.Limit))
+	}
+
+	path := "/trades"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var output listTradesOutput
+
+	_, err := c.do(ctx, http.MethodGet, path, nil, true, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Trades, nil
+}
@@ -0,0 +1,279 @@
+//go:build all || e2e
+
+package clobClient_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/juninhoitabh/clob-go/internal/infra/config"
+	"github.com/juninhoitabh/clob-go/internal/infra/http-server/router"
+	"github.com/juninhoitabh/clob-go/internal/infra/http-server/router/routes"
+	repositoriesAccount "github.com/juninhoitabh/clob-go/internal/infra/repositories/account"
+	repositoriesAPIKey "github.com/juninhoitabh/clob-go/internal/infra/repositories/apikey"
+	repositoriesBook "github.com/juninhoitabh/clob-go/internal/infra/repositories/book"
+	repositoriesOrder "github.com/juninhoitabh/clob-go/internal/infra/repositories/order"
+	repositoriesTrade "github.com/juninhoitabh/clob-go/internal/infra/repositories/trade"
+	clobClient "github.com/juninhoitabh/clob-go/pkg/clob-client"
+)
+
+const (
+	adminAPIKeyTest    = "sdk-admin-key"
+	adminAPISecretTest = "sdk-admin-secret"
+)
+
+var accountCounter atomic.Int64
+
+type ClientE2ETestSuite struct {
+	suite.Suite
+	server *httptest.Server
+	admin  *clobClient.Client
+}
+
+func (suite *ClientE2ETestSuite) SetupSuite() {
+	t := suite.T()
+
+	t.Setenv("AUTH_ENABLED", "true")
+	t.Setenv("RATE_LIMIT_ENABLED", "false")
+	t.Setenv("ADMIN_API_KEY", adminAPIKeyTest)
+	t.Setenv("ADMIN_API_SECRET", adminAPISecretTest)
+
+	log.SetOutput(io.Discard)
+
+	repositoriesAccount.ResetInMemoryAccountRepository()
+	repositoriesAPIKey.ResetInMemoryAPIKeyRepository()
+	repositoriesBook.ResetInMemoryBookRepository()
+	repositoriesOrder.ResetInMemoryOrderRepository()
+	repositoriesTrade.ResetInMemoryTradeRepository()
+
+	config.Init()
+
+	suite.server = httptest.NewServer(router.Generate(config.EnvConfigInstance.ApiPort, routes.NewPlaceOrderUseCase()))
+	suite.admin = suite.newClient(clobClient.WithCredentials(adminAPIKeyTest, adminAPISecretTest))
+}
+
+func (suite *ClientE2ETestSuite) TearDownSuite() {
+	suite.server.Close()
+	log.SetOutput(os.Stderr)
+}
+
+func (suite *ClientE2ETestSuite) newClient(opts ...clobClient.Option) *clobClient.Client {
+	client, err := clobClient.NewClient(suite.server.URL, opts...)
+	require.NoError(suite.T(), err)
+
+	return client
+}
+
+// trader creates a funded account and returns a client signing as it.
+func (suite *ClientE2ETestSuite) trader(asset string, amount int64) (*clobClient.Client, string) {
+	t := suite.T()
+
+	created, err := suite.newClient().CreateAccount(t.Context(), fmt.Sprintf("sdk-trader-%d", accountCounter.Add(1)))
+	require.NoError(t, err)
+
+	_, err = suite.admin.Credit(t.Context(), created.AccountID, asset, amount)
+	require.NoError(t, err)
+
+	return suite.newClient(clobClient.WithCredentials(created.APIKey, created.APISecret)), created.AccountID
+}
+
+func (suite *ClientE2ETestSuite) TestCreateAccount_DuplicateName() {
+	t := suite.T()
+	client := suite.newClient()
+
+	created, err := client.CreateAccount(t.Context(), "sdk-duplicate")
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.AccountID)
+	assert.NotEmpty(t, created.APIKey)
+	assert.NotEmpty(t, created.APISecret)
+
+	_, err = client.CreateAccount(t.Context(), "sdk-duplicate")
+	assert.ErrorIs(t, err, clobClient.ErrAlreadyExists)
+}
+
+func (suite *ClientE2ETestSuite) TestAccount_CreditAndBalances() {
+	t := suite.T()
+	client, accountID := suite.trader("USDT", 1_000)
+
+	credited, err := suite.admin.Credit(t.Context(), accountID, "BTC", 5)
+	require.NoError(t, err)
+	assert.Equal(t, clobClient.Balance{Available: 5}, credited.Balances["BTC"])
+
+	sub, err := client.CreateSubAccount(t.Context(), accountID, fmt.Sprintf("sdk-sub-%d", accountCounter.Add(1)))
+	require.NoError(t, err)
+	assert.Equal(t, accountID, sub.ParentAccountID)
+
+	acct, err := client.GetAccount(t.Context(), accountID)
+	require.NoError(t, err)
+	assert.Equal(t, clobClient.Balance{Available: 1_000}, acct.Balances["USDT"])
+	assert.Equal(t, []string{sub.AccountID}, acct.SubAccountIDs)
+
+	aggregated, err := client.GetAggregatedBalances(t.Context(), accountID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1_000), aggregated.Balances["USDT"].Available)
+	require.Len(t, aggregated.SubAccounts, 1)
+}
+
+func (suite *ClientE2ETestSuite) TestCredit_RequiresAdmin() {
+	t := suite.T()
+	client, accountID := suite.trader("USDT", 1)
+
+	_, err := client.Credit(t.Context(), accountID, "USDT", 1)
+
+	var apiErr *clobClient.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 403, apiErr.StatusCode)
+	assert.ErrorIs(t, err, clobClient.ErrForbidden)
+}
+
+func (suite *ClientE2ETestSuite) TestUnsigned_Unauthorized() {
+	t := suite.T()
+	_, accountID := suite.trader("USDT", 1)
+
+	_, err := suite.newClient().GetAccount(t.Context(), accountID)
+	assert.ErrorIs(t, err, clobClient.ErrUnauthorized)
+}
+
+func (suite *ClientE2ETestSuite) TestPlaceOrder_MatchesAndReportsTrades() {
+	t := suite.T()
+	buyer, buyerID := suite.trader("USDT", 10_000)
+	seller, sellerID := suite.trader("SDKA", 10)
+
+	resting, err := seller.PlaceOrder(t.Context(), clobClient.PlaceOrderInput{
+		AccountID: sellerID, Instrument: "SDKA/USDT", Side: "sell", Price: 100, Qty: 3,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resting.Order.ClientOrderID)
+	assert.Empty(t, resting.Trades)
+	assert.False(t, resting.Replayed)
+
+	taker, err := buyer.PlaceOrder(t.Context(), clobClient.PlaceOrderInput{
+		AccountID: buyerID, ClientOrderID: "sdk-taker", Instrument: "SDKA/USDT", Side: "buy", Price: 100, Qty: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, taker.Trades, 1)
+	assert.Equal(t, resting.Order.ID, taker.Trades[0].MakerOrderID)
+	assert.Equal(t, int64(2), taker.Trades[0].Qty)
+	assert.Equal(t, int64(0), taker.Order.Remaining)
+
+	replay, err := buyer.PlaceOrder(t.Context(), clobClient.PlaceOrderInput{
+		AccountID: buyerID, ClientOrderID: "sdk-taker", Instrument: "SDKA/USDT", Side: "buy", Price: 100, Qty: 2,
+	})
+	require.NoError(t, err)
+	assert.True(t, replay.Replayed)
+	assert.Equal(t, taker.Order.ID, replay.Order.ID)
+
+	book, err := buyer.GetBook(t.Context(), "SDKA/USDT")
+	require.NoError(t, err)
+	assert.Equal(t, []clobClient.Level{{Price: 100, Qty: 1}}, book.Asks)
+	assert.Empty(t, book.Bids)
+	assert.Equal(t, int64(100), book.LastPrice)
+
+	_, err = buyer.PlaceOrder(t.Context(), clobClient.PlaceOrderInput{
+		AccountID: buyerID, Instrument: "SDKA/USDT", Side: "buy", Price: 200, Qty: 1,
+	})
+
+	var rejectErr *clobClient.RejectError
+	require.ErrorAs(t, err, &rejectErr)
+	assert.Equal(t, "PRICE_OUT_OF_BAND", rejectErr.Code)
+
+	var apiErr *clobClient.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 422, apiErr.StatusCode)
+
+	trades, err := buyer.ListTrades(t.Context(), clobClient.ListTradesInput{AccountID: buyerID})
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+	assert.Equal(t, "SDKA/USDT", trades[0].Instrument)
+	assert.False(t, trades[0].ExecutedAt.IsZero())
+}
+
+func (suite *ClientE2ETestSuite) TestGetAndCancelOrder() {
+	t := suite.T()
+	client, accountID := suite.trader("USDT", 10_000)
+
+	placed, err := client.PlaceOrder(t.Context(), clobClient.PlaceOrderInput{
+		AccountID: accountID, ClientOrderID: "sdk/cancel 1", Instrument: "SDKC/USDT", Side: "buy", Price: 10, Qty: 5,
+	})
+	require.NoError(t, err)
+
+	got, err := client.GetOrder(t.Context(), placed.Order.ID)
+	require.NoError(t, err)
+	assert.Equal(t, placed.Order, *got)
+
+	got, err = client.GetOrderByClientOrderID(t.Context(), accountID, "sdk/cancel 1")
+	require.NoError(t, err)
+	assert.Equal(t, placed.Order.ID, got.ID)
+
+	canceled, err := client.CancelOrderByClientOrderID(t.Context(), accountID, "sdk/cancel 1")
+	require.NoError(t, err)
+	assert.Equal(t, placed.Order.ID, canceled.ID)
+
+	_, err = client.CancelOrder(t.Context(), placed.Order.ID)
+
+	var rejectErr *clobClient.RejectError
+	require.ErrorAs(t, err, &rejectErr)
+	assert.Equal(t, "ORDER_NOT_OPEN", rejectErr.Code)
+
+	_, err = client.GetOrder(t.Context(), "missing")
+	assert.ErrorIs(t, err, clobClient.ErrNotFound)
+}
+
+func (suite *ClientE2ETestSuite) TestBatchPlaceAndCancel() {
+	t := suite.T()
+	client, accountID := suite.trader("USDT", 1_000)
+
+	placed, err := client.PlaceOrders(t.Context(), []clobClient.PlaceOrderInput{
+		{AccountID: accountID, Instrument: "SDKD/USDT", Side: "buy", Price: 10, Qty: 1},
+		{AccountID: accountID, Instrument: "SDKD/USDT", Side: "buy", Price: 10, Qty: 1_000},
+		{AccountID: accountID, Instrument: "SDKD/USDT", Side: "buy", Price: 9, Qty: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, placed, 3)
+	require.NoError(t, placed[0].Err)
+	require.NoError(t, placed[2].Err)
+
+	var apiErr *clobClient.APIError
+	require.ErrorAs(t, placed[1].Err, &apiErr)
+	assert.Equal(t, "insufficient balance", apiErr.Message)
+
+	canceled, err := client.CancelOrders(t.Context(), []clobClient.CancelOrderInput{
+		{OrderID: placed[0].Output.Order.ID},
+		{OrderID: "missing"},
+	})
+	require.NoError(t, err)
+	require.Len(t, canceled, 2)
+	require.NoError(t, canceled[0].Err)
+	assert.Equal(t, placed[0].Output.Order.ID, canceled[0].Order.ID)
+	assert.ErrorIs(t, canceled[1].Err, clobClient.ErrNotFound)
+
+	all, err := client.CancelAll(t.Context(), accountID, "SDKD/USDT", "buy")
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, placed[2].Output.Order.ID, all[0].ID)
+
+	acct, err := client.GetAccount(t.Context(), accountID)
+	require.NoError(t, err)
+	assert.Equal(t, clobClient.Balance{Available: 1_000}, acct.Balances["USDT"])
+}
+
+func (suite *ClientE2ETestSuite) TestGetBook_NotFound() {
+	t := suite.T()
+
+	_, err := suite.newClient().GetBook(t.Context(), "NONE/USDT")
+	assert.True(t, errors.Is(err, clobClient.ErrNotFound))
+}
+
+func TestClient(t *testing.T) {
+	suite.Run(t, new(ClientE2ETestSuite))
+}
@@ -0,0 +1,209 @@
+package clobClient
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	httpClient "github.com/juninhoitabh/clob-go/internal/infra/http-client"
+)
+
+const (
+	DefaultTimeout     = 10 * time.Second
+	DefaultMaxAttempts = 3
+	DefaultBackoff     = 100 * time.Millisecond
+
+	apiV1Prefix = "/api/v1"
+)
+
+// Client calls the CLOB REST API. Requests are signed when credentials are
+// set; calls the API can safely repeat are retried on transport errors, 429
+// and 5xx responses.
+type Client struct {
+	http        httpClient.HttpClient
+	baseURL     string
+	basePath    string
+	apiKey      string
+	apiSecret   string
+	maxAttempts int
+	backoff     time.Duration
+	now         func() time.Time
+}
+
+type Option func(*Client)
+
+func WithHttpClient(client httpClient.HttpClient) Option {
+	return func(c *Client) {
+		c.http = client
+	}
+}
+
+// WithCredentials signs every non-public request with an API key issued by
+// POST /accounts or POST /accounts/{id}/api-keys.
+func WithCredentials(apiKey, apiSecret string) Option {
+	return func(c *Client) {
+		c.apiKey = apiKey
+		c.apiSecret = apiSecret
+	}
+}
+
+// WithRetry sets how many times an idempotent call is attempted and the
+// backoff before the second attempt, doubled after each further one. A 429
+// waits for its Retry-After instead when that is longer.
+func WithRetry(maxAttempts int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.maxAttempts = max(maxAttempts, 1)
+		c.backoff = backoff
+	}
+}
+
+// NewClient returns a client for the API served at baseURL, such as
+// http://localhost:3000.
+func NewClient(baseURL string, opts ...Option) (*Client, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("%w: base url %q must be absolute", ErrInvalidParam, baseURL)
+	}
+
+	basePath := strings.TrimSuffix(parsed.Path, "/") + apiV1Prefix
+
+	client := &Client{
+		http:        httpClient.NewDefaultHttpClient(DefaultTimeout),
+		baseURL:     parsed.Scheme + "://" + parsed.Host + basePath,
+		basePath:    basePath,
+		maxAttempts: DefaultMaxAttempts,
+		backoff:     DefaultBackoff,
+		now:         time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client, nil
+}
+
+// do sends one call and decodes a 2xx body into out, returning the status
+// code. Only idempotent calls are attempted more than once.
+func (c *Client) do(ctx context.Context, method, path string, body any, idempotent bool, out any) (int, error) {
+	var payload []byte
+
+	if body != nil {
+		var err error
+
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	attempts := 1
+	if idempotent {
+		attempts = c.maxAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, method, path, payload)
+		if err == nil {
+			if resp.StatusCode < http.StatusBadRequest {
+				if out != nil && len(resp.Body) > 0 {
+					err = json.Unmarshal(resp.Body, out)
+				}
+
+				return resp.StatusCode, err
+			}
+
+			err = newAPIError(resp)
+		}
+
+		wait, retry := c.retryAfter(ctx, err, attempt)
+		if !retry || attempt >= attempts {
+			return 0, err
+		}
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return 0, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *Client) retryAfter(ctx context.Context, err error, attempt int) (time.Duration, bool) {
+	if ctx.Err() != nil {
+		return 0, false
+	}
+
+	wait := c.backoff << (attempt - 1)
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if !apiErr.temporary() {
+			return 0, false
+		}
+
+		wait = max(wait, apiErr.RetryAfter)
+	}
+
+	return wait, true
+}
+
+func (c *Client) send(ctx context.Context, method, path string, payload []byte) (*httpClient.HttpResponse, error) {
+	headers := map[string]string{}
+
+	if c.apiKey != "" {
+		timestamp := c.now().Unix()
+
+		headers[domainAPIKey.HeaderKey] = c.apiKey
+		headers[domainAPIKey.HeaderTimestamp] = strconv.FormatInt(timestamp, 10)
+		headers[domainAPIKey.HeaderSignature] = domainAPIKey.Sign(c.apiSecret, timestamp, method, c.basePath+path, payload)
+	}
+
+	// The HttpClient marshals the body again; a json.RawMessage comes out
+	// byte for byte, so the signature covers what is sent.
+	var body any
+	if payload != nil {
+		body = json.RawMessage(payload)
+	}
+
+	target := c.baseURL + path
+
+	switch method {
+	case http.MethodGet:
+		return c.http.Get(ctx, target, headers)
+	case http.MethodPost:
+		return c.http.Post(ctx, target, body, headers)
+	case http.MethodPut:
+		return c.http.Put(ctx, target, body, headers)
+	case http.MethodDelete:
+		return c.http.Delete(ctx, target, headers)
+	default:
+		return nil, fmt.Errorf("%w: method %s", ErrInvalidParam, method)
+	}
+}
+
+// NewClientOrderID returns a random client order id, which makes a place
+// safe to retry: the API answers a repeated id with the original order.
+func NewClientOrderID() string {
+	buf := make([]byte, 16)
+	_, _ = rand.Read(buf)
+
+	return hex.EncodeToString(buf)
+}
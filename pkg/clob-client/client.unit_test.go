@@ -0,0 +1,162 @@
+//go:build all || unit
+
+package clobClient_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainAPIKey "github.com/juninhoitabh/clob-go/internal/domain/apikey"
+	httpClient "github.com/juninhoitabh/clob-go/internal/infra/http-client"
+	clobClient "github.com/juninhoitabh/clob-go/pkg/clob-client"
+)
+
+type (
+	recordedRequest struct {
+		headers map[string]string
+		body    []byte
+		method  string
+		url     string
+	}
+	fakeResponse struct {
+		err    error
+		body   string
+		status int
+	}
+	fakeHttpClient struct {
+		responses []fakeResponse
+		requests  []recordedRequest
+	}
+)
+
+func (f *fakeHttpClient) Get(ctx context.Context, url string, headers map[string]string) (*httpClient.HttpResponse, error) {
+	return f.do(http.MethodGet, url, nil, headers)
+}
+
+func (f *fakeHttpClient) Post(ctx context.Context, url string, body interface{}, headers map[string]string) (*httpClient.HttpResponse, error) {
+	return f.do(http.MethodPost, url, body, headers)
+}
+
+func (f *fakeHttpClient) Put(ctx context.Context, url string, body interface{}, headers map[string]string) (*httpClient.HttpResponse, error) {
+	return f.do(http.MethodPut, url, body, headers)
+}
+
+func (f *fakeHttpClient) Delete(ctx context.Context, url string, headers map[string]string) (*httpClient.HttpResponse, error) {
+	return f.do(http.MethodDelete, url, nil, headers)
+}
+
+func (f *fakeHttpClient) do(method, url string, body interface{}, headers map[string]string) (*httpClient.HttpResponse, error) {
+	var payload []byte
+
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+
+	f.requests = append(f.requests, recordedRequest{method: method, url: url, body: payload, headers: headers})
+
+	resp := f.responses[0]
+	if len(f.responses) > 1 {
+		f.responses = f.responses[1:]
+	}
+
+	if resp.err != nil {
+		return nil, resp.err
+	}
+
+	return &httpClient.HttpResponse{StatusCode: resp.status, Body: []byte(resp.body), Headers: http.Header{}}, nil
+}
+
+func newFakeClient(t *testing.T, responses ...fakeResponse) (*clobClient.Client, *fakeHttpClient) {
+	fake := &fakeHttpClient{responses: responses}
+
+	client, err := clobClient.NewClient(
+		"http://clob.test/base/",
+		clobClient.WithHttpClient(fake),
+		clobClient.WithCredentials("key", "secret"),
+		clobClient.WithRetry(3, time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	return client, fake
+}
+
+func TestNewClient_RelativeURL(t *testing.T) {
+	_, err := clobClient.NewClient("localhost:3000")
+	assert.ErrorIs(t, err, clobClient.ErrInvalidParam)
+}
+
+func TestPlaceOrder_RetriesWithSameClientOrderID(t *testing.T) {
+	client, fake := newFakeClient(t,
+		fakeResponse{err: errors.New("connection reset")},
+		fakeResponse{status: http.StatusServiceUnavailable, body: `{"message":"busy","status":503}`},
+		fakeResponse{status: http.StatusOK, body: `{"order":{"id":"o1","client_order_id":"generated"},"report":{"trades":[]}}`},
+	)
+
+	output, err := client.PlaceOrder(t.Context(), clobClient.PlaceOrderInput{AccountID: "a1", Instrument: "BTC/USDT", Side: "buy", Price: 1, Qty: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "o1", output.Order.ID)
+	assert.True(t, output.Replayed)
+
+	require.Len(t, fake.requests, 3)
+
+	var first clobClient.PlaceOrderInput
+	require.NoError(t, json.Unmarshal(fake.requests[0].body, &first))
+	assert.NotEmpty(t, first.ClientOrderID)
+
+	for _, req := range fake.requests {
+		assert.Equal(t, "http://clob.test/base/api/v1/orders", req.url)
+		assert.Equal(t, fake.requests[0].body, req.body)
+		assert.Equal(t, "key", req.headers[domainAPIKey.HeaderKey])
+
+		timestamp, err := strconv.ParseInt(req.headers[domainAPIKey.HeaderTimestamp], 10, 64)
+		require.NoError(t, err)
+		assert.Equal(t, domainAPIKey.Sign("secret", timestamp, http.MethodPost, "/base/api/v1/orders", req.body), req.headers[domainAPIKey.HeaderSignature])
+	}
+}
+
+func TestGetOrder_GivesUpAfterMaxAttempts(t *testing.T) {
+	client, fake := newFakeClient(t, fakeResponse{status: http.StatusTooManyRequests, body: `{"message":"orders budget exhausted","status":429}`})
+
+	_, err := client.GetOrder(t.Context(), "o1")
+	assert.ErrorIs(t, err, clobClient.ErrRateLimited)
+	assert.Len(t, fake.requests, 3)
+}
+
+func TestGetOrder_ClientErrorNotRetried(t *testing.T) {
+	client, fake := newFakeClient(t, fakeResponse{status: http.StatusNotFound, body: `{"message":"not found","status":404}`})
+
+	_, err := client.GetOrder(t.Context(), "o1")
+	assert.ErrorIs(t, err, clobClient.ErrNotFound)
+	assert.Len(t, fake.requests, 1)
+}
+
+func TestCredit_NotRetried(t *testing.T) {
+	client, fake := newFakeClient(t, fakeResponse{status: http.StatusInternalServerError, body: "boom\n"})
+
+	_, err := client.Credit(t.Context(), "a1", "USDT", 10)
+
+	var apiErr *clobClient.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "boom", apiErr.Message)
+	assert.ErrorIs(t, err, clobClient.ErrExternalApi)
+	assert.Len(t, fake.requests, 1)
+}
+
+func TestCancelOrder_RejectCode(t *testing.T) {
+	client, _ := newFakeClient(t, fakeResponse{status: http.StatusUnprocessableEntity, body: `{"message":"order is not open","code":"ORDER_NOT_OPEN","status":422}`})
+
+	_, err := client.CancelOrder(t.Context(), "o1")
+
+	var rejectErr *clobClient.RejectError
+	require.ErrorAs(t, err, &rejectErr)
+	assert.Equal(t, "ORDER_NOT_OPEN", rejectErr.Code)
+	assert.EqualError(t, err, "clob: 422 ORDER_NOT_OPEN: order is not open")
+}
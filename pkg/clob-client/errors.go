@@ -0,0 +1,112 @@
+package clobClient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	httpClient "github.com/juninhoitabh/clob-go/internal/infra/http-client"
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+// The sentinels an APIError unwraps to, re-exported so callers outside the
+// module can match them with errors.Is.
+var (
+	ErrNotFound      = shared.ErrNotFound
+	ErrInvalidParam  = shared.ErrInvalidParam
+	ErrAlreadyExists = shared.ErrAlreadyExists
+	ErrExternalApi   = shared.ErrExternalApi
+	ErrUnauthorized  = shared.ErrUnauthorized
+	ErrForbidden     = shared.ErrForbidden
+	ErrRateLimited   = shared.ErrRateLimited
+)
+
+// RejectError is what a 422 unwraps to; Code is the engine's reject code,
+// such as INSUFFICIENT_FUNDS or PRICE_OUT_OF_BAND.
+type RejectError = shared.RejectError
+
+// APIError is a non-2xx response, decoded from the API's shared.ErrorResponse
+// body when it has one.
+type APIError struct {
+	Message    string
+	Code       string
+	Details    []string
+	StatusCode int
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("clob: %d %s: %s", e.StatusCode, e.Code, e.Message)
+	}
+
+	return fmt.Sprintf("clob: %d: %s", e.StatusCode, e.Message)
+}
+
+func (e *APIError) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusUnprocessableEntity:
+		return shared.NewRejectError(e.Code, e.Message)
+	case e.StatusCode == http.StatusNotFound:
+		return shared.ErrNotFound
+	case e.StatusCode == http.StatusConflict:
+		return shared.ErrAlreadyExists
+	case e.StatusCode == http.StatusBadRequest:
+		return shared.ErrInvalidParam
+	case e.StatusCode == http.StatusUnauthorized:
+		return shared.ErrUnauthorized
+	case e.StatusCode == http.StatusForbidden:
+		return shared.ErrForbidden
+	case e.StatusCode == http.StatusTooManyRequests:
+		return shared.ErrRateLimited
+	case e.StatusCode >= http.StatusInternalServerError:
+		return shared.ErrExternalApi
+	default:
+		return nil
+	}
+}
+
+func (e *APIError) temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// newAPIError decodes resp's body, falling back to its raw text for the
+// handlers that answer with http.Error.
+func newAPIError(resp *httpClient.HttpResponse) *APIError {
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+
+	var errResp shared.ErrorResponse
+	if json.Unmarshal(resp.Body, &errResp) == nil && errResp.Message != "" {
+		apiErr.Message = errResp.Message
+		apiErr.Code = errResp.Code
+		apiErr.Details = errResp.Details
+	} else {
+		apiErr.Message = strings.TrimSpace(string(resp.Body))
+	}
+
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+
+	if seconds, err := strconv.Atoi(resp.Headers.Get("Retry-After")); err == nil && seconds > 0 {
+		apiErr.RetryAfter = time.Duration(seconds) * time.Second
+	}
+
+	return apiErr
+}
+
+func errorFromResponse(errResp *shared.ErrorResponse) error {
+	if errResp == nil {
+		return nil
+	}
+
+	return &APIError{
+		Message:    errResp.Message,
+		Code:       errResp.Code,
+		Details:    errResp.Details,
+		StatusCode: errResp.Status,
+	}
+}
@@ -0,0 +1,192 @@
+package clobClient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/juninhoitabh/clob-go/internal/shared"
+)
+
+type placeOrderOutput struct {
+	Order  Order `json:"order"`
+	Report struct {
+		Trades []Trade `json:"trades"`
+	} `json:"report"`
+}
+
+type orderOutput struct {
+	Order Order `json:"order"`
+}
+
+type batchPlaceInput struct {
+	Orders []PlaceOrderInput `json:"orders"`
+}
+
+type batchPlaceOutput struct {
+	Results []struct {
+		Result *placeOrderOutput     `json:"result"`
+		Error  *shared.ErrorResponse `json:"error"`
+		Status int                   `json:"status"`
+	} `json:"results"`
+}
+
+type batchCancelInput struct {
+	Orders []CancelOrderInput `json:"orders"`
+}
+
+type batchCancelOutput struct {
+	Results []struct {
+		Result *orderOutput          `json:"result"`
+		Error  *shared.ErrorResponse `json:"error"`
+	} `json:"results"`
+}
+
+type massCancelOutput struct {
+	Orders []Order `json:"orders"`
+}
+
+// PlaceOrder fills in a client order id when input has none, so the place
+// can be retried without risking a second order.
+func (c *Client) PlaceOrder(ctx context.Context, input PlaceOrderInput) (*PlaceOrderOutput, error) {
+	if input.ClientOrderID == "" {
+		input.ClientOrderID = NewClientOrderID()
+	}
+
+	var output placeOrderOutput
+
+	status, err := c.do(ctx, http.MethodPost, "/orders", input, true, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPlaceOrderOutput(&output, status), nil
+}
+
+// PlaceOrders places a batch, returning one result per input in order.
+// Each order gets a client order id when it has none, as in PlaceOrder.
+func (c *Client) PlaceOrders(ctx context.Context, inputs []PlaceOrderInput) ([]PlaceOrderResult, error) {
+	body := batchPlaceInput{Orders: make([]PlaceOrderInput, len(inputs))}
+
+	for i, input := range inputs {
+		if input.ClientOrderID == "" {
+			input.ClientOrderID = NewClientOrderID()
+		}
+
+		body.Orders[i] = input
+	}
+
+	var output batchPlaceOutput
+
+	_, err := c.do(ctx, http.MethodPost, "/orders/batch", body, true, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]PlaceOrderResult, len(output.Results))
+
+	for i, result := range output.Results {
+		if result.Error != nil {
+			results[i].Err = errorFromResponse(result.Error)
+
+			continue
+		}
+
+		results[i].Output = newPlaceOrderOutput(result.Result, result.Status)
+	}
+
+	return results, nil
+}
+
+func (c *Client) GetOrder(ctx context.Context, orderID string) (*Order, error) {
+	return c.order(ctx, http.MethodGet, "/orders/"+url.PathEscape(orderID))
+}
+
+func (c *Client) GetOrderByClientOrderID(ctx context.Context, accountID, clientOrderID string) (*Order, error) {
+	return c.order(ctx, http.MethodGet, clientOrderPath(accountID, clientOrderID))
+}
+
+// CancelOrder is retried like a read: if an earlier attempt went through,
+// the retry fails with an ORDER_NOT_OPEN reject.
+func (c *Client) CancelOrder(ctx context.Context, orderID string) (*Order, error) {
+	return c.order(ctx, http.MethodPost, "/orders/"+url.PathEscape(orderID)+"/cancel")
+}
+
+func (c *Client) CancelOrderByClientOrderID(ctx context.Context, accountID, clientOrderID string) (*Order, error) {
+	return c.order(ctx, http.MethodPost, clientOrderPath(accountID, clientOrderID)+"/cancel")
+}
+
+// CancelOrders cancels a batch, returning one result per input in order.
+func (c *Client) CancelOrders(ctx context.Context, inputs []CancelOrderInput) ([]CancelOrderResult, error) {
+	var output batchCancelOutput
+
+	_, err := c.do(ctx, http.MethodPost, "/orders/cancel-batch", batchCancelInput{Orders: inputs}, true, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]CancelOrderResult, len(output.Results))
+
+	for i, result := range output.Results {
+		if result.Error != nil {
+			results[i].Err = errorFromResponse(result.Error)
+
+			continue
+		}
+
+		results[i].Order = &result.Result.Order
+	}
+
+	return results, nil
+}
+
+// CancelAll cancels every resting order of the account, optionally only
+// those of one instrument and/or side, and returns them.
+func (c *Client) CancelAll(ctx context.Context, accountID, instrument, side string) ([]Order, error) {
+	query := url.Values{}
+
+	if instrument != "" {
+		query.Set("instrument", instrument)
+	}
+
+	if side != "" {
+		query.Set("side", side)
+	}
+
+	path := "/accounts/" + url.PathEscape(accountID) + "/orders/cancel-all"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var output massCancelOutput
+
+	_, err := c.do(ctx, http.MethodPost, path, nil, true, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Orders, nil
+}
+
+func (c *Client) order(ctx context.Context, method, path string) (*Order, error) {
+	var output orderOutput
+
+	_, err := c.do(ctx, method, path, nil, true, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output.Order, nil
+}
+
+func newPlaceOrderOutput(output *placeOrderOutput, status int) *PlaceOrderOutput {
+	return &PlaceOrderOutput{
+		Order:    output.Order,
+		Trades:   output.Report.Trades,
+		Replayed: status == http.StatusOK,
+	}
+}
+
+func clientOrderPath(accountID, clientOrderID string) string {
+	return "/accounts/" + url.PathEscape(accountID) + "/client-orders/" + url.PathEscape(clientOrderID)
+}
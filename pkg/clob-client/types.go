@@ -0,0 +1,121 @@
+package clobClient
+
+import "time"
+
+type Balance struct {
+	Available int64 `json:"available"`
+	Reserved  int64 `json:"reserved"`
+}
+
+type Account struct {
+	Balances        map[string]Balance `json:"balances"`
+	AccountID       string             `json:"account_id"`
+	ParentAccountID string             `json:"parent_account_id,omitempty"`
+	SubAccountIDs   []string           `json:"sub_account_ids,omitempty"`
+}
+
+type AggregatedAccount struct {
+	Balances    map[string]Balance `json:"balances"`
+	AccountID   string             `json:"account_id"`
+	SubAccounts []Account          `json:"sub_accounts"`
+}
+
+// CreatedAccount carries the account's first API key; the secret is only
+// ever returned here.
+type CreatedAccount struct {
+	AccountID string `json:"account_id"`
+	APIKey    string `json:"api_key"`
+	APISecret string `json:"api_secret"`
+}
+
+type SubAccount struct {
+	AccountID       string `json:"account_id"`
+	ParentAccountID string `json:"parent_account_id"`
+}
+
+type Order struct {
+	CreatedAt     time.Time `json:"created_at"`
+	ID            string    `json:"id"`
+	AccountID     string    `json:"account_id"`
+	ClientOrderID string    `json:"client_order_id,omitempty"`
+	Instrument    string    `json:"instrument"`
+	Side          string    `json:"side"`
+	Price         int64     `json:"price"`
+	Qty           int64     `json:"qty"`
+	Remaining     int64     `json:"remaining"`
+}
+
+type Trade struct {
+	ExecutedAt    time.Time `json:"executed_at,omitzero"`
+	TradeID       string    `json:"trade_id"`
+	Instrument    string    `json:"instrument,omitempty"`
+	TakerOrderID  string    `json:"taker_order_id"`
+	MakerOrderID  string    `json:"maker_order_id"`
+	BuyerID       string    `json:"buyer_id"`
+	SellerID      string    `json:"seller_id"`
+	MakerFeeAsset string    `json:"maker_fee_asset,omitempty"`
+	TakerFeeAsset string    `json:"taker_fee_asset,omitempty"`
+	Price         int64     `json:"price"`
+	Qty           int64     `json:"qty"`
+	MakerFee      int64     `json:"maker_fee"`
+	TakerFee      int64     `json:"taker_fee"`
+}
+
+type PlaceOrderInput struct {
+	AccountID     string `json:"account_id"`
+	ClientOrderID string `json:"client_order_id,omitempty"`
+	Instrument    string `json:"instrument"`
+	Side          string `json:"side"`
+	Price         int64  `json:"price"`
+	Qty           int64  `json:"qty"`
+}
+
+// PlaceOrderOutput is the order as placed and the trades it took part in.
+// Replayed is set when the client order id matched an earlier order, whose
+// state is returned instead of placing again.
+type PlaceOrderOutput struct {
+	Order    Order   `json:"order"`
+	Trades   []Trade `json:"trades"`
+	Replayed bool    `json:"replayed"`
+}
+
+// PlaceOrderResult is one entry of a batch place; Err is an *APIError when
+// the order was rejected.
+type PlaceOrderResult struct {
+	Output *PlaceOrderOutput
+	Err    error
+}
+
+// CancelOrderInput identifies an order by OrderID or by AccountID and
+// ClientOrderID.
+type CancelOrderInput struct {
+	OrderID       string `json:"order_id,omitempty"`
+	AccountID     string `json:"account_id,omitempty"`
+	ClientOrderID string `json:"client_order_id,omitempty"`
+}
+
+// CancelOrderResult is one entry of a batch cancel; Err is an *APIError when
+// the cancel failed.
+type CancelOrderResult struct {
+	Order *Order
+	Err   error
+}
+
+type Level struct {
+	Price int64 `json:"price"`
+	Qty   int64 `json:"qty"`
+}
+
+type Book struct {
+	Instrument string  `json:"instrument"`
+	Bids       []Level `json:"bids"`
+	Asks       []Level `json:"asks"`
+	LastPrice  int64   `json:"last_price"`
+	Halted     bool    `json:"halted"`
+}
+
+type ListTradesInput struct {
+	AccountID  string
+	Instrument string
+	Limit      int
+}